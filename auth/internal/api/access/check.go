@@ -0,0 +1,24 @@
+package access
+
+import (
+	"auth/internal/utils"
+	desc "auth/pkg/access_v1"
+	"context"
+)
+
+func (i *Implementation) Check(ctx context.Context, req *desc.CheckRequest) (*desc.CheckResponse, error) {
+	accessToken, err := utils.AccessTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := i.accessService.Check(ctx, accessToken, req.GetEndpointAddress())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.CheckResponse{
+		UserId:   claims.UserID,
+		Username: claims.Name,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package access
+
+import (
+	"auth/internal/model"
+	"auth/internal/utils"
+	desc "auth/pkg/access_v1"
+	"context"
+)
+
+func (i *Implementation) CheckPermission(ctx context.Context, req *desc.CheckPermissionRequest) (*desc.CheckResponse, error) {
+	accessToken, err := utils.AccessTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := i.accessService.CheckPermission(ctx, accessToken, model.Permission(req.GetPermission()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.CheckResponse{
+		UserId:   claims.UserID,
+		Username: claims.Name,
+	}, nil
+}
+
+func (i *Implementation) ListPermissions(ctx context.Context, _ *desc.ListPermissionsRequest) (*desc.ListPermissionsResponse, error) {
+	accessToken, err := utils.AccessTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := i.accessService.ListPermissions(ctx, accessToken)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	res := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		res = append(res, string(permission))
+	}
+
+	return &desc.ListPermissionsResponse{
+		Permissions: res,
+	}, nil
+}
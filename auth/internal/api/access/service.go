@@ -0,0 +1,17 @@
+package access
+
+import (
+	"auth/internal/service"
+	desc "auth/pkg/access_v1"
+)
+
+type Implementation struct {
+	desc.UnimplementedAccessV1Server
+	accessService service.AccessService
+}
+
+func NewImplementation(accessService service.AccessService) *Implementation {
+	return &Implementation{
+		accessService: accessService,
+	}
+}
@@ -0,0 +1,195 @@
+package access_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auth/internal/api/access"
+	"auth/internal/model"
+	"auth/internal/repository/mocks"
+	accessService "auth/internal/service/access"
+	"auth/internal/utils"
+	desc "auth/pkg/access_v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestImplementation_Check(t *testing.T) {
+	type accessRepositoryMockFunc func(mc *minimock.Controller) *mocks.AccessRepositoryMock
+
+	type args struct {
+		ctx context.Context
+		req *desc.CheckRequest
+	}
+
+	var (
+		mc        = minimock.NewController(t)
+		jwtConfig = &jwtConfigMock{}
+
+		endpoint = "/chat_server_v1.ChatServerV1/Delete"
+
+		user = &model.User{
+			ID: 1,
+			Info: model.UserInfo{
+				Name:  "test_user",
+				Email: "test@example.com",
+				Role:  model.RoleUser,
+			},
+		}
+
+		accessToken, _  = utils.GenerateToken(user, jwtConfig.AccessTokenSecretKey(), time.Hour)
+		refreshToken, _ = utils.GenerateToken(user, jwtConfig.RefreshTokenSecretKey(), time.Hour)
+
+		withToken = func(token string) context.Context {
+			return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		}
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 *desc.CheckResponse
+		code                 codes.Code
+		err                  error
+		accessRepositoryMock accessRepositoryMockFunc
+	}{
+		{
+			name: "role holds the permission",
+			args: args{
+				ctx: withToken(accessToken),
+				req: &desc.CheckRequest{EndpointAddress: endpoint},
+			},
+			want: &desc.CheckResponse{UserId: 1, Username: "test_user"},
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Expect(minimock.AnyContext, endpoint).Return([]model.Permission{model.PermissionChatDeleteAny, model.PermissionUserList}, nil)
+				mock.GetRolePermissionsMock.Expect(minimock.AnyContext, model.RoleUser).Return([]model.Permission{model.PermissionUserList}, nil)
+				return mock
+			},
+		},
+		{
+			name: "endpoint without permissions",
+			args: args{
+				ctx: withToken(accessToken),
+				req: &desc.CheckRequest{EndpointAddress: endpoint},
+			},
+			want: &desc.CheckResponse{UserId: 1, Username: "test_user"},
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "role lacks the permission",
+			args: args{
+				ctx: withToken(accessToken),
+				req: &desc.CheckRequest{EndpointAddress: endpoint},
+			},
+			code: codes.PermissionDenied,
+			err:  errors.New("access denied"),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Return([]model.Permission{model.PermissionChatDeleteAny}, nil)
+				mock.GetRolePermissionsMock.Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "missing metadata",
+			args: args{
+				ctx: context.Background(),
+				req: &desc.CheckRequest{EndpointAddress: endpoint},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("metadata is not provided"),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				return mocks.NewAccessRepositoryMock(mc)
+			},
+		},
+		{
+			name: "missing bearer prefix",
+			args: args{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", accessToken)),
+				req: &desc.CheckRequest{EndpointAddress: endpoint},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("invalid authorization header format"),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				return mocks.NewAccessRepositoryMock(mc)
+			},
+		},
+		{
+			name: "refresh token instead of access token",
+			args: args{
+				ctx: withToken(refreshToken),
+				req: &desc.CheckRequest{EndpointAddress: endpoint},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("invalid access token"),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				return mocks.NewAccessRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repository error",
+			args: args{
+				ctx: withToken(accessToken),
+				req: &desc.CheckRequest{EndpointAddress: endpoint},
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+		{
+			name: "role permissions error",
+			args: args{
+				ctx: withToken(accessToken),
+				req: &desc.CheckRequest{EndpointAddress: endpoint},
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Return([]model.Permission{model.PermissionChatDeleteAny}, nil)
+				mock.GetRolePermissionsMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := accessService.NewService(
+				tt.accessRepositoryMock(mc),
+				jwtConfig,
+			)
+
+			api := access.NewImplementation(service)
+
+			resp, err := api.Check(tt.args.ctx, tt.args.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package access_test
+
+import (
+	"time"
+)
+
+// jwtConfigMock is a static JWTConfig for tests
+type jwtConfigMock struct{}
+
+func (c *jwtConfigMock) AccessTokenSecretKey() []byte {
+	return []byte("access-secret")
+}
+
+func (c *jwtConfigMock) RefreshTokenSecretKey() []byte {
+	return []byte("refresh-secret")
+}
+
+func (c *jwtConfigMock) AccessTokenExpiration() time.Duration {
+	return 5 * time.Minute
+}
+
+func (c *jwtConfigMock) RefreshTokenExpiration() time.Duration {
+	return time.Hour
+}
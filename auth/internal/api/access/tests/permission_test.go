@@ -0,0 +1,180 @@
+package access_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auth/internal/api/access"
+	"auth/internal/model"
+	"auth/internal/repository/mocks"
+	accessService "auth/internal/service/access"
+	"auth/internal/utils"
+	desc "auth/pkg/access_v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestImplementation_CheckPermission(t *testing.T) {
+	type accessRepositoryMockFunc func(mc *minimock.Controller) *mocks.AccessRepositoryMock
+
+	type args struct {
+		ctx context.Context
+		req *desc.CheckPermissionRequest
+	}
+
+	var (
+		mc        = minimock.NewController(t)
+		jwtConfig = &jwtConfigMock{}
+
+		admin = &model.User{ID: 1, Info: model.UserInfo{Name: "admin", Role: model.RoleAdmin}}
+
+		accessToken, _ = utils.GenerateToken(admin, jwtConfig.AccessTokenSecretKey(), time.Hour)
+
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+accessToken))
+
+		adminPermissions = []model.Permission{model.PermissionChatDeleteAny, model.PermissionUserList}
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		want                 *desc.CheckResponse
+		code                 codes.Code
+		err                  error
+		accessRepositoryMock accessRepositoryMockFunc
+	}{
+		{
+			name: "role holds the permission",
+			args: args{
+				ctx: ctx,
+				req: &desc.CheckPermissionRequest{Permission: "chat.delete_any"},
+			},
+			want: &desc.CheckResponse{UserId: 1, Username: "admin"},
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetRolePermissionsMock.Expect(ctx, model.RoleAdmin).Return(adminPermissions, nil)
+				return mock
+			},
+		},
+		{
+			name: "role lacks the permission",
+			args: args{
+				ctx: ctx,
+				req: &desc.CheckPermissionRequest{Permission: "user.set_role"},
+			},
+			code: codes.PermissionDenied,
+			err:  errors.New("access denied"),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetRolePermissionsMock.Expect(ctx, model.RoleAdmin).Return(adminPermissions, nil)
+				return mock
+			},
+		},
+		{
+			name: "empty permission",
+			args: args{
+				ctx: ctx,
+				req: &desc.CheckPermissionRequest{},
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("permission is required"),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				return mocks.NewAccessRepositoryMock(mc)
+			},
+		},
+		{
+			name: "invalid token",
+			args: args{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer not-a-token")),
+				req: &desc.CheckPermissionRequest{Permission: "chat.delete_any"},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("invalid access token"),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				return mocks.NewAccessRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repository error",
+			args: args{
+				ctx: ctx,
+				req: &desc.CheckPermissionRequest{Permission: "chat.delete_any"},
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetRolePermissionsMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := access.NewImplementation(accessService.NewService(tt.accessRepositoryMock(mc), jwtConfig))
+
+			resp, err := api.CheckPermission(tt.args.ctx, tt.args.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, resp)
+			}
+		})
+	}
+}
+
+func TestImplementation_ListPermissions(t *testing.T) {
+	var (
+		mc        = minimock.NewController(t)
+		jwtConfig = &jwtConfigMock{}
+
+		user = &model.User{ID: 2, Info: model.UserInfo{Name: "test_user", Role: model.RoleUser}}
+
+		accessToken, _ = utils.GenerateToken(user, jwtConfig.AccessTokenSecretKey(), time.Hour)
+
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+accessToken))
+	)
+
+	t.Run("permissions of the caller's role", func(t *testing.T) {
+		repo := mocks.NewAccessRepositoryMock(mc)
+		repo.GetRolePermissionsMock.Expect(ctx, model.RoleUser).Return([]model.Permission{model.PermissionUserList}, nil)
+
+		api := access.NewImplementation(accessService.NewService(repo, jwtConfig))
+
+		resp, err := api.ListPermissions(ctx, &desc.ListPermissionsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"user.list"}, resp.GetPermissions())
+	})
+
+	t.Run("role without permissions", func(t *testing.T) {
+		repo := mocks.NewAccessRepositoryMock(mc)
+		repo.GetRolePermissionsMock.Return(nil, nil)
+
+		api := access.NewImplementation(accessService.NewService(repo, jwtConfig))
+
+		resp, err := api.ListPermissions(ctx, &desc.ListPermissionsRequest{})
+		require.NoError(t, err)
+		require.Empty(t, resp.GetPermissions())
+	})
+
+	t.Run("missing metadata", func(t *testing.T) {
+		api := access.NewImplementation(accessService.NewService(mocks.NewAccessRepositoryMock(mc), jwtConfig))
+
+		_, err := api.ListPermissions(context.Background(), &desc.ListPermissionsRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
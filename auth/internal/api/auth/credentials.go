@@ -0,0 +1,33 @@
+package auth
+
+import (
+	desc "auth/pkg/auth_v1"
+	"context"
+	"log"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) VerifyCredentials(ctx context.Context, req *desc.VerifyCredentialsRequest) (*desc.VerifyCredentialsResponse, error) {
+	user, err := i.authService.VerifyCredentials(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.VerifyCredentialsResponse{
+		UserId:        user.ID,
+		Name:          user.Info.Name,
+		EmailVerified: user.EmailVerifiedAt.Valid,
+	}, nil
+}
+
+func (i *Implementation) UnlockUser(ctx context.Context, req *desc.UnlockUserRequest) (*emptypb.Empty, error) {
+	err := i.authService.UnlockUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	log.Printf("unlocked user with id: %d", req.GetUserId())
+
+	return &emptypb.Empty{}, nil
+}
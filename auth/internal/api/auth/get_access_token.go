@@ -0,0 +1,17 @@
+package auth
+
+import (
+	desc "auth/pkg/auth_v1"
+	"context"
+)
+
+func (i *Implementation) GetAccessToken(ctx context.Context, req *desc.GetAccessTokenRequest) (*desc.GetAccessTokenResponse, error) {
+	accessToken, err := i.authService.GetAccessToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.GetAccessTokenResponse{
+		AccessToken: accessToken,
+	}, nil
+}
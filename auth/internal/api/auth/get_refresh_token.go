@@ -0,0 +1,17 @@
+package auth
+
+import (
+	desc "auth/pkg/auth_v1"
+	"context"
+)
+
+func (i *Implementation) GetRefreshToken(ctx context.Context, req *desc.GetRefreshTokenRequest) (*desc.GetRefreshTokenResponse, error) {
+	refreshToken, err := i.authService.GetRefreshToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.GetRefreshTokenResponse{
+		RefreshToken: refreshToken,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	desc "auth/pkg/auth_v1"
+	"context"
+)
+
+func (i *Implementation) Login(ctx context.Context, req *desc.LoginRequest) (*desc.LoginResponse, error) {
+	tokens, err := i.authService.Login(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.LoginResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"auth/internal/model"
+	desc "auth/pkg/auth_v1"
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) RequestPasswordReset(ctx context.Context, req *desc.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	err := i.authService.RequestPasswordReset(ctx, req.GetEmail())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) ConfirmPasswordReset(ctx context.Context, req *desc.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	err := i.authService.ConfirmPasswordReset(ctx, &model.PasswordResetCommand{
+		Token:    req.GetToken(),
+		Password: req.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"auth/internal/service"
+	desc "auth/pkg/auth_v1"
+)
+
+type Implementation struct {
+	desc.UnimplementedAuthV1Server
+	authService service.AuthService
+}
+
+func NewImplementation(authService service.AuthService) *Implementation {
+	return &Implementation{
+		authService: authService,
+	}
+}
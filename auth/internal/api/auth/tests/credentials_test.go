@@ -0,0 +1,558 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"auth/internal/api/auth"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/repository/mocks"
+	authService "auth/internal/service/auth"
+	desc "auth/pkg/auth_v1"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestImplementation_VerifyCredentials(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000},
+		})
+
+		id       = int64(1)
+		name     = "test_user"
+		email    = "test@example.com"
+		password = "password123"
+
+		hashedPassword, _ = bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+
+		credentials = &model.UserCredentials{
+			User: model.User{
+				ID:              id,
+				Info:            model.UserInfo{Name: name, Email: email, Role: model.RoleUser},
+				EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			},
+			HashedPassword: string(hashedPassword),
+		}
+
+		accountKey = model.ThrottleKey{Scope: model.ThrottleScopeAccount, Subject: email}
+		ipKey      = model.ThrottleKey{Scope: model.ThrottleScopeIP, Subject: "10.0.0.1"}
+
+		repoErr = errors.New("repository error")
+	)
+
+	knownUser := func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+		mock := mocks.NewUserRepositoryMock(mc)
+		mock.GetCredentialsMock.Expect(ctx, email).Return(credentials, nil)
+		return mock
+	}
+
+	tests := []struct {
+		name string
+		req  *desc.VerifyCredentialsRequest
+		want *desc.VerifyCredentialsResponse
+		code codes.Code
+		err  error
+		// blockedFor is the stored state of the keys relative to the
+		// attempt, keys left out have no failures on record.
+		blockedFor map[model.ThrottleKey]time.Duration
+		// recorded are the failures already on record for the keys.
+		recorded map[model.ThrottleKey]int
+		// failures is what recording a failure counts up to for each key,
+		// nil when no failure is expected.
+		failures map[model.ThrottleKey]int
+		// blocks are the expected delays the keys get blocked for.
+		blocks map[model.ThrottleKey]time.Duration
+		// resets are the keys expected to be cleared.
+		resets             []model.ThrottleKey
+		userRepositoryMock userRepositoryMockFunc
+		logRepositoryMock  logRepositoryMockFunc
+	}{
+		{
+			name:               "success case",
+			req:                &desc.VerifyCredentialsRequest{Email: email, Password: password},
+			want:               &desc.VerifyCredentialsResponse{UserId: id, Name: name, EmailVerified: true},
+			resets:             []model.ThrottleKey{accountKey},
+			userRepositoryMock: knownUser,
+		},
+		{
+			name:               "success keeps the failures of the client",
+			req:                &desc.VerifyCredentialsRequest{Email: email, Password: password},
+			want:               &desc.VerifyCredentialsResponse{UserId: id, Name: name, EmailVerified: true},
+			recorded:           map[model.ThrottleKey]int{accountKey: 2, ipKey: 7},
+			resets:             []model.ThrottleKey{accountKey},
+			userRepositoryMock: knownUser,
+		},
+		{
+			name:               "expired block",
+			req:                &desc.VerifyCredentialsRequest{Email: email, Password: password},
+			want:               &desc.VerifyCredentialsResponse{UserId: id, Name: name, EmailVerified: true},
+			blockedFor:         map[model.ThrottleKey]time.Duration{accountKey: -time.Minute},
+			resets:             []model.ThrottleKey{accountKey},
+			userRepositoryMock: knownUser,
+		},
+		{
+			name:               "first failure",
+			req:                &desc.VerifyCredentialsRequest{Email: email, Password: "wrong_password"},
+			code:               codes.Unauthenticated,
+			err:                errors.New("invalid email or password"),
+			failures:           map[model.ThrottleKey]int{accountKey: 1, ipKey: 1},
+			blocks:             map[model.ThrottleKey]time.Duration{accountKey: time.Second, ipKey: time.Second},
+			userRepositoryMock: knownUser,
+		},
+		{
+			name:               "backoff doubles with every failure",
+			req:                &desc.VerifyCredentialsRequest{Email: email, Password: "wrong_password"},
+			code:               codes.Unauthenticated,
+			err:                errors.New("invalid email or password"),
+			failures:           map[model.ThrottleKey]int{accountKey: 3, ipKey: 19},
+			blocks:             map[model.ThrottleKey]time.Duration{accountKey: 4 * time.Second, ipKey: time.Minute},
+			userRepositoryMock: knownUser,
+		},
+		{
+			name:               "account locked out",
+			req:                &desc.VerifyCredentialsRequest{Email: email, Password: "wrong_password"},
+			code:               codes.Unauthenticated,
+			err:                errors.New("invalid email or password"),
+			failures:           map[model.ThrottleKey]int{accountKey: 5, ipKey: 5},
+			blocks:             map[model.ThrottleKey]time.Duration{accountKey: 15 * time.Minute, ipKey: 16 * time.Second},
+			userRepositoryMock: knownUser,
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, &logModel.Log{Action: "account_locked", EntityID: id}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:     "client locked out",
+			req:      &desc.VerifyCredentialsRequest{Email: "unknown@example.com", Password: password},
+			code:     codes.Unauthenticated,
+			err:      errors.New("invalid email or password"),
+			failures: map[model.ThrottleKey]int{{Scope: model.ThrottleScopeAccount, Subject: "unknown@example.com"}: 5, ipKey: 20},
+			blocks: map[model.ThrottleKey]time.Duration{
+				{Scope: model.ThrottleScopeAccount, Subject: "unknown@example.com"}: 15 * time.Minute,
+				ipKey: 15 * time.Minute,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name:       "blocked account",
+			req:        &desc.VerifyCredentialsRequest{Email: email, Password: password},
+			code:       codes.ResourceExhausted,
+			err:        errors.New("too many failed attempts, retry in 30s"),
+			blockedFor: map[model.ThrottleKey]time.Duration{accountKey: 30 * time.Second},
+		},
+		{
+			name:       "blocked account under another case",
+			req:        &desc.VerifyCredentialsRequest{Email: " Test@Example.com", Password: password},
+			code:       codes.ResourceExhausted,
+			err:        errors.New("too many failed attempts"),
+			blockedFor: map[model.ThrottleKey]time.Duration{accountKey: time.Minute},
+		},
+		{
+			name:       "blocked client",
+			req:        &desc.VerifyCredentialsRequest{Email: email, Password: password},
+			code:       codes.ResourceExhausted,
+			err:        errors.New("too many failed attempts"),
+			blockedFor: map[model.ThrottleKey]time.Duration{ipKey: 10 * time.Minute},
+		},
+		{
+			name: "repository error",
+			req:  &desc.VerifyCredentialsRequest{Email: email, Password: password},
+			code: codes.Internal,
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				blocked = make(map[model.ThrottleKey]time.Time)
+				reset   []model.ThrottleKey
+			)
+
+			throttleRepo := mocks.NewLoginThrottleRepositoryMock(mc)
+			throttleRepo.LockMock.Set(func(_ context.Context, key model.ThrottleKey) (*model.LoginThrottle, error) {
+				throttle := &model.LoginThrottle{Failures: tt.recorded[key]}
+				if blockedFor, ok := tt.blockedFor[key]; ok {
+					throttle.Failures = max(throttle.Failures, 1)
+					throttle.BlockedUntil = sql.NullTime{Time: time.Now().Add(blockedFor), Valid: true}
+				}
+				return throttle, nil
+			})
+			if tt.failures != nil {
+				throttleRepo.RecordFailureMock.Set(func(_ context.Context, key model.ThrottleKey, at, windowStart time.Time) (int, error) {
+					require.Equal(t, time.Hour, at.Sub(windowStart))
+					return tt.failures[key], nil
+				})
+				throttleRepo.BlockMock.Set(func(_ context.Context, key model.ThrottleKey, until time.Time) error {
+					blocked[key] = until
+					return nil
+				})
+				// Failures left over from an earlier window are dropped.
+				throttleRepo.PruneMock.Set(func(_ context.Context, windowStart, now time.Time) (int64, error) {
+					require.Equal(t, time.Hour, now.Sub(windowStart))
+					return 1, nil
+				})
+			}
+			if tt.resets != nil {
+				throttleRepo.ResetMock.Set(func(_ context.Context, key model.ThrottleKey) error {
+					reset = append(reset, key)
+					return nil
+				})
+			}
+
+			userRepo := mocks.NewUserRepositoryMock(mc)
+			if tt.userRepositoryMock != nil {
+				userRepo = tt.userRepositoryMock(mc)
+			}
+			logRepo := mocks.NewLogRepositoryMock(mc)
+			if tt.logRepositoryMock != nil {
+				logRepo = tt.logRepositoryMock(mc)
+			}
+
+			api := auth.NewImplementation(authService.NewService(
+				userRepo,
+				mocks.NewPasswordResetRepositoryMock(mc),
+				throttleRepo,
+				logRepo,
+				&txManagerMock{},
+				mailerMocks.NewMailerMock(mc),
+				&jwtConfigMock{},
+				&passwordResetConfigMock{},
+				&emailVerificationConfigMock{},
+				&loginThrottleConfigMock{},
+			))
+
+			resp, err := api.VerifyCredentials(ctx, tt.req)
+
+			require.Equal(t, tt.resets, reset)
+			require.Len(t, blocked, len(tt.blocks))
+			for key, delay := range tt.blocks {
+				require.WithinDuration(t, time.Now().Add(delay), blocked[key], time.Second, key)
+			}
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
+
+// Two wrong guesses racing for an account one failure short of its lockout
+// must not both get a password check, the second has to find the account
+// locked.
+func TestImplementation_VerifyCredentials_ConcurrentAttempts(t *testing.T) {
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		id    = int64(1)
+		email = "test@example.com"
+
+		hashedPassword, _ = bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+
+		accountKey = model.ThrottleKey{Scope: model.ThrottleScopeAccount, Subject: email}
+	)
+
+	rows := newThrottleRows(map[model.ThrottleKey]*model.LoginThrottle{
+		accountKey: {Failures: 4, BlockedUntil: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}},
+	})
+
+	throttleRepo := mocks.NewLoginThrottleRepositoryMock(mc)
+	throttleRepo.LockMock.Set(rows.Lock)
+	throttleRepo.RecordFailureMock.Set(rows.RecordFailure)
+	throttleRepo.BlockMock.Set(rows.Block)
+	throttleRepo.PruneMock.Return(0, nil)
+
+	userRepo := mocks.NewUserRepositoryMock(mc)
+	userRepo.GetCredentialsMock.Set(func(_ context.Context, _ string) (*model.UserCredentials, error) {
+		// Hold the first attempt until the second one waits for the account.
+		for range 2 {
+			select {
+			case <-rows.locking:
+			case <-time.After(5 * time.Second):
+				return nil, errors.New("the second attempt never reached the account")
+			}
+		}
+		return &model.UserCredentials{
+			User:           model.User{ID: id, Info: model.UserInfo{Name: "test_user", Email: email, Role: model.RoleUser}},
+			HashedPassword: string(hashedPassword),
+		}, nil
+	})
+
+	logRepo := mocks.NewLogRepositoryMock(mc)
+	logRepo.LogMock.Expect(minimock.AnyContext, &logModel.Log{Action: "account_locked", EntityID: id}).Return(nil)
+
+	api := auth.NewImplementation(authService.NewService(
+		userRepo,
+		mocks.NewPasswordResetRepositoryMock(mc),
+		throttleRepo,
+		logRepo,
+		&lockingTxManagerMock{},
+		mailerMocks.NewMailerMock(mc),
+		&jwtConfigMock{},
+		&passwordResetConfigMock{},
+		&emailVerificationConfigMock{},
+		&loginThrottleConfigMock{},
+	))
+
+	var (
+		wg      sync.WaitGroup
+		results = make(chan codes.Code, 2)
+	)
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := api.VerifyCredentials(ctx, &desc.VerifyCredentialsRequest{Email: email, Password: "wrong_password"})
+			results <- status.Code(err)
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	var got []codes.Code
+	for code := range results {
+		got = append(got, code)
+	}
+	require.ElementsMatch(t, []codes.Code{codes.Unauthenticated, codes.ResourceExhausted}, got)
+	require.Equal(t, uint64(1), throttleRepo.RecordFailureAfterCounter())
+	require.Equal(t, 5, rows.rows[accountKey].Failures)
+}
+
+// Behind a proxy the peer is the proxy, failures are counted against the
+// client address it forwards.
+func TestImplementation_VerifyCredentials_BehindProxy(t *testing.T) {
+	var (
+		mc = minimock.NewController(t)
+
+		email = "unknown@example.com"
+		proxy = peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("169.254.1.1"), Port: 5000},
+		})
+
+		accountKey = model.ThrottleKey{Scope: model.ThrottleScopeAccount, Subject: email}
+	)
+
+	tests := []struct {
+		name string
+		hops int
+		// forwardedFor are the x-forwarded-for values the request arrives with.
+		forwardedFor []string
+		// want are the keys the failure is counted against.
+		want []model.ThrottleKey
+	}{
+		{
+			name:         "client behind one proxy",
+			hops:         1,
+			forwardedFor: []string{"203.0.113.7"},
+			want:         []model.ThrottleKey{accountKey, {Scope: model.ThrottleScopeIP, Subject: "203.0.113.7"}},
+		},
+		{
+			name:         "forged entries are skipped",
+			hops:         1,
+			forwardedFor: []string{"10.0.0.1, 198.51.100.2", "203.0.113.7"},
+			want:         []model.ThrottleKey{accountKey, {Scope: model.ThrottleScopeIP, Subject: "203.0.113.7"}},
+		},
+		{
+			name:         "client behind two proxies",
+			hops:         2,
+			forwardedFor: []string{"10.0.0.1, 203.0.113.7:5000, 192.0.2.1"},
+			want:         []model.ThrottleKey{accountKey, {Scope: model.ThrottleScopeIP, Subject: "203.0.113.7"}},
+		},
+		{
+			name: "nothing forwarded",
+			hops: 1,
+			want: []model.ThrottleKey{accountKey},
+		},
+		{
+			name:         "no proxies trusted",
+			forwardedFor: []string{"203.0.113.7"},
+			want:         []model.ThrottleKey{accountKey, {Scope: model.ThrottleScopeIP, Subject: "169.254.1.1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := proxy
+			if len(tt.forwardedFor) > 0 {
+				md := metadata.MD{}
+				md.Append("x-forwarded-for", tt.forwardedFor...)
+				ctx = metadata.NewIncomingContext(proxy, md)
+			}
+
+			var counted []model.ThrottleKey
+			throttleRepo := mocks.NewLoginThrottleRepositoryMock(mc)
+			throttleRepo.LockMock.Return(&model.LoginThrottle{}, nil)
+			throttleRepo.RecordFailureMock.Set(func(_ context.Context, key model.ThrottleKey, _, _ time.Time) (int, error) {
+				counted = append(counted, key)
+				return 1, nil
+			})
+			throttleRepo.BlockMock.Return(nil)
+			throttleRepo.PruneMock.Return(0, nil)
+
+			userRepo := mocks.NewUserRepositoryMock(mc)
+			userRepo.GetCredentialsMock.Return(nil, repository.ErrNotFound)
+
+			api := auth.NewImplementation(authService.NewService(
+				userRepo,
+				mocks.NewPasswordResetRepositoryMock(mc),
+				throttleRepo,
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				mailerMocks.NewMailerMock(mc),
+				&jwtConfigMock{},
+				&passwordResetConfigMock{},
+				&emailVerificationConfigMock{},
+				&loginThrottleConfigMock{trustedProxyHops: tt.hops},
+			))
+
+			_, err := api.VerifyCredentials(ctx, &desc.VerifyCredentialsRequest{Email: email, Password: "wrong_password"})
+			require.Equal(t, codes.Unauthenticated, status.Code(err))
+			require.Equal(t, tt.want, counted)
+		})
+	}
+}
+
+func TestImplementation_UnlockUser(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id   = int64(1)
+		user = &model.User{ID: id, Info: model.UserInfo{Name: "test_user", Email: "Test@Example.com", Role: model.RoleUser}}
+
+		accountKey = model.ThrottleKey{Scope: model.ThrottleScopeAccount, Subject: "test@example.com"}
+
+		req = &desc.UnlockUserRequest{UserId: id}
+
+		logErr = errors.New("log error")
+	)
+
+	findUser := func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+		mock := mocks.NewUserRepositoryMock(mc)
+		mock.GetMock.Expect(ctx, id).Return(user, nil)
+		return mock
+	}
+	reset := func(mc *minimock.Controller) *mocks.LoginThrottleRepositoryMock {
+		mock := mocks.NewLoginThrottleRepositoryMock(mc)
+		mock.ResetMock.Expect(ctx, accountKey).Return(nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                        string
+		code                        codes.Code
+		err                         error
+		userRepositoryMock          func(mc *minimock.Controller) *mocks.UserRepositoryMock
+		loginThrottleRepositoryMock func(mc *minimock.Controller) *mocks.LoginThrottleRepositoryMock
+		logRepositoryMock           func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	}{
+		{
+			name:                        "success case",
+			userRepositoryMock:          findUser,
+			loginThrottleRepositoryMock: reset,
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, &logModel.Log{Action: "account_unlocked", EntityID: id}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "user not found",
+			code: codes.NotFound,
+			err:  errors.New("user not found"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name:                        "log error",
+			code:                        codes.Internal,
+			err:                         logErr,
+			userRepositoryMock:          findUser,
+			loginThrottleRepositoryMock: reset,
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(logErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttleRepo := mocks.NewLoginThrottleRepositoryMock(mc)
+			if tt.loginThrottleRepositoryMock != nil {
+				throttleRepo = tt.loginThrottleRepositoryMock(mc)
+			}
+			logRepo := mocks.NewLogRepositoryMock(mc)
+			if tt.logRepositoryMock != nil {
+				logRepo = tt.logRepositoryMock(mc)
+			}
+
+			api := auth.NewImplementation(authService.NewService(
+				tt.userRepositoryMock(mc),
+				mocks.NewPasswordResetRepositoryMock(mc),
+				throttleRepo,
+				logRepo,
+				&txManagerMock{},
+				mailerMocks.NewMailerMock(mc),
+				&jwtConfigMock{},
+				&passwordResetConfigMock{},
+				&emailVerificationConfigMock{},
+				&loginThrottleConfigMock{},
+			))
+
+			resp, err := api.UnlockUser(ctx, req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auth/internal/api/auth"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/repository/mocks"
+	authService "auth/internal/service/auth"
+	"auth/internal/utils"
+	desc "auth/pkg/auth_v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImplementation_GetAccessToken(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+
+	type args struct {
+		ctx context.Context
+		req *desc.GetAccessTokenRequest
+	}
+
+	var (
+		ctx       = context.Background()
+		mc        = minimock.NewController(t)
+		jwtConfig = &jwtConfigMock{}
+
+		id = int64(1)
+
+		user = &model.User{
+			ID: id,
+			Info: model.UserInfo{
+				Name:  "test_user",
+				Email: "test@example.com",
+				Role:  model.RoleUser,
+			},
+		}
+
+		// The role changed after the refresh token was issued.
+		promotedUser = &model.User{
+			ID: id,
+			Info: model.UserInfo{
+				Name:  "test_user",
+				Email: "test@example.com",
+				Role:  model.RoleAdmin,
+			},
+		}
+
+		refreshToken, _ = utils.GenerateToken(user, jwtConfig.RefreshTokenSecretKey(), time.Hour)
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		wantRole           model.Role
+		code               codes.Code
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetAccessTokenRequest{RefreshToken: refreshToken},
+			},
+			wantRole: model.RoleAdmin,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(promotedUser, nil)
+				return mock
+			},
+		},
+		{
+			name: "malformed token",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetAccessTokenRequest{RefreshToken: "not a token"},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("invalid refresh token"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := authService.NewService(
+				tt.userRepositoryMock(mc),
+				mocks.NewPasswordResetRepositoryMock(mc),
+				mocks.NewLoginThrottleRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				mailerMocks.NewMailerMock(mc),
+				jwtConfig,
+				&passwordResetConfigMock{},
+				&emailVerificationConfigMock{},
+				&loginThrottleConfigMock{},
+			)
+
+			api := auth.NewImplementation(service)
+
+			resp, err := api.GetAccessToken(tt.args.ctx, tt.args.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+
+			claims, err := utils.VerifyToken(resp.GetAccessToken(), jwtConfig.AccessTokenSecretKey())
+			require.NoError(t, err)
+			require.Equal(t, id, claims.UserID)
+			require.Equal(t, tt.wantRole, claims.Role)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auth/internal/api/auth"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/repository/mocks"
+	authService "auth/internal/service/auth"
+	"auth/internal/utils"
+	desc "auth/pkg/auth_v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImplementation_GetRefreshToken(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+
+	type args struct {
+		ctx context.Context
+		req *desc.GetRefreshTokenRequest
+	}
+
+	var (
+		ctx       = context.Background()
+		mc        = minimock.NewController(t)
+		jwtConfig = &jwtConfigMock{}
+
+		id = int64(1)
+
+		user = &model.User{
+			ID: id,
+			Info: model.UserInfo{
+				Name:  "test_user",
+				Email: "test@example.com",
+				Role:  model.RoleUser,
+			},
+		}
+
+		refreshToken, _ = utils.GenerateToken(user, jwtConfig.RefreshTokenSecretKey(), time.Hour)
+		accessToken, _  = utils.GenerateToken(user, jwtConfig.AccessTokenSecretKey(), time.Hour)
+		expiredToken, _ = utils.GenerateToken(user, jwtConfig.RefreshTokenSecretKey(), -time.Minute)
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		code               codes.Code
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+		// verificationRequired keeps unverified users out.
+		verificationRequired bool
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetRefreshTokenRequest{RefreshToken: refreshToken},
+			},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(user, nil)
+				return mock
+			},
+		},
+		{
+			name: "access token instead of refresh token",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetRefreshTokenRequest{RefreshToken: accessToken},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("invalid refresh token"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "expired token",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetRefreshTokenRequest{RefreshToken: expiredToken},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("invalid refresh token"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "user deleted",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetRefreshTokenRequest{RefreshToken: refreshToken},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("invalid refresh token"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "password changed since the token was issued",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetRefreshTokenRequest{RefreshToken: refreshToken},
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("invalid refresh token"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				changed := *user
+				changed.SessionVersion++
+
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(&changed, nil)
+				return mock
+			},
+		},
+		{
+			name: "unverified email while verification is required",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetRefreshTokenRequest{RefreshToken: refreshToken},
+			},
+			code:                 codes.FailedPrecondition,
+			err:                  errors.New("email is not verified"),
+			verificationRequired: true,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(user, nil)
+				return mock
+			},
+		},
+		{
+			name: "repository error",
+			args: args{
+				ctx: ctx,
+				req: &desc.GetRefreshTokenRequest{RefreshToken: refreshToken},
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := authService.NewService(
+				tt.userRepositoryMock(mc),
+				mocks.NewPasswordResetRepositoryMock(mc),
+				mocks.NewLoginThrottleRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				mailerMocks.NewMailerMock(mc),
+				jwtConfig,
+				&passwordResetConfigMock{},
+				&emailVerificationConfigMock{required: tt.verificationRequired},
+				&loginThrottleConfigMock{},
+			)
+
+			api := auth.NewImplementation(service)
+
+			resp, err := api.GetRefreshToken(tt.args.ctx, tt.args.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+
+			claims, err := utils.VerifyToken(resp.GetRefreshToken(), jwtConfig.RefreshTokenSecretKey())
+			require.NoError(t, err)
+			require.Equal(t, id, claims.UserID)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package auth_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"auth/internal/model"
+
+	"github.com/makxtr/go-common/pkg/db"
+)
+
+// jwtConfigMock is a static JWTConfig for tests
+type jwtConfigMock struct{}
+
+func (c *jwtConfigMock) AccessTokenSecretKey() []byte {
+	return []byte("access-secret")
+}
+
+func (c *jwtConfigMock) RefreshTokenSecretKey() []byte {
+	return []byte("refresh-secret")
+}
+
+func (c *jwtConfigMock) AccessTokenExpiration() time.Duration {
+	return 5 * time.Minute
+}
+
+func (c *jwtConfigMock) RefreshTokenExpiration() time.Duration {
+	return time.Hour
+}
+
+// passwordResetConfigMock is a static PasswordResetConfig for tests
+type passwordResetConfigMock struct{}
+
+func (c *passwordResetConfigMock) TokenTTL() time.Duration {
+	return time.Hour
+}
+
+func (c *passwordResetConfigMock) ResendInterval() time.Duration {
+	return time.Minute
+}
+
+// emailVerificationConfigMock is a static EmailVerificationConfig for tests,
+// required makes unverified users unable to sign in.
+type emailVerificationConfigMock struct {
+	required bool
+}
+
+func (c *emailVerificationConfigMock) TokenTTL() time.Duration {
+	return 24 * time.Hour
+}
+
+func (c *emailVerificationConfigMock) ResendInterval() time.Duration {
+	return time.Minute
+}
+
+func (c *emailVerificationConfigMock) Required() bool {
+	return c.required
+}
+
+// loginThrottleConfigMock is a static LoginThrottleConfig for tests,
+// trustedProxyHops puts the service behind proxies.
+type loginThrottleConfigMock struct {
+	trustedProxyHops int
+}
+
+func (c *loginThrottleConfigMock) MaxAccountFailures() int {
+	return 5
+}
+
+func (c *loginThrottleConfigMock) MaxIPFailures() int {
+	return 20
+}
+
+func (c *loginThrottleConfigMock) BackoffBase() time.Duration {
+	return time.Second
+}
+
+func (c *loginThrottleConfigMock) BackoffMax() time.Duration {
+	return time.Minute
+}
+
+func (c *loginThrottleConfigMock) LockoutDuration() time.Duration {
+	return 15 * time.Minute
+}
+
+func (c *loginThrottleConfigMock) FailureWindow() time.Duration {
+	return time.Hour
+}
+
+func (c *loginThrottleConfigMock) TrustedProxyHops() int {
+	return c.trustedProxyHops
+}
+
+// txManagerMock is a simple mock for TxManager that executes the function without transaction
+type txManagerMock struct{}
+
+func (tm *txManagerMock) ReadCommitted(ctx context.Context, fn db.Handler) error {
+	return fn(ctx)
+}
+
+type heldLocksKey struct{}
+
+// lockingTxManagerMock releases the throttle rows locked by a transaction
+// once it ends, as the database does.
+type lockingTxManagerMock struct{}
+
+func (tm *lockingTxManagerMock) ReadCommitted(ctx context.Context, fn db.Handler) error {
+	if _, ok := ctx.Value(heldLocksKey{}).(*[]*sync.Mutex); ok {
+		return fn(ctx)
+	}
+
+	var held []*sync.Mutex
+	defer func() {
+		for _, lock := range held {
+			lock.Unlock()
+		}
+	}()
+
+	return fn(context.WithValue(ctx, heldLocksKey{}, &held))
+}
+
+// throttleRows stands in for the login_throttles table, Lock holds the key
+// until the transaction ends. Every call to Lock is announced on locking
+// before it waits for the key.
+type throttleRows struct {
+	mu      sync.Mutex
+	rows    map[model.ThrottleKey]*model.LoginThrottle
+	locks   map[model.ThrottleKey]*sync.Mutex
+	locking chan model.ThrottleKey
+}
+
+func newThrottleRows(rows map[model.ThrottleKey]*model.LoginThrottle) *throttleRows {
+	return &throttleRows{
+		rows:    rows,
+		locks:   make(map[model.ThrottleKey]*sync.Mutex),
+		locking: make(chan model.ThrottleKey, 16),
+	}
+}
+
+func (r *throttleRows) Lock(ctx context.Context, key model.ThrottleKey) (*model.LoginThrottle, error) {
+	r.mu.Lock()
+	lock, ok := r.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		r.locks[key] = lock
+	}
+	r.mu.Unlock()
+
+	r.locking <- key
+	lock.Lock()
+	held := ctx.Value(heldLocksKey{}).(*[]*sync.Mutex)
+	*held = append(*held, lock)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	row, ok := r.rows[key]
+	if !ok {
+		return &model.LoginThrottle{}, nil
+	}
+	throttle := *row
+	return &throttle, nil
+}
+
+func (r *throttleRows) RecordFailure(_ context.Context, key model.ThrottleKey, at, _ time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	row, ok := r.rows[key]
+	if !ok {
+		row = &model.LoginThrottle{}
+		r.rows[key] = row
+	}
+	row.Failures++
+	row.LastFailureAt = at
+	return row.Failures, nil
+}
+
+func (r *throttleRows) Block(_ context.Context, key model.ThrottleKey, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	row := r.rows[key]
+	row.BlockedUntil.Time, row.BlockedUntil.Valid = until, true
+	return nil
+}
@@ -0,0 +1,253 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"auth/internal/api/auth"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/repository/mocks"
+	authService "auth/internal/service/auth"
+	"auth/internal/utils"
+	desc "auth/pkg/auth_v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImplementation_Login(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+	type loginThrottleRepositoryMockFunc func(mc *minimock.Controller) *mocks.LoginThrottleRepositoryMock
+
+	type args struct {
+		ctx context.Context
+		req *desc.LoginRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id       = int64(1)
+		name     = "test_user"
+		email    = "test@example.com"
+		password = "password123"
+
+		hashedPassword, _ = bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+
+		credentials = &model.UserCredentials{
+			User: model.User{
+				ID: id,
+				Info: model.UserInfo{
+					Name:  name,
+					Email: email,
+					Role:  model.RoleAdmin,
+				},
+			},
+			HashedPassword: string(hashedPassword),
+		}
+
+		verifiedCredentials = &model.UserCredentials{
+			User: model.User{
+				ID:              id,
+				Info:            credentials.User.Info,
+				EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			},
+			HashedPassword: string(hashedPassword),
+		}
+
+		accountKey = model.ThrottleKey{Scope: model.ThrottleScopeAccount, Subject: email}
+
+		repoErr = errors.New("repository error")
+	)
+
+	// The context carries no peer, so only the account is throttled.
+	succeed := func(mc *minimock.Controller) *mocks.LoginThrottleRepositoryMock {
+		mock := mocks.NewLoginThrottleRepositoryMock(mc)
+		mock.LockMock.Expect(ctx, accountKey).Return(&model.LoginThrottle{}, nil)
+		mock.ResetMock.Expect(ctx, accountKey).Return(nil)
+		return mock
+	}
+	fail := func(mc *minimock.Controller) *mocks.LoginThrottleRepositoryMock {
+		mock := mocks.NewLoginThrottleRepositoryMock(mc)
+		mock.LockMock.Return(&model.LoginThrottle{}, nil)
+		mock.RecordFailureMock.Return(1, nil)
+		mock.BlockMock.Return(nil)
+		mock.PruneMock.Return(0, nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                        string
+		args                        args
+		code                        codes.Code
+		err                         error
+		userRepositoryMock          userRepositoryMockFunc
+		loginThrottleRepositoryMock loginThrottleRepositoryMockFunc
+		// verificationRequired keeps unverified users out.
+		verificationRequired bool
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: &desc.LoginRequest{Email: email, Password: password},
+			},
+			loginThrottleRepositoryMock: succeed,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Expect(ctx, email).Return(credentials, nil)
+				return mock
+			},
+		},
+		{
+			name: "verified email while verification is required",
+			args: args{
+				ctx: ctx,
+				req: &desc.LoginRequest{Email: email, Password: password},
+			},
+			verificationRequired:        true,
+			loginThrottleRepositoryMock: succeed,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Expect(ctx, email).Return(verifiedCredentials, nil)
+				return mock
+			},
+		},
+		{
+			name: "unverified email while verification is required",
+			args: args{
+				ctx: ctx,
+				req: &desc.LoginRequest{Email: email, Password: password},
+			},
+			code:                        codes.FailedPrecondition,
+			err:                         errors.New("email is not verified"),
+			verificationRequired:        true,
+			loginThrottleRepositoryMock: succeed,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Expect(ctx, email).Return(credentials, nil)
+				return mock
+			},
+		},
+		{
+			name: "wrong password of an unverified email",
+			args: args{
+				ctx: ctx,
+				req: &desc.LoginRequest{Email: email, Password: "wrong_password"},
+			},
+			code:                        codes.Unauthenticated,
+			err:                         errors.New("invalid email or password"),
+			verificationRequired:        true,
+			loginThrottleRepositoryMock: fail,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Expect(ctx, email).Return(credentials, nil)
+				return mock
+			},
+		},
+		{
+			name: "wrong password",
+			args: args{
+				ctx: ctx,
+				req: &desc.LoginRequest{Email: email, Password: "wrong_password"},
+			},
+			code:                        codes.Unauthenticated,
+			err:                         errors.New("invalid email or password"),
+			loginThrottleRepositoryMock: fail,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Expect(ctx, email).Return(credentials, nil)
+				return mock
+			},
+		},
+		{
+			name: "unknown email",
+			args: args{
+				ctx: ctx,
+				req: &desc.LoginRequest{Email: "unknown@example.com", Password: password},
+			},
+			code:                        codes.Unauthenticated,
+			err:                         errors.New("invalid email or password"),
+			loginThrottleRepositoryMock: fail,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Expect(ctx, "unknown@example.com").Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "repository error",
+			args: args{
+				ctx: ctx,
+				req: &desc.LoginRequest{Email: email, Password: password},
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			loginThrottleRepositoryMock: func(mc *minimock.Controller) *mocks.LoginThrottleRepositoryMock {
+				mock := mocks.NewLoginThrottleRepositoryMock(mc)
+				mock.LockMock.Return(&model.LoginThrottle{}, nil)
+				return mock
+			},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsMock.Expect(ctx, email).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwtConfig := &jwtConfigMock{}
+
+			service := authService.NewService(
+				tt.userRepositoryMock(mc),
+				mocks.NewPasswordResetRepositoryMock(mc),
+				tt.loginThrottleRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				mailerMocks.NewMailerMock(mc),
+				jwtConfig,
+				&passwordResetConfigMock{},
+				&emailVerificationConfigMock{required: tt.verificationRequired},
+				&loginThrottleConfigMock{},
+			)
+
+			api := auth.NewImplementation(service)
+
+			resp, err := api.Login(tt.args.ctx, tt.args.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+
+			accessClaims, err := utils.VerifyToken(resp.GetAccessToken(), jwtConfig.AccessTokenSecretKey())
+			require.NoError(t, err)
+			require.Equal(t, id, accessClaims.UserID)
+			require.Equal(t, name, accessClaims.Name)
+			require.Equal(t, model.RoleAdmin, accessClaims.Role)
+
+			refreshClaims, err := utils.VerifyToken(resp.GetRefreshToken(), jwtConfig.RefreshTokenSecretKey())
+			require.NoError(t, err)
+			require.Equal(t, id, refreshClaims.UserID)
+
+			_, err = utils.VerifyToken(resp.GetAccessToken(), jwtConfig.RefreshTokenSecretKey())
+			require.Error(t, err)
+		})
+	}
+}
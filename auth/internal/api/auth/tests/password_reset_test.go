@@ -0,0 +1,418 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"auth/internal/api/auth"
+	"auth/internal/mailer"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/repository/mocks"
+	authService "auth/internal/service/auth"
+	"auth/internal/utils"
+	desc "auth/pkg/auth_v1"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type passwordResetMocks struct {
+	userRepository          func(mc *minimock.Controller) *mocks.UserRepositoryMock
+	passwordResetRepository func(mc *minimock.Controller) *mocks.PasswordResetRepositoryMock
+	logRepository           func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	mailer                  func(mc *minimock.Controller) *mailerMocks.MailerMock
+}
+
+func (m passwordResetMocks) api(mc *minimock.Controller) *auth.Implementation {
+	userRepo := mocks.NewUserRepositoryMock(mc)
+	if m.userRepository != nil {
+		userRepo = m.userRepository(mc)
+	}
+	resetRepo := mocks.NewPasswordResetRepositoryMock(mc)
+	if m.passwordResetRepository != nil {
+		resetRepo = m.passwordResetRepository(mc)
+	}
+	logRepo := mocks.NewLogRepositoryMock(mc)
+	if m.logRepository != nil {
+		logRepo = m.logRepository(mc)
+	}
+	mailerMock := mailerMocks.NewMailerMock(mc)
+	if m.mailer != nil {
+		mailerMock = m.mailer(mc)
+	}
+
+	return auth.NewImplementation(authService.NewService(
+		userRepo,
+		resetRepo,
+		mocks.NewLoginThrottleRepositoryMock(mc),
+		logRepo,
+		&txManagerMock{},
+		mailerMock,
+		&jwtConfigMock{},
+		&passwordResetConfigMock{},
+		&emailVerificationConfigMock{},
+		&loginThrottleConfigMock{},
+	))
+}
+
+func TestImplementation_RequestPasswordReset(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		email = "test@example.com"
+		user  = &model.User{ID: 1, Info: model.UserInfo{Name: "test_user", Email: email, Role: model.RoleUser}}
+
+		repoErr = errors.New("repository error")
+		mailErr = errors.New("mail server is down")
+	)
+
+	// The token is issued after the response, detached from the request
+	// context.
+	knownUser := func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+		mock := mocks.NewUserRepositoryMock(mc)
+		mock.GetByEmailsMock.Expect(ctx, []string{email}).Return([]*model.User{user}, nil)
+		mock.LockByIDMock.Expect(minimock.AnyContext, user.ID).Return(nil)
+		return mock
+	}
+	// issueToken expects the reset outside the resend interval of the last
+	// one, the older tokens are revoked before the new one is stored.
+	issueToken := func(create func(ctx context.Context, token *model.PasswordResetToken) error) func(mc *minimock.Controller) *mocks.PasswordResetRepositoryMock {
+		return func(mc *minimock.Controller) *mocks.PasswordResetRepositoryMock {
+			mock := mocks.NewPasswordResetRepositoryMock(mc)
+			mock.LastCreatedAtMock.Expect(minimock.AnyContext, user.ID).Return(time.Now().Add(-2*time.Minute), nil)
+			mock.RevokeByUserMock.Expect(minimock.AnyContext, user.ID).Return(nil)
+			mock.CreateMock.Set(create)
+			return mock
+		}
+	}
+	logRequest := func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+		mock := mocks.NewLogRepositoryMock(mc)
+		mock.LogMock.Expect(minimock.AnyContext, &logModel.Log{Action: "password_reset_requested", EntityID: user.ID}).Return(nil)
+		return mock
+	}
+
+	t.Run("token is mailed and stored hashed", func(t *testing.T) {
+		var stored *model.PasswordResetToken
+		sent := make(chan *mailer.Message, 1)
+
+		api := passwordResetMocks{
+			userRepository: knownUser,
+			passwordResetRepository: issueToken(func(_ context.Context, token *model.PasswordResetToken) error {
+				stored = token
+				return nil
+			}),
+			logRepository: logRequest,
+			mailer: func(mc *minimock.Controller) *mailerMocks.MailerMock {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendMock.Set(func(_ context.Context, message *mailer.Message) error {
+					sent <- message
+					return nil
+				})
+				return mock
+			},
+		}.api(mc)
+
+		_, err := api.RequestPasswordReset(ctx, &desc.RequestPasswordResetRequest{Email: " " + email + " "})
+		require.NoError(t, err)
+
+		message := waitFor(t, sent)
+
+		require.Equal(t, user.ID, stored.UserID)
+		require.WithinDuration(t, time.Now().Add(time.Hour), stored.ExpiresAt, time.Minute)
+		require.Equal(t, time.Hour, stored.ExpiresAt.Sub(stored.CreatedAt))
+
+		require.Equal(t, email, message.To)
+		token := tokenFromBody(t, message.Body)
+		require.Equal(t, utils.HashToken(token), stored.TokenHash)
+		require.NotContains(t, message.Body, stored.TokenHash)
+	})
+
+	t.Run("mail failure gets the same response", func(t *testing.T) {
+		done := make(chan struct{}, 1)
+
+		api := passwordResetMocks{
+			userRepository: knownUser,
+			passwordResetRepository: issueToken(func(_ context.Context, _ *model.PasswordResetToken) error {
+				return nil
+			}),
+			logRepository: logRequest,
+			mailer: func(mc *minimock.Controller) *mailerMocks.MailerMock {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendMock.Set(func(_ context.Context, _ *mailer.Message) error {
+					done <- struct{}{}
+					return mailErr
+				})
+				return mock
+			},
+		}.api(mc)
+
+		resp, err := api.RequestPasswordReset(ctx, &desc.RequestPasswordResetRequest{Email: email})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		waitFor(t, done)
+	})
+
+	t.Run("repository failure gets the same response", func(t *testing.T) {
+		done := make(chan struct{}, 1)
+
+		api := passwordResetMocks{
+			userRepository: knownUser,
+			passwordResetRepository: issueToken(func(_ context.Context, _ *model.PasswordResetToken) error {
+				done <- struct{}{}
+				return repoErr
+			}),
+		}.api(mc)
+
+		resp, err := api.RequestPasswordReset(ctx, &desc.RequestPasswordResetRequest{Email: email})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		waitFor(t, done)
+	})
+
+	t.Run("request within the resend interval sends no mail", func(t *testing.T) {
+		done := make(chan struct{}, 1)
+
+		api := passwordResetMocks{
+			userRepository: knownUser,
+			passwordResetRepository: func(mc *minimock.Controller) *mocks.PasswordResetRepositoryMock {
+				mock := mocks.NewPasswordResetRepositoryMock(mc)
+				mock.LastCreatedAtMock.Set(func(_ context.Context, _ int64) (time.Time, error) {
+					done <- struct{}{}
+					return time.Now().Add(-30 * time.Second), nil
+				})
+				return mock
+			},
+		}.api(mc)
+
+		resp, err := api.RequestPasswordReset(ctx, &desc.RequestPasswordResetRequest{Email: email})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		waitFor(t, done)
+	})
+
+	tests := []struct {
+		name  string
+		req   *desc.RequestPasswordResetRequest
+		code  codes.Code
+		err   error
+		mocks passwordResetMocks
+	}{
+		{
+			name: "unknown email gets the same response",
+			req:  &desc.RequestPasswordResetRequest{Email: "nobody@example.com"},
+			mocks: passwordResetMocks{
+				userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+					mock := mocks.NewUserRepositoryMock(mc)
+					mock.GetByEmailsMock.Return(nil, nil)
+					return mock
+				},
+			},
+		},
+		{
+			name: "empty email",
+			req:  &desc.RequestPasswordResetRequest{Email: " "},
+			code: codes.InvalidArgument,
+			err:  errors.New("email is required"),
+		},
+		{
+			name: "repository error",
+			req:  &desc.RequestPasswordResetRequest{Email: email},
+			code: codes.Internal,
+			err:  repoErr,
+			mocks: passwordResetMocks{
+				userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+					mock := mocks.NewUserRepositoryMock(mc)
+					mock.GetByEmailsMock.Return(nil, repoErr)
+					return mock
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.mocks.api(mc).RequestPasswordReset(ctx, tt.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+func TestImplementation_ConfirmPasswordReset(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID      = int64(1)
+		token       = "reset-token"
+		newPassword = "new_password"
+
+		req = &desc.ConfirmPasswordResetRequest{Token: token, NewPassword: newPassword}
+
+		logErr = errors.New("log error")
+	)
+
+	consume := func(mc *minimock.Controller) *mocks.PasswordResetRepositoryMock {
+		mock := mocks.NewPasswordResetRepositoryMock(mc)
+		mock.ConsumeMock.Expect(ctx, utils.HashToken(token)).Return(userID, nil)
+		mock.RevokeByUserMock.Expect(ctx, userID).Return(nil)
+		return mock
+	}
+	updatePassword := func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+		mock := mocks.NewUserRepositoryMock(mc)
+		mock.UpdatePasswordMock.Set(func(_ context.Context, id int64, hashedPassword string) error {
+			require.Equal(t, userID, id)
+			require.True(t, utils.VerifyPassword(hashedPassword, newPassword))
+			return nil
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name  string
+		req   *desc.ConfirmPasswordResetRequest
+		code  codes.Code
+		err   error
+		mocks passwordResetMocks
+	}{
+		{
+			name: "success case",
+			req:  req,
+			mocks: passwordResetMocks{
+				userRepository:          updatePassword,
+				passwordResetRepository: consume,
+				logRepository: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+					mock := mocks.NewLogRepositoryMock(mc)
+					mock.LogMock.Expect(ctx, &logModel.Log{Action: "password_reset_confirmed", EntityID: userID}).Return(nil)
+					return mock
+				},
+			},
+		},
+		{
+			name: "unknown, expired or used token",
+			req:  req,
+			code: codes.InvalidArgument,
+			err:  errors.New("invalid or expired token"),
+			mocks: passwordResetMocks{
+				passwordResetRepository: func(mc *minimock.Controller) *mocks.PasswordResetRepositoryMock {
+					mock := mocks.NewPasswordResetRepositoryMock(mc)
+					mock.ConsumeMock.Return(0, repository.ErrNotFound)
+					return mock
+				},
+			},
+		},
+		{
+			name: "user deleted",
+			req:  req,
+			code: codes.InvalidArgument,
+			err:  errors.New("invalid or expired token"),
+			mocks: passwordResetMocks{
+				userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+					mock := mocks.NewUserRepositoryMock(mc)
+					mock.UpdatePasswordMock.Return(repository.ErrNotFound)
+					return mock
+				},
+				passwordResetRepository: func(mc *minimock.Controller) *mocks.PasswordResetRepositoryMock {
+					mock := mocks.NewPasswordResetRepositoryMock(mc)
+					mock.ConsumeMock.Return(userID, nil)
+					return mock
+				},
+			},
+		},
+		{
+			name: "empty token",
+			req:  &desc.ConfirmPasswordResetRequest{NewPassword: newPassword},
+			code: codes.InvalidArgument,
+			err:  errors.New("token is required"),
+		},
+		{
+			name: "password too short",
+			req:  &desc.ConfirmPasswordResetRequest{Token: token, NewPassword: "short"},
+			code: codes.InvalidArgument,
+			err:  errors.New("password must be at least 8 characters"),
+		},
+		{
+			name: "log error",
+			req:  req,
+			code: codes.Internal,
+			err:  logErr,
+			mocks: passwordResetMocks{
+				userRepository:          updatePassword,
+				passwordResetRepository: consume,
+				logRepository: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+					mock := mocks.NewLogRepositoryMock(mc)
+					mock.LogMock.Return(logErr)
+					return mock
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.mocks.api(mc).ConfirmPasswordReset(ctx, tt.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+// waitFor returns the next value of ch, work done in the background reports
+// on it.
+func waitFor[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("background work didn't finish")
+		var zero T
+		return zero
+	}
+}
+
+// tokenFromBody picks the token out of the mail, it sits on a line of its own
+// and is the only line without spaces.
+func tokenFromBody(t *testing.T, body string) string {
+	t.Helper()
+
+	for _, line := range strings.Split(body, "\n") {
+		if len(line) > 0 && !strings.Contains(line, " ") {
+			return line
+		}
+	}
+
+	t.Fatalf("no token in %q", body)
+	return ""
+}
@@ -0,0 +1,26 @@
+package user
+
+import (
+	desc "auth/pkg/user_v1"
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) VerifyEmail(ctx context.Context, req *desc.VerifyEmailRequest) (*emptypb.Empty, error) {
+	err := i.userService.VerifyEmail(ctx, req.GetToken())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) ResendVerificationEmail(ctx context.Context, req *desc.ResendVerificationEmailRequest) (*emptypb.Empty, error) {
+	err := i.userService.ResendVerificationEmail(ctx, req.GetEmail())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
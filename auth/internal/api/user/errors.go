@@ -13,6 +13,10 @@ func mapError(err error) error {
 		return nil
 	}
 
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
 	switch {
 	case errors.Is(err, repository.ErrNotFound):
 		return status.Error(codes.NotFound, "user not found")
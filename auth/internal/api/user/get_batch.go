@@ -0,0 +1,29 @@
+package user
+
+import (
+	"auth/internal/converter"
+	desc "auth/pkg/user_v1"
+	"context"
+)
+
+func (i *Implementation) GetUsersByNames(ctx context.Context, req *desc.GetUsersByNamesRequest) (*desc.GetUsersResponse, error) {
+	users, err := i.userService.GetByNames(ctx, req.GetNames())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.GetUsersResponse{
+		Users: converter.ToUsersFromService(users),
+	}, nil
+}
+
+func (i *Implementation) GetUsersByEmails(ctx context.Context, req *desc.GetUsersByEmailsRequest) (*desc.GetUsersResponse, error) {
+	users, err := i.userService.GetByEmails(ctx, req.GetEmails())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.GetUsersResponse{
+		Users: converter.ToUsersFromService(users),
+	}, nil
+}
@@ -0,0 +1,20 @@
+package user
+
+import (
+	"auth/internal/converter"
+	desc "auth/pkg/user_v1"
+	"context"
+)
+
+func (i *Implementation) ListUsers(ctx context.Context, req *desc.ListUsersRequest) (*desc.ListUsersResponse, error) {
+	page, err := i.userService.List(ctx, converter.ToListUsersParamsFromDesc(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.ListUsersResponse{
+		Users:      converter.ToUsersFromService(page.Users),
+		NextCursor: page.NextCursor,
+		TotalCount: page.TotalCount,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"auth/internal/converter"
+	desc "auth/pkg/user_v1"
+	"context"
+	"log"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) ChangePassword(ctx context.Context, req *desc.ChangePasswordRequest) (*emptypb.Empty, error) {
+	err := i.userService.ChangePassword(ctx, converter.ToChangePasswordFromDesc(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) ResetPassword(ctx context.Context, req *desc.ResetPasswordRequest) (*desc.ResetPasswordResponse, error) {
+	password, err := i.userService.ResetPassword(ctx, req.GetUserId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	log.Printf("reset password of user with id: %d", req.GetUserId())
+
+	return &desc.ResetPasswordResponse{
+		TemporaryPassword: password,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package user
+
+import (
+	"auth/internal/model"
+	desc "auth/pkg/user_v1"
+	"context"
+	"log"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) SetRole(ctx context.Context, req *desc.SetRoleRequest) (*emptypb.Empty, error) {
+	err := i.userService.SetRole(ctx, req.GetUserId(), model.Role(req.GetRole()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	log.Printf("set role of user with id %d to %s", req.GetUserId(), req.GetRole())
+
+	return &emptypb.Empty{}, nil
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"auth/internal/api/user"
+	"auth/internal/mailer"
+	mailerMocks "auth/internal/mailer/mocks"
 	"auth/internal/model"
 	"auth/internal/repository/mocks"
+	serviceMocks "auth/internal/service/mocks"
 	userService "auth/internal/service/user"
+	"auth/internal/utils"
 	desc "auth/pkg/user_v1"
 
 	"github.com/gojuno/minimock/v3"
@@ -20,6 +25,7 @@ import (
 func TestImplementation_Create(t *testing.T) {
 	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
 	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	type emailVerificationRepositoryMockFunc func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock
 
 	type args struct {
 		ctx context.Context
@@ -54,10 +60,25 @@ func TestImplementation_Create(t *testing.T) {
 			EntityID: id,
 		}
 
+		// tokenHash is the stored hash of the token issued by the create.
+		tokenHash string
+
 		repoErr = errors.New("repository error")
 		logErr  = errors.New("log error")
+		mailErr = errors.New("mail server is down")
 	)
 
+	issueToken := func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock {
+		mock := mocks.NewEmailVerificationRepositoryMock(mc)
+		mock.CreateMock.Set(func(_ context.Context, token *model.EmailVerificationToken) error {
+			assert.Equal(t, id, token.UserID)
+			assert.Equal(t, 24*time.Hour, token.ExpiresAt.Sub(token.CreatedAt))
+			tokenHash = token.TokenHash
+			return nil
+		})
+		return mock
+	}
+
 	tests := []struct {
 		name               string
 		args               args
@@ -65,6 +86,12 @@ func TestImplementation_Create(t *testing.T) {
 		err                error
 		userRepositoryMock userRepositoryMockFunc
 		logRepositoryMock  logRepositoryMockFunc
+
+		emailVerificationRepositoryMock emailVerificationRepositoryMockFunc
+		// mailed is whether a verification mail is sent, mailErr is what
+		// sending it returns.
+		mailed  bool
+		mailErr error
 	}{
 		{
 			name: "success case",
@@ -91,6 +118,62 @@ func TestImplementation_Create(t *testing.T) {
 				mock.LogMock.Expect(ctx, logEntry).Return(nil)
 				return mock
 			},
+			emailVerificationRepositoryMock: issueToken,
+			mailed:                          true,
+		},
+		{
+			name: "requested admin role is ignored",
+			args: args{
+				ctx: ctx,
+				req: &desc.CreateRequest{
+					Name:            name,
+					Email:           email,
+					Password:        password,
+					PasswordConfirm: passwordConfirm,
+					Role:            desc.Role_ROLE_ADMIN,
+				},
+			},
+			want: id,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.CreateMock.Set(func(ctx context.Context, createUser *model.CreateUserData) (int64, error) {
+					assert.Equal(t, model.RoleUser, createUser.Info.Role)
+					return id, nil
+				})
+				mock.GetMock.Expect(ctx, id).Return(nil, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, logEntry).Return(nil)
+				return mock
+			},
+			emailVerificationRepositoryMock: issueToken,
+			mailed:                          true,
+		},
+		{
+			name: "mail failure still creates the user",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: id,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.CreateMock.Return(id, nil)
+				mock.GetMock.Expect(ctx, id).Return(nil, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, logEntry).Return(nil)
+				return mock
+			},
+			emailVerificationRepositoryMock: issueToken,
+			mailed:                          true,
+			mailErr:                         mailErr,
 		},
 		{
 			name: "repository error",
@@ -133,6 +216,11 @@ func TestImplementation_Create(t *testing.T) {
 				mock.LogMock.Expect(ctx, logEntry).Return(logErr)
 				return mock
 			},
+			emailVerificationRepositoryMock: func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock {
+				mock := mocks.NewEmailVerificationRepositoryMock(mc)
+				mock.CreateMock.Return(nil)
+				return mock
+			},
 		},
 	}
 
@@ -141,12 +229,31 @@ func TestImplementation_Create(t *testing.T) {
 			userRepoMock := tt.userRepositoryMock(mc)
 			logRepoMock := tt.logRepositoryMock(mc)
 
+			emailVerificationRepoMock := mocks.NewEmailVerificationRepositoryMock(mc)
+			if tt.emailVerificationRepositoryMock != nil {
+				emailVerificationRepoMock = tt.emailVerificationRepositoryMock(mc)
+			}
+			// The mail is sent after Create returned, the test waits for it.
+			sent := make(chan *mailer.Message, 1)
+			mailerMock := mailerMocks.NewMailerMock(mc)
+			if tt.mailed {
+				mailerMock.SendMock.Set(func(_ context.Context, message *mailer.Message) error {
+					sent <- message
+					return tt.mailErr
+				})
+			}
+
 			txManager := &txManagerMock{}
 
 			service := userService.NewService(
 				userRepoMock,
+				emailVerificationRepoMock,
+				mocks.NewAccessRepositoryMock(mc),
 				logRepoMock,
 				txManager,
+				serviceMocks.NewAuthServiceMock(mc),
+				mailerMock,
+				&emailVerificationConfigMock{},
 			)
 
 			api := user.NewImplementation(service)
@@ -157,10 +264,17 @@ func TestImplementation_Create(t *testing.T) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tt.err.Error())
 				require.Nil(t, resp)
-			} else {
-				require.NoError(t, err)
-				require.NotNil(t, resp)
-				require.Equal(t, tt.want, resp.Id)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Equal(t, tt.want, resp.Id)
+
+			if tt.mailed {
+				message := waitFor(t, sent)
+				require.Equal(t, email, message.To)
+				require.Equal(t, utils.HashToken(tokenFromBody(t, message.Body)), tokenHash)
 			}
 		})
 	}
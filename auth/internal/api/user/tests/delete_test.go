@@ -6,19 +6,26 @@ import (
 	"testing"
 
 	"auth/internal/api/user"
+	"auth/internal/identity"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
 	"auth/internal/repository/mocks"
+	serviceMocks "auth/internal/service/mocks"
 	userService "auth/internal/service/user"
 	desc "auth/pkg/user_v1"
 
 	"github.com/gojuno/minimock/v3"
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestImplementation_Delete(t *testing.T) {
 	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
 	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	type accessRepositoryMockFunc func(mc *minimock.Controller) *mocks.AccessRepositoryMock
 
 	type args struct {
 		ctx context.Context
@@ -26,11 +33,14 @@ func TestImplementation_Delete(t *testing.T) {
 	}
 
 	var (
-		ctx = context.Background()
-		mc  = minimock.NewController(t)
+		mc = minimock.NewController(t)
 
 		id = int64(1)
 
+		ctx      = identity.WithClaims(context.Background(), &model.UserClaims{UserID: id, Name: "test_user", Role: model.RoleUser})
+		adminCtx = identity.WithClaims(context.Background(), &model.UserClaims{UserID: 2, Name: "admin", Role: model.RoleAdmin})
+		otherCtx = identity.WithClaims(context.Background(), &model.UserClaims{UserID: 3, Name: "other_user", Role: model.RoleUser})
+
 		req = &desc.DeleteRequest{
 			Id: id,
 		}
@@ -45,12 +55,14 @@ func TestImplementation_Delete(t *testing.T) {
 	)
 
 	tests := []struct {
-		name               string
-		args               args
-		want               *emptypb.Empty
-		err                error
-		userRepositoryMock userRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
+		name                 string
+		args                 args
+		want                 *emptypb.Empty
+		code                 codes.Code
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		logRepositoryMock    logRepositoryMockFunc
+		accessRepositoryMock accessRepositoryMockFunc
 	}{
 		{
 			name: "success case",
@@ -71,6 +83,49 @@ func TestImplementation_Delete(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "admin deletes another user",
+			args: args{
+				ctx: adminCtx,
+				req: req,
+			},
+			want: &emptypb.Empty{},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.DeleteMock.Expect(adminCtx, id).Return(nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(adminCtx, logEntry).Return(nil)
+				return mock
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetRolePermissionsMock.Expect(adminCtx, model.RoleAdmin).Return([]model.Permission{model.PermissionUserManageAny}, nil)
+				return mock
+			},
+		},
+		{
+			name: "another user's account",
+			args: args{
+				ctx: otherCtx,
+				req: req,
+			},
+			code: codes.PermissionDenied,
+			err:  errors.New("access denied"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetRolePermissionsMock.Expect(otherCtx, model.RoleUser).Return(nil, nil)
+				return mock
+			},
+		},
 		{
 			name: "repository error",
 			args: args{
@@ -115,12 +170,22 @@ func TestImplementation_Delete(t *testing.T) {
 			userRepoMock := tt.userRepositoryMock(mc)
 			logRepoMock := tt.logRepositoryMock(mc)
 
+			accessRepoMock := mocks.NewAccessRepositoryMock(mc)
+			if tt.accessRepositoryMock != nil {
+				accessRepoMock = tt.accessRepositoryMock(mc)
+			}
+
 			txManager := &txManagerMock{}
 
 			service := userService.NewService(
 				userRepoMock,
+				mocks.NewEmailVerificationRepositoryMock(mc),
+				accessRepoMock,
 				logRepoMock,
 				txManager,
+				serviceMocks.NewAuthServiceMock(mc),
+				mailerMocks.NewMailerMock(mc),
+				&emailVerificationConfigMock{},
 			)
 
 			api := user.NewImplementation(service)
@@ -129,6 +194,9 @@ func TestImplementation_Delete(t *testing.T) {
 
 			if tt.err != nil {
 				require.Error(t, err)
+				if tt.code != codes.OK {
+					require.Equal(t, tt.code, status.Code(err))
+				}
 				require.Contains(t, err.Error(), tt.err.Error())
 				require.Nil(t, resp)
 			} else {
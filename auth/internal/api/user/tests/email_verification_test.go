@@ -0,0 +1,436 @@
+package user_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"auth/internal/api/user"
+	"auth/internal/mailer"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/repository/mocks"
+	serviceMocks "auth/internal/service/mocks"
+	userService "auth/internal/service/user"
+	"auth/internal/utils"
+	desc "auth/pkg/user_v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/makxtr/go-common/pkg/db"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type emailVerificationMocks struct {
+	userRepository              func(mc *minimock.Controller) *mocks.UserRepositoryMock
+	emailVerificationRepository func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock
+	logRepository               func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	mailer                      func(mc *minimock.Controller) *mailerMocks.MailerMock
+	// txManager defaults to running the transactions without locks.
+	txManager db.TxManager
+}
+
+func (m emailVerificationMocks) api(mc *minimock.Controller) *user.Implementation {
+	userRepo := mocks.NewUserRepositoryMock(mc)
+	if m.userRepository != nil {
+		userRepo = m.userRepository(mc)
+	}
+	verificationRepo := mocks.NewEmailVerificationRepositoryMock(mc)
+	if m.emailVerificationRepository != nil {
+		verificationRepo = m.emailVerificationRepository(mc)
+	}
+	logRepo := mocks.NewLogRepositoryMock(mc)
+	if m.logRepository != nil {
+		logRepo = m.logRepository(mc)
+	}
+	mailerMock := mailerMocks.NewMailerMock(mc)
+	if m.mailer != nil {
+		mailerMock = m.mailer(mc)
+	}
+
+	var txManager db.TxManager = &txManagerMock{}
+	if m.txManager != nil {
+		txManager = m.txManager
+	}
+
+	return user.NewImplementation(userService.NewService(
+		userRepo,
+		verificationRepo,
+		mocks.NewAccessRepositoryMock(mc),
+		logRepo,
+		txManager,
+		serviceMocks.NewAuthServiceMock(mc),
+		mailerMock,
+		&emailVerificationConfigMock{},
+	))
+}
+
+func TestImplementation_VerifyEmail(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		userID = int64(1)
+		token  = "verification-token"
+
+		req = &desc.VerifyEmailRequest{Token: token}
+
+		repoErr = errors.New("repository error")
+	)
+
+	consume := func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock {
+		mock := mocks.NewEmailVerificationRepositoryMock(mc)
+		mock.ConsumeMock.Expect(ctx, utils.HashToken(token)).Return(userID, nil)
+		mock.RevokeByUserMock.Expect(ctx, userID).Return(nil)
+		return mock
+	}
+
+	tests := []struct {
+		name  string
+		req   *desc.VerifyEmailRequest
+		code  codes.Code
+		err   error
+		mocks emailVerificationMocks
+	}{
+		{
+			name: "success case",
+			req:  req,
+			mocks: emailVerificationMocks{
+				userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+					mock := mocks.NewUserRepositoryMock(mc)
+					mock.MarkEmailVerifiedMock.Expect(ctx, userID).Return(nil)
+					return mock
+				},
+				emailVerificationRepository: consume,
+				logRepository: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+					mock := mocks.NewLogRepositoryMock(mc)
+					mock.LogMock.Expect(ctx, &logModel.Log{Action: "email_verified", EntityID: userID}).Return(nil)
+					return mock
+				},
+			},
+		},
+		{
+			name: "unknown, expired or used token",
+			req:  req,
+			code: codes.InvalidArgument,
+			err:  errors.New("invalid or expired token"),
+			mocks: emailVerificationMocks{
+				emailVerificationRepository: func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock {
+					mock := mocks.NewEmailVerificationRepositoryMock(mc)
+					mock.ConsumeMock.Return(0, repository.ErrNotFound)
+					return mock
+				},
+			},
+		},
+		{
+			name: "empty token",
+			req:  &desc.VerifyEmailRequest{},
+			code: codes.InvalidArgument,
+			err:  errors.New("token is required"),
+		},
+		{
+			name: "repository error",
+			req:  req,
+			code: codes.Internal,
+			err:  errors.New("failed to update user"),
+			mocks: emailVerificationMocks{
+				userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+					mock := mocks.NewUserRepositoryMock(mc)
+					mock.MarkEmailVerifiedMock.Return(repository.ErrUpdateFailed)
+					return mock
+				},
+				emailVerificationRepository: func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock {
+					mock := mocks.NewEmailVerificationRepositoryMock(mc)
+					mock.ConsumeMock.Return(userID, nil)
+					return mock
+				},
+			},
+		},
+		{
+			name: "log error",
+			req:  req,
+			code: codes.Internal,
+			err:  repoErr,
+			mocks: emailVerificationMocks{
+				userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+					mock := mocks.NewUserRepositoryMock(mc)
+					mock.MarkEmailVerifiedMock.Return(nil)
+					return mock
+				},
+				emailVerificationRepository: consume,
+				logRepository: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+					mock := mocks.NewLogRepositoryMock(mc)
+					mock.LogMock.Return(repoErr)
+					return mock
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.mocks.api(mc).VerifyEmail(ctx, tt.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+func TestImplementation_ResendVerificationEmail(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		email      = "test@example.com"
+		unverified = &model.User{ID: 1, Info: model.UserInfo{Name: "test_user", Email: email, Role: model.RoleUser}}
+		verified   = &model.User{ID: 1, Info: unverified.Info, EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+
+		req = &desc.ResendVerificationEmailRequest{Email: email}
+
+		repoErr = errors.New("repository error")
+	)
+
+	findUser := func(u *model.User) func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+		return func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+			mock := mocks.NewUserRepositoryMock(mc)
+			mock.GetByEmailsMock.Expect(ctx, []string{email}).Return([]*model.User{u}, nil)
+			return mock
+		}
+	}
+	// findUnverified also locks the user for the resend.
+	findUnverified := func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+		mock := findUser(unverified)(mc)
+		mock.LockByIDMock.Expect(minimock.AnyContext, unverified.ID).Return(nil)
+		return mock
+	}
+
+	t.Run("new token replaces the old ones", func(t *testing.T) {
+		var stored *model.EmailVerificationToken
+		sent := make(chan *mailer.Message, 1)
+
+		// The mail is prepared after the response, detached from the
+		// request context.
+		api := emailVerificationMocks{
+			userRepository: findUnverified,
+			emailVerificationRepository: func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock {
+				mock := mocks.NewEmailVerificationRepositoryMock(mc)
+				mock.LastCreatedAtMock.Expect(minimock.AnyContext, unverified.ID).Return(time.Now().Add(-2*time.Minute), nil)
+				mock.RevokeByUserMock.Expect(minimock.AnyContext, unverified.ID).Return(nil)
+				mock.CreateMock.Set(func(_ context.Context, token *model.EmailVerificationToken) error {
+					stored = token
+					return nil
+				})
+				return mock
+			},
+			logRepository: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(minimock.AnyContext, &logModel.Log{Action: "email_verification_resent", EntityID: unverified.ID}).Return(nil)
+				return mock
+			},
+			mailer: func(mc *minimock.Controller) *mailerMocks.MailerMock {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendMock.Set(func(_ context.Context, message *mailer.Message) error {
+					sent <- message
+					return nil
+				})
+				return mock
+			},
+		}.api(mc)
+
+		_, err := api.ResendVerificationEmail(ctx, req)
+		require.NoError(t, err)
+
+		message := waitFor(t, sent)
+
+		require.Equal(t, unverified.ID, stored.UserID)
+		require.Equal(t, email, message.To)
+		require.Equal(t, utils.HashToken(tokenFromBody(t, message.Body)), stored.TokenHash)
+	})
+
+	// The outcome of the background work never reaches the caller.
+	for name, lastCreatedAt := range map[string]func() (time.Time, error){
+		"throttled within the resend interval": func() (time.Time, error) {
+			return time.Now().Add(-10 * time.Second), nil
+		},
+		"repository failure gets the same response": func() (time.Time, error) {
+			return time.Time{}, repoErr
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			done := make(chan struct{}, 1)
+
+			api := emailVerificationMocks{
+				userRepository: findUnverified,
+				emailVerificationRepository: func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock {
+					mock := mocks.NewEmailVerificationRepositoryMock(mc)
+					mock.LastCreatedAtMock.Set(func(_ context.Context, _ int64) (time.Time, error) {
+						done <- struct{}{}
+						return lastCreatedAt()
+					})
+					return mock
+				},
+			}.api(mc)
+
+			resp, err := api.ResendVerificationEmail(ctx, req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			waitFor(t, done)
+		})
+	}
+
+	t.Run("concurrent resends send one mail", func(t *testing.T) {
+		var (
+			mu         sync.Mutex
+			checks     int
+			lastSentAt = time.Now().Add(-2 * time.Minute)
+			userLock   sync.Mutex
+			locking    = make(chan struct{}, 2)
+			sent       = make(chan *mailer.Message, 2)
+			checked    = make(chan time.Time, 2)
+		)
+
+		api := emailVerificationMocks{
+			userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := findUser(unverified)(mc)
+				mock.LockByIDMock.Set(func(ctx context.Context, _ int64) error {
+					locking <- struct{}{}
+					holdLock(ctx, &userLock)
+					return nil
+				})
+				return mock
+			},
+			emailVerificationRepository: func(mc *minimock.Controller) *mocks.EmailVerificationRepositoryMock {
+				mock := mocks.NewEmailVerificationRepositoryMock(mc)
+				mock.LastCreatedAtMock.Set(func(_ context.Context, _ int64) (time.Time, error) {
+					mu.Lock()
+					checks++
+					first := checks == 1
+					mu.Unlock()
+
+					// Hold the first resend until the second one waits
+					// for the user.
+					if first {
+						waitFor(t, locking)
+						waitFor(t, locking)
+					}
+
+					mu.Lock()
+					defer mu.Unlock()
+					checked <- lastSentAt
+					return lastSentAt, nil
+				})
+				mock.RevokeByUserMock.Return(nil)
+				mock.CreateMock.Set(func(_ context.Context, token *model.EmailVerificationToken) error {
+					mu.Lock()
+					defer mu.Unlock()
+					lastSentAt = token.CreatedAt
+					return nil
+				})
+				return mock
+			},
+			logRepository: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(nil)
+				return mock
+			},
+			mailer: func(mc *minimock.Controller) *mailerMocks.MailerMock {
+				mock := mailerMocks.NewMailerMock(mc)
+				mock.SendMock.Set(func(_ context.Context, message *mailer.Message) error {
+					sent <- message
+					return nil
+				})
+				return mock
+			},
+			txManager: &lockingTxManagerMock{},
+		}.api(mc)
+
+		for range 2 {
+			_, err := api.ResendVerificationEmail(ctx, req)
+			require.NoError(t, err)
+		}
+
+		first, second := waitFor(t, checked), waitFor(t, checked)
+		require.True(t, second.After(first), "the second resend has to see the first token")
+		waitFor(t, sent)
+		require.Empty(t, sent)
+	})
+
+	tests := []struct {
+		name  string
+		req   *desc.ResendVerificationEmailRequest
+		code  codes.Code
+		err   error
+		mocks emailVerificationMocks
+	}{
+		{
+			name: "already verified",
+			req:  req,
+			mocks: emailVerificationMocks{
+				userRepository: findUser(verified),
+			},
+		},
+		{
+			name: "unknown email",
+			req:  &desc.ResendVerificationEmailRequest{Email: "nobody@example.com"},
+			mocks: emailVerificationMocks{
+				userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+					mock := mocks.NewUserRepositoryMock(mc)
+					mock.GetByEmailsMock.Return(nil, nil)
+					return mock
+				},
+			},
+		},
+		{
+			name: "empty email",
+			req:  &desc.ResendVerificationEmailRequest{Email: " "},
+			code: codes.InvalidArgument,
+			err:  errors.New("email is required"),
+		},
+		{
+			name: "repository error",
+			req:  req,
+			code: codes.Internal,
+			err:  repoErr,
+			mocks: emailVerificationMocks{
+				userRepository: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+					mock := mocks.NewUserRepositoryMock(mc)
+					mock.GetByEmailsMock.Return(nil, repoErr)
+					return mock
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.mocks.api(mc).ResendVerificationEmail(ctx, tt.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auth/internal/api/user"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/repository/mocks"
+	serviceMocks "auth/internal/service/mocks"
+	userService "auth/internal/service/user"
+	desc "auth/pkg/user_v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_GetUsersByNames(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		createdAt = time.Now()
+		names     = []string{"alice", "bob"}
+
+		userModel = &model.User{
+			ID:        1,
+			Info:      model.UserInfo{Name: "alice", Email: "alice@example.com", Role: model.RoleUser},
+			CreatedAt: createdAt,
+		}
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name               string
+		req                *desc.GetUsersByNamesRequest
+		want               *desc.GetUsersResponse
+		code               codes.Code
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+	}{
+		{
+			name: "unknown names are left out",
+			req:  &desc.GetUsersByNamesRequest{Names: names},
+			want: &desc.GetUsersResponse{
+				Users: []*desc.User{
+					{Id: 1, Name: "alice", Email: "alice@example.com", Role: desc.Role_ROLE_USER, CreatedAt: timestamppb.New(createdAt)},
+				},
+			},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetByNamesMock.Expect(ctx, names).Return([]*model.User{userModel}, nil)
+				return mock
+			},
+		},
+		{
+			name: "empty request skips the query",
+			req:  &desc.GetUsersByNamesRequest{},
+			want: &desc.GetUsersResponse{Users: []*desc.User{}},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "too many names",
+			req:  &desc.GetUsersByNamesRequest{Names: make([]string, 101)},
+			code: codes.InvalidArgument,
+			err:  errors.New("at most 100 users can be requested at once"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repository error",
+			req:  &desc.GetUsersByNamesRequest{Names: names},
+			code: codes.Internal,
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetByNamesMock.Expect(ctx, names).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := userService.NewService(
+				tt.userRepositoryMock(mc),
+				mocks.NewEmailVerificationRepositoryMock(mc),
+				mocks.NewAccessRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				serviceMocks.NewAuthServiceMock(mc),
+				mailerMocks.NewMailerMock(mc),
+				&emailVerificationConfigMock{},
+			)
+
+			api := user.NewImplementation(service)
+
+			resp, err := api.GetUsersByNames(ctx, tt.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, resp)
+			}
+		})
+	}
+}
+
+func TestImplementation_GetUsersByEmails(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		createdAt = time.Now()
+		emails    = []string{"alice@example.com"}
+	)
+
+	userRepoMock := mocks.NewUserRepositoryMock(mc)
+	userRepoMock.GetByEmailsMock.Expect(ctx, emails).Return([]*model.User{
+		{ID: 1, Info: model.UserInfo{Name: "alice", Email: "alice@example.com", Role: model.RoleUser}, CreatedAt: createdAt},
+	}, nil)
+
+	service := userService.NewService(userRepoMock, mocks.NewEmailVerificationRepositoryMock(mc), mocks.NewAccessRepositoryMock(mc), mocks.NewLogRepositoryMock(mc), &txManagerMock{}, serviceMocks.NewAuthServiceMock(mc), mailerMocks.NewMailerMock(mc), &emailVerificationConfigMock{})
+	api := user.NewImplementation(service)
+
+	resp, err := api.GetUsersByEmails(ctx, &desc.GetUsersByEmailsRequest{Emails: emails})
+	require.NoError(t, err)
+	require.Equal(t, &desc.GetUsersResponse{
+		Users: []*desc.User{
+			{Id: 1, Name: "alice", Email: "alice@example.com", Role: desc.Role_ROLE_USER, CreatedAt: timestamppb.New(createdAt)},
+		},
+	}, resp)
+}
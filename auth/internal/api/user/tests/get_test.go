@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"auth/internal/api/user"
+	mailerMocks "auth/internal/mailer/mocks"
 	"auth/internal/model"
 	"auth/internal/repository/mocks"
+	serviceMocks "auth/internal/service/mocks"
 	userService "auth/internal/service/user"
 	desc "auth/pkg/user_v1"
 
@@ -125,8 +127,13 @@ func TestImplementation_Get(t *testing.T) {
 
 			service := userService.NewService(
 				userRepoMock,
+				mocks.NewEmailVerificationRepositoryMock(mc),
+				mocks.NewAccessRepositoryMock(mc),
 				logRepoMock,
 				txManager,
+				serviceMocks.NewAuthServiceMock(mc),
+				mailerMocks.NewMailerMock(mc),
+				&emailVerificationConfigMock{},
 			)
 
 			api := user.NewImplementation(service)
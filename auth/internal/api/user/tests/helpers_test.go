@@ -2,6 +2,10 @@ package user_test
 
 import (
 	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
 
 	"github.com/makxtr/go-common/pkg/db"
 )
@@ -12,3 +16,76 @@ type txManagerMock struct{}
 func (tm *txManagerMock) ReadCommitted(ctx context.Context, fn db.Handler) error {
 	return fn(ctx)
 }
+
+type heldLocksKey struct{}
+
+// lockingTxManagerMock releases the locks taken by a transaction once it
+// ends, as the database does.
+type lockingTxManagerMock struct{}
+
+func (tm *lockingTxManagerMock) ReadCommitted(ctx context.Context, fn db.Handler) error {
+	if _, ok := ctx.Value(heldLocksKey{}).(*[]*sync.Mutex); ok {
+		return fn(ctx)
+	}
+
+	var held []*sync.Mutex
+	defer func() {
+		for _, lock := range held {
+			lock.Unlock()
+		}
+	}()
+
+	return fn(context.WithValue(ctx, heldLocksKey{}, &held))
+}
+
+// holdLock takes the lock for the transaction of ctx.
+func holdLock(ctx context.Context, lock *sync.Mutex) {
+	lock.Lock()
+	held := ctx.Value(heldLocksKey{}).(*[]*sync.Mutex)
+	*held = append(*held, lock)
+}
+
+// emailVerificationConfigMock is a static EmailVerificationConfig for tests
+type emailVerificationConfigMock struct{}
+
+func (c *emailVerificationConfigMock) TokenTTL() time.Duration {
+	return 24 * time.Hour
+}
+
+func (c *emailVerificationConfigMock) ResendInterval() time.Duration {
+	return time.Minute
+}
+
+func (c *emailVerificationConfigMock) Required() bool {
+	return false
+}
+
+// waitFor returns the next value of ch, work done in the background reports
+// on it.
+func waitFor[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("background work didn't finish")
+		var zero T
+		return zero
+	}
+}
+
+// tokenFromBody picks the token out of the mail, it sits on a line of its own
+// and is the only line without spaces.
+func tokenFromBody(t *testing.T, body string) string {
+	t.Helper()
+
+	for _, line := range strings.Split(body, "\n") {
+		if len(line) > 0 && !strings.Contains(line, " ") {
+			return line
+		}
+	}
+
+	t.Fatalf("no token in %q", body)
+	return ""
+}
@@ -0,0 +1,271 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auth/internal/api/user"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/pagination"
+	"auth/internal/repository/mocks"
+	serviceMocks "auth/internal/service/mocks"
+	userService "auth/internal/service/user"
+	desc "auth/pkg/user_v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_ListUsers(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+
+	type args struct {
+		ctx context.Context
+		req *desc.ListUsersRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		createdAt   = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		createdFrom = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		users = []*model.User{
+			{ID: 3, Info: model.UserInfo{Name: "alice", Email: "alice@example.com", Role: model.RoleAdmin}, CreatedAt: createdAt},
+			{ID: 1, Info: model.UserInfo{Name: "bob", Email: "bob@example.com", Role: model.RoleAdmin}, CreatedAt: createdAt},
+			{ID: 2, Info: model.UserInfo{Name: "carol", Email: "carol@example.com", Role: model.RoleAdmin}, CreatedAt: createdAt},
+		}
+
+		filter = model.UserFilter{
+			Role:        model.RoleAdmin,
+			Search:      "example",
+			CreatedFrom: createdFrom,
+		}
+
+		descFilter = &desc.ListUsersFilter{
+			Role:        desc.Role_ROLE_ADMIN,
+			Search:      "example",
+			CreatedFrom: timestamppb.New(createdFrom),
+		}
+
+		repoErr = errors.New("repository error")
+	)
+
+	type cursor struct {
+		SortField int    `json:"sort_field"`
+		SortDesc  bool   `json:"sort_desc"`
+		ID        int64  `json:"id"`
+		Name      string `json:"name"`
+	}
+
+	nextCursor, err := pagination.EncodeCursor(cursor{SortField: 2, ID: 1, Name: "bob"})
+	require.NoError(t, err)
+
+	descCursor, err := pagination.EncodeCursor(cursor{SortField: 2, SortDesc: true, ID: 1, Name: "bob"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               *desc.ListUsersResponse
+		code               codes.Code
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+	}{
+		{
+			name: "first page has next cursor",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListUsersRequest{
+					Filter:    descFilter,
+					SortField: desc.UserSortField_USER_SORT_FIELD_NAME,
+					Limit:     2,
+				},
+			},
+			want: &desc.ListUsersResponse{
+				Users: []*desc.User{
+					{Id: 3, Name: "alice", Email: "alice@example.com", Role: desc.Role_ROLE_ADMIN, CreatedAt: timestamppb.New(createdAt)},
+					{Id: 1, Name: "bob", Email: "bob@example.com", Role: desc.Role_ROLE_ADMIN, CreatedAt: timestamppb.New(createdAt)},
+				},
+				NextCursor: nextCursor,
+				TotalCount: 3,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.CountMock.Expect(ctx, filter).Return(3, nil)
+				mock.ListMock.Expect(ctx, &model.UserListQuery{
+					Filter:    filter,
+					SortField: model.UserSortFieldName,
+					Limit:     3,
+				}).Return(users, nil)
+				return mock
+			},
+		},
+		{
+			name: "next page resumes after cursor",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListUsersRequest{
+					Filter:    descFilter,
+					SortField: desc.UserSortField_USER_SORT_FIELD_NAME,
+					Cursor:    nextCursor,
+					Limit:     2,
+				},
+			},
+			want: &desc.ListUsersResponse{
+				Users: []*desc.User{
+					{Id: 2, Name: "carol", Email: "carol@example.com", Role: desc.Role_ROLE_ADMIN, CreatedAt: timestamppb.New(createdAt)},
+				},
+				TotalCount: 3,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.CountMock.Expect(ctx, filter).Return(3, nil)
+				mock.ListMock.Expect(ctx, &model.UserListQuery{
+					Filter:    filter,
+					SortField: model.UserSortFieldName,
+					After:     &model.User{ID: 1, Info: model.UserInfo{Name: "bob"}},
+					Limit:     3,
+				}).Return(users[2:], nil)
+				return mock
+			},
+		},
+		{
+			name: "defaults to id order",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListUsersRequest{
+					SortDirection: desc.SortDirection_SORT_DIRECTION_DESC,
+				},
+			},
+			want: &desc.ListUsersResponse{
+				Users:      []*desc.User{},
+				TotalCount: 0,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.CountMock.Expect(ctx, model.UserFilter{}).Return(0, nil)
+				mock.ListMock.Expect(ctx, &model.UserListQuery{
+					SortField: model.UserSortFieldID,
+					SortDesc:  true,
+					Limit:     51,
+				}).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid cursor",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListUsersRequest{Cursor: "not a cursor"},
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("invalid cursor"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "cursor from another sort order",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListUsersRequest{
+					SortField: desc.UserSortField_USER_SORT_FIELD_NAME,
+					Cursor:    descCursor,
+				},
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("cursor does not match the sort order"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "empty created range",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListUsersRequest{
+					Filter: &desc.ListUsersFilter{
+						CreatedFrom: timestamppb.New(createdAt),
+						CreatedTo:   timestamppb.New(createdFrom),
+					},
+				},
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("created_from must be before created_to"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				return mocks.NewUserRepositoryMock(mc)
+			},
+		},
+		{
+			name: "count error",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListUsersRequest{},
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.CountMock.Expect(ctx, model.UserFilter{}).Return(0, repoErr)
+				return mock
+			},
+		},
+		{
+			name: "list error",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListUsersRequest{},
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.CountMock.Expect(ctx, model.UserFilter{}).Return(3, nil)
+				mock.ListMock.Expect(ctx, &model.UserListQuery{
+					SortField: model.UserSortFieldID,
+					Limit:     51,
+				}).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepoMock := tt.userRepositoryMock(mc)
+
+			service := userService.NewService(
+				userRepoMock,
+				mocks.NewEmailVerificationRepositoryMock(mc),
+				mocks.NewAccessRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				serviceMocks.NewAuthServiceMock(mc),
+				mailerMocks.NewMailerMock(mc),
+				&emailVerificationConfigMock{},
+			)
+
+			api := user.NewImplementation(service)
+
+			resp, err := api.ListUsers(tt.args.ctx, tt.args.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, resp)
+			}
+		})
+	}
+}
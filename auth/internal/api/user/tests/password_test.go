@@ -0,0 +1,346 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"auth/internal/api/user"
+	"auth/internal/identity"
+	mailerMocks "auth/internal/mailer/mocks"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/repository/mocks"
+	"auth/internal/service"
+	serviceMocks "auth/internal/service/mocks"
+	userService "auth/internal/service/user"
+	"auth/internal/utils"
+	desc "auth/pkg/user_v1"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImplementation_ChangePassword(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	type authServiceMockFunc func(mc *minimock.Controller) *serviceMocks.AuthServiceMock
+
+	var (
+		mc = minimock.NewController(t)
+
+		id    = int64(1)
+		email = "test@example.com"
+		ctx   = identity.WithClaims(context.Background(), &model.UserClaims{UserID: id, Name: "test_user", Role: model.RoleUser})
+
+		oldPassword = "old_password"
+		newPassword = "new_password"
+
+		req = &desc.ChangePasswordRequest{
+			OldPassword:        oldPassword,
+			NewPassword:        newPassword,
+			NewPasswordConfirm: newPassword,
+		}
+
+		credentials = &model.UserCredentials{
+			User:           model.User{ID: id, Info: model.UserInfo{Name: "test_user", Email: email, Role: model.RoleUser}},
+			HashedPassword: "hashed_password",
+		}
+
+		logEntry = &logModel.Log{
+			Action:   "password_changed",
+			EntityID: id,
+		}
+
+		repoErr = errors.New("repository error")
+	)
+
+	// The old password goes through the throttled credentials check.
+	verifyOldPassword := func(mc *minimock.Controller) *serviceMocks.AuthServiceMock {
+		mock := serviceMocks.NewAuthServiceMock(mc)
+		mock.VerifyCredentialsMock.Expect(ctx, email, oldPassword).Return(&credentials.User, nil)
+		return mock
+	}
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		req                *desc.ChangePasswordRequest
+		code               codes.Code
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+		logRepositoryMock  logRepositoryMockFunc
+		authServiceMock    authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			req:  req,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsByIDMock.Expect(ctx, id).Return(credentials, nil)
+				mock.UpdatePasswordMock.Set(func(_ context.Context, userID int64, hashedPassword string) error {
+					require.Equal(t, id, userID)
+					require.True(t, utils.VerifyPassword(hashedPassword, newPassword))
+					return nil
+				})
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, logEntry).Return(nil)
+				return mock
+			},
+			authServiceMock: verifyOldPassword,
+		},
+		{
+			name: "wrong old password",
+			ctx:  ctx,
+			req: &desc.ChangePasswordRequest{
+				OldPassword:        "not_the_password",
+				NewPassword:        newPassword,
+				NewPasswordConfirm: newPassword,
+			},
+			code: codes.PermissionDenied,
+			err:  errors.New("old password is incorrect"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsByIDMock.Expect(ctx, id).Return(credentials, nil)
+				return mock
+			},
+			authServiceMock: func(mc *minimock.Controller) *serviceMocks.AuthServiceMock {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.VerifyCredentialsMock.Expect(ctx, email, "not_the_password").Return(nil, status.Error(codes.Unauthenticated, "invalid email or password"))
+				return mock
+			},
+		},
+		{
+			name: "too many wrong old passwords",
+			ctx:  ctx,
+			req:  req,
+			code: codes.ResourceExhausted,
+			err:  errors.New("too many failed attempts"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsByIDMock.Expect(ctx, id).Return(credentials, nil)
+				return mock
+			},
+			authServiceMock: func(mc *minimock.Controller) *serviceMocks.AuthServiceMock {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.VerifyCredentialsMock.Return(nil, status.Error(codes.ResourceExhausted, "too many failed attempts, retry in 30s"))
+				return mock
+			},
+		},
+		{
+			name: "passwords do not match",
+			ctx:  ctx,
+			req: &desc.ChangePasswordRequest{
+				OldPassword:        oldPassword,
+				NewPassword:        newPassword,
+				NewPasswordConfirm: "other_password",
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("passwords do not match"),
+		},
+		{
+			name: "password too short",
+			ctx:  ctx,
+			req: &desc.ChangePasswordRequest{
+				OldPassword:        oldPassword,
+				NewPassword:        "short",
+				NewPasswordConfirm: "short",
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("password must be at least 8 characters"),
+		},
+		{
+			name: "same password",
+			ctx:  ctx,
+			req: &desc.ChangePasswordRequest{
+				OldPassword:        oldPassword,
+				NewPassword:        oldPassword,
+				NewPasswordConfirm: oldPassword,
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("new password must differ from the old one"),
+		},
+		{
+			name: "old password missing",
+			ctx:  ctx,
+			req: &desc.ChangePasswordRequest{
+				NewPassword:        newPassword,
+				NewPasswordConfirm: newPassword,
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("old password is required"),
+		},
+		{
+			name: "unauthenticated",
+			ctx:  context.Background(),
+			req:  req,
+			code: codes.Unauthenticated,
+			err:  errors.New("user is not authenticated"),
+		},
+		{
+			name: "user deleted",
+			ctx:  ctx,
+			req:  req,
+			code: codes.Unauthenticated,
+			err:  errors.New("user is not authenticated"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsByIDMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "repository error",
+			ctx:  ctx,
+			req:  req,
+			code: codes.Internal,
+			err:  repoErr,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetCredentialsByIDMock.Return(credentials, nil)
+				mock.UpdatePasswordMock.Return(repoErr)
+				return mock
+			},
+			authServiceMock: verifyOldPassword,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := user.NewImplementation(newUserService(mc, tt.userRepositoryMock, tt.logRepositoryMock, tt.authServiceMock))
+
+			resp, err := api.ChangePassword(tt.ctx, tt.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func TestImplementation_ResetPassword(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = identity.WithClaims(context.Background(), &model.UserClaims{UserID: 1, Name: "admin", Role: model.RoleAdmin})
+
+		id = int64(2)
+
+		// stored keeps the hash written by the successful reset.
+		stored string
+
+		logEntry = &logModel.Log{
+			Action:   "password_reset",
+			EntityID: id,
+		}
+
+		logErr = errors.New("log error")
+	)
+
+	tests := []struct {
+		name               string
+		code               codes.Code
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+		logRepositoryMock  logRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.UpdatePasswordMock.Set(func(_ context.Context, userID int64, hashedPassword string) error {
+					require.Equal(t, id, userID)
+					stored = hashedPassword
+					return nil
+				})
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, logEntry).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "user not found",
+			code: codes.NotFound,
+			err:  errors.New("user not found"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.UpdatePasswordMock.Return(repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "log error",
+			code: codes.Internal,
+			err:  logErr,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.UpdatePasswordMock.Return(nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(logErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := user.NewImplementation(newUserService(mc, tt.userRepositoryMock, tt.logRepositoryMock, nil))
+
+			resp, err := api.ResetPassword(ctx, &desc.ResetPasswordRequest{UserId: id})
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.GreaterOrEqual(t, len(resp.GetTemporaryPassword()), 8)
+				require.True(t, utils.VerifyPassword(stored, resp.GetTemporaryPassword()))
+			}
+		})
+	}
+}
+
+func newUserService(
+	mc *minimock.Controller,
+	userRepositoryMock func(mc *minimock.Controller) *mocks.UserRepositoryMock,
+	logRepositoryMock func(mc *minimock.Controller) *mocks.LogRepositoryMock,
+	authServiceMock func(mc *minimock.Controller) *serviceMocks.AuthServiceMock,
+) service.UserService {
+	userRepo := mocks.NewUserRepositoryMock(mc)
+	if userRepositoryMock != nil {
+		userRepo = userRepositoryMock(mc)
+	}
+	logRepo := mocks.NewLogRepositoryMock(mc)
+	if logRepositoryMock != nil {
+		logRepo = logRepositoryMock(mc)
+	}
+	authService := serviceMocks.NewAuthServiceMock(mc)
+	if authServiceMock != nil {
+		authService = authServiceMock(mc)
+	}
+
+	return userService.NewService(userRepo, mocks.NewEmailVerificationRepositoryMock(mc), mocks.NewAccessRepositoryMock(mc), logRepo, &txManagerMock{}, authService, mailerMocks.NewMailerMock(mc), &emailVerificationConfigMock{})
+}
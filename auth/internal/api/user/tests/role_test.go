@@ -0,0 +1,137 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"auth/internal/api/user"
+	"auth/internal/identity"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/repository/mocks"
+	desc "auth/pkg/user_v1"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImplementation_SetRole(t *testing.T) {
+	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = identity.WithClaims(context.Background(), &model.UserClaims{UserID: 1, Name: "admin", Role: model.RoleAdmin})
+
+		adminID = int64(1)
+		userID  = int64(2)
+
+		logErr = errors.New("log error")
+	)
+
+	tests := []struct {
+		name               string
+		req                *desc.SetRoleRequest
+		code               codes.Code
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+		logRepositoryMock  logRepositoryMockFunc
+	}{
+		{
+			name: "promote a user",
+			req:  &desc.SetRoleRequest{UserId: userID, Role: desc.Role_ROLE_ADMIN},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.UpdateRoleMock.Expect(ctx, userID, model.RoleAdmin).Return(nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, &logModel.Log{Action: "role_changed_to_admin", EntityID: userID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "demote an admin while another one is left",
+			req:  &desc.SetRoleRequest{UserId: adminID, Role: desc.Role_ROLE_USER},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.LockByRoleMock.Expect(ctx, model.RoleAdmin).Return([]int64{adminID, 3}, nil)
+				mock.UpdateRoleMock.Expect(ctx, adminID, model.RoleUser).Return(nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, &logModel.Log{Action: "role_changed_to_user", EntityID: adminID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "demote the last admin",
+			req:  &desc.SetRoleRequest{UserId: adminID, Role: desc.Role_ROLE_USER},
+			code: codes.FailedPrecondition,
+			err:  errors.New("the last admin can't be demoted"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.LockByRoleMock.Expect(ctx, model.RoleAdmin).Return([]int64{adminID}, nil)
+				return mock
+			},
+		},
+		{
+			name: "unknown role",
+			req:  &desc.SetRoleRequest{UserId: userID, Role: desc.Role_ROLE_UNSPECIFIED},
+			code: codes.InvalidArgument,
+			err:  errors.New("unknown role"),
+		},
+		{
+			name: "user not found",
+			req:  &desc.SetRoleRequest{UserId: userID, Role: desc.Role_ROLE_USER},
+			code: codes.NotFound,
+			err:  errors.New("user not found"),
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.LockByRoleMock.Return([]int64{adminID}, nil)
+				mock.UpdateRoleMock.Return(repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "log error",
+			req:  &desc.SetRoleRequest{UserId: userID, Role: desc.Role_ROLE_ADMIN},
+			code: codes.Internal,
+			err:  logErr,
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.UpdateRoleMock.Return(nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(logErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := user.NewImplementation(newUserService(mc, tt.userRepositoryMock, tt.logRepositoryMock, nil))
+
+			resp, err := api.SetRole(ctx, tt.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, resp)
+			}
+		})
+	}
+}
@@ -2,18 +2,27 @@ package user_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"auth/internal/api/user"
+	"auth/internal/identity"
+	"auth/internal/mailer"
+	mailerMocks "auth/internal/mailer/mocks"
 	"auth/internal/model"
 	"auth/internal/repository/mocks"
+	serviceMocks "auth/internal/service/mocks"
 	userService "auth/internal/service/user"
+	"auth/internal/utils"
 	desc "auth/pkg/user_v1"
 
 	"github.com/gojuno/minimock/v3"
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -21,6 +30,7 @@ import (
 func TestImplementation_Update(t *testing.T) {
 	type userRepositoryMockFunc func(mc *minimock.Controller) *mocks.UserRepositoryMock
 	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	type accessRepositoryMockFunc func(mc *minimock.Controller) *mocks.AccessRepositoryMock
 
 	type args struct {
 		ctx context.Context
@@ -28,12 +38,16 @@ func TestImplementation_Update(t *testing.T) {
 	}
 
 	var (
-		ctx = context.Background()
-		mc  = minimock.NewController(t)
+		mc = minimock.NewController(t)
 
-		id    = int64(1)
-		name  = "new_name"
-		email = "new_email@example.com"
+		id       = int64(1)
+		name     = "new_name"
+		email    = "new_email@example.com"
+		oldEmail = "old_email@example.com"
+
+		ctx      = identity.WithClaims(context.Background(), &model.UserClaims{UserID: id, Name: "test_user", Role: model.RoleUser})
+		adminCtx = identity.WithClaims(context.Background(), &model.UserClaims{UserID: 2, Name: "admin", Role: model.RoleAdmin})
+		otherCtx = identity.WithClaims(context.Background(), &model.UserClaims{UserID: 3, Name: "other_user", Role: model.RoleUser})
 
 		req = &desc.UpdateRequest{
 			Id: id,
@@ -48,6 +62,16 @@ func TestImplementation_Update(t *testing.T) {
 			Email: &email,
 		}
 
+		current = &model.User{
+			ID:              id,
+			Info:            model.UserInfo{Name: "test_user", Email: oldEmail, Role: model.RoleUser},
+			EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}
+		updated = &model.User{
+			ID:   id,
+			Info: model.UserInfo{Name: name, Email: email, Role: model.RoleUser},
+		}
+
 		logEntry = &logModel.Log{
 			Action:   "user_updated",
 			EntityID: id,
@@ -57,13 +81,40 @@ func TestImplementation_Update(t *testing.T) {
 		logErr  = errors.New("log error")
 	)
 
+	// changeEmail returns the user as stored before the update, then as
+	// updated.
+	changeEmail := func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+		mock := mocks.NewUserRepositoryMock(mc)
+		stored := current
+		mock.GetMock.Set(func(_ context.Context, _ int64) (*model.User, error) {
+			return stored, nil
+		})
+		mock.UpdateMock.Set(func(_ context.Context, _ int64, update *model.UpdateUserData) error {
+			require.Equal(t, updateUser, update)
+			stored = updated
+			return nil
+		})
+		return mock
+	}
+	logUpdate := func(ctx context.Context) logRepositoryMockFunc {
+		return func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+			mock := mocks.NewLogRepositoryMock(mc)
+			mock.LogMock.Expect(ctx, logEntry).Return(nil)
+			return mock
+		}
+	}
+
 	tests := []struct {
-		name               string
-		args               args
-		want               *emptypb.Empty
-		err                error
-		userRepositoryMock userRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
+		name                 string
+		args                 args
+		want                 *emptypb.Empty
+		code                 codes.Code
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		logRepositoryMock    logRepositoryMockFunc
+		accessRepositoryMock accessRepositoryMockFunc
+		// mailed is whether a verification mail goes to the new email.
+		mailed bool
 	}{
 		{
 			name: "success case",
@@ -71,18 +122,79 @@ func TestImplementation_Update(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
+			want:               &emptypb.Empty{},
+			userRepositoryMock: changeEmail,
+			logRepositoryMock:  logUpdate(ctx),
+			mailed:             true,
+		},
+		{
+			name: "admin updates another user",
+			args: args{
+				ctx: adminCtx,
+				req: req,
+			},
+			want:               &emptypb.Empty{},
+			userRepositoryMock: changeEmail,
+			logRepositoryMock:  logUpdate(adminCtx),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetRolePermissionsMock.Expect(adminCtx, model.RoleAdmin).Return([]model.Permission{model.PermissionUserList, model.PermissionUserManageAny}, nil)
+				return mock
+			},
+			mailed: true,
+		},
+		{
+			name: "name only",
+			args: args{
+				ctx: ctx,
+				req: &desc.UpdateRequest{Id: id, Info: &desc.UpdateUserInfo{Name: wrapperspb.String(name)}},
+			},
 			want: &emptypb.Empty{},
-			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
 				mock := mocks.NewUserRepositoryMock(mc)
-				mock.UpdateMock.Expect(ctx, id, updateUser).Return(nil)
+				mock.GetMock.Expect(ctx, id).Return(current, nil)
+				mock.UpdateMock.Expect(ctx, id, &model.UpdateUserData{Name: &name}).Return(nil)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
-				mock := mocks.NewLogRepositoryMock(mc)
-				mock.LogMock.Expect(ctx, logEntry).Return(nil)
+			logRepositoryMock: logUpdate(ctx),
+		},
+		{
+			name: "same email",
+			args: args{
+				ctx: ctx,
+				req: &desc.UpdateRequest{Id: id, Info: &desc.UpdateUserInfo{Email: wrapperspb.String(oldEmail)}},
+			},
+			want: &emptypb.Empty{},
+			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
+				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(current, nil)
+				mock.UpdateMock.Expect(ctx, id, &model.UpdateUserData{Email: &oldEmail}).Return(nil)
 				return mock
 			},
+			logRepositoryMock: logUpdate(ctx),
+		},
+		{
+			name: "another user's account",
+			args: args{
+				ctx: otherCtx,
+				req: req,
+			},
+			code: codes.PermissionDenied,
+			err:  errors.New("access denied"),
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetRolePermissionsMock.Expect(otherCtx, model.RoleUser).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "not authenticated",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("user is not authenticated"),
 		},
 		{
 			name: "repository error",
@@ -90,17 +202,14 @@ func TestImplementation_Update(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
-			want: nil,
+			code: codes.Internal,
 			err:  repoErr,
 			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
 				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(current, nil)
 				mock.UpdateMock.Expect(ctx, id, updateUser).Return(repoErr)
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
-				mock := mocks.NewLogRepositoryMock(mc)
-				return mock
-			},
 		},
 		{
 			name: "log error",
@@ -108,10 +217,11 @@ func TestImplementation_Update(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
-			want: nil,
+			code: codes.Internal,
 			err:  logErr,
 			userRepositoryMock: func(mc *minimock.Controller) *mocks.UserRepositoryMock {
 				mock := mocks.NewUserRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, id).Return(current, nil)
 				mock.UpdateMock.Expect(ctx, id, updateUser).Return(nil)
 				return mock
 			},
@@ -125,15 +235,49 @@ func TestImplementation_Update(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			userRepoMock := tt.userRepositoryMock(mc)
-			logRepoMock := tt.logRepositoryMock(mc)
+			userRepoMock := mocks.NewUserRepositoryMock(mc)
+			if tt.userRepositoryMock != nil {
+				userRepoMock = tt.userRepositoryMock(mc)
+			}
+			logRepoMock := mocks.NewLogRepositoryMock(mc)
+			if tt.logRepositoryMock != nil {
+				logRepoMock = tt.logRepositoryMock(mc)
+			}
+			accessRepoMock := mocks.NewAccessRepositoryMock(mc)
+			if tt.accessRepositoryMock != nil {
+				accessRepoMock = tt.accessRepositoryMock(mc)
+			}
+
+			var (
+				tokenHash string
+				sent      = make(chan *mailer.Message, 1)
+			)
+			emailVerificationRepoMock := mocks.NewEmailVerificationRepositoryMock(mc)
+			mailerMock := mailerMocks.NewMailerMock(mc)
+			if tt.mailed {
+				emailVerificationRepoMock.RevokeByUserMock.Expect(tt.args.ctx, id).Return(nil)
+				emailVerificationRepoMock.CreateMock.Set(func(_ context.Context, token *model.EmailVerificationToken) error {
+					require.Equal(t, id, token.UserID)
+					tokenHash = token.TokenHash
+					return nil
+				})
+				mailerMock.SendMock.Set(func(_ context.Context, message *mailer.Message) error {
+					sent <- message
+					return nil
+				})
+			}
 
 			txManager := &txManagerMock{}
 
 			service := userService.NewService(
 				userRepoMock,
+				emailVerificationRepoMock,
+				accessRepoMock,
 				logRepoMock,
 				txManager,
+				serviceMocks.NewAuthServiceMock(mc),
+				mailerMock,
+				&emailVerificationConfigMock{},
 			)
 
 			api := user.NewImplementation(service)
@@ -142,12 +286,19 @@ func TestImplementation_Update(t *testing.T) {
 
 			if tt.err != nil {
 				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
 				require.Contains(t, err.Error(), tt.err.Error())
 				require.Nil(t, resp)
-			} else {
-				require.NoError(t, err)
-				require.NotNil(t, resp)
-				require.Equal(t, tt.want, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, resp)
+
+			if tt.mailed {
+				message := waitFor(t, sent)
+				require.Equal(t, email, message.To)
+				require.Equal(t, utils.HashToken(tokenFromBody(t, message.Body)), tokenHash)
 			}
 		})
 	}
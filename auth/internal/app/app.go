@@ -2,6 +2,8 @@ package app
 
 import (
 	"auth/internal/config"
+	accessDesc "auth/pkg/access_v1"
+	authDesc "auth/pkg/auth_v1"
 	desc "auth/pkg/user_v1"
 	"context"
 	"log"
@@ -73,11 +75,16 @@ func (a *App) initServiceProvider(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.UnaryInterceptor(a.serviceProvider.AuthInterceptor(ctx).Unary),
+	)
 
 	reflection.Register(a.grpcServer)
 
 	desc.RegisterUserV1Server(a.grpcServer, a.serviceProvider.UserImpl(ctx))
+	authDesc.RegisterAuthV1Server(a.grpcServer, a.serviceProvider.AuthImpl(ctx))
+	accessDesc.RegisterAccessV1Server(a.grpcServer, a.serviceProvider.AccessImpl(ctx))
 
 	return nil
 }
@@ -1,11 +1,23 @@
 package app
 
 import (
+	"auth/internal/api/access"
+	"auth/internal/api/auth"
 	"auth/internal/api/user"
 	"auth/internal/config"
+	"auth/internal/interceptor"
+	"auth/internal/mailer"
+	fileMailer "auth/internal/mailer/file"
+	smtpMailer "auth/internal/mailer/smtp"
 	"auth/internal/repository"
+	accessRepository "auth/internal/repository/access"
+	emailVerificationRepository "auth/internal/repository/emailverification"
+	loginThrottleRepository "auth/internal/repository/loginthrottle"
+	passwordResetRepository "auth/internal/repository/passwordreset"
 	userRepository "auth/internal/repository/user"
 	"auth/internal/service"
+	accessService "auth/internal/service/access"
+	authService "auth/internal/service/auth"
 	userService "auth/internal/service/user"
 	"context"
 	"log"
@@ -20,15 +32,34 @@ import (
 type serviceProvider struct {
 	pgConfig   config.PGConfig
 	grpcConfig config.GRPCConfig
+	jwtConfig  config.JWTConfig
 
-	dbClient       db.Client
-	txManager      db.TxManager
-	userRepository repository.UserRepository
-	logRepository  repository.LogRepository
+	mailerConfig        config.MailerConfig
+	passwordResetConfig config.PasswordResetConfig
 
-	userService service.UserService
+	emailVerificationConfig config.EmailVerificationConfig
+	loginThrottleConfig     config.LoginThrottleConfig
 
-	userImpl *user.Implementation
+	dbClient         db.Client
+	txManager        db.TxManager
+	userRepository   repository.UserRepository
+	accessRepository repository.AccessRepository
+	logRepository    repository.LogRepository
+
+	passwordResetRepository     repository.PasswordResetRepository
+	emailVerificationRepository repository.EmailVerificationRepository
+	loginThrottleRepository     repository.LoginThrottleRepository
+	mailer                      mailer.Mailer
+
+	userService   service.UserService
+	authService   service.AuthService
+	accessService service.AccessService
+
+	userImpl   *user.Implementation
+	authImpl   *auth.Implementation
+	accessImpl *access.Implementation
+
+	authInterceptor *interceptor.AuthInterceptor
 }
 
 func newServiceProvider() *serviceProvider {
@@ -61,6 +92,71 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	return s.grpcConfig
 }
 
+func (s *serviceProvider) JWTConfig() config.JWTConfig {
+	if s.jwtConfig == nil {
+		cfg, err := config.NewJWTConfig()
+		if err != nil {
+			log.Fatalf("failed to get jwt config: %s", err.Error())
+		}
+
+		s.jwtConfig = cfg
+	}
+
+	return s.jwtConfig
+}
+
+func (s *serviceProvider) MailerConfig() config.MailerConfig {
+	if s.mailerConfig == nil {
+		cfg, err := config.NewMailerConfig()
+		if err != nil {
+			log.Fatalf("failed to get mailer config: %s", err.Error())
+		}
+
+		s.mailerConfig = cfg
+	}
+
+	return s.mailerConfig
+}
+
+func (s *serviceProvider) PasswordResetConfig() config.PasswordResetConfig {
+	if s.passwordResetConfig == nil {
+		cfg, err := config.NewPasswordResetConfig()
+		if err != nil {
+			log.Fatalf("failed to get password reset config: %s", err.Error())
+		}
+
+		s.passwordResetConfig = cfg
+	}
+
+	return s.passwordResetConfig
+}
+
+func (s *serviceProvider) EmailVerificationConfig() config.EmailVerificationConfig {
+	if s.emailVerificationConfig == nil {
+		cfg, err := config.NewEmailVerificationConfig()
+		if err != nil {
+			log.Fatalf("failed to get email verification config: %s", err.Error())
+		}
+
+		s.emailVerificationConfig = cfg
+	}
+
+	return s.emailVerificationConfig
+}
+
+func (s *serviceProvider) LoginThrottleConfig() config.LoginThrottleConfig {
+	if s.loginThrottleConfig == nil {
+		cfg, err := config.NewLoginThrottleConfig()
+		if err != nil {
+			log.Fatalf("failed to get login throttle config: %s", err.Error())
+		}
+
+		s.loginThrottleConfig = cfg
+	}
+
+	return s.loginThrottleConfig
+}
+
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
 		cl, err := pg.New(ctx, s.PGConfig().DSN())
@@ -96,6 +192,14 @@ func (s *serviceProvider) UserRepository(ctx context.Context) repository.UserRep
 	return s.userRepository
 }
 
+func (s *serviceProvider) AccessRepository(ctx context.Context) repository.AccessRepository {
+	if s.accessRepository == nil {
+		s.accessRepository = accessRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.accessRepository
+}
+
 func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepository {
 	if s.logRepository == nil {
 		s.logRepository = logger.NewRepository(s.DBClient(ctx), "user_logs")
@@ -104,18 +208,97 @@ func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepos
 	return s.logRepository
 }
 
+func (s *serviceProvider) PasswordResetRepository(ctx context.Context) repository.PasswordResetRepository {
+	if s.passwordResetRepository == nil {
+		s.passwordResetRepository = passwordResetRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.passwordResetRepository
+}
+
+func (s *serviceProvider) EmailVerificationRepository(ctx context.Context) repository.EmailVerificationRepository {
+	if s.emailVerificationRepository == nil {
+		s.emailVerificationRepository = emailVerificationRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.emailVerificationRepository
+}
+
+func (s *serviceProvider) LoginThrottleRepository(ctx context.Context) repository.LoginThrottleRepository {
+	if s.loginThrottleRepository == nil {
+		s.loginThrottleRepository = loginThrottleRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.loginThrottleRepository
+}
+
+func (s *serviceProvider) Mailer() mailer.Mailer {
+	if s.mailer == nil {
+		cfg := s.MailerConfig()
+
+		switch cfg.Driver() {
+		case config.MailerDriverSMTP:
+			s.mailer = smtpMailer.NewMailer(cfg.SMTPAddress(), cfg.SMTPUsername(), cfg.SMTPPassword(), cfg.From())
+		default:
+			m, err := fileMailer.NewMailer(cfg.Dir(), cfg.From())
+			if err != nil {
+				log.Fatalf("failed to create mailer: %v", err)
+			}
+
+			s.mailer = m
+		}
+	}
+
+	return s.mailer
+}
+
 func (s *serviceProvider) UserService(ctx context.Context) service.UserService {
 	if s.userService == nil {
 		s.userService = userService.NewService(
 			s.UserRepository(ctx),
+			s.EmailVerificationRepository(ctx),
+			s.AccessRepository(ctx),
 			s.LogRepository(ctx),
 			s.TxManager(ctx),
+			s.AuthService(ctx),
+			s.Mailer(),
+			s.EmailVerificationConfig(),
 		)
 	}
 
 	return s.userService
 }
 
+func (s *serviceProvider) AuthService(ctx context.Context) service.AuthService {
+	if s.authService == nil {
+		s.authService = authService.NewService(
+			s.UserRepository(ctx),
+			s.PasswordResetRepository(ctx),
+			s.LoginThrottleRepository(ctx),
+			s.LogRepository(ctx),
+			s.TxManager(ctx),
+			s.Mailer(),
+			s.JWTConfig(),
+			s.PasswordResetConfig(),
+			s.EmailVerificationConfig(),
+			s.LoginThrottleConfig(),
+		)
+	}
+
+	return s.authService
+}
+
+func (s *serviceProvider) AccessService(ctx context.Context) service.AccessService {
+	if s.accessService == nil {
+		s.accessService = accessService.NewService(
+			s.AccessRepository(ctx),
+			s.JWTConfig(),
+		)
+	}
+
+	return s.accessService
+}
+
 func (s *serviceProvider) UserImpl(ctx context.Context) *user.Implementation {
 	if s.userImpl == nil {
 		s.userImpl = user.NewImplementation(s.UserService(ctx))
@@ -123,3 +306,27 @@ func (s *serviceProvider) UserImpl(ctx context.Context) *user.Implementation {
 
 	return s.userImpl
 }
+
+func (s *serviceProvider) AuthImpl(ctx context.Context) *auth.Implementation {
+	if s.authImpl == nil {
+		s.authImpl = auth.NewImplementation(s.AuthService(ctx))
+	}
+
+	return s.authImpl
+}
+
+func (s *serviceProvider) AccessImpl(ctx context.Context) *access.Implementation {
+	if s.accessImpl == nil {
+		s.accessImpl = access.NewImplementation(s.AccessService(ctx))
+	}
+
+	return s.accessImpl
+}
+
+func (s *serviceProvider) AuthInterceptor(ctx context.Context) *interceptor.AuthInterceptor {
+	if s.authInterceptor == nil {
+		s.authInterceptor = interceptor.NewAuthInterceptor(s.AccessService(ctx))
+	}
+
+	return s.authInterceptor
+}
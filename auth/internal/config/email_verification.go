@@ -0,0 +1,70 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	emailVerificationTokenTTLEnvName       = "EMAIL_VERIFICATION_TOKEN_TTL"
+	emailVerificationResendIntervalEnvName = "EMAIL_VERIFICATION_RESEND_INTERVAL"
+	emailVerificationRequiredEnvName       = "EMAIL_VERIFICATION_REQUIRED"
+
+	defaultEmailVerificationTokenTTL       = 24 * time.Hour
+	defaultEmailVerificationResendInterval = time.Minute
+)
+
+type EmailVerificationConfig interface {
+	TokenTTL() time.Duration
+	// ResendInterval is the least time between two mails to the same user.
+	ResendInterval() time.Duration
+	// Required keeps unverified users from signing in.
+	Required() bool
+}
+
+type emailVerificationConfig struct {
+	tokenTTL       time.Duration
+	resendInterval time.Duration
+	required       bool
+}
+
+func NewEmailVerificationConfig() (EmailVerificationConfig, error) {
+	ttl, err := durationFromEnv(emailVerificationTokenTTLEnvName, defaultEmailVerificationTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	resendInterval, err := durationFromEnv(emailVerificationResendIntervalEnvName, defaultEmailVerificationResendInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	var required bool
+	if value := os.Getenv(emailVerificationRequiredEnvName); len(value) > 0 {
+		required, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", emailVerificationRequiredEnvName)
+		}
+	}
+
+	return &emailVerificationConfig{
+		tokenTTL:       ttl,
+		resendInterval: resendInterval,
+		required:       required,
+	}, nil
+}
+
+func (cfg *emailVerificationConfig) TokenTTL() time.Duration {
+	return cfg.tokenTTL
+}
+
+func (cfg *emailVerificationConfig) ResendInterval() time.Duration {
+	return cfg.resendInterval
+}
+
+func (cfg *emailVerificationConfig) Required() bool {
+	return cfg.required
+}
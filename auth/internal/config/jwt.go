@@ -0,0 +1,91 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	accessTokenSecretKeyEnvName   = "ACCESS_TOKEN_SECRET_KEY"
+	refreshTokenSecretKeyEnvName  = "REFRESH_TOKEN_SECRET_KEY"
+	accessTokenExpirationEnvName  = "ACCESS_TOKEN_EXPIRATION"
+	refreshTokenExpirationEnvName = "REFRESH_TOKEN_EXPIRATION"
+
+	defaultAccessTokenExpiration  = 15 * time.Minute
+	defaultRefreshTokenExpiration = 30 * 24 * time.Hour
+)
+
+type JWTConfig interface {
+	AccessTokenSecretKey() []byte
+	RefreshTokenSecretKey() []byte
+	AccessTokenExpiration() time.Duration
+	RefreshTokenExpiration() time.Duration
+}
+
+type jwtConfig struct {
+	accessTokenSecretKey   []byte
+	refreshTokenSecretKey  []byte
+	accessTokenExpiration  time.Duration
+	refreshTokenExpiration time.Duration
+}
+
+func NewJWTConfig() (JWTConfig, error) {
+	accessKey := os.Getenv(accessTokenSecretKeyEnvName)
+	if len(accessKey) == 0 {
+		return nil, errors.New("access token secret key not found")
+	}
+
+	refreshKey := os.Getenv(refreshTokenSecretKeyEnvName)
+	if len(refreshKey) == 0 {
+		return nil, errors.New("refresh token secret key not found")
+	}
+
+	accessExpiration, err := durationFromEnv(accessTokenExpirationEnvName, defaultAccessTokenExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshExpiration, err := durationFromEnv(refreshTokenExpirationEnvName, defaultRefreshTokenExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jwtConfig{
+		accessTokenSecretKey:   []byte(accessKey),
+		refreshTokenSecretKey:  []byte(refreshKey),
+		accessTokenExpiration:  accessExpiration,
+		refreshTokenExpiration: refreshExpiration,
+	}, nil
+}
+
+func (cfg *jwtConfig) AccessTokenSecretKey() []byte {
+	return cfg.accessTokenSecretKey
+}
+
+func (cfg *jwtConfig) RefreshTokenSecretKey() []byte {
+	return cfg.refreshTokenSecretKey
+}
+
+func (cfg *jwtConfig) AccessTokenExpiration() time.Duration {
+	return cfg.accessTokenExpiration
+}
+
+func (cfg *jwtConfig) RefreshTokenExpiration() time.Duration {
+	return cfg.refreshTokenExpiration
+}
+
+func durationFromEnv(name string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if len(value) == 0 {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", name)
+	}
+
+	return d, nil
+}
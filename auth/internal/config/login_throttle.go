@@ -0,0 +1,164 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	loginMaxAccountFailuresEnvName = "LOGIN_MAX_ACCOUNT_FAILURES"
+	loginMaxIPFailuresEnvName      = "LOGIN_MAX_IP_FAILURES"
+	loginBackoffBaseEnvName        = "LOGIN_BACKOFF_BASE"
+	loginBackoffMaxEnvName         = "LOGIN_BACKOFF_MAX"
+	loginLockoutDurationEnvName    = "LOGIN_LOCKOUT_DURATION"
+	loginFailureWindowEnvName      = "LOGIN_FAILURE_WINDOW"
+	loginTrustedProxyHopsEnvName   = "LOGIN_TRUSTED_PROXY_HOPS"
+
+	defaultLoginMaxAccountFailures = 5
+	defaultLoginMaxIPFailures      = 20
+	defaultLoginBackoffBase        = time.Second
+	defaultLoginBackoffMax         = 5 * time.Minute
+	defaultLoginLockoutDuration    = 15 * time.Minute
+	defaultLoginFailureWindow      = time.Hour
+	defaultLoginTrustedProxyHops   = 0
+)
+
+type LoginThrottleConfig interface {
+	// MaxAccountFailures and MaxIPFailures are the failures that lock an
+	// account or a client address out.
+	MaxAccountFailures() int
+	MaxIPFailures() int
+	// BackoffBase is the delay after the first failure, it doubles with
+	// every further failure up to BackoffMax.
+	BackoffBase() time.Duration
+	BackoffMax() time.Duration
+	LockoutDuration() time.Duration
+	// FailureWindow is how long a failure counts, the count starts over
+	// after a quiet window.
+	FailureWindow() time.Duration
+	// TrustedProxyHops is the number of proxies in front of the service, the
+	// client address is taken from what they forward instead of the peer.
+	TrustedProxyHops() int
+}
+
+type loginThrottleConfig struct {
+	maxAccountFailures int
+	maxIPFailures      int
+	backoffBase        time.Duration
+	backoffMax         time.Duration
+	lockoutDuration    time.Duration
+	failureWindow      time.Duration
+	trustedProxyHops   int
+}
+
+func NewLoginThrottleConfig() (LoginThrottleConfig, error) {
+	maxAccountFailures, err := positiveIntFromEnv(loginMaxAccountFailuresEnvName, defaultLoginMaxAccountFailures)
+	if err != nil {
+		return nil, err
+	}
+
+	maxIPFailures, err := positiveIntFromEnv(loginMaxIPFailuresEnvName, defaultLoginMaxIPFailures)
+	if err != nil {
+		return nil, err
+	}
+
+	backoffBase, err := durationFromEnv(loginBackoffBaseEnvName, defaultLoginBackoffBase)
+	if err != nil {
+		return nil, err
+	}
+
+	backoffMax, err := durationFromEnv(loginBackoffMaxEnvName, defaultLoginBackoffMax)
+	if err != nil {
+		return nil, err
+	}
+
+	lockoutDuration, err := durationFromEnv(loginLockoutDurationEnvName, defaultLoginLockoutDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	failureWindow, err := durationFromEnv(loginFailureWindowEnvName, defaultLoginFailureWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedProxyHops, err := nonNegativeIntFromEnv(loginTrustedProxyHopsEnvName, defaultLoginTrustedProxyHops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loginThrottleConfig{
+		maxAccountFailures: maxAccountFailures,
+		maxIPFailures:      maxIPFailures,
+		backoffBase:        backoffBase,
+		backoffMax:         backoffMax,
+		lockoutDuration:    lockoutDuration,
+		failureWindow:      failureWindow,
+		trustedProxyHops:   trustedProxyHops,
+	}, nil
+}
+
+func (cfg *loginThrottleConfig) MaxAccountFailures() int {
+	return cfg.maxAccountFailures
+}
+
+func (cfg *loginThrottleConfig) MaxIPFailures() int {
+	return cfg.maxIPFailures
+}
+
+func (cfg *loginThrottleConfig) BackoffBase() time.Duration {
+	return cfg.backoffBase
+}
+
+func (cfg *loginThrottleConfig) BackoffMax() time.Duration {
+	return cfg.backoffMax
+}
+
+func (cfg *loginThrottleConfig) LockoutDuration() time.Duration {
+	return cfg.lockoutDuration
+}
+
+func (cfg *loginThrottleConfig) FailureWindow() time.Duration {
+	return cfg.failureWindow
+}
+
+func (cfg *loginThrottleConfig) TrustedProxyHops() int {
+	return cfg.trustedProxyHops
+}
+
+func positiveIntFromEnv(name string, def int) (int, error) {
+	value := os.Getenv(name)
+	if len(value) == 0 {
+		return def, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", name)
+	}
+	if n <= 0 {
+		return 0, errors.Errorf("invalid %s: must be positive", name)
+	}
+
+	return n, nil
+}
+
+func nonNegativeIntFromEnv(name string, def int) (int, error) {
+	value := os.Getenv(name)
+	if len(value) == 0 {
+		return def, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", name)
+	}
+	if n < 0 {
+		return 0, errors.Errorf("invalid %s: must not be negative", name)
+	}
+
+	return n, nil
+}
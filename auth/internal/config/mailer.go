@@ -0,0 +1,106 @@
+package config
+
+import (
+	"net"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	mailerDriverEnvName = "MAILER_DRIVER"
+	mailFromEnvName     = "MAIL_FROM"
+	mailDirEnvName      = "MAIL_DIR"
+	smtpHostEnvName     = "SMTP_HOST"
+	smtpPortEnvName     = "SMTP_PORT"
+	smtpUsernameEnvName = "SMTP_USERNAME"
+	smtpPasswordEnvName = "SMTP_PASSWORD"
+
+	MailerDriverSMTP = "smtp"
+	MailerDriverFile = "file"
+
+	defaultMailDir  = "./tmp/mail"
+	defaultSMTPPort = "587"
+)
+
+type MailerConfig interface {
+	// Driver is MailerDriverSMTP or MailerDriverFile.
+	Driver() string
+	From() string
+	// Dir is where the file driver writes the messages.
+	Dir() string
+	SMTPAddress() string
+	SMTPUsername() string
+	SMTPPassword() string
+}
+
+type mailerConfig struct {
+	driver       string
+	from         string
+	dir          string
+	smtpHost     string
+	smtpPort     string
+	smtpUsername string
+	smtpPassword string
+}
+
+func NewMailerConfig() (MailerConfig, error) {
+	cfg := &mailerConfig{
+		driver:       envOrDefault(mailerDriverEnvName, MailerDriverFile),
+		from:         os.Getenv(mailFromEnvName),
+		dir:          envOrDefault(mailDirEnvName, defaultMailDir),
+		smtpHost:     os.Getenv(smtpHostEnvName),
+		smtpPort:     envOrDefault(smtpPortEnvName, defaultSMTPPort),
+		smtpUsername: os.Getenv(smtpUsernameEnvName),
+		smtpPassword: os.Getenv(smtpPasswordEnvName),
+	}
+
+	if len(cfg.from) == 0 {
+		return nil, errors.New("mail from address not found")
+	}
+
+	switch cfg.driver {
+	case MailerDriverFile:
+	case MailerDriverSMTP:
+		if len(cfg.smtpHost) == 0 {
+			return nil, errors.New("smtp host not found")
+		}
+	default:
+		return nil, errors.Errorf("unknown %s %q", mailerDriverEnvName, cfg.driver)
+	}
+
+	return cfg, nil
+}
+
+func (cfg *mailerConfig) Driver() string {
+	return cfg.driver
+}
+
+func (cfg *mailerConfig) From() string {
+	return cfg.from
+}
+
+func (cfg *mailerConfig) Dir() string {
+	return cfg.dir
+}
+
+func (cfg *mailerConfig) SMTPAddress() string {
+	return net.JoinHostPort(cfg.smtpHost, cfg.smtpPort)
+}
+
+func (cfg *mailerConfig) SMTPUsername() string {
+	return cfg.smtpUsername
+}
+
+func (cfg *mailerConfig) SMTPPassword() string {
+	return cfg.smtpPassword
+}
+
+func envOrDefault(name, def string) string {
+	value := os.Getenv(name)
+	if len(value) == 0 {
+		return def
+	}
+
+	return value
+}
@@ -0,0 +1,47 @@
+package config
+
+import "time"
+
+const (
+	passwordResetTokenTTLEnvName       = "PASSWORD_RESET_TOKEN_TTL"
+	passwordResetResendIntervalEnvName = "PASSWORD_RESET_RESEND_INTERVAL"
+
+	defaultPasswordResetTokenTTL       = time.Hour
+	defaultPasswordResetResendInterval = time.Minute
+)
+
+type PasswordResetConfig interface {
+	TokenTTL() time.Duration
+	// ResendInterval is the least time between two mails to the same user.
+	ResendInterval() time.Duration
+}
+
+type passwordResetConfig struct {
+	tokenTTL       time.Duration
+	resendInterval time.Duration
+}
+
+func NewPasswordResetConfig() (PasswordResetConfig, error) {
+	ttl, err := durationFromEnv(passwordResetTokenTTLEnvName, defaultPasswordResetTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	resendInterval, err := durationFromEnv(passwordResetResendIntervalEnvName, defaultPasswordResetResendInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passwordResetConfig{
+		tokenTTL:       ttl,
+		resendInterval: resendInterval,
+	}, nil
+}
+
+func (cfg *passwordResetConfig) TokenTTL() time.Duration {
+	return cfg.tokenTTL
+}
+
+func (cfg *passwordResetConfig) ResendInterval() time.Duration {
+	return cfg.resendInterval
+}
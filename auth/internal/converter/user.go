@@ -12,7 +12,6 @@ func ToUserCreateFromDesc(req *desc.CreateRequest) *model.CreateUserCommand {
 		Info: model.UserInfo{
 			Name:  req.GetName(),
 			Email: req.GetEmail(),
-			Role:  model.Role(req.GetRole()),
 		},
 		Password:        req.GetPassword(),
 		PasswordConfirm: req.GetPasswordConfirm(),
@@ -42,7 +41,7 @@ func ToUserFromService(user *model.User) *desc.User {
 		updatedAt = timestamppb.New(user.UpdatedAt.Time)
 	}
 
-	return &desc.User{
+	res := &desc.User{
 		Id:        user.ID,
 		Name:      user.Info.Name,
 		Email:     user.Info.Email,
@@ -50,4 +49,47 @@ func ToUserFromService(user *model.User) *desc.User {
 		CreatedAt: timestamppb.New(user.CreatedAt),
 		UpdatedAt: updatedAt,
 	}
+	if user.EmailVerifiedAt.Valid {
+		res.EmailVerifiedAt = timestamppb.New(user.EmailVerifiedAt.Time)
+	}
+
+	return res
+}
+
+func ToListUsersParamsFromDesc(req *desc.ListUsersRequest) *model.ListUsersParams {
+	filter := model.UserFilter{
+		Role:   model.Role(req.GetFilter().GetRole()),
+		Search: req.GetFilter().GetSearch(),
+	}
+	if req.GetFilter().GetCreatedFrom() != nil {
+		filter.CreatedFrom = req.GetFilter().GetCreatedFrom().AsTime()
+	}
+	if req.GetFilter().GetCreatedTo() != nil {
+		filter.CreatedTo = req.GetFilter().GetCreatedTo().AsTime()
+	}
+
+	return &model.ListUsersParams{
+		Filter:    filter,
+		SortField: model.UserSortField(req.GetSortField()),
+		SortDesc:  req.GetSortDirection() == desc.SortDirection_SORT_DIRECTION_DESC,
+		Cursor:    req.GetCursor(),
+		Limit:     uint64(req.GetLimit()),
+	}
+}
+
+func ToUsersFromService(users []*model.User) []*desc.User {
+	res := make([]*desc.User, 0, len(users))
+	for _, user := range users {
+		res = append(res, ToUserFromService(user))
+	}
+
+	return res
+}
+
+func ToChangePasswordFromDesc(req *desc.ChangePasswordRequest) *model.ChangePasswordCommand {
+	return &model.ChangePasswordCommand{
+		OldPassword:     req.GetOldPassword(),
+		Password:        req.GetNewPassword(),
+		PasswordConfirm: req.GetNewPasswordConfirm(),
+	}
 }
@@ -0,0 +1,19 @@
+package identity
+
+import (
+	"auth/internal/model"
+	"context"
+)
+
+type ctxKey struct{}
+
+// WithClaims stores the claims of the authenticated caller in the context.
+func WithClaims(ctx context.Context, claims *model.UserClaims) context.Context {
+	return context.WithValue(ctx, ctxKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims put into the context by the auth interceptor.
+func ClaimsFromContext(ctx context.Context) (*model.UserClaims, bool) {
+	claims, ok := ctx.Value(ctxKey{}).(*model.UserClaims)
+	return claims, ok
+}
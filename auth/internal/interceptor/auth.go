@@ -0,0 +1,61 @@
+package interceptor
+
+import (
+	"auth/internal/identity"
+	"auth/internal/service"
+	"auth/internal/utils"
+	"context"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// protectedMethods need an access token, the remaining methods are called by
+// other services or before the user has a token.
+var protectedMethods = map[string]struct{}{
+	"/user_v1.UserV1/Update":           {},
+	"/user_v1.UserV1/Delete":           {},
+	"/user_v1.UserV1/ListUsers":        {},
+	"/user_v1.UserV1/GetUsersByNames":  {},
+	"/user_v1.UserV1/GetUsersByEmails": {},
+	"/user_v1.UserV1/ChangePassword":   {},
+	"/user_v1.UserV1/ResetPassword":    {},
+	"/user_v1.UserV1/SetRole":          {},
+	"/auth_v1.AuthV1/UnlockUser":       {},
+}
+
+type AuthInterceptor struct {
+	accessService service.AccessService
+}
+
+func NewAuthInterceptor(accessService service.AccessService) *AuthInterceptor {
+	return &AuthInterceptor{
+		accessService: accessService,
+	}
+}
+
+// Unary checks the caller against the endpoint permissions, the same way
+// AccessV1.Check does for other services.
+func (i *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if _, ok := protectedMethods[info.FullMethod]; !ok {
+		return handler(ctx, req)
+	}
+
+	accessToken, err := utils.AccessTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := i.accessService.Check(ctx, accessToken, info.FullMethod)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("failed to check access to %s: %v", info.FullMethod, err)
+		return nil, status.Error(codes.Internal, "failed to check access")
+	}
+
+	return handler(identity.WithClaims(ctx, claims), req)
+}
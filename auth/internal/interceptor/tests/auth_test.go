@@ -0,0 +1,200 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auth/internal/identity"
+	"auth/internal/interceptor"
+	"auth/internal/model"
+	"auth/internal/repository/mocks"
+	accessService "auth/internal/service/access"
+	"auth/internal/utils"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type accessRepositoryMockFunc func(mc *minimock.Controller) *mocks.AccessRepositoryMock
+
+const (
+	protectedMethod = "/user_v1.UserV1/ResetPassword"
+	openMethod      = "/user_v1.UserV1/Create"
+)
+
+// jwtConfigMock is a static JWTConfig for tests
+type jwtConfigMock struct{}
+
+func (c *jwtConfigMock) AccessTokenSecretKey() []byte {
+	return []byte("access-secret")
+}
+
+func (c *jwtConfigMock) RefreshTokenSecretKey() []byte {
+	return []byte("refresh-secret")
+}
+
+func (c *jwtConfigMock) AccessTokenExpiration() time.Duration {
+	return 5 * time.Minute
+}
+
+func (c *jwtConfigMock) RefreshTokenExpiration() time.Duration {
+	return time.Hour
+}
+
+func TestAuthInterceptor_Unary(t *testing.T) {
+	var (
+		mc        = minimock.NewController(t)
+		jwtConfig = &jwtConfigMock{}
+
+		admin = &model.User{ID: 1, Info: model.UserInfo{Name: "admin", Role: model.RoleAdmin}}
+
+		accessToken, _ = utils.GenerateToken(admin, jwtConfig.AccessTokenSecretKey(), time.Hour)
+
+		withToken = func(token string) context.Context {
+			return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		}
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name                 string
+		ctx                  context.Context
+		method               string
+		want                 *model.UserClaims
+		code                 codes.Code
+		accessRepositoryMock accessRepositoryMockFunc
+	}{
+		{
+			name:   "success",
+			ctx:    withToken(accessToken),
+			method: protectedMethod,
+			want:   &model.UserClaims{UserID: 1, Name: "admin", Role: model.RoleAdmin},
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Expect(minimock.AnyContext, protectedMethod).Return([]model.Permission{model.PermissionUserResetPassword}, nil)
+				mock.GetRolePermissionsMock.Expect(minimock.AnyContext, model.RoleAdmin).Return([]model.Permission{model.PermissionUserList, model.PermissionUserResetPassword}, nil)
+				return mock
+			},
+		},
+		{
+			name:   "unprotected method",
+			ctx:    context.Background(),
+			method: openMethod,
+		},
+		{
+			name:   "missing token",
+			ctx:    context.Background(),
+			method: protectedMethod,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "listing users without a token",
+			ctx:    context.Background(),
+			method: "/user_v1.UserV1/ListUsers",
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "updating a user without a token",
+			ctx:    context.Background(),
+			method: "/user_v1.UserV1/Update",
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "deleting a user without a token",
+			ctx:    context.Background(),
+			method: "/user_v1.UserV1/Delete",
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "looking up users without a token",
+			ctx:    context.Background(),
+			method: "/user_v1.UserV1/GetUsersByEmails",
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "looking up users as any user",
+			ctx:    withToken(accessToken),
+			method: "/user_v1.UserV1/GetUsersByNames",
+			want:   &model.UserClaims{UserID: 1, Name: "admin", Role: model.RoleAdmin},
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name:   "invalid token",
+			ctx:    withToken("not a token"),
+			method: protectedMethod,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "access denied",
+			ctx:    withToken(accessToken),
+			method: protectedMethod,
+			code:   codes.PermissionDenied,
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Return([]model.Permission{model.PermissionUserResetPassword}, nil)
+				mock.GetRolePermissionsMock.Return([]model.Permission{model.PermissionUserList}, nil)
+				return mock
+			},
+		},
+		{
+			name:   "repository error",
+			ctx:    withToken(accessToken),
+			method: protectedMethod,
+			code:   codes.Internal,
+			accessRepositoryMock: func(mc *minimock.Controller) *mocks.AccessRepositoryMock {
+				mock := mocks.NewAccessRepositoryMock(mc)
+				mock.GetEndpointPermissionsMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessRepo := mocks.NewAccessRepositoryMock(mc)
+			if tt.accessRepositoryMock != nil {
+				accessRepo = tt.accessRepositoryMock(mc)
+			}
+
+			i := interceptor.NewAuthInterceptor(accessService.NewService(accessRepo, jwtConfig))
+
+			called := false
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				called = true
+
+				claims, ok := identity.ClaimsFromContext(ctx)
+				if tt.want == nil {
+					require.False(t, ok)
+					return nil, nil
+				}
+
+				require.True(t, ok)
+				require.Equal(t, tt.want.UserID, claims.UserID)
+				require.Equal(t, tt.want.Name, claims.Name)
+				require.Equal(t, tt.want.Role, claims.Role)
+				return nil, nil
+			}
+
+			_, err := i.Unary(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if tt.code != codes.OK {
+				require.Equal(t, tt.code, status.Code(err))
+				require.False(t, called)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, called)
+		})
+	}
+}
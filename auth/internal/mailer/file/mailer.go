@@ -0,0 +1,47 @@
+package file
+
+import (
+	"auth/internal/mailer"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+// NewMailer writes every message as an .eml file under dir instead of
+// sending it, for local runs without a mail server.
+func NewMailer(dir, from string) (mailer.Mailer, error) {
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileMailer{
+		dir:  dir,
+		from: from,
+	}, nil
+}
+
+func (m *fileMailer) Send(_ context.Context, message *mailer.Message) error {
+	f, err := os.CreateTemp(m.dir, fmt.Sprintf("%d-*.eml", time.Now().UnixNano()))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(mailer.Format(m.from, message))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("mail %q to %s written to %s", message.Subject, message.To, filepath.Base(f.Name()))
+
+	return nil
+}
@@ -0,0 +1,3 @@
+package mailer
+
+//go:generate minimock -i Mailer -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i auth/internal/mailer.Mailer -o mailer_minimock.go -n MailerMock -p mocks
+
+import (
+	mm_mailer "auth/internal/mailer"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// MailerMock implements mm_mailer.Mailer
+type MailerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSend          func(ctx context.Context, message *mm_mailer.Message) (err error)
+	funcSendOrigin    string
+	inspectFuncSend   func(ctx context.Context, message *mm_mailer.Message)
+	afterSendCounter  uint64
+	beforeSendCounter uint64
+	SendMock          mMailerMockSend
+}
+
+// NewMailerMock returns a mock for mm_mailer.Mailer
+func NewMailerMock(t minimock.Tester) *MailerMock {
+	m := &MailerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SendMock = mMailerMockSend{mock: m}
+	m.SendMock.callArgs = []*MailerMockSendParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMailerMockSend struct {
+	optional           bool
+	mock               *MailerMock
+	defaultExpectation *MailerMockSendExpectation
+	expectations       []*MailerMockSendExpectation
+
+	callArgs []*MailerMockSendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MailerMockSendExpectation specifies expectation struct of the Mailer.Send
+type MailerMockSendExpectation struct {
+	mock               *MailerMock
+	params             *MailerMockSendParams
+	paramPtrs          *MailerMockSendParamPtrs
+	expectationOrigins MailerMockSendExpectationOrigins
+	results            *MailerMockSendResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MailerMockSendParams contains parameters of the Mailer.Send
+type MailerMockSendParams struct {
+	ctx     context.Context
+	message *mm_mailer.Message
+}
+
+// MailerMockSendParamPtrs contains pointers to parameters of the Mailer.Send
+type MailerMockSendParamPtrs struct {
+	ctx     *context.Context
+	message **mm_mailer.Message
+}
+
+// MailerMockSendResults contains results of the Mailer.Send
+type MailerMockSendResults struct {
+	err error
+}
+
+// MailerMockSendOrigins contains origins of expectations of the Mailer.Send
+type MailerMockSendExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSend *mMailerMockSend) Optional() *mMailerMockSend {
+	mmSend.optional = true
+	return mmSend
+}
+
+// Expect sets up expected params for Mailer.Send
+func (mmSend *mMailerMockSend) Expect(ctx context.Context, message *mm_mailer.Message) *mMailerMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("MailerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &MailerMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.paramPtrs != nil {
+		mmSend.mock.t.Fatalf("MailerMock.Send mock is already set by ExpectParams functions")
+	}
+
+	mmSend.defaultExpectation.params = &MailerMockSendParams{ctx, message}
+	mmSend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSend.expectations {
+		if minimock.Equal(e.params, mmSend.defaultExpectation.params) {
+			mmSend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSend.defaultExpectation.params)
+		}
+	}
+
+	return mmSend
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Mailer.Send
+func (mmSend *mMailerMockSend) ExpectCtxParam1(ctx context.Context) *mMailerMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("MailerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &MailerMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("MailerMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &MailerMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSend.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// ExpectMessageParam2 sets up expected param message for Mailer.Send
+func (mmSend *mMailerMockSend) ExpectMessageParam2(message *mm_mailer.Message) *mMailerMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("MailerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &MailerMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("MailerMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &MailerMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.message = &message
+	mmSend.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// Inspect accepts an inspector function that has same arguments as the Mailer.Send
+func (mmSend *mMailerMockSend) Inspect(f func(ctx context.Context, message *mm_mailer.Message)) *mMailerMockSend {
+	if mmSend.mock.inspectFuncSend != nil {
+		mmSend.mock.t.Fatalf("Inspect function is already set for MailerMock.Send")
+	}
+
+	mmSend.mock.inspectFuncSend = f
+
+	return mmSend
+}
+
+// Return sets up results that will be returned by Mailer.Send
+func (mmSend *mMailerMockSend) Return(err error) *MailerMock {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("MailerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &MailerMockSendExpectation{mock: mmSend.mock}
+	}
+	mmSend.defaultExpectation.results = &MailerMockSendResults{err}
+	mmSend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// Set uses given function f to mock the Mailer.Send method
+func (mmSend *mMailerMockSend) Set(f func(ctx context.Context, message *mm_mailer.Message) (err error)) *MailerMock {
+	if mmSend.defaultExpectation != nil {
+		mmSend.mock.t.Fatalf("Default expectation is already set for the Mailer.Send method")
+	}
+
+	if len(mmSend.expectations) > 0 {
+		mmSend.mock.t.Fatalf("Some expectations are already set for the Mailer.Send method")
+	}
+
+	mmSend.mock.funcSend = f
+	mmSend.mock.funcSendOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// When sets expectation for the Mailer.Send which will trigger the result defined by the following
+// Then helper
+func (mmSend *mMailerMockSend) When(ctx context.Context, message *mm_mailer.Message) *MailerMockSendExpectation {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("MailerMock.Send mock is already set by Set")
+	}
+
+	expectation := &MailerMockSendExpectation{
+		mock:               mmSend.mock,
+		params:             &MailerMockSendParams{ctx, message},
+		expectationOrigins: MailerMockSendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSend.expectations = append(mmSend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Mailer.Send return parameters for the expectation previously defined by the When method
+func (e *MailerMockSendExpectation) Then(err error) *MailerMock {
+	e.results = &MailerMockSendResults{err}
+	return e.mock
+}
+
+// Times sets number of times Mailer.Send should be invoked
+func (mmSend *mMailerMockSend) Times(n uint64) *mMailerMockSend {
+	if n == 0 {
+		mmSend.mock.t.Fatalf("Times of MailerMock.Send mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSend.expectedInvocations, n)
+	mmSend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSend
+}
+
+func (mmSend *mMailerMockSend) invocationsDone() bool {
+	if len(mmSend.expectations) == 0 && mmSend.defaultExpectation == nil && mmSend.mock.funcSend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSend.mock.afterSendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Send implements mm_mailer.Mailer
+func (mmSend *MailerMock) Send(ctx context.Context, message *mm_mailer.Message) (err error) {
+	mm_atomic.AddUint64(&mmSend.beforeSendCounter, 1)
+	defer mm_atomic.AddUint64(&mmSend.afterSendCounter, 1)
+
+	mmSend.t.Helper()
+
+	if mmSend.inspectFuncSend != nil {
+		mmSend.inspectFuncSend(ctx, message)
+	}
+
+	mm_params := MailerMockSendParams{ctx, message}
+
+	// Record call args
+	mmSend.SendMock.mutex.Lock()
+	mmSend.SendMock.callArgs = append(mmSend.SendMock.callArgs, &mm_params)
+	mmSend.SendMock.mutex.Unlock()
+
+	for _, e := range mmSend.SendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSend.SendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSend.SendMock.defaultExpectation.Counter, 1)
+		mm_want := mmSend.SendMock.defaultExpectation.params
+		mm_want_ptrs := mmSend.SendMock.defaultExpectation.paramPtrs
+
+		mm_got := MailerMockSendParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSend.t.Errorf("MailerMock.Send got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmSend.t.Errorf("MailerMock.Send got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSend.t.Errorf("MailerMock.Send got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSend.SendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSend.SendMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSend.t.Fatal("No results are set for the MailerMock.Send")
+		}
+		return (*mm_results).err
+	}
+	if mmSend.funcSend != nil {
+		return mmSend.funcSend(ctx, message)
+	}
+	mmSend.t.Fatalf("Unexpected call to MailerMock.Send. %v %v", ctx, message)
+	return
+}
+
+// SendAfterCounter returns a count of finished MailerMock.Send invocations
+func (mmSend *MailerMock) SendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.afterSendCounter)
+}
+
+// SendBeforeCounter returns a count of MailerMock.Send invocations
+func (mmSend *MailerMock) SendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.beforeSendCounter)
+}
+
+// Calls returns a list of arguments used in each call to MailerMock.Send.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSend *mMailerMockSend) Calls() []*MailerMockSendParams {
+	mmSend.mutex.RLock()
+
+	argCopy := make([]*MailerMockSendParams, len(mmSend.callArgs))
+	copy(argCopy, mmSend.callArgs)
+
+	mmSend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendDone returns true if the count of the Send invocations corresponds
+// the number of defined expectations
+func (m *MailerMock) MinimockSendDone() bool {
+	if m.SendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMock.invocationsDone()
+}
+
+// MinimockSendInspect logs each unmet expectation
+func (m *MailerMock) MinimockSendInspect() {
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MailerMock.Send at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendCounter := mm_atomic.LoadUint64(&m.afterSendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMock.defaultExpectation != nil && afterSendCounter < 1 {
+		if m.SendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MailerMock.Send at\n%s", m.SendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MailerMock.Send at\n%s with params: %#v", m.SendMock.defaultExpectation.expectationOrigins.origin, *m.SendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSend != nil && afterSendCounter < 1 {
+		m.t.Errorf("Expected call to MailerMock.Send at\n%s", m.funcSendOrigin)
+	}
+
+	if !m.SendMock.invocationsDone() && afterSendCounter > 0 {
+		m.t.Errorf("Expected %d calls to MailerMock.Send at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMock.expectedInvocations), m.SendMock.expectedInvocationsOrigin, afterSendCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MailerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSendInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MailerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MailerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSendDone()
+}
@@ -0,0 +1,14 @@
+package model
+
+// Permission names an action a role may take, the roles holding it are kept
+// in the role_permissions table.
+type Permission string
+
+const (
+	PermissionChatDeleteAny     Permission = "chat.delete_any"
+	PermissionUserList          Permission = "user.list"
+	PermissionUserManageAny     Permission = "user.manage_any"
+	PermissionUserResetPassword Permission = "user.reset_password"
+	PermissionUserSetRole       Permission = "user.set_role"
+	PermissionUserUnlock        Permission = "user.unlock"
+)
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type UserClaims struct {
+	jwt.RegisteredClaims
+	UserID int64  `json:"user_id"`
+	Name   string `json:"name"`
+	Role   Role   `json:"role"`
+
+	SessionVersion int64 `json:"session_version"`
+}
+
+type UserCredentials struct {
+	User           User
+	HashedPassword string
+}
+
+type AuthTokens struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// PasswordResetToken is a one-time token, only its hash is stored.
+type PasswordResetToken struct {
+	UserID    int64
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+type PasswordResetCommand struct {
+	Token    string
+	Password string
+}
+
+func (c *PasswordResetCommand) Validate() error {
+	if len(c.Token) == 0 {
+		return errors.New("token is required")
+	}
+	return validatePasswordStrength(c.Password)
+}
+
+type ThrottleScope string
+
+const (
+	ThrottleScopeAccount ThrottleScope = "account"
+	ThrottleScopeIP      ThrottleScope = "ip"
+)
+
+// ThrottleKey names whose failed attempts are counted: an account by its
+// normalized email or a client by its address.
+type ThrottleKey struct {
+	Scope   ThrottleScope
+	Subject string
+}
+
+// LoginThrottle is the failure count of a key, attempts are refused until
+// BlockedUntil.
+type LoginThrottle struct {
+	Failures      int
+	LastFailureAt time.Time
+	BlockedUntil  sql.NullTime
+}
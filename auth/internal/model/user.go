@@ -11,6 +11,11 @@ type User struct {
 	Info      UserInfo
 	CreatedAt time.Time
 	UpdatedAt sql.NullTime
+	// SessionVersion goes up with every password change, tokens issued for
+	// an older version are no longer accepted.
+	SessionVersion int64
+	// EmailVerifiedAt stays null until the user proves they own the email.
+	EmailVerifiedAt sql.NullTime
 }
 
 type UserInfo struct {
@@ -26,10 +31,37 @@ type CreateUserCommand struct {
 }
 
 func (c *CreateUserCommand) Validate() error {
-	if c.Password != c.PasswordConfirm {
+	return validatePassword(c.Password, c.PasswordConfirm)
+}
+
+type ChangePasswordCommand struct {
+	OldPassword     string
+	Password        string
+	PasswordConfirm string
+}
+
+func (c *ChangePasswordCommand) Validate() error {
+	if len(c.OldPassword) == 0 {
+		return errors.New("old password is required")
+	}
+	if err := validatePassword(c.Password, c.PasswordConfirm); err != nil {
+		return err
+	}
+	if c.Password == c.OldPassword {
+		return errors.New("new password must differ from the old one")
+	}
+	return nil
+}
+
+func validatePassword(password, confirm string) error {
+	if password != confirm {
 		return errors.New("passwords do not match")
 	}
-	if len(c.Password) < 8 {
+	return validatePasswordStrength(password)
+}
+
+func validatePasswordStrength(password string) error {
+	if len(password) < 8 {
 		return errors.New("password must be at least 8 characters")
 	}
 	return nil
@@ -40,6 +72,15 @@ type CreateUserData struct {
 	HashedPassword string
 }
 
+// EmailVerificationToken is a one-time token mailed to the user's address,
+// only its hash is stored.
+type EmailVerificationToken struct {
+	UserID    int64
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
 type UpdateUserData struct {
 	Name  *string
 	Email *string
@@ -63,3 +104,45 @@ func (r Role) String() string {
 		return "UNSPECIFIED"
 	}
 }
+
+type UserSortField int32
+
+const (
+	UserSortFieldUnspecified UserSortField = iota
+	UserSortFieldID
+	UserSortFieldName
+	UserSortFieldEmail
+	UserSortFieldCreatedAt
+)
+
+// UserFilter narrows a user listing, zero values disable a condition.
+type UserFilter struct {
+	Role        Role
+	Search      string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+type ListUsersParams struct {
+	Filter    UserFilter
+	SortField UserSortField
+	SortDesc  bool
+	Cursor    string
+	Limit     uint64
+}
+
+// UserListQuery is a page request resolved for the repository: After holds
+// the last user of the previous page, nil for the first page.
+type UserListQuery struct {
+	Filter    UserFilter
+	SortField UserSortField
+	SortDesc  bool
+	After     *User
+	Limit     uint64
+}
+
+type UserPage struct {
+	Users      []*User
+	NextCursor string
+	TotalCount int64
+}
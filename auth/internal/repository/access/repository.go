@@ -0,0 +1,76 @@
+package access
+
+import (
+	"auth/internal/model"
+	"auth/internal/repository"
+	"context"
+	"log"
+
+	"github.com/makxtr/go-common/pkg/db"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	tableName            = "endpoint_permissions"
+	rolePermissionsTable = "role_permissions"
+
+	endpointColumn   = "endpoint"
+	roleColumn       = "role"
+	permissionColumn = "permission"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.AccessRepository {
+	return &repo{db: db}
+}
+
+// GetEndpointPermissions returns the permissions any of which lets a caller
+// invoke the endpoint.
+func (r *repo) GetEndpointPermissions(ctx context.Context, endpoint string) ([]model.Permission, error) {
+	builder := sq.Select(permissionColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{endpointColumn: endpoint})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, repository.ErrQueryBuild
+	}
+
+	var permissions []model.Permission
+	err = r.db.DB().ScanAllContext(ctx, &permissions, db.Query{Name: "access_repository.GetEndpointPermissions", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to get endpoint permissions: %v", err)
+		return nil, repository.ErrQueryExec
+	}
+
+	return permissions, nil
+}
+
+func (r *repo) GetRolePermissions(ctx context.Context, role model.Role) ([]model.Permission, error) {
+	builder := sq.Select(permissionColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(rolePermissionsTable).
+		Where(sq.Eq{roleColumn: int32(role)}).
+		OrderBy(permissionColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, repository.ErrQueryBuild
+	}
+
+	var permissions []model.Permission
+	err = r.db.DB().ScanAllContext(ctx, &permissions, db.Query{Name: "access_repository.GetRolePermissions", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to get role permissions: %v", err)
+		return nil, repository.ErrQueryExec
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,131 @@
+package emailverification
+
+import (
+	"auth/internal/model"
+	"auth/internal/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/makxtr/go-common/pkg/db"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableName = "email_verification_tokens"
+
+	userIDColumn    = "user_id"
+	tokenHashColumn = "token_hash"
+	expiresAtColumn = "expires_at"
+	usedAtColumn    = "used_at"
+	createdAtColumn = "created_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.EmailVerificationRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, token *model.EmailVerificationToken) error {
+	builder := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, tokenHashColumn, expiresAtColumn, createdAtColumn).
+		Values(token.UserID, token.TokenHash, token.ExpiresAt, token.CreatedAt)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return repository.ErrQueryBuild
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "email_verification_repository.Create", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to create email verification token: %v", err)
+		return repository.ErrCreateFailed
+	}
+
+	return nil
+}
+
+// Consume marks the token as used and returns its user, tokens that are
+// unknown, expired or used already are not found.
+func (r *repo) Consume(ctx context.Context, tokenHash string) (int64, error) {
+	now := time.Now()
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, now).
+		Where(sq.Eq{tokenHashColumn: tokenHash, usedAtColumn: nil}).
+		Where(sq.Gt{expiresAtColumn: now}).
+		Suffix("RETURNING " + userIDColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, repository.ErrQueryBuild
+	}
+
+	var userID int64
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "email_verification_repository.Consume", QueryRaw: query}, args...).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrNotFound
+		}
+		log.Printf("failed to consume email verification token: %v", err)
+		return 0, repository.ErrUpdateFailed
+	}
+
+	return userID, nil
+}
+
+// RevokeByUser uses up the tokens of the user that are still outstanding.
+func (r *repo) RevokeByUser(ctx context.Context, userID int64) error {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(usedAtColumn, time.Now()).
+		Where(sq.Eq{userIDColumn: userID, usedAtColumn: nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return repository.ErrQueryBuild
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "email_verification_repository.RevokeByUser", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to revoke email verification tokens: %v", err)
+		return repository.ErrUpdateFailed
+	}
+
+	return nil
+}
+
+// LastCreatedAt returns when the newest token of the user was issued, the
+// zero time if none was.
+func (r *repo) LastCreatedAt(ctx context.Context, userID int64) (time.Time, error) {
+	builder := sq.Select("MAX(" + createdAtColumn + ")").
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{userIDColumn: userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return time.Time{}, repository.ErrQueryBuild
+	}
+
+	var createdAt sql.NullTime
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "email_verification_repository.LastCreatedAt", QueryRaw: query}, args...).Scan(&createdAt)
+	if err != nil {
+		log.Printf("failed to get last email verification token: %v", err)
+		return time.Time{}, repository.ErrQueryExec
+	}
+
+	return createdAt.Time, nil
+}
@@ -1,4 +1,8 @@
 package repository
 
 //go:generate minimock -i UserRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AccessRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PasswordResetRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i EmailVerificationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i LoginThrottleRepository -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i LogRepository -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,184 @@
+package loginthrottle
+
+import (
+	"auth/internal/model"
+	"auth/internal/repository"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/makxtr/go-common/pkg/db"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableName = "login_throttles"
+
+	scopeColumn         = "scope"
+	subjectColumn       = "subject"
+	failuresColumn      = "failures"
+	lastFailureAtColumn = "last_failure_at"
+	blockedUntilColumn  = "blocked_until"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.LoginThrottleRepository {
+	return &repo{db: db}
+}
+
+// Lock holds the key until the transaction ends and returns its failures,
+// concurrent attempts on the key wait for the outcome of this one. The key
+// is locked rather than its row, keys without failures have no row and get
+// none. Callers are expected to run it inside a transaction.
+func (r *repo) Lock(ctx context.Context, key model.ThrottleKey) (*model.LoginThrottle, error) {
+	lockBuilder := sq.Select().
+		PlaceholderFormat(sq.Dollar).
+		Column(sq.Expr("pg_advisory_xact_lock(hashtextextended(?, 0))", string(key.Scope)+":"+key.Subject))
+
+	query, args, err := lockBuilder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, repository.ErrQueryBuild
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "login_throttle_repository.Lock", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to lock login throttle: %v", err)
+		return nil, repository.ErrQueryExec
+	}
+
+	builder := sq.Select(failuresColumn, lastFailureAtColumn, blockedUntilColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{scopeColumn: string(key.Scope), subjectColumn: key.Subject})
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, repository.ErrQueryBuild
+	}
+
+	var throttle model.LoginThrottle
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "login_throttle_repository.Get", QueryRaw: query}, args...).
+		Scan(&throttle.Failures, &throttle.LastFailureAt, &throttle.BlockedUntil)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &model.LoginThrottle{}, nil
+		}
+		log.Printf("failed to get login throttle: %v", err)
+		return nil, repository.ErrQueryExec
+	}
+
+	return &throttle, nil
+}
+
+// RecordFailure counts a failed attempt of the key and returns the failures
+// so far. Failures older than windowStart are forgotten first.
+func (r *repo) RecordFailure(ctx context.Context, key model.ThrottleKey, at, windowStart time.Time) (int, error) {
+	builder := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(scopeColumn, subjectColumn, failuresColumn, lastFailureAtColumn).
+		Values(string(key.Scope), key.Subject, 1, at).
+		Suffix("ON CONFLICT ("+scopeColumn+", "+subjectColumn+") DO UPDATE SET "+
+			failuresColumn+" = CASE WHEN "+tableName+"."+lastFailureAtColumn+" < ? THEN 1 ELSE "+tableName+"."+failuresColumn+" + 1 END, "+
+			lastFailureAtColumn+" = EXCLUDED."+lastFailureAtColumn+" "+
+			"RETURNING "+failuresColumn, windowStart)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, repository.ErrQueryBuild
+	}
+
+	var failures int
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "login_throttle_repository.RecordFailure", QueryRaw: query}, args...).Scan(&failures)
+	if err != nil {
+		log.Printf("failed to record login failure: %v", err)
+		return 0, repository.ErrUpdateFailed
+	}
+
+	return failures, nil
+}
+
+func (r *repo) Block(ctx context.Context, key model.ThrottleKey, until time.Time) error {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(blockedUntilColumn, until).
+		Where(sq.Eq{scopeColumn: string(key.Scope), subjectColumn: key.Subject})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return repository.ErrQueryBuild
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "login_throttle_repository.Block", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to block login: %v", err)
+		return repository.ErrUpdateFailed
+	}
+
+	return nil
+}
+
+// Reset forgets the failures of the key, lifting any block.
+func (r *repo) Reset(ctx context.Context, key model.ThrottleKey) error {
+	builder := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{scopeColumn: string(key.Scope), subjectColumn: key.Subject})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return repository.ErrQueryBuild
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "login_throttle_repository.Reset", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to reset login throttle: %v", err)
+		return repository.ErrDeleteFailed
+	}
+
+	return nil
+}
+
+// Prune deletes the keys whose failures are older than windowStart and that
+// are no longer blocked at now, they would start over anyway. Keys locked by
+// a running attempt are left for a later prune.
+func (r *repo) Prune(ctx context.Context, windowStart, now time.Time) (int64, error) {
+	stale := sq.Select(scopeColumn, subjectColumn).
+		From(tableName).
+		Where(sq.Lt{lastFailureAtColumn: windowStart}).
+		Where(sq.Or{sq.Eq{blockedUntilColumn: nil}, sq.Lt{blockedUntilColumn: now}}).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	staleQuery, staleArgs, err := stale.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, repository.ErrQueryBuild
+	}
+
+	builder := sq.Delete(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where("("+scopeColumn+", "+subjectColumn+") IN ("+staleQuery+")", staleArgs...)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, repository.ErrQueryBuild
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "login_throttle_repository.Prune", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to prune login throttles: %v", err)
+		return 0, repository.ErrDeleteFailed
+	}
+
+	return res.RowsAffected(), nil
+}
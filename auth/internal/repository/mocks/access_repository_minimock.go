@@ -0,0 +1,774 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i auth/internal/repository.AccessRepository -o access_repository_minimock.go -n AccessRepositoryMock -p mocks
+
+import (
+	"auth/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AccessRepositoryMock implements mm_repository.AccessRepository
+type AccessRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetEndpointPermissions          func(ctx context.Context, endpoint string) (pa1 []model.Permission, err error)
+	funcGetEndpointPermissionsOrigin    string
+	inspectFuncGetEndpointPermissions   func(ctx context.Context, endpoint string)
+	afterGetEndpointPermissionsCounter  uint64
+	beforeGetEndpointPermissionsCounter uint64
+	GetEndpointPermissionsMock          mAccessRepositoryMockGetEndpointPermissions
+
+	funcGetRolePermissions          func(ctx context.Context, role model.Role) (pa1 []model.Permission, err error)
+	funcGetRolePermissionsOrigin    string
+	inspectFuncGetRolePermissions   func(ctx context.Context, role model.Role)
+	afterGetRolePermissionsCounter  uint64
+	beforeGetRolePermissionsCounter uint64
+	GetRolePermissionsMock          mAccessRepositoryMockGetRolePermissions
+}
+
+// NewAccessRepositoryMock returns a mock for mm_repository.AccessRepository
+func NewAccessRepositoryMock(t minimock.Tester) *AccessRepositoryMock {
+	m := &AccessRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetEndpointPermissionsMock = mAccessRepositoryMockGetEndpointPermissions{mock: m}
+	m.GetEndpointPermissionsMock.callArgs = []*AccessRepositoryMockGetEndpointPermissionsParams{}
+
+	m.GetRolePermissionsMock = mAccessRepositoryMockGetRolePermissions{mock: m}
+	m.GetRolePermissionsMock.callArgs = []*AccessRepositoryMockGetRolePermissionsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessRepositoryMockGetEndpointPermissions struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetEndpointPermissionsExpectation
+	expectations       []*AccessRepositoryMockGetEndpointPermissionsExpectation
+
+	callArgs []*AccessRepositoryMockGetEndpointPermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockGetEndpointPermissionsExpectation specifies expectation struct of the AccessRepository.GetEndpointPermissions
+type AccessRepositoryMockGetEndpointPermissionsExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockGetEndpointPermissionsParams
+	paramPtrs          *AccessRepositoryMockGetEndpointPermissionsParamPtrs
+	expectationOrigins AccessRepositoryMockGetEndpointPermissionsExpectationOrigins
+	results            *AccessRepositoryMockGetEndpointPermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockGetEndpointPermissionsParams contains parameters of the AccessRepository.GetEndpointPermissions
+type AccessRepositoryMockGetEndpointPermissionsParams struct {
+	ctx      context.Context
+	endpoint string
+}
+
+// AccessRepositoryMockGetEndpointPermissionsParamPtrs contains pointers to parameters of the AccessRepository.GetEndpointPermissions
+type AccessRepositoryMockGetEndpointPermissionsParamPtrs struct {
+	ctx      *context.Context
+	endpoint *string
+}
+
+// AccessRepositoryMockGetEndpointPermissionsResults contains results of the AccessRepository.GetEndpointPermissions
+type AccessRepositoryMockGetEndpointPermissionsResults struct {
+	pa1 []model.Permission
+	err error
+}
+
+// AccessRepositoryMockGetEndpointPermissionsOrigins contains origins of expectations of the AccessRepository.GetEndpointPermissions
+type AccessRepositoryMockGetEndpointPermissionsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originEndpoint string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) Optional() *mAccessRepositoryMockGetEndpointPermissions {
+	mmGetEndpointPermissions.optional = true
+	return mmGetEndpointPermissions
+}
+
+// Expect sets up expected params for AccessRepository.GetEndpointPermissions
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) Expect(ctx context.Context, endpoint string) *mAccessRepositoryMockGetEndpointPermissions {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("AccessRepositoryMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation == nil {
+		mmGetEndpointPermissions.defaultExpectation = &AccessRepositoryMockGetEndpointPermissionsExpectation{}
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.paramPtrs != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("AccessRepositoryMock.GetEndpointPermissions mock is already set by ExpectParams functions")
+	}
+
+	mmGetEndpointPermissions.defaultExpectation.params = &AccessRepositoryMockGetEndpointPermissionsParams{ctx, endpoint}
+	mmGetEndpointPermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetEndpointPermissions.expectations {
+		if minimock.Equal(e.params, mmGetEndpointPermissions.defaultExpectation.params) {
+			mmGetEndpointPermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetEndpointPermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmGetEndpointPermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetEndpointPermissions
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetEndpointPermissions {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("AccessRepositoryMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation == nil {
+		mmGetEndpointPermissions.defaultExpectation = &AccessRepositoryMockGetEndpointPermissionsExpectation{}
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.params != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("AccessRepositoryMock.GetEndpointPermissions mock is already set by Expect")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.paramPtrs == nil {
+		mmGetEndpointPermissions.defaultExpectation.paramPtrs = &AccessRepositoryMockGetEndpointPermissionsParamPtrs{}
+	}
+	mmGetEndpointPermissions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetEndpointPermissions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetEndpointPermissions
+}
+
+// ExpectEndpointParam2 sets up expected param endpoint for AccessRepository.GetEndpointPermissions
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) ExpectEndpointParam2(endpoint string) *mAccessRepositoryMockGetEndpointPermissions {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("AccessRepositoryMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation == nil {
+		mmGetEndpointPermissions.defaultExpectation = &AccessRepositoryMockGetEndpointPermissionsExpectation{}
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.params != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("AccessRepositoryMock.GetEndpointPermissions mock is already set by Expect")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation.paramPtrs == nil {
+		mmGetEndpointPermissions.defaultExpectation.paramPtrs = &AccessRepositoryMockGetEndpointPermissionsParamPtrs{}
+	}
+	mmGetEndpointPermissions.defaultExpectation.paramPtrs.endpoint = &endpoint
+	mmGetEndpointPermissions.defaultExpectation.expectationOrigins.originEndpoint = minimock.CallerInfo(1)
+
+	return mmGetEndpointPermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetEndpointPermissions
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) Inspect(f func(ctx context.Context, endpoint string)) *mAccessRepositoryMockGetEndpointPermissions {
+	if mmGetEndpointPermissions.mock.inspectFuncGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetEndpointPermissions")
+	}
+
+	mmGetEndpointPermissions.mock.inspectFuncGetEndpointPermissions = f
+
+	return mmGetEndpointPermissions
+}
+
+// Return sets up results that will be returned by AccessRepository.GetEndpointPermissions
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) Return(pa1 []model.Permission, err error) *AccessRepositoryMock {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("AccessRepositoryMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	if mmGetEndpointPermissions.defaultExpectation == nil {
+		mmGetEndpointPermissions.defaultExpectation = &AccessRepositoryMockGetEndpointPermissionsExpectation{mock: mmGetEndpointPermissions.mock}
+	}
+	mmGetEndpointPermissions.defaultExpectation.results = &AccessRepositoryMockGetEndpointPermissionsResults{pa1, err}
+	mmGetEndpointPermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetEndpointPermissions.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetEndpointPermissions method
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) Set(f func(ctx context.Context, endpoint string) (pa1 []model.Permission, err error)) *AccessRepositoryMock {
+	if mmGetEndpointPermissions.defaultExpectation != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetEndpointPermissions method")
+	}
+
+	if len(mmGetEndpointPermissions.expectations) > 0 {
+		mmGetEndpointPermissions.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetEndpointPermissions method")
+	}
+
+	mmGetEndpointPermissions.mock.funcGetEndpointPermissions = f
+	mmGetEndpointPermissions.mock.funcGetEndpointPermissionsOrigin = minimock.CallerInfo(1)
+	return mmGetEndpointPermissions.mock
+}
+
+// When sets expectation for the AccessRepository.GetEndpointPermissions which will trigger the result defined by the following
+// Then helper
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) When(ctx context.Context, endpoint string) *AccessRepositoryMockGetEndpointPermissionsExpectation {
+	if mmGetEndpointPermissions.mock.funcGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.mock.t.Fatalf("AccessRepositoryMock.GetEndpointPermissions mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetEndpointPermissionsExpectation{
+		mock:               mmGetEndpointPermissions.mock,
+		params:             &AccessRepositoryMockGetEndpointPermissionsParams{ctx, endpoint},
+		expectationOrigins: AccessRepositoryMockGetEndpointPermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetEndpointPermissions.expectations = append(mmGetEndpointPermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetEndpointPermissions return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetEndpointPermissionsExpectation) Then(pa1 []model.Permission, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetEndpointPermissionsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.GetEndpointPermissions should be invoked
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) Times(n uint64) *mAccessRepositoryMockGetEndpointPermissions {
+	if n == 0 {
+		mmGetEndpointPermissions.mock.t.Fatalf("Times of AccessRepositoryMock.GetEndpointPermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetEndpointPermissions.expectedInvocations, n)
+	mmGetEndpointPermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetEndpointPermissions
+}
+
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) invocationsDone() bool {
+	if len(mmGetEndpointPermissions.expectations) == 0 && mmGetEndpointPermissions.defaultExpectation == nil && mmGetEndpointPermissions.mock.funcGetEndpointPermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetEndpointPermissions.mock.afterGetEndpointPermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetEndpointPermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetEndpointPermissions implements mm_repository.AccessRepository
+func (mmGetEndpointPermissions *AccessRepositoryMock) GetEndpointPermissions(ctx context.Context, endpoint string) (pa1 []model.Permission, err error) {
+	mm_atomic.AddUint64(&mmGetEndpointPermissions.beforeGetEndpointPermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetEndpointPermissions.afterGetEndpointPermissionsCounter, 1)
+
+	mmGetEndpointPermissions.t.Helper()
+
+	if mmGetEndpointPermissions.inspectFuncGetEndpointPermissions != nil {
+		mmGetEndpointPermissions.inspectFuncGetEndpointPermissions(ctx, endpoint)
+	}
+
+	mm_params := AccessRepositoryMockGetEndpointPermissionsParams{ctx, endpoint}
+
+	// Record call args
+	mmGetEndpointPermissions.GetEndpointPermissionsMock.mutex.Lock()
+	mmGetEndpointPermissions.GetEndpointPermissionsMock.callArgs = append(mmGetEndpointPermissions.GetEndpointPermissionsMock.callArgs, &mm_params)
+	mmGetEndpointPermissions.GetEndpointPermissionsMock.mutex.Unlock()
+
+	for _, e := range mmGetEndpointPermissions.GetEndpointPermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetEndpointPermissionsParams{ctx, endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetEndpointPermissions.t.Errorf("AccessRepositoryMock.GetEndpointPermissions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmGetEndpointPermissions.t.Errorf("AccessRepositoryMock.GetEndpointPermissions got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetEndpointPermissions.t.Errorf("AccessRepositoryMock.GetEndpointPermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetEndpointPermissions.GetEndpointPermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetEndpointPermissions.t.Fatal("No results are set for the AccessRepositoryMock.GetEndpointPermissions")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetEndpointPermissions.funcGetEndpointPermissions != nil {
+		return mmGetEndpointPermissions.funcGetEndpointPermissions(ctx, endpoint)
+	}
+	mmGetEndpointPermissions.t.Fatalf("Unexpected call to AccessRepositoryMock.GetEndpointPermissions. %v %v", ctx, endpoint)
+	return
+}
+
+// GetEndpointPermissionsAfterCounter returns a count of finished AccessRepositoryMock.GetEndpointPermissions invocations
+func (mmGetEndpointPermissions *AccessRepositoryMock) GetEndpointPermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEndpointPermissions.afterGetEndpointPermissionsCounter)
+}
+
+// GetEndpointPermissionsBeforeCounter returns a count of AccessRepositoryMock.GetEndpointPermissions invocations
+func (mmGetEndpointPermissions *AccessRepositoryMock) GetEndpointPermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEndpointPermissions.beforeGetEndpointPermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetEndpointPermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetEndpointPermissions *mAccessRepositoryMockGetEndpointPermissions) Calls() []*AccessRepositoryMockGetEndpointPermissionsParams {
+	mmGetEndpointPermissions.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetEndpointPermissionsParams, len(mmGetEndpointPermissions.callArgs))
+	copy(argCopy, mmGetEndpointPermissions.callArgs)
+
+	mmGetEndpointPermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetEndpointPermissionsDone returns true if the count of the GetEndpointPermissions invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetEndpointPermissionsDone() bool {
+	if m.GetEndpointPermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetEndpointPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetEndpointPermissionsMock.invocationsDone()
+}
+
+// MinimockGetEndpointPermissionsInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetEndpointPermissionsInspect() {
+	for _, e := range m.GetEndpointPermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetEndpointPermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetEndpointPermissionsCounter := mm_atomic.LoadUint64(&m.afterGetEndpointPermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEndpointPermissionsMock.defaultExpectation != nil && afterGetEndpointPermissionsCounter < 1 {
+		if m.GetEndpointPermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetEndpointPermissions at\n%s", m.GetEndpointPermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetEndpointPermissions at\n%s with params: %#v", m.GetEndpointPermissionsMock.defaultExpectation.expectationOrigins.origin, *m.GetEndpointPermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEndpointPermissions != nil && afterGetEndpointPermissionsCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.GetEndpointPermissions at\n%s", m.funcGetEndpointPermissionsOrigin)
+	}
+
+	if !m.GetEndpointPermissionsMock.invocationsDone() && afterGetEndpointPermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.GetEndpointPermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetEndpointPermissionsMock.expectedInvocations), m.GetEndpointPermissionsMock.expectedInvocationsOrigin, afterGetEndpointPermissionsCounter)
+	}
+}
+
+type mAccessRepositoryMockGetRolePermissions struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockGetRolePermissionsExpectation
+	expectations       []*AccessRepositoryMockGetRolePermissionsExpectation
+
+	callArgs []*AccessRepositoryMockGetRolePermissionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessRepositoryMockGetRolePermissionsExpectation specifies expectation struct of the AccessRepository.GetRolePermissions
+type AccessRepositoryMockGetRolePermissionsExpectation struct {
+	mock               *AccessRepositoryMock
+	params             *AccessRepositoryMockGetRolePermissionsParams
+	paramPtrs          *AccessRepositoryMockGetRolePermissionsParamPtrs
+	expectationOrigins AccessRepositoryMockGetRolePermissionsExpectationOrigins
+	results            *AccessRepositoryMockGetRolePermissionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessRepositoryMockGetRolePermissionsParams contains parameters of the AccessRepository.GetRolePermissions
+type AccessRepositoryMockGetRolePermissionsParams struct {
+	ctx  context.Context
+	role model.Role
+}
+
+// AccessRepositoryMockGetRolePermissionsParamPtrs contains pointers to parameters of the AccessRepository.GetRolePermissions
+type AccessRepositoryMockGetRolePermissionsParamPtrs struct {
+	ctx  *context.Context
+	role *model.Role
+}
+
+// AccessRepositoryMockGetRolePermissionsResults contains results of the AccessRepository.GetRolePermissions
+type AccessRepositoryMockGetRolePermissionsResults struct {
+	pa1 []model.Permission
+	err error
+}
+
+// AccessRepositoryMockGetRolePermissionsOrigins contains origins of expectations of the AccessRepository.GetRolePermissions
+type AccessRepositoryMockGetRolePermissionsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) Optional() *mAccessRepositoryMockGetRolePermissions {
+	mmGetRolePermissions.optional = true
+	return mmGetRolePermissions
+}
+
+// Expect sets up expected params for AccessRepository.GetRolePermissions
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) Expect(ctx context.Context, role model.Role) *mAccessRepositoryMockGetRolePermissions {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("AccessRepositoryMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &AccessRepositoryMockGetRolePermissionsExpectation{}
+	}
+
+	if mmGetRolePermissions.defaultExpectation.paramPtrs != nil {
+		mmGetRolePermissions.mock.t.Fatalf("AccessRepositoryMock.GetRolePermissions mock is already set by ExpectParams functions")
+	}
+
+	mmGetRolePermissions.defaultExpectation.params = &AccessRepositoryMockGetRolePermissionsParams{ctx, role}
+	mmGetRolePermissions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRolePermissions.expectations {
+		if minimock.Equal(e.params, mmGetRolePermissions.defaultExpectation.params) {
+			mmGetRolePermissions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRolePermissions.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRolePermissions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.GetRolePermissions
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockGetRolePermissions {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("AccessRepositoryMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &AccessRepositoryMockGetRolePermissionsExpectation{}
+	}
+
+	if mmGetRolePermissions.defaultExpectation.params != nil {
+		mmGetRolePermissions.mock.t.Fatalf("AccessRepositoryMock.GetRolePermissions mock is already set by Expect")
+	}
+
+	if mmGetRolePermissions.defaultExpectation.paramPtrs == nil {
+		mmGetRolePermissions.defaultExpectation.paramPtrs = &AccessRepositoryMockGetRolePermissionsParamPtrs{}
+	}
+	mmGetRolePermissions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRolePermissions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRolePermissions
+}
+
+// ExpectRoleParam2 sets up expected param role for AccessRepository.GetRolePermissions
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) ExpectRoleParam2(role model.Role) *mAccessRepositoryMockGetRolePermissions {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("AccessRepositoryMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &AccessRepositoryMockGetRolePermissionsExpectation{}
+	}
+
+	if mmGetRolePermissions.defaultExpectation.params != nil {
+		mmGetRolePermissions.mock.t.Fatalf("AccessRepositoryMock.GetRolePermissions mock is already set by Expect")
+	}
+
+	if mmGetRolePermissions.defaultExpectation.paramPtrs == nil {
+		mmGetRolePermissions.defaultExpectation.paramPtrs = &AccessRepositoryMockGetRolePermissionsParamPtrs{}
+	}
+	mmGetRolePermissions.defaultExpectation.paramPtrs.role = &role
+	mmGetRolePermissions.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmGetRolePermissions
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.GetRolePermissions
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) Inspect(f func(ctx context.Context, role model.Role)) *mAccessRepositoryMockGetRolePermissions {
+	if mmGetRolePermissions.mock.inspectFuncGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.GetRolePermissions")
+	}
+
+	mmGetRolePermissions.mock.inspectFuncGetRolePermissions = f
+
+	return mmGetRolePermissions
+}
+
+// Return sets up results that will be returned by AccessRepository.GetRolePermissions
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) Return(pa1 []model.Permission, err error) *AccessRepositoryMock {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("AccessRepositoryMock.GetRolePermissions mock is already set by Set")
+	}
+
+	if mmGetRolePermissions.defaultExpectation == nil {
+		mmGetRolePermissions.defaultExpectation = &AccessRepositoryMockGetRolePermissionsExpectation{mock: mmGetRolePermissions.mock}
+	}
+	mmGetRolePermissions.defaultExpectation.results = &AccessRepositoryMockGetRolePermissionsResults{pa1, err}
+	mmGetRolePermissions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRolePermissions.mock
+}
+
+// Set uses given function f to mock the AccessRepository.GetRolePermissions method
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) Set(f func(ctx context.Context, role model.Role) (pa1 []model.Permission, err error)) *AccessRepositoryMock {
+	if mmGetRolePermissions.defaultExpectation != nil {
+		mmGetRolePermissions.mock.t.Fatalf("Default expectation is already set for the AccessRepository.GetRolePermissions method")
+	}
+
+	if len(mmGetRolePermissions.expectations) > 0 {
+		mmGetRolePermissions.mock.t.Fatalf("Some expectations are already set for the AccessRepository.GetRolePermissions method")
+	}
+
+	mmGetRolePermissions.mock.funcGetRolePermissions = f
+	mmGetRolePermissions.mock.funcGetRolePermissionsOrigin = minimock.CallerInfo(1)
+	return mmGetRolePermissions.mock
+}
+
+// When sets expectation for the AccessRepository.GetRolePermissions which will trigger the result defined by the following
+// Then helper
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) When(ctx context.Context, role model.Role) *AccessRepositoryMockGetRolePermissionsExpectation {
+	if mmGetRolePermissions.mock.funcGetRolePermissions != nil {
+		mmGetRolePermissions.mock.t.Fatalf("AccessRepositoryMock.GetRolePermissions mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockGetRolePermissionsExpectation{
+		mock:               mmGetRolePermissions.mock,
+		params:             &AccessRepositoryMockGetRolePermissionsParams{ctx, role},
+		expectationOrigins: AccessRepositoryMockGetRolePermissionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRolePermissions.expectations = append(mmGetRolePermissions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.GetRolePermissions return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockGetRolePermissionsExpectation) Then(pa1 []model.Permission, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockGetRolePermissionsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.GetRolePermissions should be invoked
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) Times(n uint64) *mAccessRepositoryMockGetRolePermissions {
+	if n == 0 {
+		mmGetRolePermissions.mock.t.Fatalf("Times of AccessRepositoryMock.GetRolePermissions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRolePermissions.expectedInvocations, n)
+	mmGetRolePermissions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRolePermissions
+}
+
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) invocationsDone() bool {
+	if len(mmGetRolePermissions.expectations) == 0 && mmGetRolePermissions.defaultExpectation == nil && mmGetRolePermissions.mock.funcGetRolePermissions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRolePermissions.mock.afterGetRolePermissionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRolePermissions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRolePermissions implements mm_repository.AccessRepository
+func (mmGetRolePermissions *AccessRepositoryMock) GetRolePermissions(ctx context.Context, role model.Role) (pa1 []model.Permission, err error) {
+	mm_atomic.AddUint64(&mmGetRolePermissions.beforeGetRolePermissionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRolePermissions.afterGetRolePermissionsCounter, 1)
+
+	mmGetRolePermissions.t.Helper()
+
+	if mmGetRolePermissions.inspectFuncGetRolePermissions != nil {
+		mmGetRolePermissions.inspectFuncGetRolePermissions(ctx, role)
+	}
+
+	mm_params := AccessRepositoryMockGetRolePermissionsParams{ctx, role}
+
+	// Record call args
+	mmGetRolePermissions.GetRolePermissionsMock.mutex.Lock()
+	mmGetRolePermissions.GetRolePermissionsMock.callArgs = append(mmGetRolePermissions.GetRolePermissionsMock.callArgs, &mm_params)
+	mmGetRolePermissions.GetRolePermissionsMock.mutex.Unlock()
+
+	for _, e := range mmGetRolePermissions.GetRolePermissionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockGetRolePermissionsParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRolePermissions.t.Errorf("AccessRepositoryMock.GetRolePermissions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmGetRolePermissions.t.Errorf("AccessRepositoryMock.GetRolePermissions got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRolePermissions.t.Errorf("AccessRepositoryMock.GetRolePermissions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRolePermissions.GetRolePermissionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRolePermissions.t.Fatal("No results are set for the AccessRepositoryMock.GetRolePermissions")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetRolePermissions.funcGetRolePermissions != nil {
+		return mmGetRolePermissions.funcGetRolePermissions(ctx, role)
+	}
+	mmGetRolePermissions.t.Fatalf("Unexpected call to AccessRepositoryMock.GetRolePermissions. %v %v", ctx, role)
+	return
+}
+
+// GetRolePermissionsAfterCounter returns a count of finished AccessRepositoryMock.GetRolePermissions invocations
+func (mmGetRolePermissions *AccessRepositoryMock) GetRolePermissionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRolePermissions.afterGetRolePermissionsCounter)
+}
+
+// GetRolePermissionsBeforeCounter returns a count of AccessRepositoryMock.GetRolePermissions invocations
+func (mmGetRolePermissions *AccessRepositoryMock) GetRolePermissionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRolePermissions.beforeGetRolePermissionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.GetRolePermissions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRolePermissions *mAccessRepositoryMockGetRolePermissions) Calls() []*AccessRepositoryMockGetRolePermissionsParams {
+	mmGetRolePermissions.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockGetRolePermissionsParams, len(mmGetRolePermissions.callArgs))
+	copy(argCopy, mmGetRolePermissions.callArgs)
+
+	mmGetRolePermissions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRolePermissionsDone returns true if the count of the GetRolePermissions invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockGetRolePermissionsDone() bool {
+	if m.GetRolePermissionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRolePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRolePermissionsMock.invocationsDone()
+}
+
+// MinimockGetRolePermissionsInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockGetRolePermissionsInspect() {
+	for _, e := range m.GetRolePermissionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetRolePermissions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRolePermissionsCounter := mm_atomic.LoadUint64(&m.afterGetRolePermissionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRolePermissionsMock.defaultExpectation != nil && afterGetRolePermissionsCounter < 1 {
+		if m.GetRolePermissionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetRolePermissions at\n%s", m.GetRolePermissionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.GetRolePermissions at\n%s with params: %#v", m.GetRolePermissionsMock.defaultExpectation.expectationOrigins.origin, *m.GetRolePermissionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRolePermissions != nil && afterGetRolePermissionsCounter < 1 {
+		m.t.Errorf("Expected call to AccessRepositoryMock.GetRolePermissions at\n%s", m.funcGetRolePermissionsOrigin)
+	}
+
+	if !m.GetRolePermissionsMock.invocationsDone() && afterGetRolePermissionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.GetRolePermissions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRolePermissionsMock.expectedInvocations), m.GetRolePermissionsMock.expectedInvocationsOrigin, afterGetRolePermissionsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetEndpointPermissionsInspect()
+
+			m.MinimockGetRolePermissionsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetEndpointPermissionsDone() &&
+		m.MinimockGetRolePermissionsDone()
+}
@@ -0,0 +1,1485 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i auth/internal/repository.EmailVerificationRepository -o email_verification_repository_minimock.go -n EmailVerificationRepositoryMock -p mocks
+
+import (
+	"auth/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// EmailVerificationRepositoryMock implements mm_repository.EmailVerificationRepository
+type EmailVerificationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConsume          func(ctx context.Context, tokenHash string) (i1 int64, err error)
+	funcConsumeOrigin    string
+	inspectFuncConsume   func(ctx context.Context, tokenHash string)
+	afterConsumeCounter  uint64
+	beforeConsumeCounter uint64
+	ConsumeMock          mEmailVerificationRepositoryMockConsume
+
+	funcCreate          func(ctx context.Context, token *model.EmailVerificationToken) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, token *model.EmailVerificationToken)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mEmailVerificationRepositoryMockCreate
+
+	funcLastCreatedAt          func(ctx context.Context, userID int64) (t1 time.Time, err error)
+	funcLastCreatedAtOrigin    string
+	inspectFuncLastCreatedAt   func(ctx context.Context, userID int64)
+	afterLastCreatedAtCounter  uint64
+	beforeLastCreatedAtCounter uint64
+	LastCreatedAtMock          mEmailVerificationRepositoryMockLastCreatedAt
+
+	funcRevokeByUser          func(ctx context.Context, userID int64) (err error)
+	funcRevokeByUserOrigin    string
+	inspectFuncRevokeByUser   func(ctx context.Context, userID int64)
+	afterRevokeByUserCounter  uint64
+	beforeRevokeByUserCounter uint64
+	RevokeByUserMock          mEmailVerificationRepositoryMockRevokeByUser
+}
+
+// NewEmailVerificationRepositoryMock returns a mock for mm_repository.EmailVerificationRepository
+func NewEmailVerificationRepositoryMock(t minimock.Tester) *EmailVerificationRepositoryMock {
+	m := &EmailVerificationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConsumeMock = mEmailVerificationRepositoryMockConsume{mock: m}
+	m.ConsumeMock.callArgs = []*EmailVerificationRepositoryMockConsumeParams{}
+
+	m.CreateMock = mEmailVerificationRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*EmailVerificationRepositoryMockCreateParams{}
+
+	m.LastCreatedAtMock = mEmailVerificationRepositoryMockLastCreatedAt{mock: m}
+	m.LastCreatedAtMock.callArgs = []*EmailVerificationRepositoryMockLastCreatedAtParams{}
+
+	m.RevokeByUserMock = mEmailVerificationRepositoryMockRevokeByUser{mock: m}
+	m.RevokeByUserMock.callArgs = []*EmailVerificationRepositoryMockRevokeByUserParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEmailVerificationRepositoryMockConsume struct {
+	optional           bool
+	mock               *EmailVerificationRepositoryMock
+	defaultExpectation *EmailVerificationRepositoryMockConsumeExpectation
+	expectations       []*EmailVerificationRepositoryMockConsumeExpectation
+
+	callArgs []*EmailVerificationRepositoryMockConsumeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmailVerificationRepositoryMockConsumeExpectation specifies expectation struct of the EmailVerificationRepository.Consume
+type EmailVerificationRepositoryMockConsumeExpectation struct {
+	mock               *EmailVerificationRepositoryMock
+	params             *EmailVerificationRepositoryMockConsumeParams
+	paramPtrs          *EmailVerificationRepositoryMockConsumeParamPtrs
+	expectationOrigins EmailVerificationRepositoryMockConsumeExpectationOrigins
+	results            *EmailVerificationRepositoryMockConsumeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmailVerificationRepositoryMockConsumeParams contains parameters of the EmailVerificationRepository.Consume
+type EmailVerificationRepositoryMockConsumeParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// EmailVerificationRepositoryMockConsumeParamPtrs contains pointers to parameters of the EmailVerificationRepository.Consume
+type EmailVerificationRepositoryMockConsumeParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// EmailVerificationRepositoryMockConsumeResults contains results of the EmailVerificationRepository.Consume
+type EmailVerificationRepositoryMockConsumeResults struct {
+	i1  int64
+	err error
+}
+
+// EmailVerificationRepositoryMockConsumeOrigins contains origins of expectations of the EmailVerificationRepository.Consume
+type EmailVerificationRepositoryMockConsumeExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConsume *mEmailVerificationRepositoryMockConsume) Optional() *mEmailVerificationRepositoryMockConsume {
+	mmConsume.optional = true
+	return mmConsume
+}
+
+// Expect sets up expected params for EmailVerificationRepository.Consume
+func (mmConsume *mEmailVerificationRepositoryMockConsume) Expect(ctx context.Context, tokenHash string) *mEmailVerificationRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("EmailVerificationRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &EmailVerificationRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs != nil {
+		mmConsume.mock.t.Fatalf("EmailVerificationRepositoryMock.Consume mock is already set by ExpectParams functions")
+	}
+
+	mmConsume.defaultExpectation.params = &EmailVerificationRepositoryMockConsumeParams{ctx, tokenHash}
+	mmConsume.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConsume.expectations {
+		if minimock.Equal(e.params, mmConsume.defaultExpectation.params) {
+			mmConsume.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConsume.defaultExpectation.params)
+		}
+	}
+
+	return mmConsume
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmailVerificationRepository.Consume
+func (mmConsume *mEmailVerificationRepositoryMockConsume) ExpectCtxParam1(ctx context.Context) *mEmailVerificationRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("EmailVerificationRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &EmailVerificationRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("EmailVerificationRepositoryMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConsume.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConsume
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for EmailVerificationRepository.Consume
+func (mmConsume *mEmailVerificationRepositoryMockConsume) ExpectTokenHashParam2(tokenHash string) *mEmailVerificationRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("EmailVerificationRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &EmailVerificationRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("EmailVerificationRepositoryMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmConsume.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmConsume
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmailVerificationRepository.Consume
+func (mmConsume *mEmailVerificationRepositoryMockConsume) Inspect(f func(ctx context.Context, tokenHash string)) *mEmailVerificationRepositoryMockConsume {
+	if mmConsume.mock.inspectFuncConsume != nil {
+		mmConsume.mock.t.Fatalf("Inspect function is already set for EmailVerificationRepositoryMock.Consume")
+	}
+
+	mmConsume.mock.inspectFuncConsume = f
+
+	return mmConsume
+}
+
+// Return sets up results that will be returned by EmailVerificationRepository.Consume
+func (mmConsume *mEmailVerificationRepositoryMockConsume) Return(i1 int64, err error) *EmailVerificationRepositoryMock {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("EmailVerificationRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &EmailVerificationRepositoryMockConsumeExpectation{mock: mmConsume.mock}
+	}
+	mmConsume.defaultExpectation.results = &EmailVerificationRepositoryMockConsumeResults{i1, err}
+	mmConsume.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConsume.mock
+}
+
+// Set uses given function f to mock the EmailVerificationRepository.Consume method
+func (mmConsume *mEmailVerificationRepositoryMockConsume) Set(f func(ctx context.Context, tokenHash string) (i1 int64, err error)) *EmailVerificationRepositoryMock {
+	if mmConsume.defaultExpectation != nil {
+		mmConsume.mock.t.Fatalf("Default expectation is already set for the EmailVerificationRepository.Consume method")
+	}
+
+	if len(mmConsume.expectations) > 0 {
+		mmConsume.mock.t.Fatalf("Some expectations are already set for the EmailVerificationRepository.Consume method")
+	}
+
+	mmConsume.mock.funcConsume = f
+	mmConsume.mock.funcConsumeOrigin = minimock.CallerInfo(1)
+	return mmConsume.mock
+}
+
+// When sets expectation for the EmailVerificationRepository.Consume which will trigger the result defined by the following
+// Then helper
+func (mmConsume *mEmailVerificationRepositoryMockConsume) When(ctx context.Context, tokenHash string) *EmailVerificationRepositoryMockConsumeExpectation {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("EmailVerificationRepositoryMock.Consume mock is already set by Set")
+	}
+
+	expectation := &EmailVerificationRepositoryMockConsumeExpectation{
+		mock:               mmConsume.mock,
+		params:             &EmailVerificationRepositoryMockConsumeParams{ctx, tokenHash},
+		expectationOrigins: EmailVerificationRepositoryMockConsumeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConsume.expectations = append(mmConsume.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmailVerificationRepository.Consume return parameters for the expectation previously defined by the When method
+func (e *EmailVerificationRepositoryMockConsumeExpectation) Then(i1 int64, err error) *EmailVerificationRepositoryMock {
+	e.results = &EmailVerificationRepositoryMockConsumeResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times EmailVerificationRepository.Consume should be invoked
+func (mmConsume *mEmailVerificationRepositoryMockConsume) Times(n uint64) *mEmailVerificationRepositoryMockConsume {
+	if n == 0 {
+		mmConsume.mock.t.Fatalf("Times of EmailVerificationRepositoryMock.Consume mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConsume.expectedInvocations, n)
+	mmConsume.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConsume
+}
+
+func (mmConsume *mEmailVerificationRepositoryMockConsume) invocationsDone() bool {
+	if len(mmConsume.expectations) == 0 && mmConsume.defaultExpectation == nil && mmConsume.mock.funcConsume == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConsume.mock.afterConsumeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConsume.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Consume implements mm_repository.EmailVerificationRepository
+func (mmConsume *EmailVerificationRepositoryMock) Consume(ctx context.Context, tokenHash string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmConsume.beforeConsumeCounter, 1)
+	defer mm_atomic.AddUint64(&mmConsume.afterConsumeCounter, 1)
+
+	mmConsume.t.Helper()
+
+	if mmConsume.inspectFuncConsume != nil {
+		mmConsume.inspectFuncConsume(ctx, tokenHash)
+	}
+
+	mm_params := EmailVerificationRepositoryMockConsumeParams{ctx, tokenHash}
+
+	// Record call args
+	mmConsume.ConsumeMock.mutex.Lock()
+	mmConsume.ConsumeMock.callArgs = append(mmConsume.ConsumeMock.callArgs, &mm_params)
+	mmConsume.ConsumeMock.mutex.Unlock()
+
+	for _, e := range mmConsume.ConsumeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmConsume.ConsumeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConsume.ConsumeMock.defaultExpectation.Counter, 1)
+		mm_want := mmConsume.ConsumeMock.defaultExpectation.params
+		mm_want_ptrs := mmConsume.ConsumeMock.defaultExpectation.paramPtrs
+
+		mm_got := EmailVerificationRepositoryMockConsumeParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConsume.t.Errorf("EmailVerificationRepositoryMock.Consume got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsume.ConsumeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmConsume.t.Errorf("EmailVerificationRepositoryMock.Consume got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsume.ConsumeMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConsume.t.Errorf("EmailVerificationRepositoryMock.Consume got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConsume.ConsumeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConsume.ConsumeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConsume.t.Fatal("No results are set for the EmailVerificationRepositoryMock.Consume")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmConsume.funcConsume != nil {
+		return mmConsume.funcConsume(ctx, tokenHash)
+	}
+	mmConsume.t.Fatalf("Unexpected call to EmailVerificationRepositoryMock.Consume. %v %v", ctx, tokenHash)
+	return
+}
+
+// ConsumeAfterCounter returns a count of finished EmailVerificationRepositoryMock.Consume invocations
+func (mmConsume *EmailVerificationRepositoryMock) ConsumeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsume.afterConsumeCounter)
+}
+
+// ConsumeBeforeCounter returns a count of EmailVerificationRepositoryMock.Consume invocations
+func (mmConsume *EmailVerificationRepositoryMock) ConsumeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsume.beforeConsumeCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmailVerificationRepositoryMock.Consume.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConsume *mEmailVerificationRepositoryMockConsume) Calls() []*EmailVerificationRepositoryMockConsumeParams {
+	mmConsume.mutex.RLock()
+
+	argCopy := make([]*EmailVerificationRepositoryMockConsumeParams, len(mmConsume.callArgs))
+	copy(argCopy, mmConsume.callArgs)
+
+	mmConsume.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConsumeDone returns true if the count of the Consume invocations corresponds
+// the number of defined expectations
+func (m *EmailVerificationRepositoryMock) MinimockConsumeDone() bool {
+	if m.ConsumeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConsumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConsumeMock.invocationsDone()
+}
+
+// MinimockConsumeInspect logs each unmet expectation
+func (m *EmailVerificationRepositoryMock) MinimockConsumeInspect() {
+	for _, e := range m.ConsumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Consume at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConsumeCounter := mm_atomic.LoadUint64(&m.afterConsumeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeMock.defaultExpectation != nil && afterConsumeCounter < 1 {
+		if m.ConsumeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Consume at\n%s", m.ConsumeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Consume at\n%s with params: %#v", m.ConsumeMock.defaultExpectation.expectationOrigins.origin, *m.ConsumeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsume != nil && afterConsumeCounter < 1 {
+		m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Consume at\n%s", m.funcConsumeOrigin)
+	}
+
+	if !m.ConsumeMock.invocationsDone() && afterConsumeCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmailVerificationRepositoryMock.Consume at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConsumeMock.expectedInvocations), m.ConsumeMock.expectedInvocationsOrigin, afterConsumeCounter)
+	}
+}
+
+type mEmailVerificationRepositoryMockCreate struct {
+	optional           bool
+	mock               *EmailVerificationRepositoryMock
+	defaultExpectation *EmailVerificationRepositoryMockCreateExpectation
+	expectations       []*EmailVerificationRepositoryMockCreateExpectation
+
+	callArgs []*EmailVerificationRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmailVerificationRepositoryMockCreateExpectation specifies expectation struct of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateExpectation struct {
+	mock               *EmailVerificationRepositoryMock
+	params             *EmailVerificationRepositoryMockCreateParams
+	paramPtrs          *EmailVerificationRepositoryMockCreateParamPtrs
+	expectationOrigins EmailVerificationRepositoryMockCreateExpectationOrigins
+	results            *EmailVerificationRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmailVerificationRepositoryMockCreateParams contains parameters of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateParams struct {
+	ctx   context.Context
+	token *model.EmailVerificationToken
+}
+
+// EmailVerificationRepositoryMockCreateParamPtrs contains pointers to parameters of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	token **model.EmailVerificationToken
+}
+
+// EmailVerificationRepositoryMockCreateResults contains results of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateResults struct {
+	err error
+}
+
+// EmailVerificationRepositoryMockCreateOrigins contains origins of expectations of the EmailVerificationRepository.Create
+type EmailVerificationRepositoryMockCreateExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Optional() *mEmailVerificationRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Expect(ctx context.Context, token *model.EmailVerificationToken) *mEmailVerificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EmailVerificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &EmailVerificationRepositoryMockCreateParams{ctx, token}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mEmailVerificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EmailVerificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectTokenParam2 sets up expected param token for EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) ExpectTokenParam2(token *model.EmailVerificationToken) *mEmailVerificationRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EmailVerificationRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.token = &token
+	mmCreate.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Inspect(f func(ctx context.Context, token *model.EmailVerificationToken)) *mEmailVerificationRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for EmailVerificationRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by EmailVerificationRepository.Create
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Return(err error) *EmailVerificationRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &EmailVerificationRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &EmailVerificationRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the EmailVerificationRepository.Create method
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Set(f func(ctx context.Context, token *model.EmailVerificationToken) (err error)) *EmailVerificationRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the EmailVerificationRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the EmailVerificationRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the EmailVerificationRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mEmailVerificationRepositoryMockCreate) When(ctx context.Context, token *model.EmailVerificationToken) *EmailVerificationRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("EmailVerificationRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &EmailVerificationRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &EmailVerificationRepositoryMockCreateParams{ctx, token},
+		expectationOrigins: EmailVerificationRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmailVerificationRepository.Create return parameters for the expectation previously defined by the When method
+func (e *EmailVerificationRepositoryMockCreateExpectation) Then(err error) *EmailVerificationRepositoryMock {
+	e.results = &EmailVerificationRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmailVerificationRepository.Create should be invoked
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Times(n uint64) *mEmailVerificationRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of EmailVerificationRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mEmailVerificationRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.EmailVerificationRepository
+func (mmCreate *EmailVerificationRepositoryMock) Create(ctx context.Context, token *model.EmailVerificationToken) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, token)
+	}
+
+	mm_params := EmailVerificationRepositoryMockCreateParams{ctx, token}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := EmailVerificationRepositoryMockCreateParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("EmailVerificationRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreate.t.Errorf("EmailVerificationRepositoryMock.Create got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("EmailVerificationRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the EmailVerificationRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, token)
+	}
+	mmCreate.t.Fatalf("Unexpected call to EmailVerificationRepositoryMock.Create. %v %v", ctx, token)
+	return
+}
+
+// CreateAfterCounter returns a count of finished EmailVerificationRepositoryMock.Create invocations
+func (mmCreate *EmailVerificationRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of EmailVerificationRepositoryMock.Create invocations
+func (mmCreate *EmailVerificationRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmailVerificationRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mEmailVerificationRepositoryMockCreate) Calls() []*EmailVerificationRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*EmailVerificationRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *EmailVerificationRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *EmailVerificationRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to EmailVerificationRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmailVerificationRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mEmailVerificationRepositoryMockLastCreatedAt struct {
+	optional           bool
+	mock               *EmailVerificationRepositoryMock
+	defaultExpectation *EmailVerificationRepositoryMockLastCreatedAtExpectation
+	expectations       []*EmailVerificationRepositoryMockLastCreatedAtExpectation
+
+	callArgs []*EmailVerificationRepositoryMockLastCreatedAtParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmailVerificationRepositoryMockLastCreatedAtExpectation specifies expectation struct of the EmailVerificationRepository.LastCreatedAt
+type EmailVerificationRepositoryMockLastCreatedAtExpectation struct {
+	mock               *EmailVerificationRepositoryMock
+	params             *EmailVerificationRepositoryMockLastCreatedAtParams
+	paramPtrs          *EmailVerificationRepositoryMockLastCreatedAtParamPtrs
+	expectationOrigins EmailVerificationRepositoryMockLastCreatedAtExpectationOrigins
+	results            *EmailVerificationRepositoryMockLastCreatedAtResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmailVerificationRepositoryMockLastCreatedAtParams contains parameters of the EmailVerificationRepository.LastCreatedAt
+type EmailVerificationRepositoryMockLastCreatedAtParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// EmailVerificationRepositoryMockLastCreatedAtParamPtrs contains pointers to parameters of the EmailVerificationRepository.LastCreatedAt
+type EmailVerificationRepositoryMockLastCreatedAtParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// EmailVerificationRepositoryMockLastCreatedAtResults contains results of the EmailVerificationRepository.LastCreatedAt
+type EmailVerificationRepositoryMockLastCreatedAtResults struct {
+	t1  time.Time
+	err error
+}
+
+// EmailVerificationRepositoryMockLastCreatedAtOrigins contains origins of expectations of the EmailVerificationRepository.LastCreatedAt
+type EmailVerificationRepositoryMockLastCreatedAtExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) Optional() *mEmailVerificationRepositoryMockLastCreatedAt {
+	mmLastCreatedAt.optional = true
+	return mmLastCreatedAt
+}
+
+// Expect sets up expected params for EmailVerificationRepository.LastCreatedAt
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) Expect(ctx context.Context, userID int64) *mEmailVerificationRepositoryMockLastCreatedAt {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("EmailVerificationRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	if mmLastCreatedAt.defaultExpectation == nil {
+		mmLastCreatedAt.defaultExpectation = &EmailVerificationRepositoryMockLastCreatedAtExpectation{}
+	}
+
+	if mmLastCreatedAt.defaultExpectation.paramPtrs != nil {
+		mmLastCreatedAt.mock.t.Fatalf("EmailVerificationRepositoryMock.LastCreatedAt mock is already set by ExpectParams functions")
+	}
+
+	mmLastCreatedAt.defaultExpectation.params = &EmailVerificationRepositoryMockLastCreatedAtParams{ctx, userID}
+	mmLastCreatedAt.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLastCreatedAt.expectations {
+		if minimock.Equal(e.params, mmLastCreatedAt.defaultExpectation.params) {
+			mmLastCreatedAt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastCreatedAt.defaultExpectation.params)
+		}
+	}
+
+	return mmLastCreatedAt
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmailVerificationRepository.LastCreatedAt
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) ExpectCtxParam1(ctx context.Context) *mEmailVerificationRepositoryMockLastCreatedAt {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("EmailVerificationRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	if mmLastCreatedAt.defaultExpectation == nil {
+		mmLastCreatedAt.defaultExpectation = &EmailVerificationRepositoryMockLastCreatedAtExpectation{}
+	}
+
+	if mmLastCreatedAt.defaultExpectation.params != nil {
+		mmLastCreatedAt.mock.t.Fatalf("EmailVerificationRepositoryMock.LastCreatedAt mock is already set by Expect")
+	}
+
+	if mmLastCreatedAt.defaultExpectation.paramPtrs == nil {
+		mmLastCreatedAt.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockLastCreatedAtParamPtrs{}
+	}
+	mmLastCreatedAt.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLastCreatedAt.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLastCreatedAt
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EmailVerificationRepository.LastCreatedAt
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) ExpectUserIDParam2(userID int64) *mEmailVerificationRepositoryMockLastCreatedAt {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("EmailVerificationRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	if mmLastCreatedAt.defaultExpectation == nil {
+		mmLastCreatedAt.defaultExpectation = &EmailVerificationRepositoryMockLastCreatedAtExpectation{}
+	}
+
+	if mmLastCreatedAt.defaultExpectation.params != nil {
+		mmLastCreatedAt.mock.t.Fatalf("EmailVerificationRepositoryMock.LastCreatedAt mock is already set by Expect")
+	}
+
+	if mmLastCreatedAt.defaultExpectation.paramPtrs == nil {
+		mmLastCreatedAt.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockLastCreatedAtParamPtrs{}
+	}
+	mmLastCreatedAt.defaultExpectation.paramPtrs.userID = &userID
+	mmLastCreatedAt.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmLastCreatedAt
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmailVerificationRepository.LastCreatedAt
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) Inspect(f func(ctx context.Context, userID int64)) *mEmailVerificationRepositoryMockLastCreatedAt {
+	if mmLastCreatedAt.mock.inspectFuncLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("Inspect function is already set for EmailVerificationRepositoryMock.LastCreatedAt")
+	}
+
+	mmLastCreatedAt.mock.inspectFuncLastCreatedAt = f
+
+	return mmLastCreatedAt
+}
+
+// Return sets up results that will be returned by EmailVerificationRepository.LastCreatedAt
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) Return(t1 time.Time, err error) *EmailVerificationRepositoryMock {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("EmailVerificationRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	if mmLastCreatedAt.defaultExpectation == nil {
+		mmLastCreatedAt.defaultExpectation = &EmailVerificationRepositoryMockLastCreatedAtExpectation{mock: mmLastCreatedAt.mock}
+	}
+	mmLastCreatedAt.defaultExpectation.results = &EmailVerificationRepositoryMockLastCreatedAtResults{t1, err}
+	mmLastCreatedAt.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLastCreatedAt.mock
+}
+
+// Set uses given function f to mock the EmailVerificationRepository.LastCreatedAt method
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) Set(f func(ctx context.Context, userID int64) (t1 time.Time, err error)) *EmailVerificationRepositoryMock {
+	if mmLastCreatedAt.defaultExpectation != nil {
+		mmLastCreatedAt.mock.t.Fatalf("Default expectation is already set for the EmailVerificationRepository.LastCreatedAt method")
+	}
+
+	if len(mmLastCreatedAt.expectations) > 0 {
+		mmLastCreatedAt.mock.t.Fatalf("Some expectations are already set for the EmailVerificationRepository.LastCreatedAt method")
+	}
+
+	mmLastCreatedAt.mock.funcLastCreatedAt = f
+	mmLastCreatedAt.mock.funcLastCreatedAtOrigin = minimock.CallerInfo(1)
+	return mmLastCreatedAt.mock
+}
+
+// When sets expectation for the EmailVerificationRepository.LastCreatedAt which will trigger the result defined by the following
+// Then helper
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) When(ctx context.Context, userID int64) *EmailVerificationRepositoryMockLastCreatedAtExpectation {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("EmailVerificationRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	expectation := &EmailVerificationRepositoryMockLastCreatedAtExpectation{
+		mock:               mmLastCreatedAt.mock,
+		params:             &EmailVerificationRepositoryMockLastCreatedAtParams{ctx, userID},
+		expectationOrigins: EmailVerificationRepositoryMockLastCreatedAtExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLastCreatedAt.expectations = append(mmLastCreatedAt.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmailVerificationRepository.LastCreatedAt return parameters for the expectation previously defined by the When method
+func (e *EmailVerificationRepositoryMockLastCreatedAtExpectation) Then(t1 time.Time, err error) *EmailVerificationRepositoryMock {
+	e.results = &EmailVerificationRepositoryMockLastCreatedAtResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times EmailVerificationRepository.LastCreatedAt should be invoked
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) Times(n uint64) *mEmailVerificationRepositoryMockLastCreatedAt {
+	if n == 0 {
+		mmLastCreatedAt.mock.t.Fatalf("Times of EmailVerificationRepositoryMock.LastCreatedAt mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastCreatedAt.expectedInvocations, n)
+	mmLastCreatedAt.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLastCreatedAt
+}
+
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) invocationsDone() bool {
+	if len(mmLastCreatedAt.expectations) == 0 && mmLastCreatedAt.defaultExpectation == nil && mmLastCreatedAt.mock.funcLastCreatedAt == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastCreatedAt.mock.afterLastCreatedAtCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastCreatedAt.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastCreatedAt implements mm_repository.EmailVerificationRepository
+func (mmLastCreatedAt *EmailVerificationRepositoryMock) LastCreatedAt(ctx context.Context, userID int64) (t1 time.Time, err error) {
+	mm_atomic.AddUint64(&mmLastCreatedAt.beforeLastCreatedAtCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastCreatedAt.afterLastCreatedAtCounter, 1)
+
+	mmLastCreatedAt.t.Helper()
+
+	if mmLastCreatedAt.inspectFuncLastCreatedAt != nil {
+		mmLastCreatedAt.inspectFuncLastCreatedAt(ctx, userID)
+	}
+
+	mm_params := EmailVerificationRepositoryMockLastCreatedAtParams{ctx, userID}
+
+	// Record call args
+	mmLastCreatedAt.LastCreatedAtMock.mutex.Lock()
+	mmLastCreatedAt.LastCreatedAtMock.callArgs = append(mmLastCreatedAt.LastCreatedAtMock.callArgs, &mm_params)
+	mmLastCreatedAt.LastCreatedAtMock.mutex.Unlock()
+
+	for _, e := range mmLastCreatedAt.LastCreatedAtMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmLastCreatedAt.LastCreatedAtMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.params
+		mm_want_ptrs := mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.paramPtrs
+
+		mm_got := EmailVerificationRepositoryMockLastCreatedAtParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastCreatedAt.t.Errorf("EmailVerificationRepositoryMock.LastCreatedAt got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmLastCreatedAt.t.Errorf("EmailVerificationRepositoryMock.LastCreatedAt got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastCreatedAt.t.Errorf("EmailVerificationRepositoryMock.LastCreatedAt got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastCreatedAt.t.Fatal("No results are set for the EmailVerificationRepositoryMock.LastCreatedAt")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmLastCreatedAt.funcLastCreatedAt != nil {
+		return mmLastCreatedAt.funcLastCreatedAt(ctx, userID)
+	}
+	mmLastCreatedAt.t.Fatalf("Unexpected call to EmailVerificationRepositoryMock.LastCreatedAt. %v %v", ctx, userID)
+	return
+}
+
+// LastCreatedAtAfterCounter returns a count of finished EmailVerificationRepositoryMock.LastCreatedAt invocations
+func (mmLastCreatedAt *EmailVerificationRepositoryMock) LastCreatedAtAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastCreatedAt.afterLastCreatedAtCounter)
+}
+
+// LastCreatedAtBeforeCounter returns a count of EmailVerificationRepositoryMock.LastCreatedAt invocations
+func (mmLastCreatedAt *EmailVerificationRepositoryMock) LastCreatedAtBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastCreatedAt.beforeLastCreatedAtCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmailVerificationRepositoryMock.LastCreatedAt.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastCreatedAt *mEmailVerificationRepositoryMockLastCreatedAt) Calls() []*EmailVerificationRepositoryMockLastCreatedAtParams {
+	mmLastCreatedAt.mutex.RLock()
+
+	argCopy := make([]*EmailVerificationRepositoryMockLastCreatedAtParams, len(mmLastCreatedAt.callArgs))
+	copy(argCopy, mmLastCreatedAt.callArgs)
+
+	mmLastCreatedAt.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastCreatedAtDone returns true if the count of the LastCreatedAt invocations corresponds
+// the number of defined expectations
+func (m *EmailVerificationRepositoryMock) MinimockLastCreatedAtDone() bool {
+	if m.LastCreatedAtMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastCreatedAtMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastCreatedAtMock.invocationsDone()
+}
+
+// MinimockLastCreatedAtInspect logs each unmet expectation
+func (m *EmailVerificationRepositoryMock) MinimockLastCreatedAtInspect() {
+	for _, e := range m.LastCreatedAtMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.LastCreatedAt at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLastCreatedAtCounter := mm_atomic.LoadUint64(&m.afterLastCreatedAtCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastCreatedAtMock.defaultExpectation != nil && afterLastCreatedAtCounter < 1 {
+		if m.LastCreatedAtMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.LastCreatedAt at\n%s", m.LastCreatedAtMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.LastCreatedAt at\n%s with params: %#v", m.LastCreatedAtMock.defaultExpectation.expectationOrigins.origin, *m.LastCreatedAtMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastCreatedAt != nil && afterLastCreatedAtCounter < 1 {
+		m.t.Errorf("Expected call to EmailVerificationRepositoryMock.LastCreatedAt at\n%s", m.funcLastCreatedAtOrigin)
+	}
+
+	if !m.LastCreatedAtMock.invocationsDone() && afterLastCreatedAtCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmailVerificationRepositoryMock.LastCreatedAt at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LastCreatedAtMock.expectedInvocations), m.LastCreatedAtMock.expectedInvocationsOrigin, afterLastCreatedAtCounter)
+	}
+}
+
+type mEmailVerificationRepositoryMockRevokeByUser struct {
+	optional           bool
+	mock               *EmailVerificationRepositoryMock
+	defaultExpectation *EmailVerificationRepositoryMockRevokeByUserExpectation
+	expectations       []*EmailVerificationRepositoryMockRevokeByUserExpectation
+
+	callArgs []*EmailVerificationRepositoryMockRevokeByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmailVerificationRepositoryMockRevokeByUserExpectation specifies expectation struct of the EmailVerificationRepository.RevokeByUser
+type EmailVerificationRepositoryMockRevokeByUserExpectation struct {
+	mock               *EmailVerificationRepositoryMock
+	params             *EmailVerificationRepositoryMockRevokeByUserParams
+	paramPtrs          *EmailVerificationRepositoryMockRevokeByUserParamPtrs
+	expectationOrigins EmailVerificationRepositoryMockRevokeByUserExpectationOrigins
+	results            *EmailVerificationRepositoryMockRevokeByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmailVerificationRepositoryMockRevokeByUserParams contains parameters of the EmailVerificationRepository.RevokeByUser
+type EmailVerificationRepositoryMockRevokeByUserParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// EmailVerificationRepositoryMockRevokeByUserParamPtrs contains pointers to parameters of the EmailVerificationRepository.RevokeByUser
+type EmailVerificationRepositoryMockRevokeByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// EmailVerificationRepositoryMockRevokeByUserResults contains results of the EmailVerificationRepository.RevokeByUser
+type EmailVerificationRepositoryMockRevokeByUserResults struct {
+	err error
+}
+
+// EmailVerificationRepositoryMockRevokeByUserOrigins contains origins of expectations of the EmailVerificationRepository.RevokeByUser
+type EmailVerificationRepositoryMockRevokeByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) Optional() *mEmailVerificationRepositoryMockRevokeByUser {
+	mmRevokeByUser.optional = true
+	return mmRevokeByUser
+}
+
+// Expect sets up expected params for EmailVerificationRepository.RevokeByUser
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) Expect(ctx context.Context, userID int64) *mEmailVerificationRepositoryMockRevokeByUser {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("EmailVerificationRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	if mmRevokeByUser.defaultExpectation == nil {
+		mmRevokeByUser.defaultExpectation = &EmailVerificationRepositoryMockRevokeByUserExpectation{}
+	}
+
+	if mmRevokeByUser.defaultExpectation.paramPtrs != nil {
+		mmRevokeByUser.mock.t.Fatalf("EmailVerificationRepositoryMock.RevokeByUser mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeByUser.defaultExpectation.params = &EmailVerificationRepositoryMockRevokeByUserParams{ctx, userID}
+	mmRevokeByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeByUser.expectations {
+		if minimock.Equal(e.params, mmRevokeByUser.defaultExpectation.params) {
+			mmRevokeByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmailVerificationRepository.RevokeByUser
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) ExpectCtxParam1(ctx context.Context) *mEmailVerificationRepositoryMockRevokeByUser {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("EmailVerificationRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	if mmRevokeByUser.defaultExpectation == nil {
+		mmRevokeByUser.defaultExpectation = &EmailVerificationRepositoryMockRevokeByUserExpectation{}
+	}
+
+	if mmRevokeByUser.defaultExpectation.params != nil {
+		mmRevokeByUser.mock.t.Fatalf("EmailVerificationRepositoryMock.RevokeByUser mock is already set by Expect")
+	}
+
+	if mmRevokeByUser.defaultExpectation.paramPtrs == nil {
+		mmRevokeByUser.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockRevokeByUserParamPtrs{}
+	}
+	mmRevokeByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for EmailVerificationRepository.RevokeByUser
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) ExpectUserIDParam2(userID int64) *mEmailVerificationRepositoryMockRevokeByUser {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("EmailVerificationRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	if mmRevokeByUser.defaultExpectation == nil {
+		mmRevokeByUser.defaultExpectation = &EmailVerificationRepositoryMockRevokeByUserExpectation{}
+	}
+
+	if mmRevokeByUser.defaultExpectation.params != nil {
+		mmRevokeByUser.mock.t.Fatalf("EmailVerificationRepositoryMock.RevokeByUser mock is already set by Expect")
+	}
+
+	if mmRevokeByUser.defaultExpectation.paramPtrs == nil {
+		mmRevokeByUser.defaultExpectation.paramPtrs = &EmailVerificationRepositoryMockRevokeByUserParamPtrs{}
+	}
+	mmRevokeByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmRevokeByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRevokeByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmailVerificationRepository.RevokeByUser
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) Inspect(f func(ctx context.Context, userID int64)) *mEmailVerificationRepositoryMockRevokeByUser {
+	if mmRevokeByUser.mock.inspectFuncRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("Inspect function is already set for EmailVerificationRepositoryMock.RevokeByUser")
+	}
+
+	mmRevokeByUser.mock.inspectFuncRevokeByUser = f
+
+	return mmRevokeByUser
+}
+
+// Return sets up results that will be returned by EmailVerificationRepository.RevokeByUser
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) Return(err error) *EmailVerificationRepositoryMock {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("EmailVerificationRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	if mmRevokeByUser.defaultExpectation == nil {
+		mmRevokeByUser.defaultExpectation = &EmailVerificationRepositoryMockRevokeByUserExpectation{mock: mmRevokeByUser.mock}
+	}
+	mmRevokeByUser.defaultExpectation.results = &EmailVerificationRepositoryMockRevokeByUserResults{err}
+	mmRevokeByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeByUser.mock
+}
+
+// Set uses given function f to mock the EmailVerificationRepository.RevokeByUser method
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) Set(f func(ctx context.Context, userID int64) (err error)) *EmailVerificationRepositoryMock {
+	if mmRevokeByUser.defaultExpectation != nil {
+		mmRevokeByUser.mock.t.Fatalf("Default expectation is already set for the EmailVerificationRepository.RevokeByUser method")
+	}
+
+	if len(mmRevokeByUser.expectations) > 0 {
+		mmRevokeByUser.mock.t.Fatalf("Some expectations are already set for the EmailVerificationRepository.RevokeByUser method")
+	}
+
+	mmRevokeByUser.mock.funcRevokeByUser = f
+	mmRevokeByUser.mock.funcRevokeByUserOrigin = minimock.CallerInfo(1)
+	return mmRevokeByUser.mock
+}
+
+// When sets expectation for the EmailVerificationRepository.RevokeByUser which will trigger the result defined by the following
+// Then helper
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) When(ctx context.Context, userID int64) *EmailVerificationRepositoryMockRevokeByUserExpectation {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("EmailVerificationRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	expectation := &EmailVerificationRepositoryMockRevokeByUserExpectation{
+		mock:               mmRevokeByUser.mock,
+		params:             &EmailVerificationRepositoryMockRevokeByUserParams{ctx, userID},
+		expectationOrigins: EmailVerificationRepositoryMockRevokeByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeByUser.expectations = append(mmRevokeByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmailVerificationRepository.RevokeByUser return parameters for the expectation previously defined by the When method
+func (e *EmailVerificationRepositoryMockRevokeByUserExpectation) Then(err error) *EmailVerificationRepositoryMock {
+	e.results = &EmailVerificationRepositoryMockRevokeByUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmailVerificationRepository.RevokeByUser should be invoked
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) Times(n uint64) *mEmailVerificationRepositoryMockRevokeByUser {
+	if n == 0 {
+		mmRevokeByUser.mock.t.Fatalf("Times of EmailVerificationRepositoryMock.RevokeByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeByUser.expectedInvocations, n)
+	mmRevokeByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeByUser
+}
+
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) invocationsDone() bool {
+	if len(mmRevokeByUser.expectations) == 0 && mmRevokeByUser.defaultExpectation == nil && mmRevokeByUser.mock.funcRevokeByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeByUser.mock.afterRevokeByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeByUser implements mm_repository.EmailVerificationRepository
+func (mmRevokeByUser *EmailVerificationRepositoryMock) RevokeByUser(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRevokeByUser.beforeRevokeByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeByUser.afterRevokeByUserCounter, 1)
+
+	mmRevokeByUser.t.Helper()
+
+	if mmRevokeByUser.inspectFuncRevokeByUser != nil {
+		mmRevokeByUser.inspectFuncRevokeByUser(ctx, userID)
+	}
+
+	mm_params := EmailVerificationRepositoryMockRevokeByUserParams{ctx, userID}
+
+	// Record call args
+	mmRevokeByUser.RevokeByUserMock.mutex.Lock()
+	mmRevokeByUser.RevokeByUserMock.callArgs = append(mmRevokeByUser.RevokeByUserMock.callArgs, &mm_params)
+	mmRevokeByUser.RevokeByUserMock.mutex.Unlock()
+
+	for _, e := range mmRevokeByUser.RevokeByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeByUser.RevokeByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeByUser.RevokeByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeByUser.RevokeByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeByUser.RevokeByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := EmailVerificationRepositoryMockRevokeByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeByUser.t.Errorf("EmailVerificationRepositoryMock.RevokeByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeByUser.RevokeByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRevokeByUser.t.Errorf("EmailVerificationRepositoryMock.RevokeByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeByUser.RevokeByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeByUser.t.Errorf("EmailVerificationRepositoryMock.RevokeByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeByUser.RevokeByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeByUser.RevokeByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeByUser.t.Fatal("No results are set for the EmailVerificationRepositoryMock.RevokeByUser")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeByUser.funcRevokeByUser != nil {
+		return mmRevokeByUser.funcRevokeByUser(ctx, userID)
+	}
+	mmRevokeByUser.t.Fatalf("Unexpected call to EmailVerificationRepositoryMock.RevokeByUser. %v %v", ctx, userID)
+	return
+}
+
+// RevokeByUserAfterCounter returns a count of finished EmailVerificationRepositoryMock.RevokeByUser invocations
+func (mmRevokeByUser *EmailVerificationRepositoryMock) RevokeByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeByUser.afterRevokeByUserCounter)
+}
+
+// RevokeByUserBeforeCounter returns a count of EmailVerificationRepositoryMock.RevokeByUser invocations
+func (mmRevokeByUser *EmailVerificationRepositoryMock) RevokeByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeByUser.beforeRevokeByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmailVerificationRepositoryMock.RevokeByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeByUser *mEmailVerificationRepositoryMockRevokeByUser) Calls() []*EmailVerificationRepositoryMockRevokeByUserParams {
+	mmRevokeByUser.mutex.RLock()
+
+	argCopy := make([]*EmailVerificationRepositoryMockRevokeByUserParams, len(mmRevokeByUser.callArgs))
+	copy(argCopy, mmRevokeByUser.callArgs)
+
+	mmRevokeByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeByUserDone returns true if the count of the RevokeByUser invocations corresponds
+// the number of defined expectations
+func (m *EmailVerificationRepositoryMock) MinimockRevokeByUserDone() bool {
+	if m.RevokeByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeByUserMock.invocationsDone()
+}
+
+// MinimockRevokeByUserInspect logs each unmet expectation
+func (m *EmailVerificationRepositoryMock) MinimockRevokeByUserInspect() {
+	for _, e := range m.RevokeByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.RevokeByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeByUserCounter := mm_atomic.LoadUint64(&m.afterRevokeByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeByUserMock.defaultExpectation != nil && afterRevokeByUserCounter < 1 {
+		if m.RevokeByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.RevokeByUser at\n%s", m.RevokeByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmailVerificationRepositoryMock.RevokeByUser at\n%s with params: %#v", m.RevokeByUserMock.defaultExpectation.expectationOrigins.origin, *m.RevokeByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeByUser != nil && afterRevokeByUserCounter < 1 {
+		m.t.Errorf("Expected call to EmailVerificationRepositoryMock.RevokeByUser at\n%s", m.funcRevokeByUserOrigin)
+	}
+
+	if !m.RevokeByUserMock.invocationsDone() && afterRevokeByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmailVerificationRepositoryMock.RevokeByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeByUserMock.expectedInvocations), m.RevokeByUserMock.expectedInvocationsOrigin, afterRevokeByUserCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EmailVerificationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConsumeInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockLastCreatedAtInspect()
+
+			m.MinimockRevokeByUserInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EmailVerificationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EmailVerificationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConsumeDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockLastCreatedAtDone() &&
+		m.MinimockRevokeByUserDone()
+}
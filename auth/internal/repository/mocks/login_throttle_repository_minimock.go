@@ -0,0 +1,1965 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i auth/internal/repository.LoginThrottleRepository -o login_throttle_repository_minimock.go -n LoginThrottleRepositoryMock -p mocks
+
+import (
+	"auth/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// LoginThrottleRepositoryMock implements mm_repository.LoginThrottleRepository
+type LoginThrottleRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcBlock          func(ctx context.Context, key model.ThrottleKey, until time.Time) (err error)
+	funcBlockOrigin    string
+	inspectFuncBlock   func(ctx context.Context, key model.ThrottleKey, until time.Time)
+	afterBlockCounter  uint64
+	beforeBlockCounter uint64
+	BlockMock          mLoginThrottleRepositoryMockBlock
+
+	funcLock          func(ctx context.Context, key model.ThrottleKey) (lp1 *model.LoginThrottle, err error)
+	funcLockOrigin    string
+	inspectFuncLock   func(ctx context.Context, key model.ThrottleKey)
+	afterLockCounter  uint64
+	beforeLockCounter uint64
+	LockMock          mLoginThrottleRepositoryMockLock
+
+	funcPrune          func(ctx context.Context, windowStart time.Time, now time.Time) (i1 int64, err error)
+	funcPruneOrigin    string
+	inspectFuncPrune   func(ctx context.Context, windowStart time.Time, now time.Time)
+	afterPruneCounter  uint64
+	beforePruneCounter uint64
+	PruneMock          mLoginThrottleRepositoryMockPrune
+
+	funcRecordFailure          func(ctx context.Context, key model.ThrottleKey, at time.Time, windowStart time.Time) (i1 int, err error)
+	funcRecordFailureOrigin    string
+	inspectFuncRecordFailure   func(ctx context.Context, key model.ThrottleKey, at time.Time, windowStart time.Time)
+	afterRecordFailureCounter  uint64
+	beforeRecordFailureCounter uint64
+	RecordFailureMock          mLoginThrottleRepositoryMockRecordFailure
+
+	funcReset          func(ctx context.Context, key model.ThrottleKey) (err error)
+	funcResetOrigin    string
+	inspectFuncReset   func(ctx context.Context, key model.ThrottleKey)
+	afterResetCounter  uint64
+	beforeResetCounter uint64
+	ResetMock          mLoginThrottleRepositoryMockReset
+}
+
+// NewLoginThrottleRepositoryMock returns a mock for mm_repository.LoginThrottleRepository
+func NewLoginThrottleRepositoryMock(t minimock.Tester) *LoginThrottleRepositoryMock {
+	m := &LoginThrottleRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BlockMock = mLoginThrottleRepositoryMockBlock{mock: m}
+	m.BlockMock.callArgs = []*LoginThrottleRepositoryMockBlockParams{}
+
+	m.LockMock = mLoginThrottleRepositoryMockLock{mock: m}
+	m.LockMock.callArgs = []*LoginThrottleRepositoryMockLockParams{}
+
+	m.PruneMock = mLoginThrottleRepositoryMockPrune{mock: m}
+	m.PruneMock.callArgs = []*LoginThrottleRepositoryMockPruneParams{}
+
+	m.RecordFailureMock = mLoginThrottleRepositoryMockRecordFailure{mock: m}
+	m.RecordFailureMock.callArgs = []*LoginThrottleRepositoryMockRecordFailureParams{}
+
+	m.ResetMock = mLoginThrottleRepositoryMockReset{mock: m}
+	m.ResetMock.callArgs = []*LoginThrottleRepositoryMockResetParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLoginThrottleRepositoryMockBlock struct {
+	optional           bool
+	mock               *LoginThrottleRepositoryMock
+	defaultExpectation *LoginThrottleRepositoryMockBlockExpectation
+	expectations       []*LoginThrottleRepositoryMockBlockExpectation
+
+	callArgs []*LoginThrottleRepositoryMockBlockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginThrottleRepositoryMockBlockExpectation specifies expectation struct of the LoginThrottleRepository.Block
+type LoginThrottleRepositoryMockBlockExpectation struct {
+	mock               *LoginThrottleRepositoryMock
+	params             *LoginThrottleRepositoryMockBlockParams
+	paramPtrs          *LoginThrottleRepositoryMockBlockParamPtrs
+	expectationOrigins LoginThrottleRepositoryMockBlockExpectationOrigins
+	results            *LoginThrottleRepositoryMockBlockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginThrottleRepositoryMockBlockParams contains parameters of the LoginThrottleRepository.Block
+type LoginThrottleRepositoryMockBlockParams struct {
+	ctx   context.Context
+	key   model.ThrottleKey
+	until time.Time
+}
+
+// LoginThrottleRepositoryMockBlockParamPtrs contains pointers to parameters of the LoginThrottleRepository.Block
+type LoginThrottleRepositoryMockBlockParamPtrs struct {
+	ctx   *context.Context
+	key   *model.ThrottleKey
+	until *time.Time
+}
+
+// LoginThrottleRepositoryMockBlockResults contains results of the LoginThrottleRepository.Block
+type LoginThrottleRepositoryMockBlockResults struct {
+	err error
+}
+
+// LoginThrottleRepositoryMockBlockOrigins contains origins of expectations of the LoginThrottleRepository.Block
+type LoginThrottleRepositoryMockBlockExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originKey   string
+	originUntil string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBlock *mLoginThrottleRepositoryMockBlock) Optional() *mLoginThrottleRepositoryMockBlock {
+	mmBlock.optional = true
+	return mmBlock
+}
+
+// Expect sets up expected params for LoginThrottleRepository.Block
+func (mmBlock *mLoginThrottleRepositoryMockBlock) Expect(ctx context.Context, key model.ThrottleKey, until time.Time) *mLoginThrottleRepositoryMockBlock {
+	if mmBlock.mock.funcBlock != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Set")
+	}
+
+	if mmBlock.defaultExpectation == nil {
+		mmBlock.defaultExpectation = &LoginThrottleRepositoryMockBlockExpectation{}
+	}
+
+	if mmBlock.defaultExpectation.paramPtrs != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by ExpectParams functions")
+	}
+
+	mmBlock.defaultExpectation.params = &LoginThrottleRepositoryMockBlockParams{ctx, key, until}
+	mmBlock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBlock.expectations {
+		if minimock.Equal(e.params, mmBlock.defaultExpectation.params) {
+			mmBlock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBlock.defaultExpectation.params)
+		}
+	}
+
+	return mmBlock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginThrottleRepository.Block
+func (mmBlock *mLoginThrottleRepositoryMockBlock) ExpectCtxParam1(ctx context.Context) *mLoginThrottleRepositoryMockBlock {
+	if mmBlock.mock.funcBlock != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Set")
+	}
+
+	if mmBlock.defaultExpectation == nil {
+		mmBlock.defaultExpectation = &LoginThrottleRepositoryMockBlockExpectation{}
+	}
+
+	if mmBlock.defaultExpectation.params != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Expect")
+	}
+
+	if mmBlock.defaultExpectation.paramPtrs == nil {
+		mmBlock.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockBlockParamPtrs{}
+	}
+	mmBlock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBlock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBlock
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginThrottleRepository.Block
+func (mmBlock *mLoginThrottleRepositoryMockBlock) ExpectKeyParam2(key model.ThrottleKey) *mLoginThrottleRepositoryMockBlock {
+	if mmBlock.mock.funcBlock != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Set")
+	}
+
+	if mmBlock.defaultExpectation == nil {
+		mmBlock.defaultExpectation = &LoginThrottleRepositoryMockBlockExpectation{}
+	}
+
+	if mmBlock.defaultExpectation.params != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Expect")
+	}
+
+	if mmBlock.defaultExpectation.paramPtrs == nil {
+		mmBlock.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockBlockParamPtrs{}
+	}
+	mmBlock.defaultExpectation.paramPtrs.key = &key
+	mmBlock.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmBlock
+}
+
+// ExpectUntilParam3 sets up expected param until for LoginThrottleRepository.Block
+func (mmBlock *mLoginThrottleRepositoryMockBlock) ExpectUntilParam3(until time.Time) *mLoginThrottleRepositoryMockBlock {
+	if mmBlock.mock.funcBlock != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Set")
+	}
+
+	if mmBlock.defaultExpectation == nil {
+		mmBlock.defaultExpectation = &LoginThrottleRepositoryMockBlockExpectation{}
+	}
+
+	if mmBlock.defaultExpectation.params != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Expect")
+	}
+
+	if mmBlock.defaultExpectation.paramPtrs == nil {
+		mmBlock.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockBlockParamPtrs{}
+	}
+	mmBlock.defaultExpectation.paramPtrs.until = &until
+	mmBlock.defaultExpectation.expectationOrigins.originUntil = minimock.CallerInfo(1)
+
+	return mmBlock
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginThrottleRepository.Block
+func (mmBlock *mLoginThrottleRepositoryMockBlock) Inspect(f func(ctx context.Context, key model.ThrottleKey, until time.Time)) *mLoginThrottleRepositoryMockBlock {
+	if mmBlock.mock.inspectFuncBlock != nil {
+		mmBlock.mock.t.Fatalf("Inspect function is already set for LoginThrottleRepositoryMock.Block")
+	}
+
+	mmBlock.mock.inspectFuncBlock = f
+
+	return mmBlock
+}
+
+// Return sets up results that will be returned by LoginThrottleRepository.Block
+func (mmBlock *mLoginThrottleRepositoryMockBlock) Return(err error) *LoginThrottleRepositoryMock {
+	if mmBlock.mock.funcBlock != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Set")
+	}
+
+	if mmBlock.defaultExpectation == nil {
+		mmBlock.defaultExpectation = &LoginThrottleRepositoryMockBlockExpectation{mock: mmBlock.mock}
+	}
+	mmBlock.defaultExpectation.results = &LoginThrottleRepositoryMockBlockResults{err}
+	mmBlock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBlock.mock
+}
+
+// Set uses given function f to mock the LoginThrottleRepository.Block method
+func (mmBlock *mLoginThrottleRepositoryMockBlock) Set(f func(ctx context.Context, key model.ThrottleKey, until time.Time) (err error)) *LoginThrottleRepositoryMock {
+	if mmBlock.defaultExpectation != nil {
+		mmBlock.mock.t.Fatalf("Default expectation is already set for the LoginThrottleRepository.Block method")
+	}
+
+	if len(mmBlock.expectations) > 0 {
+		mmBlock.mock.t.Fatalf("Some expectations are already set for the LoginThrottleRepository.Block method")
+	}
+
+	mmBlock.mock.funcBlock = f
+	mmBlock.mock.funcBlockOrigin = minimock.CallerInfo(1)
+	return mmBlock.mock
+}
+
+// When sets expectation for the LoginThrottleRepository.Block which will trigger the result defined by the following
+// Then helper
+func (mmBlock *mLoginThrottleRepositoryMockBlock) When(ctx context.Context, key model.ThrottleKey, until time.Time) *LoginThrottleRepositoryMockBlockExpectation {
+	if mmBlock.mock.funcBlock != nil {
+		mmBlock.mock.t.Fatalf("LoginThrottleRepositoryMock.Block mock is already set by Set")
+	}
+
+	expectation := &LoginThrottleRepositoryMockBlockExpectation{
+		mock:               mmBlock.mock,
+		params:             &LoginThrottleRepositoryMockBlockParams{ctx, key, until},
+		expectationOrigins: LoginThrottleRepositoryMockBlockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBlock.expectations = append(mmBlock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginThrottleRepository.Block return parameters for the expectation previously defined by the When method
+func (e *LoginThrottleRepositoryMockBlockExpectation) Then(err error) *LoginThrottleRepositoryMock {
+	e.results = &LoginThrottleRepositoryMockBlockResults{err}
+	return e.mock
+}
+
+// Times sets number of times LoginThrottleRepository.Block should be invoked
+func (mmBlock *mLoginThrottleRepositoryMockBlock) Times(n uint64) *mLoginThrottleRepositoryMockBlock {
+	if n == 0 {
+		mmBlock.mock.t.Fatalf("Times of LoginThrottleRepositoryMock.Block mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBlock.expectedInvocations, n)
+	mmBlock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBlock
+}
+
+func (mmBlock *mLoginThrottleRepositoryMockBlock) invocationsDone() bool {
+	if len(mmBlock.expectations) == 0 && mmBlock.defaultExpectation == nil && mmBlock.mock.funcBlock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBlock.mock.afterBlockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBlock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Block implements mm_repository.LoginThrottleRepository
+func (mmBlock *LoginThrottleRepositoryMock) Block(ctx context.Context, key model.ThrottleKey, until time.Time) (err error) {
+	mm_atomic.AddUint64(&mmBlock.beforeBlockCounter, 1)
+	defer mm_atomic.AddUint64(&mmBlock.afterBlockCounter, 1)
+
+	mmBlock.t.Helper()
+
+	if mmBlock.inspectFuncBlock != nil {
+		mmBlock.inspectFuncBlock(ctx, key, until)
+	}
+
+	mm_params := LoginThrottleRepositoryMockBlockParams{ctx, key, until}
+
+	// Record call args
+	mmBlock.BlockMock.mutex.Lock()
+	mmBlock.BlockMock.callArgs = append(mmBlock.BlockMock.callArgs, &mm_params)
+	mmBlock.BlockMock.mutex.Unlock()
+
+	for _, e := range mmBlock.BlockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmBlock.BlockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBlock.BlockMock.defaultExpectation.Counter, 1)
+		mm_want := mmBlock.BlockMock.defaultExpectation.params
+		mm_want_ptrs := mmBlock.BlockMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginThrottleRepositoryMockBlockParams{ctx, key, until}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBlock.t.Errorf("LoginThrottleRepositoryMock.Block got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBlock.BlockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmBlock.t.Errorf("LoginThrottleRepositoryMock.Block got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBlock.BlockMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.until != nil && !minimock.Equal(*mm_want_ptrs.until, mm_got.until) {
+				mmBlock.t.Errorf("LoginThrottleRepositoryMock.Block got unexpected parameter until, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBlock.BlockMock.defaultExpectation.expectationOrigins.originUntil, *mm_want_ptrs.until, mm_got.until, minimock.Diff(*mm_want_ptrs.until, mm_got.until))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBlock.t.Errorf("LoginThrottleRepositoryMock.Block got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBlock.BlockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBlock.BlockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBlock.t.Fatal("No results are set for the LoginThrottleRepositoryMock.Block")
+		}
+		return (*mm_results).err
+	}
+	if mmBlock.funcBlock != nil {
+		return mmBlock.funcBlock(ctx, key, until)
+	}
+	mmBlock.t.Fatalf("Unexpected call to LoginThrottleRepositoryMock.Block. %v %v %v", ctx, key, until)
+	return
+}
+
+// BlockAfterCounter returns a count of finished LoginThrottleRepositoryMock.Block invocations
+func (mmBlock *LoginThrottleRepositoryMock) BlockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlock.afterBlockCounter)
+}
+
+// BlockBeforeCounter returns a count of LoginThrottleRepositoryMock.Block invocations
+func (mmBlock *LoginThrottleRepositoryMock) BlockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlock.beforeBlockCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginThrottleRepositoryMock.Block.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBlock *mLoginThrottleRepositoryMockBlock) Calls() []*LoginThrottleRepositoryMockBlockParams {
+	mmBlock.mutex.RLock()
+
+	argCopy := make([]*LoginThrottleRepositoryMockBlockParams, len(mmBlock.callArgs))
+	copy(argCopy, mmBlock.callArgs)
+
+	mmBlock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBlockDone returns true if the count of the Block invocations corresponds
+// the number of defined expectations
+func (m *LoginThrottleRepositoryMock) MinimockBlockDone() bool {
+	if m.BlockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BlockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BlockMock.invocationsDone()
+}
+
+// MinimockBlockInspect logs each unmet expectation
+func (m *LoginThrottleRepositoryMock) MinimockBlockInspect() {
+	for _, e := range m.BlockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Block at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBlockCounter := mm_atomic.LoadUint64(&m.afterBlockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BlockMock.defaultExpectation != nil && afterBlockCounter < 1 {
+		if m.BlockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Block at\n%s", m.BlockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Block at\n%s with params: %#v", m.BlockMock.defaultExpectation.expectationOrigins.origin, *m.BlockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBlock != nil && afterBlockCounter < 1 {
+		m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Block at\n%s", m.funcBlockOrigin)
+	}
+
+	if !m.BlockMock.invocationsDone() && afterBlockCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginThrottleRepositoryMock.Block at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BlockMock.expectedInvocations), m.BlockMock.expectedInvocationsOrigin, afterBlockCounter)
+	}
+}
+
+type mLoginThrottleRepositoryMockLock struct {
+	optional           bool
+	mock               *LoginThrottleRepositoryMock
+	defaultExpectation *LoginThrottleRepositoryMockLockExpectation
+	expectations       []*LoginThrottleRepositoryMockLockExpectation
+
+	callArgs []*LoginThrottleRepositoryMockLockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginThrottleRepositoryMockLockExpectation specifies expectation struct of the LoginThrottleRepository.Lock
+type LoginThrottleRepositoryMockLockExpectation struct {
+	mock               *LoginThrottleRepositoryMock
+	params             *LoginThrottleRepositoryMockLockParams
+	paramPtrs          *LoginThrottleRepositoryMockLockParamPtrs
+	expectationOrigins LoginThrottleRepositoryMockLockExpectationOrigins
+	results            *LoginThrottleRepositoryMockLockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginThrottleRepositoryMockLockParams contains parameters of the LoginThrottleRepository.Lock
+type LoginThrottleRepositoryMockLockParams struct {
+	ctx context.Context
+	key model.ThrottleKey
+}
+
+// LoginThrottleRepositoryMockLockParamPtrs contains pointers to parameters of the LoginThrottleRepository.Lock
+type LoginThrottleRepositoryMockLockParamPtrs struct {
+	ctx *context.Context
+	key *model.ThrottleKey
+}
+
+// LoginThrottleRepositoryMockLockResults contains results of the LoginThrottleRepository.Lock
+type LoginThrottleRepositoryMockLockResults struct {
+	lp1 *model.LoginThrottle
+	err error
+}
+
+// LoginThrottleRepositoryMockLockOrigins contains origins of expectations of the LoginThrottleRepository.Lock
+type LoginThrottleRepositoryMockLockExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLock *mLoginThrottleRepositoryMockLock) Optional() *mLoginThrottleRepositoryMockLock {
+	mmLock.optional = true
+	return mmLock
+}
+
+// Expect sets up expected params for LoginThrottleRepository.Lock
+func (mmLock *mLoginThrottleRepositoryMockLock) Expect(ctx context.Context, key model.ThrottleKey) *mLoginThrottleRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginThrottleRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginThrottleRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.paramPtrs != nil {
+		mmLock.mock.t.Fatalf("LoginThrottleRepositoryMock.Lock mock is already set by ExpectParams functions")
+	}
+
+	mmLock.defaultExpectation.params = &LoginThrottleRepositoryMockLockParams{ctx, key}
+	mmLock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLock.expectations {
+		if minimock.Equal(e.params, mmLock.defaultExpectation.params) {
+			mmLock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLock.defaultExpectation.params)
+		}
+	}
+
+	return mmLock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginThrottleRepository.Lock
+func (mmLock *mLoginThrottleRepositoryMockLock) ExpectCtxParam1(ctx context.Context) *mLoginThrottleRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginThrottleRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginThrottleRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("LoginThrottleRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLock
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginThrottleRepository.Lock
+func (mmLock *mLoginThrottleRepositoryMockLock) ExpectKeyParam2(key model.ThrottleKey) *mLoginThrottleRepositoryMockLock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginThrottleRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginThrottleRepositoryMockLockExpectation{}
+	}
+
+	if mmLock.defaultExpectation.params != nil {
+		mmLock.mock.t.Fatalf("LoginThrottleRepositoryMock.Lock mock is already set by Expect")
+	}
+
+	if mmLock.defaultExpectation.paramPtrs == nil {
+		mmLock.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockLockParamPtrs{}
+	}
+	mmLock.defaultExpectation.paramPtrs.key = &key
+	mmLock.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmLock
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginThrottleRepository.Lock
+func (mmLock *mLoginThrottleRepositoryMockLock) Inspect(f func(ctx context.Context, key model.ThrottleKey)) *mLoginThrottleRepositoryMockLock {
+	if mmLock.mock.inspectFuncLock != nil {
+		mmLock.mock.t.Fatalf("Inspect function is already set for LoginThrottleRepositoryMock.Lock")
+	}
+
+	mmLock.mock.inspectFuncLock = f
+
+	return mmLock
+}
+
+// Return sets up results that will be returned by LoginThrottleRepository.Lock
+func (mmLock *mLoginThrottleRepositoryMockLock) Return(lp1 *model.LoginThrottle, err error) *LoginThrottleRepositoryMock {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginThrottleRepositoryMock.Lock mock is already set by Set")
+	}
+
+	if mmLock.defaultExpectation == nil {
+		mmLock.defaultExpectation = &LoginThrottleRepositoryMockLockExpectation{mock: mmLock.mock}
+	}
+	mmLock.defaultExpectation.results = &LoginThrottleRepositoryMockLockResults{lp1, err}
+	mmLock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLock.mock
+}
+
+// Set uses given function f to mock the LoginThrottleRepository.Lock method
+func (mmLock *mLoginThrottleRepositoryMockLock) Set(f func(ctx context.Context, key model.ThrottleKey) (lp1 *model.LoginThrottle, err error)) *LoginThrottleRepositoryMock {
+	if mmLock.defaultExpectation != nil {
+		mmLock.mock.t.Fatalf("Default expectation is already set for the LoginThrottleRepository.Lock method")
+	}
+
+	if len(mmLock.expectations) > 0 {
+		mmLock.mock.t.Fatalf("Some expectations are already set for the LoginThrottleRepository.Lock method")
+	}
+
+	mmLock.mock.funcLock = f
+	mmLock.mock.funcLockOrigin = minimock.CallerInfo(1)
+	return mmLock.mock
+}
+
+// When sets expectation for the LoginThrottleRepository.Lock which will trigger the result defined by the following
+// Then helper
+func (mmLock *mLoginThrottleRepositoryMockLock) When(ctx context.Context, key model.ThrottleKey) *LoginThrottleRepositoryMockLockExpectation {
+	if mmLock.mock.funcLock != nil {
+		mmLock.mock.t.Fatalf("LoginThrottleRepositoryMock.Lock mock is already set by Set")
+	}
+
+	expectation := &LoginThrottleRepositoryMockLockExpectation{
+		mock:               mmLock.mock,
+		params:             &LoginThrottleRepositoryMockLockParams{ctx, key},
+		expectationOrigins: LoginThrottleRepositoryMockLockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLock.expectations = append(mmLock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginThrottleRepository.Lock return parameters for the expectation previously defined by the When method
+func (e *LoginThrottleRepositoryMockLockExpectation) Then(lp1 *model.LoginThrottle, err error) *LoginThrottleRepositoryMock {
+	e.results = &LoginThrottleRepositoryMockLockResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times LoginThrottleRepository.Lock should be invoked
+func (mmLock *mLoginThrottleRepositoryMockLock) Times(n uint64) *mLoginThrottleRepositoryMockLock {
+	if n == 0 {
+		mmLock.mock.t.Fatalf("Times of LoginThrottleRepositoryMock.Lock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLock.expectedInvocations, n)
+	mmLock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLock
+}
+
+func (mmLock *mLoginThrottleRepositoryMockLock) invocationsDone() bool {
+	if len(mmLock.expectations) == 0 && mmLock.defaultExpectation == nil && mmLock.mock.funcLock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLock.mock.afterLockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Lock implements mm_repository.LoginThrottleRepository
+func (mmLock *LoginThrottleRepositoryMock) Lock(ctx context.Context, key model.ThrottleKey) (lp1 *model.LoginThrottle, err error) {
+	mm_atomic.AddUint64(&mmLock.beforeLockCounter, 1)
+	defer mm_atomic.AddUint64(&mmLock.afterLockCounter, 1)
+
+	mmLock.t.Helper()
+
+	if mmLock.inspectFuncLock != nil {
+		mmLock.inspectFuncLock(ctx, key)
+	}
+
+	mm_params := LoginThrottleRepositoryMockLockParams{ctx, key}
+
+	// Record call args
+	mmLock.LockMock.mutex.Lock()
+	mmLock.LockMock.callArgs = append(mmLock.LockMock.callArgs, &mm_params)
+	mmLock.LockMock.mutex.Unlock()
+
+	for _, e := range mmLock.LockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmLock.LockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLock.LockMock.defaultExpectation.Counter, 1)
+		mm_want := mmLock.LockMock.defaultExpectation.params
+		mm_want_ptrs := mmLock.LockMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginThrottleRepositoryMockLockParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLock.t.Errorf("LoginThrottleRepositoryMock.Lock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLock.LockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmLock.t.Errorf("LoginThrottleRepositoryMock.Lock got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLock.LockMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLock.t.Errorf("LoginThrottleRepositoryMock.Lock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLock.LockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLock.LockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLock.t.Fatal("No results are set for the LoginThrottleRepositoryMock.Lock")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmLock.funcLock != nil {
+		return mmLock.funcLock(ctx, key)
+	}
+	mmLock.t.Fatalf("Unexpected call to LoginThrottleRepositoryMock.Lock. %v %v", ctx, key)
+	return
+}
+
+// LockAfterCounter returns a count of finished LoginThrottleRepositoryMock.Lock invocations
+func (mmLock *LoginThrottleRepositoryMock) LockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLock.afterLockCounter)
+}
+
+// LockBeforeCounter returns a count of LoginThrottleRepositoryMock.Lock invocations
+func (mmLock *LoginThrottleRepositoryMock) LockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLock.beforeLockCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginThrottleRepositoryMock.Lock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLock *mLoginThrottleRepositoryMockLock) Calls() []*LoginThrottleRepositoryMockLockParams {
+	mmLock.mutex.RLock()
+
+	argCopy := make([]*LoginThrottleRepositoryMockLockParams, len(mmLock.callArgs))
+	copy(argCopy, mmLock.callArgs)
+
+	mmLock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockDone returns true if the count of the Lock invocations corresponds
+// the number of defined expectations
+func (m *LoginThrottleRepositoryMock) MinimockLockDone() bool {
+	if m.LockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockMock.invocationsDone()
+}
+
+// MinimockLockInspect logs each unmet expectation
+func (m *LoginThrottleRepositoryMock) MinimockLockInspect() {
+	for _, e := range m.LockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Lock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockCounter := mm_atomic.LoadUint64(&m.afterLockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockMock.defaultExpectation != nil && afterLockCounter < 1 {
+		if m.LockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Lock at\n%s", m.LockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Lock at\n%s with params: %#v", m.LockMock.defaultExpectation.expectationOrigins.origin, *m.LockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLock != nil && afterLockCounter < 1 {
+		m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Lock at\n%s", m.funcLockOrigin)
+	}
+
+	if !m.LockMock.invocationsDone() && afterLockCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginThrottleRepositoryMock.Lock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockMock.expectedInvocations), m.LockMock.expectedInvocationsOrigin, afterLockCounter)
+	}
+}
+
+type mLoginThrottleRepositoryMockPrune struct {
+	optional           bool
+	mock               *LoginThrottleRepositoryMock
+	defaultExpectation *LoginThrottleRepositoryMockPruneExpectation
+	expectations       []*LoginThrottleRepositoryMockPruneExpectation
+
+	callArgs []*LoginThrottleRepositoryMockPruneParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginThrottleRepositoryMockPruneExpectation specifies expectation struct of the LoginThrottleRepository.Prune
+type LoginThrottleRepositoryMockPruneExpectation struct {
+	mock               *LoginThrottleRepositoryMock
+	params             *LoginThrottleRepositoryMockPruneParams
+	paramPtrs          *LoginThrottleRepositoryMockPruneParamPtrs
+	expectationOrigins LoginThrottleRepositoryMockPruneExpectationOrigins
+	results            *LoginThrottleRepositoryMockPruneResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginThrottleRepositoryMockPruneParams contains parameters of the LoginThrottleRepository.Prune
+type LoginThrottleRepositoryMockPruneParams struct {
+	ctx         context.Context
+	windowStart time.Time
+	now         time.Time
+}
+
+// LoginThrottleRepositoryMockPruneParamPtrs contains pointers to parameters of the LoginThrottleRepository.Prune
+type LoginThrottleRepositoryMockPruneParamPtrs struct {
+	ctx         *context.Context
+	windowStart *time.Time
+	now         *time.Time
+}
+
+// LoginThrottleRepositoryMockPruneResults contains results of the LoginThrottleRepository.Prune
+type LoginThrottleRepositoryMockPruneResults struct {
+	i1  int64
+	err error
+}
+
+// LoginThrottleRepositoryMockPruneOrigins contains origins of expectations of the LoginThrottleRepository.Prune
+type LoginThrottleRepositoryMockPruneExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originWindowStart string
+	originNow         string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPrune *mLoginThrottleRepositoryMockPrune) Optional() *mLoginThrottleRepositoryMockPrune {
+	mmPrune.optional = true
+	return mmPrune
+}
+
+// Expect sets up expected params for LoginThrottleRepository.Prune
+func (mmPrune *mLoginThrottleRepositoryMockPrune) Expect(ctx context.Context, windowStart time.Time, now time.Time) *mLoginThrottleRepositoryMockPrune {
+	if mmPrune.mock.funcPrune != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Set")
+	}
+
+	if mmPrune.defaultExpectation == nil {
+		mmPrune.defaultExpectation = &LoginThrottleRepositoryMockPruneExpectation{}
+	}
+
+	if mmPrune.defaultExpectation.paramPtrs != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by ExpectParams functions")
+	}
+
+	mmPrune.defaultExpectation.params = &LoginThrottleRepositoryMockPruneParams{ctx, windowStart, now}
+	mmPrune.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPrune.expectations {
+		if minimock.Equal(e.params, mmPrune.defaultExpectation.params) {
+			mmPrune.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPrune.defaultExpectation.params)
+		}
+	}
+
+	return mmPrune
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginThrottleRepository.Prune
+func (mmPrune *mLoginThrottleRepositoryMockPrune) ExpectCtxParam1(ctx context.Context) *mLoginThrottleRepositoryMockPrune {
+	if mmPrune.mock.funcPrune != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Set")
+	}
+
+	if mmPrune.defaultExpectation == nil {
+		mmPrune.defaultExpectation = &LoginThrottleRepositoryMockPruneExpectation{}
+	}
+
+	if mmPrune.defaultExpectation.params != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Expect")
+	}
+
+	if mmPrune.defaultExpectation.paramPtrs == nil {
+		mmPrune.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockPruneParamPtrs{}
+	}
+	mmPrune.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPrune.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPrune
+}
+
+// ExpectWindowStartParam2 sets up expected param windowStart for LoginThrottleRepository.Prune
+func (mmPrune *mLoginThrottleRepositoryMockPrune) ExpectWindowStartParam2(windowStart time.Time) *mLoginThrottleRepositoryMockPrune {
+	if mmPrune.mock.funcPrune != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Set")
+	}
+
+	if mmPrune.defaultExpectation == nil {
+		mmPrune.defaultExpectation = &LoginThrottleRepositoryMockPruneExpectation{}
+	}
+
+	if mmPrune.defaultExpectation.params != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Expect")
+	}
+
+	if mmPrune.defaultExpectation.paramPtrs == nil {
+		mmPrune.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockPruneParamPtrs{}
+	}
+	mmPrune.defaultExpectation.paramPtrs.windowStart = &windowStart
+	mmPrune.defaultExpectation.expectationOrigins.originWindowStart = minimock.CallerInfo(1)
+
+	return mmPrune
+}
+
+// ExpectNowParam3 sets up expected param now for LoginThrottleRepository.Prune
+func (mmPrune *mLoginThrottleRepositoryMockPrune) ExpectNowParam3(now time.Time) *mLoginThrottleRepositoryMockPrune {
+	if mmPrune.mock.funcPrune != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Set")
+	}
+
+	if mmPrune.defaultExpectation == nil {
+		mmPrune.defaultExpectation = &LoginThrottleRepositoryMockPruneExpectation{}
+	}
+
+	if mmPrune.defaultExpectation.params != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Expect")
+	}
+
+	if mmPrune.defaultExpectation.paramPtrs == nil {
+		mmPrune.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockPruneParamPtrs{}
+	}
+	mmPrune.defaultExpectation.paramPtrs.now = &now
+	mmPrune.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmPrune
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginThrottleRepository.Prune
+func (mmPrune *mLoginThrottleRepositoryMockPrune) Inspect(f func(ctx context.Context, windowStart time.Time, now time.Time)) *mLoginThrottleRepositoryMockPrune {
+	if mmPrune.mock.inspectFuncPrune != nil {
+		mmPrune.mock.t.Fatalf("Inspect function is already set for LoginThrottleRepositoryMock.Prune")
+	}
+
+	mmPrune.mock.inspectFuncPrune = f
+
+	return mmPrune
+}
+
+// Return sets up results that will be returned by LoginThrottleRepository.Prune
+func (mmPrune *mLoginThrottleRepositoryMockPrune) Return(i1 int64, err error) *LoginThrottleRepositoryMock {
+	if mmPrune.mock.funcPrune != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Set")
+	}
+
+	if mmPrune.defaultExpectation == nil {
+		mmPrune.defaultExpectation = &LoginThrottleRepositoryMockPruneExpectation{mock: mmPrune.mock}
+	}
+	mmPrune.defaultExpectation.results = &LoginThrottleRepositoryMockPruneResults{i1, err}
+	mmPrune.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPrune.mock
+}
+
+// Set uses given function f to mock the LoginThrottleRepository.Prune method
+func (mmPrune *mLoginThrottleRepositoryMockPrune) Set(f func(ctx context.Context, windowStart time.Time, now time.Time) (i1 int64, err error)) *LoginThrottleRepositoryMock {
+	if mmPrune.defaultExpectation != nil {
+		mmPrune.mock.t.Fatalf("Default expectation is already set for the LoginThrottleRepository.Prune method")
+	}
+
+	if len(mmPrune.expectations) > 0 {
+		mmPrune.mock.t.Fatalf("Some expectations are already set for the LoginThrottleRepository.Prune method")
+	}
+
+	mmPrune.mock.funcPrune = f
+	mmPrune.mock.funcPruneOrigin = minimock.CallerInfo(1)
+	return mmPrune.mock
+}
+
+// When sets expectation for the LoginThrottleRepository.Prune which will trigger the result defined by the following
+// Then helper
+func (mmPrune *mLoginThrottleRepositoryMockPrune) When(ctx context.Context, windowStart time.Time, now time.Time) *LoginThrottleRepositoryMockPruneExpectation {
+	if mmPrune.mock.funcPrune != nil {
+		mmPrune.mock.t.Fatalf("LoginThrottleRepositoryMock.Prune mock is already set by Set")
+	}
+
+	expectation := &LoginThrottleRepositoryMockPruneExpectation{
+		mock:               mmPrune.mock,
+		params:             &LoginThrottleRepositoryMockPruneParams{ctx, windowStart, now},
+		expectationOrigins: LoginThrottleRepositoryMockPruneExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPrune.expectations = append(mmPrune.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginThrottleRepository.Prune return parameters for the expectation previously defined by the When method
+func (e *LoginThrottleRepositoryMockPruneExpectation) Then(i1 int64, err error) *LoginThrottleRepositoryMock {
+	e.results = &LoginThrottleRepositoryMockPruneResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times LoginThrottleRepository.Prune should be invoked
+func (mmPrune *mLoginThrottleRepositoryMockPrune) Times(n uint64) *mLoginThrottleRepositoryMockPrune {
+	if n == 0 {
+		mmPrune.mock.t.Fatalf("Times of LoginThrottleRepositoryMock.Prune mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPrune.expectedInvocations, n)
+	mmPrune.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPrune
+}
+
+func (mmPrune *mLoginThrottleRepositoryMockPrune) invocationsDone() bool {
+	if len(mmPrune.expectations) == 0 && mmPrune.defaultExpectation == nil && mmPrune.mock.funcPrune == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPrune.mock.afterPruneCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPrune.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Prune implements mm_repository.LoginThrottleRepository
+func (mmPrune *LoginThrottleRepositoryMock) Prune(ctx context.Context, windowStart time.Time, now time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPrune.beforePruneCounter, 1)
+	defer mm_atomic.AddUint64(&mmPrune.afterPruneCounter, 1)
+
+	mmPrune.t.Helper()
+
+	if mmPrune.inspectFuncPrune != nil {
+		mmPrune.inspectFuncPrune(ctx, windowStart, now)
+	}
+
+	mm_params := LoginThrottleRepositoryMockPruneParams{ctx, windowStart, now}
+
+	// Record call args
+	mmPrune.PruneMock.mutex.Lock()
+	mmPrune.PruneMock.callArgs = append(mmPrune.PruneMock.callArgs, &mm_params)
+	mmPrune.PruneMock.mutex.Unlock()
+
+	for _, e := range mmPrune.PruneMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPrune.PruneMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPrune.PruneMock.defaultExpectation.Counter, 1)
+		mm_want := mmPrune.PruneMock.defaultExpectation.params
+		mm_want_ptrs := mmPrune.PruneMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginThrottleRepositoryMockPruneParams{ctx, windowStart, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPrune.t.Errorf("LoginThrottleRepositoryMock.Prune got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPrune.PruneMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.windowStart != nil && !minimock.Equal(*mm_want_ptrs.windowStart, mm_got.windowStart) {
+				mmPrune.t.Errorf("LoginThrottleRepositoryMock.Prune got unexpected parameter windowStart, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPrune.PruneMock.defaultExpectation.expectationOrigins.originWindowStart, *mm_want_ptrs.windowStart, mm_got.windowStart, minimock.Diff(*mm_want_ptrs.windowStart, mm_got.windowStart))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmPrune.t.Errorf("LoginThrottleRepositoryMock.Prune got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPrune.PruneMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPrune.t.Errorf("LoginThrottleRepositoryMock.Prune got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPrune.PruneMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPrune.PruneMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPrune.t.Fatal("No results are set for the LoginThrottleRepositoryMock.Prune")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPrune.funcPrune != nil {
+		return mmPrune.funcPrune(ctx, windowStart, now)
+	}
+	mmPrune.t.Fatalf("Unexpected call to LoginThrottleRepositoryMock.Prune. %v %v %v", ctx, windowStart, now)
+	return
+}
+
+// PruneAfterCounter returns a count of finished LoginThrottleRepositoryMock.Prune invocations
+func (mmPrune *LoginThrottleRepositoryMock) PruneAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPrune.afterPruneCounter)
+}
+
+// PruneBeforeCounter returns a count of LoginThrottleRepositoryMock.Prune invocations
+func (mmPrune *LoginThrottleRepositoryMock) PruneBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPrune.beforePruneCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginThrottleRepositoryMock.Prune.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPrune *mLoginThrottleRepositoryMockPrune) Calls() []*LoginThrottleRepositoryMockPruneParams {
+	mmPrune.mutex.RLock()
+
+	argCopy := make([]*LoginThrottleRepositoryMockPruneParams, len(mmPrune.callArgs))
+	copy(argCopy, mmPrune.callArgs)
+
+	mmPrune.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPruneDone returns true if the count of the Prune invocations corresponds
+// the number of defined expectations
+func (m *LoginThrottleRepositoryMock) MinimockPruneDone() bool {
+	if m.PruneMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PruneMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PruneMock.invocationsDone()
+}
+
+// MinimockPruneInspect logs each unmet expectation
+func (m *LoginThrottleRepositoryMock) MinimockPruneInspect() {
+	for _, e := range m.PruneMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Prune at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPruneCounter := mm_atomic.LoadUint64(&m.afterPruneCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PruneMock.defaultExpectation != nil && afterPruneCounter < 1 {
+		if m.PruneMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Prune at\n%s", m.PruneMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Prune at\n%s with params: %#v", m.PruneMock.defaultExpectation.expectationOrigins.origin, *m.PruneMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPrune != nil && afterPruneCounter < 1 {
+		m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Prune at\n%s", m.funcPruneOrigin)
+	}
+
+	if !m.PruneMock.invocationsDone() && afterPruneCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginThrottleRepositoryMock.Prune at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PruneMock.expectedInvocations), m.PruneMock.expectedInvocationsOrigin, afterPruneCounter)
+	}
+}
+
+type mLoginThrottleRepositoryMockRecordFailure struct {
+	optional           bool
+	mock               *LoginThrottleRepositoryMock
+	defaultExpectation *LoginThrottleRepositoryMockRecordFailureExpectation
+	expectations       []*LoginThrottleRepositoryMockRecordFailureExpectation
+
+	callArgs []*LoginThrottleRepositoryMockRecordFailureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginThrottleRepositoryMockRecordFailureExpectation specifies expectation struct of the LoginThrottleRepository.RecordFailure
+type LoginThrottleRepositoryMockRecordFailureExpectation struct {
+	mock               *LoginThrottleRepositoryMock
+	params             *LoginThrottleRepositoryMockRecordFailureParams
+	paramPtrs          *LoginThrottleRepositoryMockRecordFailureParamPtrs
+	expectationOrigins LoginThrottleRepositoryMockRecordFailureExpectationOrigins
+	results            *LoginThrottleRepositoryMockRecordFailureResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginThrottleRepositoryMockRecordFailureParams contains parameters of the LoginThrottleRepository.RecordFailure
+type LoginThrottleRepositoryMockRecordFailureParams struct {
+	ctx         context.Context
+	key         model.ThrottleKey
+	at          time.Time
+	windowStart time.Time
+}
+
+// LoginThrottleRepositoryMockRecordFailureParamPtrs contains pointers to parameters of the LoginThrottleRepository.RecordFailure
+type LoginThrottleRepositoryMockRecordFailureParamPtrs struct {
+	ctx         *context.Context
+	key         *model.ThrottleKey
+	at          *time.Time
+	windowStart *time.Time
+}
+
+// LoginThrottleRepositoryMockRecordFailureResults contains results of the LoginThrottleRepository.RecordFailure
+type LoginThrottleRepositoryMockRecordFailureResults struct {
+	i1  int
+	err error
+}
+
+// LoginThrottleRepositoryMockRecordFailureOrigins contains origins of expectations of the LoginThrottleRepository.RecordFailure
+type LoginThrottleRepositoryMockRecordFailureExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originKey         string
+	originAt          string
+	originWindowStart string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) Optional() *mLoginThrottleRepositoryMockRecordFailure {
+	mmRecordFailure.optional = true
+	return mmRecordFailure
+}
+
+// Expect sets up expected params for LoginThrottleRepository.RecordFailure
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) Expect(ctx context.Context, key model.ThrottleKey, at time.Time, windowStart time.Time) *mLoginThrottleRepositoryMockRecordFailure {
+	if mmRecordFailure.mock.funcRecordFailure != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Set")
+	}
+
+	if mmRecordFailure.defaultExpectation == nil {
+		mmRecordFailure.defaultExpectation = &LoginThrottleRepositoryMockRecordFailureExpectation{}
+	}
+
+	if mmRecordFailure.defaultExpectation.paramPtrs != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by ExpectParams functions")
+	}
+
+	mmRecordFailure.defaultExpectation.params = &LoginThrottleRepositoryMockRecordFailureParams{ctx, key, at, windowStart}
+	mmRecordFailure.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordFailure.expectations {
+		if minimock.Equal(e.params, mmRecordFailure.defaultExpectation.params) {
+			mmRecordFailure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordFailure.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordFailure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginThrottleRepository.RecordFailure
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) ExpectCtxParam1(ctx context.Context) *mLoginThrottleRepositoryMockRecordFailure {
+	if mmRecordFailure.mock.funcRecordFailure != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Set")
+	}
+
+	if mmRecordFailure.defaultExpectation == nil {
+		mmRecordFailure.defaultExpectation = &LoginThrottleRepositoryMockRecordFailureExpectation{}
+	}
+
+	if mmRecordFailure.defaultExpectation.params != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Expect")
+	}
+
+	if mmRecordFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordFailure.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockRecordFailureParamPtrs{}
+	}
+	mmRecordFailure.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecordFailure.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecordFailure
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginThrottleRepository.RecordFailure
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) ExpectKeyParam2(key model.ThrottleKey) *mLoginThrottleRepositoryMockRecordFailure {
+	if mmRecordFailure.mock.funcRecordFailure != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Set")
+	}
+
+	if mmRecordFailure.defaultExpectation == nil {
+		mmRecordFailure.defaultExpectation = &LoginThrottleRepositoryMockRecordFailureExpectation{}
+	}
+
+	if mmRecordFailure.defaultExpectation.params != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Expect")
+	}
+
+	if mmRecordFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordFailure.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockRecordFailureParamPtrs{}
+	}
+	mmRecordFailure.defaultExpectation.paramPtrs.key = &key
+	mmRecordFailure.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmRecordFailure
+}
+
+// ExpectAtParam3 sets up expected param at for LoginThrottleRepository.RecordFailure
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) ExpectAtParam3(at time.Time) *mLoginThrottleRepositoryMockRecordFailure {
+	if mmRecordFailure.mock.funcRecordFailure != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Set")
+	}
+
+	if mmRecordFailure.defaultExpectation == nil {
+		mmRecordFailure.defaultExpectation = &LoginThrottleRepositoryMockRecordFailureExpectation{}
+	}
+
+	if mmRecordFailure.defaultExpectation.params != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Expect")
+	}
+
+	if mmRecordFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordFailure.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockRecordFailureParamPtrs{}
+	}
+	mmRecordFailure.defaultExpectation.paramPtrs.at = &at
+	mmRecordFailure.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmRecordFailure
+}
+
+// ExpectWindowStartParam4 sets up expected param windowStart for LoginThrottleRepository.RecordFailure
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) ExpectWindowStartParam4(windowStart time.Time) *mLoginThrottleRepositoryMockRecordFailure {
+	if mmRecordFailure.mock.funcRecordFailure != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Set")
+	}
+
+	if mmRecordFailure.defaultExpectation == nil {
+		mmRecordFailure.defaultExpectation = &LoginThrottleRepositoryMockRecordFailureExpectation{}
+	}
+
+	if mmRecordFailure.defaultExpectation.params != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Expect")
+	}
+
+	if mmRecordFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordFailure.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockRecordFailureParamPtrs{}
+	}
+	mmRecordFailure.defaultExpectation.paramPtrs.windowStart = &windowStart
+	mmRecordFailure.defaultExpectation.expectationOrigins.originWindowStart = minimock.CallerInfo(1)
+
+	return mmRecordFailure
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginThrottleRepository.RecordFailure
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) Inspect(f func(ctx context.Context, key model.ThrottleKey, at time.Time, windowStart time.Time)) *mLoginThrottleRepositoryMockRecordFailure {
+	if mmRecordFailure.mock.inspectFuncRecordFailure != nil {
+		mmRecordFailure.mock.t.Fatalf("Inspect function is already set for LoginThrottleRepositoryMock.RecordFailure")
+	}
+
+	mmRecordFailure.mock.inspectFuncRecordFailure = f
+
+	return mmRecordFailure
+}
+
+// Return sets up results that will be returned by LoginThrottleRepository.RecordFailure
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) Return(i1 int, err error) *LoginThrottleRepositoryMock {
+	if mmRecordFailure.mock.funcRecordFailure != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Set")
+	}
+
+	if mmRecordFailure.defaultExpectation == nil {
+		mmRecordFailure.defaultExpectation = &LoginThrottleRepositoryMockRecordFailureExpectation{mock: mmRecordFailure.mock}
+	}
+	mmRecordFailure.defaultExpectation.results = &LoginThrottleRepositoryMockRecordFailureResults{i1, err}
+	mmRecordFailure.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordFailure.mock
+}
+
+// Set uses given function f to mock the LoginThrottleRepository.RecordFailure method
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) Set(f func(ctx context.Context, key model.ThrottleKey, at time.Time, windowStart time.Time) (i1 int, err error)) *LoginThrottleRepositoryMock {
+	if mmRecordFailure.defaultExpectation != nil {
+		mmRecordFailure.mock.t.Fatalf("Default expectation is already set for the LoginThrottleRepository.RecordFailure method")
+	}
+
+	if len(mmRecordFailure.expectations) > 0 {
+		mmRecordFailure.mock.t.Fatalf("Some expectations are already set for the LoginThrottleRepository.RecordFailure method")
+	}
+
+	mmRecordFailure.mock.funcRecordFailure = f
+	mmRecordFailure.mock.funcRecordFailureOrigin = minimock.CallerInfo(1)
+	return mmRecordFailure.mock
+}
+
+// When sets expectation for the LoginThrottleRepository.RecordFailure which will trigger the result defined by the following
+// Then helper
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) When(ctx context.Context, key model.ThrottleKey, at time.Time, windowStart time.Time) *LoginThrottleRepositoryMockRecordFailureExpectation {
+	if mmRecordFailure.mock.funcRecordFailure != nil {
+		mmRecordFailure.mock.t.Fatalf("LoginThrottleRepositoryMock.RecordFailure mock is already set by Set")
+	}
+
+	expectation := &LoginThrottleRepositoryMockRecordFailureExpectation{
+		mock:               mmRecordFailure.mock,
+		params:             &LoginThrottleRepositoryMockRecordFailureParams{ctx, key, at, windowStart},
+		expectationOrigins: LoginThrottleRepositoryMockRecordFailureExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecordFailure.expectations = append(mmRecordFailure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginThrottleRepository.RecordFailure return parameters for the expectation previously defined by the When method
+func (e *LoginThrottleRepositoryMockRecordFailureExpectation) Then(i1 int, err error) *LoginThrottleRepositoryMock {
+	e.results = &LoginThrottleRepositoryMockRecordFailureResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times LoginThrottleRepository.RecordFailure should be invoked
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) Times(n uint64) *mLoginThrottleRepositoryMockRecordFailure {
+	if n == 0 {
+		mmRecordFailure.mock.t.Fatalf("Times of LoginThrottleRepositoryMock.RecordFailure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordFailure.expectedInvocations, n)
+	mmRecordFailure.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordFailure
+}
+
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) invocationsDone() bool {
+	if len(mmRecordFailure.expectations) == 0 && mmRecordFailure.defaultExpectation == nil && mmRecordFailure.mock.funcRecordFailure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordFailure.mock.afterRecordFailureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordFailure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordFailure implements mm_repository.LoginThrottleRepository
+func (mmRecordFailure *LoginThrottleRepositoryMock) RecordFailure(ctx context.Context, key model.ThrottleKey, at time.Time, windowStart time.Time) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmRecordFailure.beforeRecordFailureCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordFailure.afterRecordFailureCounter, 1)
+
+	mmRecordFailure.t.Helper()
+
+	if mmRecordFailure.inspectFuncRecordFailure != nil {
+		mmRecordFailure.inspectFuncRecordFailure(ctx, key, at, windowStart)
+	}
+
+	mm_params := LoginThrottleRepositoryMockRecordFailureParams{ctx, key, at, windowStart}
+
+	// Record call args
+	mmRecordFailure.RecordFailureMock.mutex.Lock()
+	mmRecordFailure.RecordFailureMock.callArgs = append(mmRecordFailure.RecordFailureMock.callArgs, &mm_params)
+	mmRecordFailure.RecordFailureMock.mutex.Unlock()
+
+	for _, e := range mmRecordFailure.RecordFailureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmRecordFailure.RecordFailureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordFailure.RecordFailureMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordFailure.RecordFailureMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordFailure.RecordFailureMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginThrottleRepositoryMockRecordFailureParams{ctx, key, at, windowStart}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordFailure.t.Errorf("LoginThrottleRepositoryMock.RecordFailure got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordFailure.RecordFailureMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmRecordFailure.t.Errorf("LoginThrottleRepositoryMock.RecordFailure got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordFailure.RecordFailureMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmRecordFailure.t.Errorf("LoginThrottleRepositoryMock.RecordFailure got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordFailure.RecordFailureMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+			if mm_want_ptrs.windowStart != nil && !minimock.Equal(*mm_want_ptrs.windowStart, mm_got.windowStart) {
+				mmRecordFailure.t.Errorf("LoginThrottleRepositoryMock.RecordFailure got unexpected parameter windowStart, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordFailure.RecordFailureMock.defaultExpectation.expectationOrigins.originWindowStart, *mm_want_ptrs.windowStart, mm_got.windowStart, minimock.Diff(*mm_want_ptrs.windowStart, mm_got.windowStart))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordFailure.t.Errorf("LoginThrottleRepositoryMock.RecordFailure got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordFailure.RecordFailureMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordFailure.RecordFailureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordFailure.t.Fatal("No results are set for the LoginThrottleRepositoryMock.RecordFailure")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmRecordFailure.funcRecordFailure != nil {
+		return mmRecordFailure.funcRecordFailure(ctx, key, at, windowStart)
+	}
+	mmRecordFailure.t.Fatalf("Unexpected call to LoginThrottleRepositoryMock.RecordFailure. %v %v %v %v", ctx, key, at, windowStart)
+	return
+}
+
+// RecordFailureAfterCounter returns a count of finished LoginThrottleRepositoryMock.RecordFailure invocations
+func (mmRecordFailure *LoginThrottleRepositoryMock) RecordFailureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordFailure.afterRecordFailureCounter)
+}
+
+// RecordFailureBeforeCounter returns a count of LoginThrottleRepositoryMock.RecordFailure invocations
+func (mmRecordFailure *LoginThrottleRepositoryMock) RecordFailureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordFailure.beforeRecordFailureCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginThrottleRepositoryMock.RecordFailure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordFailure *mLoginThrottleRepositoryMockRecordFailure) Calls() []*LoginThrottleRepositoryMockRecordFailureParams {
+	mmRecordFailure.mutex.RLock()
+
+	argCopy := make([]*LoginThrottleRepositoryMockRecordFailureParams, len(mmRecordFailure.callArgs))
+	copy(argCopy, mmRecordFailure.callArgs)
+
+	mmRecordFailure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordFailureDone returns true if the count of the RecordFailure invocations corresponds
+// the number of defined expectations
+func (m *LoginThrottleRepositoryMock) MinimockRecordFailureDone() bool {
+	if m.RecordFailureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordFailureMock.invocationsDone()
+}
+
+// MinimockRecordFailureInspect logs each unmet expectation
+func (m *LoginThrottleRepositoryMock) MinimockRecordFailureInspect() {
+	for _, e := range m.RecordFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.RecordFailure at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordFailureCounter := mm_atomic.LoadUint64(&m.afterRecordFailureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordFailureMock.defaultExpectation != nil && afterRecordFailureCounter < 1 {
+		if m.RecordFailureMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.RecordFailure at\n%s", m.RecordFailureMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.RecordFailure at\n%s with params: %#v", m.RecordFailureMock.defaultExpectation.expectationOrigins.origin, *m.RecordFailureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordFailure != nil && afterRecordFailureCounter < 1 {
+		m.t.Errorf("Expected call to LoginThrottleRepositoryMock.RecordFailure at\n%s", m.funcRecordFailureOrigin)
+	}
+
+	if !m.RecordFailureMock.invocationsDone() && afterRecordFailureCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginThrottleRepositoryMock.RecordFailure at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordFailureMock.expectedInvocations), m.RecordFailureMock.expectedInvocationsOrigin, afterRecordFailureCounter)
+	}
+}
+
+type mLoginThrottleRepositoryMockReset struct {
+	optional           bool
+	mock               *LoginThrottleRepositoryMock
+	defaultExpectation *LoginThrottleRepositoryMockResetExpectation
+	expectations       []*LoginThrottleRepositoryMockResetExpectation
+
+	callArgs []*LoginThrottleRepositoryMockResetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LoginThrottleRepositoryMockResetExpectation specifies expectation struct of the LoginThrottleRepository.Reset
+type LoginThrottleRepositoryMockResetExpectation struct {
+	mock               *LoginThrottleRepositoryMock
+	params             *LoginThrottleRepositoryMockResetParams
+	paramPtrs          *LoginThrottleRepositoryMockResetParamPtrs
+	expectationOrigins LoginThrottleRepositoryMockResetExpectationOrigins
+	results            *LoginThrottleRepositoryMockResetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LoginThrottleRepositoryMockResetParams contains parameters of the LoginThrottleRepository.Reset
+type LoginThrottleRepositoryMockResetParams struct {
+	ctx context.Context
+	key model.ThrottleKey
+}
+
+// LoginThrottleRepositoryMockResetParamPtrs contains pointers to parameters of the LoginThrottleRepository.Reset
+type LoginThrottleRepositoryMockResetParamPtrs struct {
+	ctx *context.Context
+	key *model.ThrottleKey
+}
+
+// LoginThrottleRepositoryMockResetResults contains results of the LoginThrottleRepository.Reset
+type LoginThrottleRepositoryMockResetResults struct {
+	err error
+}
+
+// LoginThrottleRepositoryMockResetOrigins contains origins of expectations of the LoginThrottleRepository.Reset
+type LoginThrottleRepositoryMockResetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReset *mLoginThrottleRepositoryMockReset) Optional() *mLoginThrottleRepositoryMockReset {
+	mmReset.optional = true
+	return mmReset
+}
+
+// Expect sets up expected params for LoginThrottleRepository.Reset
+func (mmReset *mLoginThrottleRepositoryMockReset) Expect(ctx context.Context, key model.ThrottleKey) *mLoginThrottleRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginThrottleRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginThrottleRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.paramPtrs != nil {
+		mmReset.mock.t.Fatalf("LoginThrottleRepositoryMock.Reset mock is already set by ExpectParams functions")
+	}
+
+	mmReset.defaultExpectation.params = &LoginThrottleRepositoryMockResetParams{ctx, key}
+	mmReset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReset.expectations {
+		if minimock.Equal(e.params, mmReset.defaultExpectation.params) {
+			mmReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReset.defaultExpectation.params)
+		}
+	}
+
+	return mmReset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginThrottleRepository.Reset
+func (mmReset *mLoginThrottleRepositoryMockReset) ExpectCtxParam1(ctx context.Context) *mLoginThrottleRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginThrottleRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginThrottleRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.params != nil {
+		mmReset.mock.t.Fatalf("LoginThrottleRepositoryMock.Reset mock is already set by Expect")
+	}
+
+	if mmReset.defaultExpectation.paramPtrs == nil {
+		mmReset.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockResetParamPtrs{}
+	}
+	mmReset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReset
+}
+
+// ExpectKeyParam2 sets up expected param key for LoginThrottleRepository.Reset
+func (mmReset *mLoginThrottleRepositoryMockReset) ExpectKeyParam2(key model.ThrottleKey) *mLoginThrottleRepositoryMockReset {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginThrottleRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginThrottleRepositoryMockResetExpectation{}
+	}
+
+	if mmReset.defaultExpectation.params != nil {
+		mmReset.mock.t.Fatalf("LoginThrottleRepositoryMock.Reset mock is already set by Expect")
+	}
+
+	if mmReset.defaultExpectation.paramPtrs == nil {
+		mmReset.defaultExpectation.paramPtrs = &LoginThrottleRepositoryMockResetParamPtrs{}
+	}
+	mmReset.defaultExpectation.paramPtrs.key = &key
+	mmReset.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginThrottleRepository.Reset
+func (mmReset *mLoginThrottleRepositoryMockReset) Inspect(f func(ctx context.Context, key model.ThrottleKey)) *mLoginThrottleRepositoryMockReset {
+	if mmReset.mock.inspectFuncReset != nil {
+		mmReset.mock.t.Fatalf("Inspect function is already set for LoginThrottleRepositoryMock.Reset")
+	}
+
+	mmReset.mock.inspectFuncReset = f
+
+	return mmReset
+}
+
+// Return sets up results that will be returned by LoginThrottleRepository.Reset
+func (mmReset *mLoginThrottleRepositoryMockReset) Return(err error) *LoginThrottleRepositoryMock {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginThrottleRepositoryMock.Reset mock is already set by Set")
+	}
+
+	if mmReset.defaultExpectation == nil {
+		mmReset.defaultExpectation = &LoginThrottleRepositoryMockResetExpectation{mock: mmReset.mock}
+	}
+	mmReset.defaultExpectation.results = &LoginThrottleRepositoryMockResetResults{err}
+	mmReset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReset.mock
+}
+
+// Set uses given function f to mock the LoginThrottleRepository.Reset method
+func (mmReset *mLoginThrottleRepositoryMockReset) Set(f func(ctx context.Context, key model.ThrottleKey) (err error)) *LoginThrottleRepositoryMock {
+	if mmReset.defaultExpectation != nil {
+		mmReset.mock.t.Fatalf("Default expectation is already set for the LoginThrottleRepository.Reset method")
+	}
+
+	if len(mmReset.expectations) > 0 {
+		mmReset.mock.t.Fatalf("Some expectations are already set for the LoginThrottleRepository.Reset method")
+	}
+
+	mmReset.mock.funcReset = f
+	mmReset.mock.funcResetOrigin = minimock.CallerInfo(1)
+	return mmReset.mock
+}
+
+// When sets expectation for the LoginThrottleRepository.Reset which will trigger the result defined by the following
+// Then helper
+func (mmReset *mLoginThrottleRepositoryMockReset) When(ctx context.Context, key model.ThrottleKey) *LoginThrottleRepositoryMockResetExpectation {
+	if mmReset.mock.funcReset != nil {
+		mmReset.mock.t.Fatalf("LoginThrottleRepositoryMock.Reset mock is already set by Set")
+	}
+
+	expectation := &LoginThrottleRepositoryMockResetExpectation{
+		mock:               mmReset.mock,
+		params:             &LoginThrottleRepositoryMockResetParams{ctx, key},
+		expectationOrigins: LoginThrottleRepositoryMockResetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReset.expectations = append(mmReset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginThrottleRepository.Reset return parameters for the expectation previously defined by the When method
+func (e *LoginThrottleRepositoryMockResetExpectation) Then(err error) *LoginThrottleRepositoryMock {
+	e.results = &LoginThrottleRepositoryMockResetResults{err}
+	return e.mock
+}
+
+// Times sets number of times LoginThrottleRepository.Reset should be invoked
+func (mmReset *mLoginThrottleRepositoryMockReset) Times(n uint64) *mLoginThrottleRepositoryMockReset {
+	if n == 0 {
+		mmReset.mock.t.Fatalf("Times of LoginThrottleRepositoryMock.Reset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReset.expectedInvocations, n)
+	mmReset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReset
+}
+
+func (mmReset *mLoginThrottleRepositoryMockReset) invocationsDone() bool {
+	if len(mmReset.expectations) == 0 && mmReset.defaultExpectation == nil && mmReset.mock.funcReset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReset.mock.afterResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reset implements mm_repository.LoginThrottleRepository
+func (mmReset *LoginThrottleRepositoryMock) Reset(ctx context.Context, key model.ThrottleKey) (err error) {
+	mm_atomic.AddUint64(&mmReset.beforeResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmReset.afterResetCounter, 1)
+
+	mmReset.t.Helper()
+
+	if mmReset.inspectFuncReset != nil {
+		mmReset.inspectFuncReset(ctx, key)
+	}
+
+	mm_params := LoginThrottleRepositoryMockResetParams{ctx, key}
+
+	// Record call args
+	mmReset.ResetMock.mutex.Lock()
+	mmReset.ResetMock.callArgs = append(mmReset.ResetMock.callArgs, &mm_params)
+	mmReset.ResetMock.mutex.Unlock()
+
+	for _, e := range mmReset.ResetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReset.ResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReset.ResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmReset.ResetMock.defaultExpectation.params
+		mm_want_ptrs := mmReset.ResetMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginThrottleRepositoryMockResetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReset.t.Errorf("LoginThrottleRepositoryMock.Reset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReset.ResetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReset.t.Errorf("LoginThrottleRepositoryMock.Reset got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReset.ResetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReset.t.Errorf("LoginThrottleRepositoryMock.Reset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReset.ResetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReset.ResetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReset.t.Fatal("No results are set for the LoginThrottleRepositoryMock.Reset")
+		}
+		return (*mm_results).err
+	}
+	if mmReset.funcReset != nil {
+		return mmReset.funcReset(ctx, key)
+	}
+	mmReset.t.Fatalf("Unexpected call to LoginThrottleRepositoryMock.Reset. %v %v", ctx, key)
+	return
+}
+
+// ResetAfterCounter returns a count of finished LoginThrottleRepositoryMock.Reset invocations
+func (mmReset *LoginThrottleRepositoryMock) ResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReset.afterResetCounter)
+}
+
+// ResetBeforeCounter returns a count of LoginThrottleRepositoryMock.Reset invocations
+func (mmReset *LoginThrottleRepositoryMock) ResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReset.beforeResetCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginThrottleRepositoryMock.Reset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReset *mLoginThrottleRepositoryMockReset) Calls() []*LoginThrottleRepositoryMockResetParams {
+	mmReset.mutex.RLock()
+
+	argCopy := make([]*LoginThrottleRepositoryMockResetParams, len(mmReset.callArgs))
+	copy(argCopy, mmReset.callArgs)
+
+	mmReset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResetDone returns true if the count of the Reset invocations corresponds
+// the number of defined expectations
+func (m *LoginThrottleRepositoryMock) MinimockResetDone() bool {
+	if m.ResetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResetMock.invocationsDone()
+}
+
+// MinimockResetInspect logs each unmet expectation
+func (m *LoginThrottleRepositoryMock) MinimockResetInspect() {
+	for _, e := range m.ResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Reset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResetCounter := mm_atomic.LoadUint64(&m.afterResetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResetMock.defaultExpectation != nil && afterResetCounter < 1 {
+		if m.ResetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Reset at\n%s", m.ResetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Reset at\n%s with params: %#v", m.ResetMock.defaultExpectation.expectationOrigins.origin, *m.ResetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReset != nil && afterResetCounter < 1 {
+		m.t.Errorf("Expected call to LoginThrottleRepositoryMock.Reset at\n%s", m.funcResetOrigin)
+	}
+
+	if !m.ResetMock.invocationsDone() && afterResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginThrottleRepositoryMock.Reset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetMock.expectedInvocations), m.ResetMock.expectedInvocationsOrigin, afterResetCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LoginThrottleRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockBlockInspect()
+
+			m.MinimockLockInspect()
+
+			m.MinimockPruneInspect()
+
+			m.MinimockRecordFailureInspect()
+
+			m.MinimockResetInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LoginThrottleRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LoginThrottleRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockBlockDone() &&
+		m.MinimockLockDone() &&
+		m.MinimockPruneDone() &&
+		m.MinimockRecordFailureDone() &&
+		m.MinimockResetDone()
+}
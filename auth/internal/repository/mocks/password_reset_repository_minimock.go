@@ -0,0 +1,1485 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i auth/internal/repository.PasswordResetRepository -o password_reset_repository_minimock.go -n PasswordResetRepositoryMock -p mocks
+
+import (
+	"auth/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PasswordResetRepositoryMock implements mm_repository.PasswordResetRepository
+type PasswordResetRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConsume          func(ctx context.Context, tokenHash string) (i1 int64, err error)
+	funcConsumeOrigin    string
+	inspectFuncConsume   func(ctx context.Context, tokenHash string)
+	afterConsumeCounter  uint64
+	beforeConsumeCounter uint64
+	ConsumeMock          mPasswordResetRepositoryMockConsume
+
+	funcCreate          func(ctx context.Context, token *model.PasswordResetToken) (err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, token *model.PasswordResetToken)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mPasswordResetRepositoryMockCreate
+
+	funcLastCreatedAt          func(ctx context.Context, userID int64) (t1 time.Time, err error)
+	funcLastCreatedAtOrigin    string
+	inspectFuncLastCreatedAt   func(ctx context.Context, userID int64)
+	afterLastCreatedAtCounter  uint64
+	beforeLastCreatedAtCounter uint64
+	LastCreatedAtMock          mPasswordResetRepositoryMockLastCreatedAt
+
+	funcRevokeByUser          func(ctx context.Context, userID int64) (err error)
+	funcRevokeByUserOrigin    string
+	inspectFuncRevokeByUser   func(ctx context.Context, userID int64)
+	afterRevokeByUserCounter  uint64
+	beforeRevokeByUserCounter uint64
+	RevokeByUserMock          mPasswordResetRepositoryMockRevokeByUser
+}
+
+// NewPasswordResetRepositoryMock returns a mock for mm_repository.PasswordResetRepository
+func NewPasswordResetRepositoryMock(t minimock.Tester) *PasswordResetRepositoryMock {
+	m := &PasswordResetRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConsumeMock = mPasswordResetRepositoryMockConsume{mock: m}
+	m.ConsumeMock.callArgs = []*PasswordResetRepositoryMockConsumeParams{}
+
+	m.CreateMock = mPasswordResetRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*PasswordResetRepositoryMockCreateParams{}
+
+	m.LastCreatedAtMock = mPasswordResetRepositoryMockLastCreatedAt{mock: m}
+	m.LastCreatedAtMock.callArgs = []*PasswordResetRepositoryMockLastCreatedAtParams{}
+
+	m.RevokeByUserMock = mPasswordResetRepositoryMockRevokeByUser{mock: m}
+	m.RevokeByUserMock.callArgs = []*PasswordResetRepositoryMockRevokeByUserParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPasswordResetRepositoryMockConsume struct {
+	optional           bool
+	mock               *PasswordResetRepositoryMock
+	defaultExpectation *PasswordResetRepositoryMockConsumeExpectation
+	expectations       []*PasswordResetRepositoryMockConsumeExpectation
+
+	callArgs []*PasswordResetRepositoryMockConsumeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordResetRepositoryMockConsumeExpectation specifies expectation struct of the PasswordResetRepository.Consume
+type PasswordResetRepositoryMockConsumeExpectation struct {
+	mock               *PasswordResetRepositoryMock
+	params             *PasswordResetRepositoryMockConsumeParams
+	paramPtrs          *PasswordResetRepositoryMockConsumeParamPtrs
+	expectationOrigins PasswordResetRepositoryMockConsumeExpectationOrigins
+	results            *PasswordResetRepositoryMockConsumeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordResetRepositoryMockConsumeParams contains parameters of the PasswordResetRepository.Consume
+type PasswordResetRepositoryMockConsumeParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// PasswordResetRepositoryMockConsumeParamPtrs contains pointers to parameters of the PasswordResetRepository.Consume
+type PasswordResetRepositoryMockConsumeParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// PasswordResetRepositoryMockConsumeResults contains results of the PasswordResetRepository.Consume
+type PasswordResetRepositoryMockConsumeResults struct {
+	i1  int64
+	err error
+}
+
+// PasswordResetRepositoryMockConsumeOrigins contains origins of expectations of the PasswordResetRepository.Consume
+type PasswordResetRepositoryMockConsumeExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConsume *mPasswordResetRepositoryMockConsume) Optional() *mPasswordResetRepositoryMockConsume {
+	mmConsume.optional = true
+	return mmConsume
+}
+
+// Expect sets up expected params for PasswordResetRepository.Consume
+func (mmConsume *mPasswordResetRepositoryMockConsume) Expect(ctx context.Context, tokenHash string) *mPasswordResetRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("PasswordResetRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &PasswordResetRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs != nil {
+		mmConsume.mock.t.Fatalf("PasswordResetRepositoryMock.Consume mock is already set by ExpectParams functions")
+	}
+
+	mmConsume.defaultExpectation.params = &PasswordResetRepositoryMockConsumeParams{ctx, tokenHash}
+	mmConsume.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConsume.expectations {
+		if minimock.Equal(e.params, mmConsume.defaultExpectation.params) {
+			mmConsume.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConsume.defaultExpectation.params)
+		}
+	}
+
+	return mmConsume
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasswordResetRepository.Consume
+func (mmConsume *mPasswordResetRepositoryMockConsume) ExpectCtxParam1(ctx context.Context) *mPasswordResetRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("PasswordResetRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &PasswordResetRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("PasswordResetRepositoryMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConsume.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConsume
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for PasswordResetRepository.Consume
+func (mmConsume *mPasswordResetRepositoryMockConsume) ExpectTokenHashParam2(tokenHash string) *mPasswordResetRepositoryMockConsume {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("PasswordResetRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &PasswordResetRepositoryMockConsumeExpectation{}
+	}
+
+	if mmConsume.defaultExpectation.params != nil {
+		mmConsume.mock.t.Fatalf("PasswordResetRepositoryMock.Consume mock is already set by Expect")
+	}
+
+	if mmConsume.defaultExpectation.paramPtrs == nil {
+		mmConsume.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockConsumeParamPtrs{}
+	}
+	mmConsume.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmConsume.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmConsume
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordResetRepository.Consume
+func (mmConsume *mPasswordResetRepositoryMockConsume) Inspect(f func(ctx context.Context, tokenHash string)) *mPasswordResetRepositoryMockConsume {
+	if mmConsume.mock.inspectFuncConsume != nil {
+		mmConsume.mock.t.Fatalf("Inspect function is already set for PasswordResetRepositoryMock.Consume")
+	}
+
+	mmConsume.mock.inspectFuncConsume = f
+
+	return mmConsume
+}
+
+// Return sets up results that will be returned by PasswordResetRepository.Consume
+func (mmConsume *mPasswordResetRepositoryMockConsume) Return(i1 int64, err error) *PasswordResetRepositoryMock {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("PasswordResetRepositoryMock.Consume mock is already set by Set")
+	}
+
+	if mmConsume.defaultExpectation == nil {
+		mmConsume.defaultExpectation = &PasswordResetRepositoryMockConsumeExpectation{mock: mmConsume.mock}
+	}
+	mmConsume.defaultExpectation.results = &PasswordResetRepositoryMockConsumeResults{i1, err}
+	mmConsume.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConsume.mock
+}
+
+// Set uses given function f to mock the PasswordResetRepository.Consume method
+func (mmConsume *mPasswordResetRepositoryMockConsume) Set(f func(ctx context.Context, tokenHash string) (i1 int64, err error)) *PasswordResetRepositoryMock {
+	if mmConsume.defaultExpectation != nil {
+		mmConsume.mock.t.Fatalf("Default expectation is already set for the PasswordResetRepository.Consume method")
+	}
+
+	if len(mmConsume.expectations) > 0 {
+		mmConsume.mock.t.Fatalf("Some expectations are already set for the PasswordResetRepository.Consume method")
+	}
+
+	mmConsume.mock.funcConsume = f
+	mmConsume.mock.funcConsumeOrigin = minimock.CallerInfo(1)
+	return mmConsume.mock
+}
+
+// When sets expectation for the PasswordResetRepository.Consume which will trigger the result defined by the following
+// Then helper
+func (mmConsume *mPasswordResetRepositoryMockConsume) When(ctx context.Context, tokenHash string) *PasswordResetRepositoryMockConsumeExpectation {
+	if mmConsume.mock.funcConsume != nil {
+		mmConsume.mock.t.Fatalf("PasswordResetRepositoryMock.Consume mock is already set by Set")
+	}
+
+	expectation := &PasswordResetRepositoryMockConsumeExpectation{
+		mock:               mmConsume.mock,
+		params:             &PasswordResetRepositoryMockConsumeParams{ctx, tokenHash},
+		expectationOrigins: PasswordResetRepositoryMockConsumeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConsume.expectations = append(mmConsume.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordResetRepository.Consume return parameters for the expectation previously defined by the When method
+func (e *PasswordResetRepositoryMockConsumeExpectation) Then(i1 int64, err error) *PasswordResetRepositoryMock {
+	e.results = &PasswordResetRepositoryMockConsumeResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PasswordResetRepository.Consume should be invoked
+func (mmConsume *mPasswordResetRepositoryMockConsume) Times(n uint64) *mPasswordResetRepositoryMockConsume {
+	if n == 0 {
+		mmConsume.mock.t.Fatalf("Times of PasswordResetRepositoryMock.Consume mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConsume.expectedInvocations, n)
+	mmConsume.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConsume
+}
+
+func (mmConsume *mPasswordResetRepositoryMockConsume) invocationsDone() bool {
+	if len(mmConsume.expectations) == 0 && mmConsume.defaultExpectation == nil && mmConsume.mock.funcConsume == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConsume.mock.afterConsumeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConsume.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Consume implements mm_repository.PasswordResetRepository
+func (mmConsume *PasswordResetRepositoryMock) Consume(ctx context.Context, tokenHash string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmConsume.beforeConsumeCounter, 1)
+	defer mm_atomic.AddUint64(&mmConsume.afterConsumeCounter, 1)
+
+	mmConsume.t.Helper()
+
+	if mmConsume.inspectFuncConsume != nil {
+		mmConsume.inspectFuncConsume(ctx, tokenHash)
+	}
+
+	mm_params := PasswordResetRepositoryMockConsumeParams{ctx, tokenHash}
+
+	// Record call args
+	mmConsume.ConsumeMock.mutex.Lock()
+	mmConsume.ConsumeMock.callArgs = append(mmConsume.ConsumeMock.callArgs, &mm_params)
+	mmConsume.ConsumeMock.mutex.Unlock()
+
+	for _, e := range mmConsume.ConsumeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmConsume.ConsumeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConsume.ConsumeMock.defaultExpectation.Counter, 1)
+		mm_want := mmConsume.ConsumeMock.defaultExpectation.params
+		mm_want_ptrs := mmConsume.ConsumeMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordResetRepositoryMockConsumeParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConsume.t.Errorf("PasswordResetRepositoryMock.Consume got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsume.ConsumeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmConsume.t.Errorf("PasswordResetRepositoryMock.Consume got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConsume.ConsumeMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConsume.t.Errorf("PasswordResetRepositoryMock.Consume got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConsume.ConsumeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConsume.ConsumeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConsume.t.Fatal("No results are set for the PasswordResetRepositoryMock.Consume")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmConsume.funcConsume != nil {
+		return mmConsume.funcConsume(ctx, tokenHash)
+	}
+	mmConsume.t.Fatalf("Unexpected call to PasswordResetRepositoryMock.Consume. %v %v", ctx, tokenHash)
+	return
+}
+
+// ConsumeAfterCounter returns a count of finished PasswordResetRepositoryMock.Consume invocations
+func (mmConsume *PasswordResetRepositoryMock) ConsumeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsume.afterConsumeCounter)
+}
+
+// ConsumeBeforeCounter returns a count of PasswordResetRepositoryMock.Consume invocations
+func (mmConsume *PasswordResetRepositoryMock) ConsumeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConsume.beforeConsumeCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordResetRepositoryMock.Consume.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConsume *mPasswordResetRepositoryMockConsume) Calls() []*PasswordResetRepositoryMockConsumeParams {
+	mmConsume.mutex.RLock()
+
+	argCopy := make([]*PasswordResetRepositoryMockConsumeParams, len(mmConsume.callArgs))
+	copy(argCopy, mmConsume.callArgs)
+
+	mmConsume.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConsumeDone returns true if the count of the Consume invocations corresponds
+// the number of defined expectations
+func (m *PasswordResetRepositoryMock) MinimockConsumeDone() bool {
+	if m.ConsumeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConsumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConsumeMock.invocationsDone()
+}
+
+// MinimockConsumeInspect logs each unmet expectation
+func (m *PasswordResetRepositoryMock) MinimockConsumeInspect() {
+	for _, e := range m.ConsumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Consume at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConsumeCounter := mm_atomic.LoadUint64(&m.afterConsumeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConsumeMock.defaultExpectation != nil && afterConsumeCounter < 1 {
+		if m.ConsumeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Consume at\n%s", m.ConsumeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Consume at\n%s with params: %#v", m.ConsumeMock.defaultExpectation.expectationOrigins.origin, *m.ConsumeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConsume != nil && afterConsumeCounter < 1 {
+		m.t.Errorf("Expected call to PasswordResetRepositoryMock.Consume at\n%s", m.funcConsumeOrigin)
+	}
+
+	if !m.ConsumeMock.invocationsDone() && afterConsumeCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordResetRepositoryMock.Consume at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConsumeMock.expectedInvocations), m.ConsumeMock.expectedInvocationsOrigin, afterConsumeCounter)
+	}
+}
+
+type mPasswordResetRepositoryMockCreate struct {
+	optional           bool
+	mock               *PasswordResetRepositoryMock
+	defaultExpectation *PasswordResetRepositoryMockCreateExpectation
+	expectations       []*PasswordResetRepositoryMockCreateExpectation
+
+	callArgs []*PasswordResetRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordResetRepositoryMockCreateExpectation specifies expectation struct of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateExpectation struct {
+	mock               *PasswordResetRepositoryMock
+	params             *PasswordResetRepositoryMockCreateParams
+	paramPtrs          *PasswordResetRepositoryMockCreateParamPtrs
+	expectationOrigins PasswordResetRepositoryMockCreateExpectationOrigins
+	results            *PasswordResetRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordResetRepositoryMockCreateParams contains parameters of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateParams struct {
+	ctx   context.Context
+	token *model.PasswordResetToken
+}
+
+// PasswordResetRepositoryMockCreateParamPtrs contains pointers to parameters of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateParamPtrs struct {
+	ctx   *context.Context
+	token **model.PasswordResetToken
+}
+
+// PasswordResetRepositoryMockCreateResults contains results of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateResults struct {
+	err error
+}
+
+// PasswordResetRepositoryMockCreateOrigins contains origins of expectations of the PasswordResetRepository.Create
+type PasswordResetRepositoryMockCreateExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mPasswordResetRepositoryMockCreate) Optional() *mPasswordResetRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) Expect(ctx context.Context, token *model.PasswordResetToken) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &PasswordResetRepositoryMockCreateParams{ctx, token}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectTokenParam2 sets up expected param token for PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) ExpectTokenParam2(token *model.PasswordResetToken) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.token = &token
+	mmCreate.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) Inspect(f func(ctx context.Context, token *model.PasswordResetToken)) *mPasswordResetRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for PasswordResetRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by PasswordResetRepository.Create
+func (mmCreate *mPasswordResetRepositoryMockCreate) Return(err error) *PasswordResetRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &PasswordResetRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &PasswordResetRepositoryMockCreateResults{err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the PasswordResetRepository.Create method
+func (mmCreate *mPasswordResetRepositoryMockCreate) Set(f func(ctx context.Context, token *model.PasswordResetToken) (err error)) *PasswordResetRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the PasswordResetRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the PasswordResetRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the PasswordResetRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mPasswordResetRepositoryMockCreate) When(ctx context.Context, token *model.PasswordResetToken) *PasswordResetRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("PasswordResetRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &PasswordResetRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &PasswordResetRepositoryMockCreateParams{ctx, token},
+		expectationOrigins: PasswordResetRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordResetRepository.Create return parameters for the expectation previously defined by the When method
+func (e *PasswordResetRepositoryMockCreateExpectation) Then(err error) *PasswordResetRepositoryMock {
+	e.results = &PasswordResetRepositoryMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times PasswordResetRepository.Create should be invoked
+func (mmCreate *mPasswordResetRepositoryMockCreate) Times(n uint64) *mPasswordResetRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of PasswordResetRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mPasswordResetRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.PasswordResetRepository
+func (mmCreate *PasswordResetRepositoryMock) Create(ctx context.Context, token *model.PasswordResetToken) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, token)
+	}
+
+	mm_params := PasswordResetRepositoryMockCreateParams{ctx, token}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordResetRepositoryMockCreateParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("PasswordResetRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmCreate.t.Errorf("PasswordResetRepositoryMock.Create got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("PasswordResetRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the PasswordResetRepositoryMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, token)
+	}
+	mmCreate.t.Fatalf("Unexpected call to PasswordResetRepositoryMock.Create. %v %v", ctx, token)
+	return
+}
+
+// CreateAfterCounter returns a count of finished PasswordResetRepositoryMock.Create invocations
+func (mmCreate *PasswordResetRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of PasswordResetRepositoryMock.Create invocations
+func (mmCreate *PasswordResetRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordResetRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mPasswordResetRepositoryMockCreate) Calls() []*PasswordResetRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*PasswordResetRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *PasswordResetRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *PasswordResetRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to PasswordResetRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordResetRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mPasswordResetRepositoryMockLastCreatedAt struct {
+	optional           bool
+	mock               *PasswordResetRepositoryMock
+	defaultExpectation *PasswordResetRepositoryMockLastCreatedAtExpectation
+	expectations       []*PasswordResetRepositoryMockLastCreatedAtExpectation
+
+	callArgs []*PasswordResetRepositoryMockLastCreatedAtParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordResetRepositoryMockLastCreatedAtExpectation specifies expectation struct of the PasswordResetRepository.LastCreatedAt
+type PasswordResetRepositoryMockLastCreatedAtExpectation struct {
+	mock               *PasswordResetRepositoryMock
+	params             *PasswordResetRepositoryMockLastCreatedAtParams
+	paramPtrs          *PasswordResetRepositoryMockLastCreatedAtParamPtrs
+	expectationOrigins PasswordResetRepositoryMockLastCreatedAtExpectationOrigins
+	results            *PasswordResetRepositoryMockLastCreatedAtResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordResetRepositoryMockLastCreatedAtParams contains parameters of the PasswordResetRepository.LastCreatedAt
+type PasswordResetRepositoryMockLastCreatedAtParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// PasswordResetRepositoryMockLastCreatedAtParamPtrs contains pointers to parameters of the PasswordResetRepository.LastCreatedAt
+type PasswordResetRepositoryMockLastCreatedAtParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// PasswordResetRepositoryMockLastCreatedAtResults contains results of the PasswordResetRepository.LastCreatedAt
+type PasswordResetRepositoryMockLastCreatedAtResults struct {
+	t1  time.Time
+	err error
+}
+
+// PasswordResetRepositoryMockLastCreatedAtOrigins contains origins of expectations of the PasswordResetRepository.LastCreatedAt
+type PasswordResetRepositoryMockLastCreatedAtExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) Optional() *mPasswordResetRepositoryMockLastCreatedAt {
+	mmLastCreatedAt.optional = true
+	return mmLastCreatedAt
+}
+
+// Expect sets up expected params for PasswordResetRepository.LastCreatedAt
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) Expect(ctx context.Context, userID int64) *mPasswordResetRepositoryMockLastCreatedAt {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("PasswordResetRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	if mmLastCreatedAt.defaultExpectation == nil {
+		mmLastCreatedAt.defaultExpectation = &PasswordResetRepositoryMockLastCreatedAtExpectation{}
+	}
+
+	if mmLastCreatedAt.defaultExpectation.paramPtrs != nil {
+		mmLastCreatedAt.mock.t.Fatalf("PasswordResetRepositoryMock.LastCreatedAt mock is already set by ExpectParams functions")
+	}
+
+	mmLastCreatedAt.defaultExpectation.params = &PasswordResetRepositoryMockLastCreatedAtParams{ctx, userID}
+	mmLastCreatedAt.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLastCreatedAt.expectations {
+		if minimock.Equal(e.params, mmLastCreatedAt.defaultExpectation.params) {
+			mmLastCreatedAt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastCreatedAt.defaultExpectation.params)
+		}
+	}
+
+	return mmLastCreatedAt
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasswordResetRepository.LastCreatedAt
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) ExpectCtxParam1(ctx context.Context) *mPasswordResetRepositoryMockLastCreatedAt {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("PasswordResetRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	if mmLastCreatedAt.defaultExpectation == nil {
+		mmLastCreatedAt.defaultExpectation = &PasswordResetRepositoryMockLastCreatedAtExpectation{}
+	}
+
+	if mmLastCreatedAt.defaultExpectation.params != nil {
+		mmLastCreatedAt.mock.t.Fatalf("PasswordResetRepositoryMock.LastCreatedAt mock is already set by Expect")
+	}
+
+	if mmLastCreatedAt.defaultExpectation.paramPtrs == nil {
+		mmLastCreatedAt.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockLastCreatedAtParamPtrs{}
+	}
+	mmLastCreatedAt.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLastCreatedAt.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLastCreatedAt
+}
+
+// ExpectUserIDParam2 sets up expected param userID for PasswordResetRepository.LastCreatedAt
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) ExpectUserIDParam2(userID int64) *mPasswordResetRepositoryMockLastCreatedAt {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("PasswordResetRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	if mmLastCreatedAt.defaultExpectation == nil {
+		mmLastCreatedAt.defaultExpectation = &PasswordResetRepositoryMockLastCreatedAtExpectation{}
+	}
+
+	if mmLastCreatedAt.defaultExpectation.params != nil {
+		mmLastCreatedAt.mock.t.Fatalf("PasswordResetRepositoryMock.LastCreatedAt mock is already set by Expect")
+	}
+
+	if mmLastCreatedAt.defaultExpectation.paramPtrs == nil {
+		mmLastCreatedAt.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockLastCreatedAtParamPtrs{}
+	}
+	mmLastCreatedAt.defaultExpectation.paramPtrs.userID = &userID
+	mmLastCreatedAt.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmLastCreatedAt
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordResetRepository.LastCreatedAt
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) Inspect(f func(ctx context.Context, userID int64)) *mPasswordResetRepositoryMockLastCreatedAt {
+	if mmLastCreatedAt.mock.inspectFuncLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("Inspect function is already set for PasswordResetRepositoryMock.LastCreatedAt")
+	}
+
+	mmLastCreatedAt.mock.inspectFuncLastCreatedAt = f
+
+	return mmLastCreatedAt
+}
+
+// Return sets up results that will be returned by PasswordResetRepository.LastCreatedAt
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) Return(t1 time.Time, err error) *PasswordResetRepositoryMock {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("PasswordResetRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	if mmLastCreatedAt.defaultExpectation == nil {
+		mmLastCreatedAt.defaultExpectation = &PasswordResetRepositoryMockLastCreatedAtExpectation{mock: mmLastCreatedAt.mock}
+	}
+	mmLastCreatedAt.defaultExpectation.results = &PasswordResetRepositoryMockLastCreatedAtResults{t1, err}
+	mmLastCreatedAt.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLastCreatedAt.mock
+}
+
+// Set uses given function f to mock the PasswordResetRepository.LastCreatedAt method
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) Set(f func(ctx context.Context, userID int64) (t1 time.Time, err error)) *PasswordResetRepositoryMock {
+	if mmLastCreatedAt.defaultExpectation != nil {
+		mmLastCreatedAt.mock.t.Fatalf("Default expectation is already set for the PasswordResetRepository.LastCreatedAt method")
+	}
+
+	if len(mmLastCreatedAt.expectations) > 0 {
+		mmLastCreatedAt.mock.t.Fatalf("Some expectations are already set for the PasswordResetRepository.LastCreatedAt method")
+	}
+
+	mmLastCreatedAt.mock.funcLastCreatedAt = f
+	mmLastCreatedAt.mock.funcLastCreatedAtOrigin = minimock.CallerInfo(1)
+	return mmLastCreatedAt.mock
+}
+
+// When sets expectation for the PasswordResetRepository.LastCreatedAt which will trigger the result defined by the following
+// Then helper
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) When(ctx context.Context, userID int64) *PasswordResetRepositoryMockLastCreatedAtExpectation {
+	if mmLastCreatedAt.mock.funcLastCreatedAt != nil {
+		mmLastCreatedAt.mock.t.Fatalf("PasswordResetRepositoryMock.LastCreatedAt mock is already set by Set")
+	}
+
+	expectation := &PasswordResetRepositoryMockLastCreatedAtExpectation{
+		mock:               mmLastCreatedAt.mock,
+		params:             &PasswordResetRepositoryMockLastCreatedAtParams{ctx, userID},
+		expectationOrigins: PasswordResetRepositoryMockLastCreatedAtExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLastCreatedAt.expectations = append(mmLastCreatedAt.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordResetRepository.LastCreatedAt return parameters for the expectation previously defined by the When method
+func (e *PasswordResetRepositoryMockLastCreatedAtExpectation) Then(t1 time.Time, err error) *PasswordResetRepositoryMock {
+	e.results = &PasswordResetRepositoryMockLastCreatedAtResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times PasswordResetRepository.LastCreatedAt should be invoked
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) Times(n uint64) *mPasswordResetRepositoryMockLastCreatedAt {
+	if n == 0 {
+		mmLastCreatedAt.mock.t.Fatalf("Times of PasswordResetRepositoryMock.LastCreatedAt mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastCreatedAt.expectedInvocations, n)
+	mmLastCreatedAt.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLastCreatedAt
+}
+
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) invocationsDone() bool {
+	if len(mmLastCreatedAt.expectations) == 0 && mmLastCreatedAt.defaultExpectation == nil && mmLastCreatedAt.mock.funcLastCreatedAt == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastCreatedAt.mock.afterLastCreatedAtCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastCreatedAt.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastCreatedAt implements mm_repository.PasswordResetRepository
+func (mmLastCreatedAt *PasswordResetRepositoryMock) LastCreatedAt(ctx context.Context, userID int64) (t1 time.Time, err error) {
+	mm_atomic.AddUint64(&mmLastCreatedAt.beforeLastCreatedAtCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastCreatedAt.afterLastCreatedAtCounter, 1)
+
+	mmLastCreatedAt.t.Helper()
+
+	if mmLastCreatedAt.inspectFuncLastCreatedAt != nil {
+		mmLastCreatedAt.inspectFuncLastCreatedAt(ctx, userID)
+	}
+
+	mm_params := PasswordResetRepositoryMockLastCreatedAtParams{ctx, userID}
+
+	// Record call args
+	mmLastCreatedAt.LastCreatedAtMock.mutex.Lock()
+	mmLastCreatedAt.LastCreatedAtMock.callArgs = append(mmLastCreatedAt.LastCreatedAtMock.callArgs, &mm_params)
+	mmLastCreatedAt.LastCreatedAtMock.mutex.Unlock()
+
+	for _, e := range mmLastCreatedAt.LastCreatedAtMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmLastCreatedAt.LastCreatedAtMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.params
+		mm_want_ptrs := mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordResetRepositoryMockLastCreatedAtParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastCreatedAt.t.Errorf("PasswordResetRepositoryMock.LastCreatedAt got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmLastCreatedAt.t.Errorf("PasswordResetRepositoryMock.LastCreatedAt got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastCreatedAt.t.Errorf("PasswordResetRepositoryMock.LastCreatedAt got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastCreatedAt.LastCreatedAtMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastCreatedAt.t.Fatal("No results are set for the PasswordResetRepositoryMock.LastCreatedAt")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmLastCreatedAt.funcLastCreatedAt != nil {
+		return mmLastCreatedAt.funcLastCreatedAt(ctx, userID)
+	}
+	mmLastCreatedAt.t.Fatalf("Unexpected call to PasswordResetRepositoryMock.LastCreatedAt. %v %v", ctx, userID)
+	return
+}
+
+// LastCreatedAtAfterCounter returns a count of finished PasswordResetRepositoryMock.LastCreatedAt invocations
+func (mmLastCreatedAt *PasswordResetRepositoryMock) LastCreatedAtAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastCreatedAt.afterLastCreatedAtCounter)
+}
+
+// LastCreatedAtBeforeCounter returns a count of PasswordResetRepositoryMock.LastCreatedAt invocations
+func (mmLastCreatedAt *PasswordResetRepositoryMock) LastCreatedAtBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastCreatedAt.beforeLastCreatedAtCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordResetRepositoryMock.LastCreatedAt.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastCreatedAt *mPasswordResetRepositoryMockLastCreatedAt) Calls() []*PasswordResetRepositoryMockLastCreatedAtParams {
+	mmLastCreatedAt.mutex.RLock()
+
+	argCopy := make([]*PasswordResetRepositoryMockLastCreatedAtParams, len(mmLastCreatedAt.callArgs))
+	copy(argCopy, mmLastCreatedAt.callArgs)
+
+	mmLastCreatedAt.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastCreatedAtDone returns true if the count of the LastCreatedAt invocations corresponds
+// the number of defined expectations
+func (m *PasswordResetRepositoryMock) MinimockLastCreatedAtDone() bool {
+	if m.LastCreatedAtMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastCreatedAtMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastCreatedAtMock.invocationsDone()
+}
+
+// MinimockLastCreatedAtInspect logs each unmet expectation
+func (m *PasswordResetRepositoryMock) MinimockLastCreatedAtInspect() {
+	for _, e := range m.LastCreatedAtMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.LastCreatedAt at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLastCreatedAtCounter := mm_atomic.LoadUint64(&m.afterLastCreatedAtCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastCreatedAtMock.defaultExpectation != nil && afterLastCreatedAtCounter < 1 {
+		if m.LastCreatedAtMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.LastCreatedAt at\n%s", m.LastCreatedAtMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.LastCreatedAt at\n%s with params: %#v", m.LastCreatedAtMock.defaultExpectation.expectationOrigins.origin, *m.LastCreatedAtMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastCreatedAt != nil && afterLastCreatedAtCounter < 1 {
+		m.t.Errorf("Expected call to PasswordResetRepositoryMock.LastCreatedAt at\n%s", m.funcLastCreatedAtOrigin)
+	}
+
+	if !m.LastCreatedAtMock.invocationsDone() && afterLastCreatedAtCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordResetRepositoryMock.LastCreatedAt at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LastCreatedAtMock.expectedInvocations), m.LastCreatedAtMock.expectedInvocationsOrigin, afterLastCreatedAtCounter)
+	}
+}
+
+type mPasswordResetRepositoryMockRevokeByUser struct {
+	optional           bool
+	mock               *PasswordResetRepositoryMock
+	defaultExpectation *PasswordResetRepositoryMockRevokeByUserExpectation
+	expectations       []*PasswordResetRepositoryMockRevokeByUserExpectation
+
+	callArgs []*PasswordResetRepositoryMockRevokeByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PasswordResetRepositoryMockRevokeByUserExpectation specifies expectation struct of the PasswordResetRepository.RevokeByUser
+type PasswordResetRepositoryMockRevokeByUserExpectation struct {
+	mock               *PasswordResetRepositoryMock
+	params             *PasswordResetRepositoryMockRevokeByUserParams
+	paramPtrs          *PasswordResetRepositoryMockRevokeByUserParamPtrs
+	expectationOrigins PasswordResetRepositoryMockRevokeByUserExpectationOrigins
+	results            *PasswordResetRepositoryMockRevokeByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PasswordResetRepositoryMockRevokeByUserParams contains parameters of the PasswordResetRepository.RevokeByUser
+type PasswordResetRepositoryMockRevokeByUserParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// PasswordResetRepositoryMockRevokeByUserParamPtrs contains pointers to parameters of the PasswordResetRepository.RevokeByUser
+type PasswordResetRepositoryMockRevokeByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// PasswordResetRepositoryMockRevokeByUserResults contains results of the PasswordResetRepository.RevokeByUser
+type PasswordResetRepositoryMockRevokeByUserResults struct {
+	err error
+}
+
+// PasswordResetRepositoryMockRevokeByUserOrigins contains origins of expectations of the PasswordResetRepository.RevokeByUser
+type PasswordResetRepositoryMockRevokeByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) Optional() *mPasswordResetRepositoryMockRevokeByUser {
+	mmRevokeByUser.optional = true
+	return mmRevokeByUser
+}
+
+// Expect sets up expected params for PasswordResetRepository.RevokeByUser
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) Expect(ctx context.Context, userID int64) *mPasswordResetRepositoryMockRevokeByUser {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("PasswordResetRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	if mmRevokeByUser.defaultExpectation == nil {
+		mmRevokeByUser.defaultExpectation = &PasswordResetRepositoryMockRevokeByUserExpectation{}
+	}
+
+	if mmRevokeByUser.defaultExpectation.paramPtrs != nil {
+		mmRevokeByUser.mock.t.Fatalf("PasswordResetRepositoryMock.RevokeByUser mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeByUser.defaultExpectation.params = &PasswordResetRepositoryMockRevokeByUserParams{ctx, userID}
+	mmRevokeByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeByUser.expectations {
+		if minimock.Equal(e.params, mmRevokeByUser.defaultExpectation.params) {
+			mmRevokeByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PasswordResetRepository.RevokeByUser
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) ExpectCtxParam1(ctx context.Context) *mPasswordResetRepositoryMockRevokeByUser {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("PasswordResetRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	if mmRevokeByUser.defaultExpectation == nil {
+		mmRevokeByUser.defaultExpectation = &PasswordResetRepositoryMockRevokeByUserExpectation{}
+	}
+
+	if mmRevokeByUser.defaultExpectation.params != nil {
+		mmRevokeByUser.mock.t.Fatalf("PasswordResetRepositoryMock.RevokeByUser mock is already set by Expect")
+	}
+
+	if mmRevokeByUser.defaultExpectation.paramPtrs == nil {
+		mmRevokeByUser.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockRevokeByUserParamPtrs{}
+	}
+	mmRevokeByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for PasswordResetRepository.RevokeByUser
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) ExpectUserIDParam2(userID int64) *mPasswordResetRepositoryMockRevokeByUser {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("PasswordResetRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	if mmRevokeByUser.defaultExpectation == nil {
+		mmRevokeByUser.defaultExpectation = &PasswordResetRepositoryMockRevokeByUserExpectation{}
+	}
+
+	if mmRevokeByUser.defaultExpectation.params != nil {
+		mmRevokeByUser.mock.t.Fatalf("PasswordResetRepositoryMock.RevokeByUser mock is already set by Expect")
+	}
+
+	if mmRevokeByUser.defaultExpectation.paramPtrs == nil {
+		mmRevokeByUser.defaultExpectation.paramPtrs = &PasswordResetRepositoryMockRevokeByUserParamPtrs{}
+	}
+	mmRevokeByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmRevokeByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRevokeByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordResetRepository.RevokeByUser
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) Inspect(f func(ctx context.Context, userID int64)) *mPasswordResetRepositoryMockRevokeByUser {
+	if mmRevokeByUser.mock.inspectFuncRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("Inspect function is already set for PasswordResetRepositoryMock.RevokeByUser")
+	}
+
+	mmRevokeByUser.mock.inspectFuncRevokeByUser = f
+
+	return mmRevokeByUser
+}
+
+// Return sets up results that will be returned by PasswordResetRepository.RevokeByUser
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) Return(err error) *PasswordResetRepositoryMock {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("PasswordResetRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	if mmRevokeByUser.defaultExpectation == nil {
+		mmRevokeByUser.defaultExpectation = &PasswordResetRepositoryMockRevokeByUserExpectation{mock: mmRevokeByUser.mock}
+	}
+	mmRevokeByUser.defaultExpectation.results = &PasswordResetRepositoryMockRevokeByUserResults{err}
+	mmRevokeByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeByUser.mock
+}
+
+// Set uses given function f to mock the PasswordResetRepository.RevokeByUser method
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) Set(f func(ctx context.Context, userID int64) (err error)) *PasswordResetRepositoryMock {
+	if mmRevokeByUser.defaultExpectation != nil {
+		mmRevokeByUser.mock.t.Fatalf("Default expectation is already set for the PasswordResetRepository.RevokeByUser method")
+	}
+
+	if len(mmRevokeByUser.expectations) > 0 {
+		mmRevokeByUser.mock.t.Fatalf("Some expectations are already set for the PasswordResetRepository.RevokeByUser method")
+	}
+
+	mmRevokeByUser.mock.funcRevokeByUser = f
+	mmRevokeByUser.mock.funcRevokeByUserOrigin = minimock.CallerInfo(1)
+	return mmRevokeByUser.mock
+}
+
+// When sets expectation for the PasswordResetRepository.RevokeByUser which will trigger the result defined by the following
+// Then helper
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) When(ctx context.Context, userID int64) *PasswordResetRepositoryMockRevokeByUserExpectation {
+	if mmRevokeByUser.mock.funcRevokeByUser != nil {
+		mmRevokeByUser.mock.t.Fatalf("PasswordResetRepositoryMock.RevokeByUser mock is already set by Set")
+	}
+
+	expectation := &PasswordResetRepositoryMockRevokeByUserExpectation{
+		mock:               mmRevokeByUser.mock,
+		params:             &PasswordResetRepositoryMockRevokeByUserParams{ctx, userID},
+		expectationOrigins: PasswordResetRepositoryMockRevokeByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeByUser.expectations = append(mmRevokeByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordResetRepository.RevokeByUser return parameters for the expectation previously defined by the When method
+func (e *PasswordResetRepositoryMockRevokeByUserExpectation) Then(err error) *PasswordResetRepositoryMock {
+	e.results = &PasswordResetRepositoryMockRevokeByUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times PasswordResetRepository.RevokeByUser should be invoked
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) Times(n uint64) *mPasswordResetRepositoryMockRevokeByUser {
+	if n == 0 {
+		mmRevokeByUser.mock.t.Fatalf("Times of PasswordResetRepositoryMock.RevokeByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeByUser.expectedInvocations, n)
+	mmRevokeByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeByUser
+}
+
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) invocationsDone() bool {
+	if len(mmRevokeByUser.expectations) == 0 && mmRevokeByUser.defaultExpectation == nil && mmRevokeByUser.mock.funcRevokeByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeByUser.mock.afterRevokeByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeByUser implements mm_repository.PasswordResetRepository
+func (mmRevokeByUser *PasswordResetRepositoryMock) RevokeByUser(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRevokeByUser.beforeRevokeByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeByUser.afterRevokeByUserCounter, 1)
+
+	mmRevokeByUser.t.Helper()
+
+	if mmRevokeByUser.inspectFuncRevokeByUser != nil {
+		mmRevokeByUser.inspectFuncRevokeByUser(ctx, userID)
+	}
+
+	mm_params := PasswordResetRepositoryMockRevokeByUserParams{ctx, userID}
+
+	// Record call args
+	mmRevokeByUser.RevokeByUserMock.mutex.Lock()
+	mmRevokeByUser.RevokeByUserMock.callArgs = append(mmRevokeByUser.RevokeByUserMock.callArgs, &mm_params)
+	mmRevokeByUser.RevokeByUserMock.mutex.Unlock()
+
+	for _, e := range mmRevokeByUser.RevokeByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeByUser.RevokeByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeByUser.RevokeByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeByUser.RevokeByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeByUser.RevokeByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordResetRepositoryMockRevokeByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeByUser.t.Errorf("PasswordResetRepositoryMock.RevokeByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeByUser.RevokeByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRevokeByUser.t.Errorf("PasswordResetRepositoryMock.RevokeByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeByUser.RevokeByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeByUser.t.Errorf("PasswordResetRepositoryMock.RevokeByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeByUser.RevokeByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeByUser.RevokeByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeByUser.t.Fatal("No results are set for the PasswordResetRepositoryMock.RevokeByUser")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeByUser.funcRevokeByUser != nil {
+		return mmRevokeByUser.funcRevokeByUser(ctx, userID)
+	}
+	mmRevokeByUser.t.Fatalf("Unexpected call to PasswordResetRepositoryMock.RevokeByUser. %v %v", ctx, userID)
+	return
+}
+
+// RevokeByUserAfterCounter returns a count of finished PasswordResetRepositoryMock.RevokeByUser invocations
+func (mmRevokeByUser *PasswordResetRepositoryMock) RevokeByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeByUser.afterRevokeByUserCounter)
+}
+
+// RevokeByUserBeforeCounter returns a count of PasswordResetRepositoryMock.RevokeByUser invocations
+func (mmRevokeByUser *PasswordResetRepositoryMock) RevokeByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeByUser.beforeRevokeByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordResetRepositoryMock.RevokeByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeByUser *mPasswordResetRepositoryMockRevokeByUser) Calls() []*PasswordResetRepositoryMockRevokeByUserParams {
+	mmRevokeByUser.mutex.RLock()
+
+	argCopy := make([]*PasswordResetRepositoryMockRevokeByUserParams, len(mmRevokeByUser.callArgs))
+	copy(argCopy, mmRevokeByUser.callArgs)
+
+	mmRevokeByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeByUserDone returns true if the count of the RevokeByUser invocations corresponds
+// the number of defined expectations
+func (m *PasswordResetRepositoryMock) MinimockRevokeByUserDone() bool {
+	if m.RevokeByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeByUserMock.invocationsDone()
+}
+
+// MinimockRevokeByUserInspect logs each unmet expectation
+func (m *PasswordResetRepositoryMock) MinimockRevokeByUserInspect() {
+	for _, e := range m.RevokeByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.RevokeByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeByUserCounter := mm_atomic.LoadUint64(&m.afterRevokeByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeByUserMock.defaultExpectation != nil && afterRevokeByUserCounter < 1 {
+		if m.RevokeByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.RevokeByUser at\n%s", m.RevokeByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PasswordResetRepositoryMock.RevokeByUser at\n%s with params: %#v", m.RevokeByUserMock.defaultExpectation.expectationOrigins.origin, *m.RevokeByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeByUser != nil && afterRevokeByUserCounter < 1 {
+		m.t.Errorf("Expected call to PasswordResetRepositoryMock.RevokeByUser at\n%s", m.funcRevokeByUserOrigin)
+	}
+
+	if !m.RevokeByUserMock.invocationsDone() && afterRevokeByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordResetRepositoryMock.RevokeByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeByUserMock.expectedInvocations), m.RevokeByUserMock.expectedInvocationsOrigin, afterRevokeByUserCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PasswordResetRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConsumeInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockLastCreatedAtInspect()
+
+			m.MinimockRevokeByUserInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PasswordResetRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PasswordResetRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConsumeDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockLastCreatedAtDone() &&
+		m.MinimockRevokeByUserDone()
+}
@@ -19,6 +19,13 @@ type UserRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCount          func(ctx context.Context, filter model.UserFilter) (i1 int64, err error)
+	funcCountOrigin    string
+	inspectFuncCount   func(ctx context.Context, filter model.UserFilter)
+	afterCountCounter  uint64
+	beforeCountCounter uint64
+	CountMock          mUserRepositoryMockCount
+
 	funcCreate          func(ctx context.Context, createUser *model.CreateUserData) (i1 int64, err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, createUser *model.CreateUserData)
@@ -40,12 +47,82 @@ type UserRepositoryMock struct {
 	beforeGetCounter uint64
 	GetMock          mUserRepositoryMockGet
 
+	funcGetByEmails          func(ctx context.Context, emails []string) (upa1 []*model.User, err error)
+	funcGetByEmailsOrigin    string
+	inspectFuncGetByEmails   func(ctx context.Context, emails []string)
+	afterGetByEmailsCounter  uint64
+	beforeGetByEmailsCounter uint64
+	GetByEmailsMock          mUserRepositoryMockGetByEmails
+
+	funcGetByNames          func(ctx context.Context, names []string) (upa1 []*model.User, err error)
+	funcGetByNamesOrigin    string
+	inspectFuncGetByNames   func(ctx context.Context, names []string)
+	afterGetByNamesCounter  uint64
+	beforeGetByNamesCounter uint64
+	GetByNamesMock          mUserRepositoryMockGetByNames
+
+	funcGetCredentials          func(ctx context.Context, email string) (up1 *model.UserCredentials, err error)
+	funcGetCredentialsOrigin    string
+	inspectFuncGetCredentials   func(ctx context.Context, email string)
+	afterGetCredentialsCounter  uint64
+	beforeGetCredentialsCounter uint64
+	GetCredentialsMock          mUserRepositoryMockGetCredentials
+
+	funcGetCredentialsByID          func(ctx context.Context, id int64) (up1 *model.UserCredentials, err error)
+	funcGetCredentialsByIDOrigin    string
+	inspectFuncGetCredentialsByID   func(ctx context.Context, id int64)
+	afterGetCredentialsByIDCounter  uint64
+	beforeGetCredentialsByIDCounter uint64
+	GetCredentialsByIDMock          mUserRepositoryMockGetCredentialsByID
+
+	funcList          func(ctx context.Context, query *model.UserListQuery) (upa1 []*model.User, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, query *model.UserListQuery)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mUserRepositoryMockList
+
+	funcLockByID          func(ctx context.Context, id int64) (err error)
+	funcLockByIDOrigin    string
+	inspectFuncLockByID   func(ctx context.Context, id int64)
+	afterLockByIDCounter  uint64
+	beforeLockByIDCounter uint64
+	LockByIDMock          mUserRepositoryMockLockByID
+
+	funcLockByRole          func(ctx context.Context, role model.Role) (ia1 []int64, err error)
+	funcLockByRoleOrigin    string
+	inspectFuncLockByRole   func(ctx context.Context, role model.Role)
+	afterLockByRoleCounter  uint64
+	beforeLockByRoleCounter uint64
+	LockByRoleMock          mUserRepositoryMockLockByRole
+
+	funcMarkEmailVerified          func(ctx context.Context, id int64) (err error)
+	funcMarkEmailVerifiedOrigin    string
+	inspectFuncMarkEmailVerified   func(ctx context.Context, id int64)
+	afterMarkEmailVerifiedCounter  uint64
+	beforeMarkEmailVerifiedCounter uint64
+	MarkEmailVerifiedMock          mUserRepositoryMockMarkEmailVerified
+
 	funcUpdate          func(ctx context.Context, id int64, updateUser *model.UpdateUserData) (err error)
 	funcUpdateOrigin    string
 	inspectFuncUpdate   func(ctx context.Context, id int64, updateUser *model.UpdateUserData)
 	afterUpdateCounter  uint64
 	beforeUpdateCounter uint64
 	UpdateMock          mUserRepositoryMockUpdate
+
+	funcUpdatePassword          func(ctx context.Context, id int64, hashedPassword string) (err error)
+	funcUpdatePasswordOrigin    string
+	inspectFuncUpdatePassword   func(ctx context.Context, id int64, hashedPassword string)
+	afterUpdatePasswordCounter  uint64
+	beforeUpdatePasswordCounter uint64
+	UpdatePasswordMock          mUserRepositoryMockUpdatePassword
+
+	funcUpdateRole          func(ctx context.Context, id int64, role model.Role) (err error)
+	funcUpdateRoleOrigin    string
+	inspectFuncUpdateRole   func(ctx context.Context, id int64, role model.Role)
+	afterUpdateRoleCounter  uint64
+	beforeUpdateRoleCounter uint64
+	UpdateRoleMock          mUserRepositoryMockUpdateRole
 }
 
 // NewUserRepositoryMock returns a mock for mm_repository.UserRepository
@@ -56,6 +133,9 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CountMock = mUserRepositoryMockCount{mock: m}
+	m.CountMock.callArgs = []*UserRepositoryMockCountParams{}
+
 	m.CreateMock = mUserRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*UserRepositoryMockCreateParams{}
 
@@ -65,14 +145,387 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	m.GetMock = mUserRepositoryMockGet{mock: m}
 	m.GetMock.callArgs = []*UserRepositoryMockGetParams{}
 
+	m.GetByEmailsMock = mUserRepositoryMockGetByEmails{mock: m}
+	m.GetByEmailsMock.callArgs = []*UserRepositoryMockGetByEmailsParams{}
+
+	m.GetByNamesMock = mUserRepositoryMockGetByNames{mock: m}
+	m.GetByNamesMock.callArgs = []*UserRepositoryMockGetByNamesParams{}
+
+	m.GetCredentialsMock = mUserRepositoryMockGetCredentials{mock: m}
+	m.GetCredentialsMock.callArgs = []*UserRepositoryMockGetCredentialsParams{}
+
+	m.GetCredentialsByIDMock = mUserRepositoryMockGetCredentialsByID{mock: m}
+	m.GetCredentialsByIDMock.callArgs = []*UserRepositoryMockGetCredentialsByIDParams{}
+
+	m.ListMock = mUserRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*UserRepositoryMockListParams{}
+
+	m.LockByIDMock = mUserRepositoryMockLockByID{mock: m}
+	m.LockByIDMock.callArgs = []*UserRepositoryMockLockByIDParams{}
+
+	m.LockByRoleMock = mUserRepositoryMockLockByRole{mock: m}
+	m.LockByRoleMock.callArgs = []*UserRepositoryMockLockByRoleParams{}
+
+	m.MarkEmailVerifiedMock = mUserRepositoryMockMarkEmailVerified{mock: m}
+	m.MarkEmailVerifiedMock.callArgs = []*UserRepositoryMockMarkEmailVerifiedParams{}
+
 	m.UpdateMock = mUserRepositoryMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*UserRepositoryMockUpdateParams{}
 
+	m.UpdatePasswordMock = mUserRepositoryMockUpdatePassword{mock: m}
+	m.UpdatePasswordMock.callArgs = []*UserRepositoryMockUpdatePasswordParams{}
+
+	m.UpdateRoleMock = mUserRepositoryMockUpdateRole{mock: m}
+	m.UpdateRoleMock.callArgs = []*UserRepositoryMockUpdateRoleParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mUserRepositoryMockCount struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockCountExpectation
+	expectations       []*UserRepositoryMockCountExpectation
+
+	callArgs []*UserRepositoryMockCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockCountExpectation specifies expectation struct of the UserRepository.Count
+type UserRepositoryMockCountExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockCountParams
+	paramPtrs          *UserRepositoryMockCountParamPtrs
+	expectationOrigins UserRepositoryMockCountExpectationOrigins
+	results            *UserRepositoryMockCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockCountParams contains parameters of the UserRepository.Count
+type UserRepositoryMockCountParams struct {
+	ctx    context.Context
+	filter model.UserFilter
+}
+
+// UserRepositoryMockCountParamPtrs contains pointers to parameters of the UserRepository.Count
+type UserRepositoryMockCountParamPtrs struct {
+	ctx    *context.Context
+	filter *model.UserFilter
+}
+
+// UserRepositoryMockCountResults contains results of the UserRepository.Count
+type UserRepositoryMockCountResults struct {
+	i1  int64
+	err error
+}
+
+// UserRepositoryMockCountOrigins contains origins of expectations of the UserRepository.Count
+type UserRepositoryMockCountExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCount *mUserRepositoryMockCount) Optional() *mUserRepositoryMockCount {
+	mmCount.optional = true
+	return mmCount
+}
+
+// Expect sets up expected params for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Expect(ctx context.Context, filter model.UserFilter) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.paramPtrs != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by ExpectParams functions")
+	}
+
+	mmCount.defaultExpectation.params = &UserRepositoryMockCountParams{ctx, filter}
+	mmCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCount.expectations {
+		if minimock.Equal(e.params, mmCount.defaultExpectation.params) {
+			mmCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCount.defaultExpectation.params)
+		}
+	}
+
+	return mmCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Expect")
+	}
+
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &UserRepositoryMockCountParamPtrs{}
+	}
+	mmCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCount
+}
+
+// ExpectFilterParam2 sets up expected param filter for UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) ExpectFilterParam2(filter model.UserFilter) *mUserRepositoryMockCount {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{}
+	}
+
+	if mmCount.defaultExpectation.params != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Expect")
+	}
+
+	if mmCount.defaultExpectation.paramPtrs == nil {
+		mmCount.defaultExpectation.paramPtrs = &UserRepositoryMockCountParamPtrs{}
+	}
+	mmCount.defaultExpectation.paramPtrs.filter = &filter
+	mmCount.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Inspect(f func(ctx context.Context, filter model.UserFilter)) *mUserRepositoryMockCount {
+	if mmCount.mock.inspectFuncCount != nil {
+		mmCount.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Count")
+	}
+
+	mmCount.mock.inspectFuncCount = f
+
+	return mmCount
+}
+
+// Return sets up results that will be returned by UserRepository.Count
+func (mmCount *mUserRepositoryMockCount) Return(i1 int64, err error) *UserRepositoryMock {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	if mmCount.defaultExpectation == nil {
+		mmCount.defaultExpectation = &UserRepositoryMockCountExpectation{mock: mmCount.mock}
+	}
+	mmCount.defaultExpectation.results = &UserRepositoryMockCountResults{i1, err}
+	mmCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCount.mock
+}
+
+// Set uses given function f to mock the UserRepository.Count method
+func (mmCount *mUserRepositoryMockCount) Set(f func(ctx context.Context, filter model.UserFilter) (i1 int64, err error)) *UserRepositoryMock {
+	if mmCount.defaultExpectation != nil {
+		mmCount.mock.t.Fatalf("Default expectation is already set for the UserRepository.Count method")
+	}
+
+	if len(mmCount.expectations) > 0 {
+		mmCount.mock.t.Fatalf("Some expectations are already set for the UserRepository.Count method")
+	}
+
+	mmCount.mock.funcCount = f
+	mmCount.mock.funcCountOrigin = minimock.CallerInfo(1)
+	return mmCount.mock
+}
+
+// When sets expectation for the UserRepository.Count which will trigger the result defined by the following
+// Then helper
+func (mmCount *mUserRepositoryMockCount) When(ctx context.Context, filter model.UserFilter) *UserRepositoryMockCountExpectation {
+	if mmCount.mock.funcCount != nil {
+		mmCount.mock.t.Fatalf("UserRepositoryMock.Count mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockCountExpectation{
+		mock:               mmCount.mock,
+		params:             &UserRepositoryMockCountParams{ctx, filter},
+		expectationOrigins: UserRepositoryMockCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCount.expectations = append(mmCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.Count return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockCountExpectation) Then(i1 int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockCountResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.Count should be invoked
+func (mmCount *mUserRepositoryMockCount) Times(n uint64) *mUserRepositoryMockCount {
+	if n == 0 {
+		mmCount.mock.t.Fatalf("Times of UserRepositoryMock.Count mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCount.expectedInvocations, n)
+	mmCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCount
+}
+
+func (mmCount *mUserRepositoryMockCount) invocationsDone() bool {
+	if len(mmCount.expectations) == 0 && mmCount.defaultExpectation == nil && mmCount.mock.funcCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCount.mock.afterCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Count implements mm_repository.UserRepository
+func (mmCount *UserRepositoryMock) Count(ctx context.Context, filter model.UserFilter) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCount.beforeCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCount.afterCountCounter, 1)
+
+	mmCount.t.Helper()
+
+	if mmCount.inspectFuncCount != nil {
+		mmCount.inspectFuncCount(ctx, filter)
+	}
+
+	mm_params := UserRepositoryMockCountParams{ctx, filter}
+
+	// Record call args
+	mmCount.CountMock.mutex.Lock()
+	mmCount.CountMock.callArgs = append(mmCount.CountMock.callArgs, &mm_params)
+	mmCount.CountMock.mutex.Unlock()
+
+	for _, e := range mmCount.CountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCount.CountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCount.CountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCount.CountMock.defaultExpectation.params
+		mm_want_ptrs := mmCount.CountMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockCountParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCount.CountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCount.CountMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCount.t.Errorf("UserRepositoryMock.Count got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCount.CountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCount.CountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCount.t.Fatal("No results are set for the UserRepositoryMock.Count")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCount.funcCount != nil {
+		return mmCount.funcCount(ctx, filter)
+	}
+	mmCount.t.Fatalf("Unexpected call to UserRepositoryMock.Count. %v %v", ctx, filter)
+	return
+}
+
+// CountAfterCounter returns a count of finished UserRepositoryMock.Count invocations
+func (mmCount *UserRepositoryMock) CountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.afterCountCounter)
+}
+
+// CountBeforeCounter returns a count of UserRepositoryMock.Count invocations
+func (mmCount *UserRepositoryMock) CountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCount.beforeCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Count.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCount *mUserRepositoryMockCount) Calls() []*UserRepositoryMockCountParams {
+	mmCount.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockCountParams, len(mmCount.callArgs))
+	copy(argCopy, mmCount.callArgs)
+
+	mmCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountDone returns true if the count of the Count invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockCountDone() bool {
+	if m.CountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountMock.invocationsDone()
+}
+
+// MinimockCountInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockCountInspect() {
+	for _, e := range m.CountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.Count at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountCounter := mm_atomic.LoadUint64(&m.afterCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountMock.defaultExpectation != nil && afterCountCounter < 1 {
+		if m.CountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.Count at\n%s", m.CountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.Count at\n%s with params: %#v", m.CountMock.defaultExpectation.expectationOrigins.origin, *m.CountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCount != nil && afterCountCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.Count at\n%s", m.funcCountOrigin)
+	}
+
+	if !m.CountMock.invocationsDone() && afterCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.Count at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountMock.expectedInvocations), m.CountMock.expectedInvocationsOrigin, afterCountCounter)
+	}
+}
+
 type mUserRepositoryMockCreate struct {
 	optional           bool
 	mock               *UserRepositoryMock
@@ -1101,55 +1554,53 @@ func (m *UserRepositoryMock) MinimockGetInspect() {
 	}
 }
 
-type mUserRepositoryMockUpdate struct {
+type mUserRepositoryMockGetByEmails struct {
 	optional           bool
 	mock               *UserRepositoryMock
-	defaultExpectation *UserRepositoryMockUpdateExpectation
-	expectations       []*UserRepositoryMockUpdateExpectation
+	defaultExpectation *UserRepositoryMockGetByEmailsExpectation
+	expectations       []*UserRepositoryMockGetByEmailsExpectation
 
-	callArgs []*UserRepositoryMockUpdateParams
+	callArgs []*UserRepositoryMockGetByEmailsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UserRepositoryMockUpdateExpectation specifies expectation struct of the UserRepository.Update
-type UserRepositoryMockUpdateExpectation struct {
+// UserRepositoryMockGetByEmailsExpectation specifies expectation struct of the UserRepository.GetByEmails
+type UserRepositoryMockGetByEmailsExpectation struct {
 	mock               *UserRepositoryMock
-	params             *UserRepositoryMockUpdateParams
-	paramPtrs          *UserRepositoryMockUpdateParamPtrs
-	expectationOrigins UserRepositoryMockUpdateExpectationOrigins
-	results            *UserRepositoryMockUpdateResults
+	params             *UserRepositoryMockGetByEmailsParams
+	paramPtrs          *UserRepositoryMockGetByEmailsParamPtrs
+	expectationOrigins UserRepositoryMockGetByEmailsExpectationOrigins
+	results            *UserRepositoryMockGetByEmailsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UserRepositoryMockUpdateParams contains parameters of the UserRepository.Update
-type UserRepositoryMockUpdateParams struct {
-	ctx        context.Context
-	id         int64
-	updateUser *model.UpdateUserData
+// UserRepositoryMockGetByEmailsParams contains parameters of the UserRepository.GetByEmails
+type UserRepositoryMockGetByEmailsParams struct {
+	ctx    context.Context
+	emails []string
 }
 
-// UserRepositoryMockUpdateParamPtrs contains pointers to parameters of the UserRepository.Update
-type UserRepositoryMockUpdateParamPtrs struct {
-	ctx        *context.Context
-	id         *int64
-	updateUser **model.UpdateUserData
+// UserRepositoryMockGetByEmailsParamPtrs contains pointers to parameters of the UserRepository.GetByEmails
+type UserRepositoryMockGetByEmailsParamPtrs struct {
+	ctx    *context.Context
+	emails *[]string
 }
 
-// UserRepositoryMockUpdateResults contains results of the UserRepository.Update
-type UserRepositoryMockUpdateResults struct {
-	err error
+// UserRepositoryMockGetByEmailsResults contains results of the UserRepository.GetByEmails
+type UserRepositoryMockGetByEmailsResults struct {
+	upa1 []*model.User
+	err  error
 }
 
-// UserRepositoryMockUpdateOrigins contains origins of expectations of the UserRepository.Update
-type UserRepositoryMockUpdateExpectationOrigins struct {
-	origin           string
-	originCtx        string
-	originId         string
-	originUpdateUser string
+// UserRepositoryMockGetByEmailsOrigins contains origins of expectations of the UserRepository.GetByEmails
+type UserRepositoryMockGetByEmailsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originEmails string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1157,320 +1608,3810 @@ type UserRepositoryMockUpdateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdate *mUserRepositoryMockUpdate) Optional() *mUserRepositoryMockUpdate {
-	mmUpdate.optional = true
-	return mmUpdate
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) Optional() *mUserRepositoryMockGetByEmails {
+	mmGetByEmails.optional = true
+	return mmGetByEmails
 }
 
-// Expect sets up expected params for UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) Expect(ctx context.Context, id int64, updateUser *model.UpdateUserData) *mUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+// Expect sets up expected params for UserRepository.GetByEmails
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) Expect(ctx context.Context, emails []string) *mUserRepositoryMockGetByEmails {
+	if mmGetByEmails.mock.funcGetByEmails != nil {
+		mmGetByEmails.mock.t.Fatalf("UserRepositoryMock.GetByEmails mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	if mmGetByEmails.defaultExpectation == nil {
+		mmGetByEmails.defaultExpectation = &UserRepositoryMockGetByEmailsExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by ExpectParams functions")
+	if mmGetByEmails.defaultExpectation.paramPtrs != nil {
+		mmGetByEmails.mock.t.Fatalf("UserRepositoryMock.GetByEmails mock is already set by ExpectParams functions")
 	}
 
-	mmUpdate.defaultExpectation.params = &UserRepositoryMockUpdateParams{ctx, id, updateUser}
-	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdate.expectations {
-		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
-			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+	mmGetByEmails.defaultExpectation.params = &UserRepositoryMockGetByEmailsParams{ctx, emails}
+	mmGetByEmails.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetByEmails.expectations {
+		if minimock.Equal(e.params, mmGetByEmails.defaultExpectation.params) {
+			mmGetByEmails.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByEmails.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdate
+	return mmGetByEmails
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetByEmails
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetByEmails {
+	if mmGetByEmails.mock.funcGetByEmails != nil {
+		mmGetByEmails.mock.t.Fatalf("UserRepositoryMock.GetByEmails mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	if mmGetByEmails.defaultExpectation == nil {
+		mmGetByEmails.defaultExpectation = &UserRepositoryMockGetByEmailsExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	if mmGetByEmails.defaultExpectation.params != nil {
+		mmGetByEmails.mock.t.Fatalf("UserRepositoryMock.GetByEmails mock is already set by Expect")
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	if mmGetByEmails.defaultExpectation.paramPtrs == nil {
+		mmGetByEmails.defaultExpectation.paramPtrs = &UserRepositoryMockGetByEmailsParamPtrs{}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetByEmails.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetByEmails.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdate
+	return mmGetByEmails
 }
 
-// ExpectIdParam2 sets up expected param id for UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) ExpectIdParam2(id int64) *mUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+// ExpectEmailsParam2 sets up expected param emails for UserRepository.GetByEmails
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) ExpectEmailsParam2(emails []string) *mUserRepositoryMockGetByEmails {
+	if mmGetByEmails.mock.funcGetByEmails != nil {
+		mmGetByEmails.mock.t.Fatalf("UserRepositoryMock.GetByEmails mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	if mmGetByEmails.defaultExpectation == nil {
+		mmGetByEmails.defaultExpectation = &UserRepositoryMockGetByEmailsExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	if mmGetByEmails.defaultExpectation.params != nil {
+		mmGetByEmails.mock.t.Fatalf("UserRepositoryMock.GetByEmails mock is already set by Expect")
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	if mmGetByEmails.defaultExpectation.paramPtrs == nil {
+		mmGetByEmails.defaultExpectation.paramPtrs = &UserRepositoryMockGetByEmailsParamPtrs{}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.id = &id
-	mmUpdate.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmGetByEmails.defaultExpectation.paramPtrs.emails = &emails
+	mmGetByEmails.defaultExpectation.expectationOrigins.originEmails = minimock.CallerInfo(1)
 
-	return mmUpdate
+	return mmGetByEmails
 }
 
-// ExpectUpdateUserParam3 sets up expected param updateUser for UserRepository.Update
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetByEmails
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) Inspect(f func(ctx context.Context, emails []string)) *mUserRepositoryMockGetByEmails {
+	if mmGetByEmails.mock.inspectFuncGetByEmails != nil {
+		mmGetByEmails.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetByEmails")
+	}
+
+	mmGetByEmails.mock.inspectFuncGetByEmails = f
+
+	return mmGetByEmails
+}
+
+// Return sets up results that will be returned by UserRepository.GetByEmails
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) Return(upa1 []*model.User, err error) *UserRepositoryMock {
+	if mmGetByEmails.mock.funcGetByEmails != nil {
+		mmGetByEmails.mock.t.Fatalf("UserRepositoryMock.GetByEmails mock is already set by Set")
+	}
+
+	if mmGetByEmails.defaultExpectation == nil {
+		mmGetByEmails.defaultExpectation = &UserRepositoryMockGetByEmailsExpectation{mock: mmGetByEmails.mock}
+	}
+	mmGetByEmails.defaultExpectation.results = &UserRepositoryMockGetByEmailsResults{upa1, err}
+	mmGetByEmails.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetByEmails.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetByEmails method
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) Set(f func(ctx context.Context, emails []string) (upa1 []*model.User, err error)) *UserRepositoryMock {
+	if mmGetByEmails.defaultExpectation != nil {
+		mmGetByEmails.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetByEmails method")
+	}
+
+	if len(mmGetByEmails.expectations) > 0 {
+		mmGetByEmails.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetByEmails method")
+	}
+
+	mmGetByEmails.mock.funcGetByEmails = f
+	mmGetByEmails.mock.funcGetByEmailsOrigin = minimock.CallerInfo(1)
+	return mmGetByEmails.mock
+}
+
+// When sets expectation for the UserRepository.GetByEmails which will trigger the result defined by the following
+// Then helper
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) When(ctx context.Context, emails []string) *UserRepositoryMockGetByEmailsExpectation {
+	if mmGetByEmails.mock.funcGetByEmails != nil {
+		mmGetByEmails.mock.t.Fatalf("UserRepositoryMock.GetByEmails mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetByEmailsExpectation{
+		mock:               mmGetByEmails.mock,
+		params:             &UserRepositoryMockGetByEmailsParams{ctx, emails},
+		expectationOrigins: UserRepositoryMockGetByEmailsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetByEmails.expectations = append(mmGetByEmails.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetByEmails return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetByEmailsExpectation) Then(upa1 []*model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetByEmailsResults{upa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetByEmails should be invoked
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) Times(n uint64) *mUserRepositoryMockGetByEmails {
+	if n == 0 {
+		mmGetByEmails.mock.t.Fatalf("Times of UserRepositoryMock.GetByEmails mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByEmails.expectedInvocations, n)
+	mmGetByEmails.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetByEmails
+}
+
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) invocationsDone() bool {
+	if len(mmGetByEmails.expectations) == 0 && mmGetByEmails.defaultExpectation == nil && mmGetByEmails.mock.funcGetByEmails == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByEmails.mock.afterGetByEmailsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByEmails.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByEmails implements mm_repository.UserRepository
+func (mmGetByEmails *UserRepositoryMock) GetByEmails(ctx context.Context, emails []string) (upa1 []*model.User, err error) {
+	mm_atomic.AddUint64(&mmGetByEmails.beforeGetByEmailsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByEmails.afterGetByEmailsCounter, 1)
+
+	mmGetByEmails.t.Helper()
+
+	if mmGetByEmails.inspectFuncGetByEmails != nil {
+		mmGetByEmails.inspectFuncGetByEmails(ctx, emails)
+	}
+
+	mm_params := UserRepositoryMockGetByEmailsParams{ctx, emails}
+
+	// Record call args
+	mmGetByEmails.GetByEmailsMock.mutex.Lock()
+	mmGetByEmails.GetByEmailsMock.callArgs = append(mmGetByEmails.GetByEmailsMock.callArgs, &mm_params)
+	mmGetByEmails.GetByEmailsMock.mutex.Unlock()
+
+	for _, e := range mmGetByEmails.GetByEmailsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.upa1, e.results.err
+		}
+	}
+
+	if mmGetByEmails.GetByEmailsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByEmails.GetByEmailsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByEmails.GetByEmailsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByEmails.GetByEmailsMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetByEmailsParams{ctx, emails}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByEmails.t.Errorf("UserRepositoryMock.GetByEmails got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByEmails.GetByEmailsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.emails != nil && !minimock.Equal(*mm_want_ptrs.emails, mm_got.emails) {
+				mmGetByEmails.t.Errorf("UserRepositoryMock.GetByEmails got unexpected parameter emails, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByEmails.GetByEmailsMock.defaultExpectation.expectationOrigins.originEmails, *mm_want_ptrs.emails, mm_got.emails, minimock.Diff(*mm_want_ptrs.emails, mm_got.emails))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByEmails.t.Errorf("UserRepositoryMock.GetByEmails got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetByEmails.GetByEmailsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByEmails.GetByEmailsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByEmails.t.Fatal("No results are set for the UserRepositoryMock.GetByEmails")
+		}
+		return (*mm_results).upa1, (*mm_results).err
+	}
+	if mmGetByEmails.funcGetByEmails != nil {
+		return mmGetByEmails.funcGetByEmails(ctx, emails)
+	}
+	mmGetByEmails.t.Fatalf("Unexpected call to UserRepositoryMock.GetByEmails. %v %v", ctx, emails)
+	return
+}
+
+// GetByEmailsAfterCounter returns a count of finished UserRepositoryMock.GetByEmails invocations
+func (mmGetByEmails *UserRepositoryMock) GetByEmailsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByEmails.afterGetByEmailsCounter)
+}
+
+// GetByEmailsBeforeCounter returns a count of UserRepositoryMock.GetByEmails invocations
+func (mmGetByEmails *UserRepositoryMock) GetByEmailsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByEmails.beforeGetByEmailsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetByEmails.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByEmails *mUserRepositoryMockGetByEmails) Calls() []*UserRepositoryMockGetByEmailsParams {
+	mmGetByEmails.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetByEmailsParams, len(mmGetByEmails.callArgs))
+	copy(argCopy, mmGetByEmails.callArgs)
+
+	mmGetByEmails.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByEmailsDone returns true if the count of the GetByEmails invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetByEmailsDone() bool {
+	if m.GetByEmailsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByEmailsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByEmailsMock.invocationsDone()
+}
+
+// MinimockGetByEmailsInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetByEmailsInspect() {
+	for _, e := range m.GetByEmailsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByEmails at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetByEmailsCounter := mm_atomic.LoadUint64(&m.afterGetByEmailsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByEmailsMock.defaultExpectation != nil && afterGetByEmailsCounter < 1 {
+		if m.GetByEmailsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByEmails at\n%s", m.GetByEmailsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByEmails at\n%s with params: %#v", m.GetByEmailsMock.defaultExpectation.expectationOrigins.origin, *m.GetByEmailsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByEmails != nil && afterGetByEmailsCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetByEmails at\n%s", m.funcGetByEmailsOrigin)
+	}
+
+	if !m.GetByEmailsMock.invocationsDone() && afterGetByEmailsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetByEmails at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByEmailsMock.expectedInvocations), m.GetByEmailsMock.expectedInvocationsOrigin, afterGetByEmailsCounter)
+	}
+}
+
+type mUserRepositoryMockGetByNames struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetByNamesExpectation
+	expectations       []*UserRepositoryMockGetByNamesExpectation
+
+	callArgs []*UserRepositoryMockGetByNamesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetByNamesExpectation specifies expectation struct of the UserRepository.GetByNames
+type UserRepositoryMockGetByNamesExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetByNamesParams
+	paramPtrs          *UserRepositoryMockGetByNamesParamPtrs
+	expectationOrigins UserRepositoryMockGetByNamesExpectationOrigins
+	results            *UserRepositoryMockGetByNamesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetByNamesParams contains parameters of the UserRepository.GetByNames
+type UserRepositoryMockGetByNamesParams struct {
+	ctx   context.Context
+	names []string
+}
+
+// UserRepositoryMockGetByNamesParamPtrs contains pointers to parameters of the UserRepository.GetByNames
+type UserRepositoryMockGetByNamesParamPtrs struct {
+	ctx   *context.Context
+	names *[]string
+}
+
+// UserRepositoryMockGetByNamesResults contains results of the UserRepository.GetByNames
+type UserRepositoryMockGetByNamesResults struct {
+	upa1 []*model.User
+	err  error
+}
+
+// UserRepositoryMockGetByNamesOrigins contains origins of expectations of the UserRepository.GetByNames
+type UserRepositoryMockGetByNamesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originNames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByNames *mUserRepositoryMockGetByNames) Optional() *mUserRepositoryMockGetByNames {
+	mmGetByNames.optional = true
+	return mmGetByNames
+}
+
+// Expect sets up expected params for UserRepository.GetByNames
+func (mmGetByNames *mUserRepositoryMockGetByNames) Expect(ctx context.Context, names []string) *mUserRepositoryMockGetByNames {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserRepositoryMock.GetByNames mock is already set by Set")
+	}
+
+	if mmGetByNames.defaultExpectation == nil {
+		mmGetByNames.defaultExpectation = &UserRepositoryMockGetByNamesExpectation{}
+	}
+
+	if mmGetByNames.defaultExpectation.paramPtrs != nil {
+		mmGetByNames.mock.t.Fatalf("UserRepositoryMock.GetByNames mock is already set by ExpectParams functions")
+	}
+
+	mmGetByNames.defaultExpectation.params = &UserRepositoryMockGetByNamesParams{ctx, names}
+	mmGetByNames.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetByNames.expectations {
+		if minimock.Equal(e.params, mmGetByNames.defaultExpectation.params) {
+			mmGetByNames.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByNames.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByNames
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetByNames
+func (mmGetByNames *mUserRepositoryMockGetByNames) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetByNames {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserRepositoryMock.GetByNames mock is already set by Set")
+	}
+
+	if mmGetByNames.defaultExpectation == nil {
+		mmGetByNames.defaultExpectation = &UserRepositoryMockGetByNamesExpectation{}
+	}
+
+	if mmGetByNames.defaultExpectation.params != nil {
+		mmGetByNames.mock.t.Fatalf("UserRepositoryMock.GetByNames mock is already set by Expect")
+	}
+
+	if mmGetByNames.defaultExpectation.paramPtrs == nil {
+		mmGetByNames.defaultExpectation.paramPtrs = &UserRepositoryMockGetByNamesParamPtrs{}
+	}
+	mmGetByNames.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetByNames.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetByNames
+}
+
+// ExpectNamesParam2 sets up expected param names for UserRepository.GetByNames
+func (mmGetByNames *mUserRepositoryMockGetByNames) ExpectNamesParam2(names []string) *mUserRepositoryMockGetByNames {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserRepositoryMock.GetByNames mock is already set by Set")
+	}
+
+	if mmGetByNames.defaultExpectation == nil {
+		mmGetByNames.defaultExpectation = &UserRepositoryMockGetByNamesExpectation{}
+	}
+
+	if mmGetByNames.defaultExpectation.params != nil {
+		mmGetByNames.mock.t.Fatalf("UserRepositoryMock.GetByNames mock is already set by Expect")
+	}
+
+	if mmGetByNames.defaultExpectation.paramPtrs == nil {
+		mmGetByNames.defaultExpectation.paramPtrs = &UserRepositoryMockGetByNamesParamPtrs{}
+	}
+	mmGetByNames.defaultExpectation.paramPtrs.names = &names
+	mmGetByNames.defaultExpectation.expectationOrigins.originNames = minimock.CallerInfo(1)
+
+	return mmGetByNames
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetByNames
+func (mmGetByNames *mUserRepositoryMockGetByNames) Inspect(f func(ctx context.Context, names []string)) *mUserRepositoryMockGetByNames {
+	if mmGetByNames.mock.inspectFuncGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetByNames")
+	}
+
+	mmGetByNames.mock.inspectFuncGetByNames = f
+
+	return mmGetByNames
+}
+
+// Return sets up results that will be returned by UserRepository.GetByNames
+func (mmGetByNames *mUserRepositoryMockGetByNames) Return(upa1 []*model.User, err error) *UserRepositoryMock {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserRepositoryMock.GetByNames mock is already set by Set")
+	}
+
+	if mmGetByNames.defaultExpectation == nil {
+		mmGetByNames.defaultExpectation = &UserRepositoryMockGetByNamesExpectation{mock: mmGetByNames.mock}
+	}
+	mmGetByNames.defaultExpectation.results = &UserRepositoryMockGetByNamesResults{upa1, err}
+	mmGetByNames.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetByNames.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetByNames method
+func (mmGetByNames *mUserRepositoryMockGetByNames) Set(f func(ctx context.Context, names []string) (upa1 []*model.User, err error)) *UserRepositoryMock {
+	if mmGetByNames.defaultExpectation != nil {
+		mmGetByNames.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetByNames method")
+	}
+
+	if len(mmGetByNames.expectations) > 0 {
+		mmGetByNames.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetByNames method")
+	}
+
+	mmGetByNames.mock.funcGetByNames = f
+	mmGetByNames.mock.funcGetByNamesOrigin = minimock.CallerInfo(1)
+	return mmGetByNames.mock
+}
+
+// When sets expectation for the UserRepository.GetByNames which will trigger the result defined by the following
+// Then helper
+func (mmGetByNames *mUserRepositoryMockGetByNames) When(ctx context.Context, names []string) *UserRepositoryMockGetByNamesExpectation {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserRepositoryMock.GetByNames mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetByNamesExpectation{
+		mock:               mmGetByNames.mock,
+		params:             &UserRepositoryMockGetByNamesParams{ctx, names},
+		expectationOrigins: UserRepositoryMockGetByNamesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetByNames.expectations = append(mmGetByNames.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetByNames return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetByNamesExpectation) Then(upa1 []*model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetByNamesResults{upa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetByNames should be invoked
+func (mmGetByNames *mUserRepositoryMockGetByNames) Times(n uint64) *mUserRepositoryMockGetByNames {
+	if n == 0 {
+		mmGetByNames.mock.t.Fatalf("Times of UserRepositoryMock.GetByNames mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByNames.expectedInvocations, n)
+	mmGetByNames.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetByNames
+}
+
+func (mmGetByNames *mUserRepositoryMockGetByNames) invocationsDone() bool {
+	if len(mmGetByNames.expectations) == 0 && mmGetByNames.defaultExpectation == nil && mmGetByNames.mock.funcGetByNames == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByNames.mock.afterGetByNamesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByNames.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByNames implements mm_repository.UserRepository
+func (mmGetByNames *UserRepositoryMock) GetByNames(ctx context.Context, names []string) (upa1 []*model.User, err error) {
+	mm_atomic.AddUint64(&mmGetByNames.beforeGetByNamesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByNames.afterGetByNamesCounter, 1)
+
+	mmGetByNames.t.Helper()
+
+	if mmGetByNames.inspectFuncGetByNames != nil {
+		mmGetByNames.inspectFuncGetByNames(ctx, names)
+	}
+
+	mm_params := UserRepositoryMockGetByNamesParams{ctx, names}
+
+	// Record call args
+	mmGetByNames.GetByNamesMock.mutex.Lock()
+	mmGetByNames.GetByNamesMock.callArgs = append(mmGetByNames.GetByNamesMock.callArgs, &mm_params)
+	mmGetByNames.GetByNamesMock.mutex.Unlock()
+
+	for _, e := range mmGetByNames.GetByNamesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.upa1, e.results.err
+		}
+	}
+
+	if mmGetByNames.GetByNamesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByNames.GetByNamesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByNames.GetByNamesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByNames.GetByNamesMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetByNamesParams{ctx, names}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByNames.t.Errorf("UserRepositoryMock.GetByNames got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByNames.GetByNamesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.names != nil && !minimock.Equal(*mm_want_ptrs.names, mm_got.names) {
+				mmGetByNames.t.Errorf("UserRepositoryMock.GetByNames got unexpected parameter names, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByNames.GetByNamesMock.defaultExpectation.expectationOrigins.originNames, *mm_want_ptrs.names, mm_got.names, minimock.Diff(*mm_want_ptrs.names, mm_got.names))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByNames.t.Errorf("UserRepositoryMock.GetByNames got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetByNames.GetByNamesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByNames.GetByNamesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByNames.t.Fatal("No results are set for the UserRepositoryMock.GetByNames")
+		}
+		return (*mm_results).upa1, (*mm_results).err
+	}
+	if mmGetByNames.funcGetByNames != nil {
+		return mmGetByNames.funcGetByNames(ctx, names)
+	}
+	mmGetByNames.t.Fatalf("Unexpected call to UserRepositoryMock.GetByNames. %v %v", ctx, names)
+	return
+}
+
+// GetByNamesAfterCounter returns a count of finished UserRepositoryMock.GetByNames invocations
+func (mmGetByNames *UserRepositoryMock) GetByNamesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByNames.afterGetByNamesCounter)
+}
+
+// GetByNamesBeforeCounter returns a count of UserRepositoryMock.GetByNames invocations
+func (mmGetByNames *UserRepositoryMock) GetByNamesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByNames.beforeGetByNamesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetByNames.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByNames *mUserRepositoryMockGetByNames) Calls() []*UserRepositoryMockGetByNamesParams {
+	mmGetByNames.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetByNamesParams, len(mmGetByNames.callArgs))
+	copy(argCopy, mmGetByNames.callArgs)
+
+	mmGetByNames.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByNamesDone returns true if the count of the GetByNames invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetByNamesDone() bool {
+	if m.GetByNamesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByNamesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByNamesMock.invocationsDone()
+}
+
+// MinimockGetByNamesInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetByNamesInspect() {
+	for _, e := range m.GetByNamesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByNames at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetByNamesCounter := mm_atomic.LoadUint64(&m.afterGetByNamesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByNamesMock.defaultExpectation != nil && afterGetByNamesCounter < 1 {
+		if m.GetByNamesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByNames at\n%s", m.GetByNamesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetByNames at\n%s with params: %#v", m.GetByNamesMock.defaultExpectation.expectationOrigins.origin, *m.GetByNamesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByNames != nil && afterGetByNamesCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetByNames at\n%s", m.funcGetByNamesOrigin)
+	}
+
+	if !m.GetByNamesMock.invocationsDone() && afterGetByNamesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetByNames at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByNamesMock.expectedInvocations), m.GetByNamesMock.expectedInvocationsOrigin, afterGetByNamesCounter)
+	}
+}
+
+type mUserRepositoryMockGetCredentials struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetCredentialsExpectation
+	expectations       []*UserRepositoryMockGetCredentialsExpectation
+
+	callArgs []*UserRepositoryMockGetCredentialsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetCredentialsExpectation specifies expectation struct of the UserRepository.GetCredentials
+type UserRepositoryMockGetCredentialsExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetCredentialsParams
+	paramPtrs          *UserRepositoryMockGetCredentialsParamPtrs
+	expectationOrigins UserRepositoryMockGetCredentialsExpectationOrigins
+	results            *UserRepositoryMockGetCredentialsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetCredentialsParams contains parameters of the UserRepository.GetCredentials
+type UserRepositoryMockGetCredentialsParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UserRepositoryMockGetCredentialsParamPtrs contains pointers to parameters of the UserRepository.GetCredentials
+type UserRepositoryMockGetCredentialsParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UserRepositoryMockGetCredentialsResults contains results of the UserRepository.GetCredentials
+type UserRepositoryMockGetCredentialsResults struct {
+	up1 *model.UserCredentials
+	err error
+}
+
+// UserRepositoryMockGetCredentialsOrigins contains origins of expectations of the UserRepository.GetCredentials
+type UserRepositoryMockGetCredentialsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) Optional() *mUserRepositoryMockGetCredentials {
+	mmGetCredentials.optional = true
+	return mmGetCredentials
+}
+
+// Expect sets up expected params for UserRepository.GetCredentials
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) Expect(ctx context.Context, email string) *mUserRepositoryMockGetCredentials {
+	if mmGetCredentials.mock.funcGetCredentials != nil {
+		mmGetCredentials.mock.t.Fatalf("UserRepositoryMock.GetCredentials mock is already set by Set")
+	}
+
+	if mmGetCredentials.defaultExpectation == nil {
+		mmGetCredentials.defaultExpectation = &UserRepositoryMockGetCredentialsExpectation{}
+	}
+
+	if mmGetCredentials.defaultExpectation.paramPtrs != nil {
+		mmGetCredentials.mock.t.Fatalf("UserRepositoryMock.GetCredentials mock is already set by ExpectParams functions")
+	}
+
+	mmGetCredentials.defaultExpectation.params = &UserRepositoryMockGetCredentialsParams{ctx, email}
+	mmGetCredentials.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCredentials.expectations {
+		if minimock.Equal(e.params, mmGetCredentials.defaultExpectation.params) {
+			mmGetCredentials.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCredentials.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCredentials
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetCredentials
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetCredentials {
+	if mmGetCredentials.mock.funcGetCredentials != nil {
+		mmGetCredentials.mock.t.Fatalf("UserRepositoryMock.GetCredentials mock is already set by Set")
+	}
+
+	if mmGetCredentials.defaultExpectation == nil {
+		mmGetCredentials.defaultExpectation = &UserRepositoryMockGetCredentialsExpectation{}
+	}
+
+	if mmGetCredentials.defaultExpectation.params != nil {
+		mmGetCredentials.mock.t.Fatalf("UserRepositoryMock.GetCredentials mock is already set by Expect")
+	}
+
+	if mmGetCredentials.defaultExpectation.paramPtrs == nil {
+		mmGetCredentials.defaultExpectation.paramPtrs = &UserRepositoryMockGetCredentialsParamPtrs{}
+	}
+	mmGetCredentials.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCredentials.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCredentials
+}
+
+// ExpectEmailParam2 sets up expected param email for UserRepository.GetCredentials
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) ExpectEmailParam2(email string) *mUserRepositoryMockGetCredentials {
+	if mmGetCredentials.mock.funcGetCredentials != nil {
+		mmGetCredentials.mock.t.Fatalf("UserRepositoryMock.GetCredentials mock is already set by Set")
+	}
+
+	if mmGetCredentials.defaultExpectation == nil {
+		mmGetCredentials.defaultExpectation = &UserRepositoryMockGetCredentialsExpectation{}
+	}
+
+	if mmGetCredentials.defaultExpectation.params != nil {
+		mmGetCredentials.mock.t.Fatalf("UserRepositoryMock.GetCredentials mock is already set by Expect")
+	}
+
+	if mmGetCredentials.defaultExpectation.paramPtrs == nil {
+		mmGetCredentials.defaultExpectation.paramPtrs = &UserRepositoryMockGetCredentialsParamPtrs{}
+	}
+	mmGetCredentials.defaultExpectation.paramPtrs.email = &email
+	mmGetCredentials.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmGetCredentials
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetCredentials
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) Inspect(f func(ctx context.Context, email string)) *mUserRepositoryMockGetCredentials {
+	if mmGetCredentials.mock.inspectFuncGetCredentials != nil {
+		mmGetCredentials.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetCredentials")
+	}
+
+	mmGetCredentials.mock.inspectFuncGetCredentials = f
+
+	return mmGetCredentials
+}
+
+// Return sets up results that will be returned by UserRepository.GetCredentials
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) Return(up1 *model.UserCredentials, err error) *UserRepositoryMock {
+	if mmGetCredentials.mock.funcGetCredentials != nil {
+		mmGetCredentials.mock.t.Fatalf("UserRepositoryMock.GetCredentials mock is already set by Set")
+	}
+
+	if mmGetCredentials.defaultExpectation == nil {
+		mmGetCredentials.defaultExpectation = &UserRepositoryMockGetCredentialsExpectation{mock: mmGetCredentials.mock}
+	}
+	mmGetCredentials.defaultExpectation.results = &UserRepositoryMockGetCredentialsResults{up1, err}
+	mmGetCredentials.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCredentials.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetCredentials method
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) Set(f func(ctx context.Context, email string) (up1 *model.UserCredentials, err error)) *UserRepositoryMock {
+	if mmGetCredentials.defaultExpectation != nil {
+		mmGetCredentials.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetCredentials method")
+	}
+
+	if len(mmGetCredentials.expectations) > 0 {
+		mmGetCredentials.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetCredentials method")
+	}
+
+	mmGetCredentials.mock.funcGetCredentials = f
+	mmGetCredentials.mock.funcGetCredentialsOrigin = minimock.CallerInfo(1)
+	return mmGetCredentials.mock
+}
+
+// When sets expectation for the UserRepository.GetCredentials which will trigger the result defined by the following
+// Then helper
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) When(ctx context.Context, email string) *UserRepositoryMockGetCredentialsExpectation {
+	if mmGetCredentials.mock.funcGetCredentials != nil {
+		mmGetCredentials.mock.t.Fatalf("UserRepositoryMock.GetCredentials mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetCredentialsExpectation{
+		mock:               mmGetCredentials.mock,
+		params:             &UserRepositoryMockGetCredentialsParams{ctx, email},
+		expectationOrigins: UserRepositoryMockGetCredentialsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCredentials.expectations = append(mmGetCredentials.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetCredentials return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetCredentialsExpectation) Then(up1 *model.UserCredentials, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetCredentialsResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetCredentials should be invoked
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) Times(n uint64) *mUserRepositoryMockGetCredentials {
+	if n == 0 {
+		mmGetCredentials.mock.t.Fatalf("Times of UserRepositoryMock.GetCredentials mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCredentials.expectedInvocations, n)
+	mmGetCredentials.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCredentials
+}
+
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) invocationsDone() bool {
+	if len(mmGetCredentials.expectations) == 0 && mmGetCredentials.defaultExpectation == nil && mmGetCredentials.mock.funcGetCredentials == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCredentials.mock.afterGetCredentialsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCredentials.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCredentials implements mm_repository.UserRepository
+func (mmGetCredentials *UserRepositoryMock) GetCredentials(ctx context.Context, email string) (up1 *model.UserCredentials, err error) {
+	mm_atomic.AddUint64(&mmGetCredentials.beforeGetCredentialsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCredentials.afterGetCredentialsCounter, 1)
+
+	mmGetCredentials.t.Helper()
+
+	if mmGetCredentials.inspectFuncGetCredentials != nil {
+		mmGetCredentials.inspectFuncGetCredentials(ctx, email)
+	}
+
+	mm_params := UserRepositoryMockGetCredentialsParams{ctx, email}
+
+	// Record call args
+	mmGetCredentials.GetCredentialsMock.mutex.Lock()
+	mmGetCredentials.GetCredentialsMock.callArgs = append(mmGetCredentials.GetCredentialsMock.callArgs, &mm_params)
+	mmGetCredentials.GetCredentialsMock.mutex.Unlock()
+
+	for _, e := range mmGetCredentials.GetCredentialsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetCredentials.GetCredentialsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCredentials.GetCredentialsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCredentials.GetCredentialsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCredentials.GetCredentialsMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetCredentialsParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCredentials.t.Errorf("UserRepositoryMock.GetCredentials got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCredentials.GetCredentialsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmGetCredentials.t.Errorf("UserRepositoryMock.GetCredentials got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCredentials.GetCredentialsMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCredentials.t.Errorf("UserRepositoryMock.GetCredentials got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCredentials.GetCredentialsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCredentials.GetCredentialsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCredentials.t.Fatal("No results are set for the UserRepositoryMock.GetCredentials")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetCredentials.funcGetCredentials != nil {
+		return mmGetCredentials.funcGetCredentials(ctx, email)
+	}
+	mmGetCredentials.t.Fatalf("Unexpected call to UserRepositoryMock.GetCredentials. %v %v", ctx, email)
+	return
+}
+
+// GetCredentialsAfterCounter returns a count of finished UserRepositoryMock.GetCredentials invocations
+func (mmGetCredentials *UserRepositoryMock) GetCredentialsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCredentials.afterGetCredentialsCounter)
+}
+
+// GetCredentialsBeforeCounter returns a count of UserRepositoryMock.GetCredentials invocations
+func (mmGetCredentials *UserRepositoryMock) GetCredentialsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCredentials.beforeGetCredentialsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetCredentials.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCredentials *mUserRepositoryMockGetCredentials) Calls() []*UserRepositoryMockGetCredentialsParams {
+	mmGetCredentials.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetCredentialsParams, len(mmGetCredentials.callArgs))
+	copy(argCopy, mmGetCredentials.callArgs)
+
+	mmGetCredentials.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCredentialsDone returns true if the count of the GetCredentials invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetCredentialsDone() bool {
+	if m.GetCredentialsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCredentialsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCredentialsMock.invocationsDone()
+}
+
+// MinimockGetCredentialsInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetCredentialsInspect() {
+	for _, e := range m.GetCredentialsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetCredentials at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCredentialsCounter := mm_atomic.LoadUint64(&m.afterGetCredentialsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCredentialsMock.defaultExpectation != nil && afterGetCredentialsCounter < 1 {
+		if m.GetCredentialsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetCredentials at\n%s", m.GetCredentialsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetCredentials at\n%s with params: %#v", m.GetCredentialsMock.defaultExpectation.expectationOrigins.origin, *m.GetCredentialsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCredentials != nil && afterGetCredentialsCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetCredentials at\n%s", m.funcGetCredentialsOrigin)
+	}
+
+	if !m.GetCredentialsMock.invocationsDone() && afterGetCredentialsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetCredentials at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCredentialsMock.expectedInvocations), m.GetCredentialsMock.expectedInvocationsOrigin, afterGetCredentialsCounter)
+	}
+}
+
+type mUserRepositoryMockGetCredentialsByID struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetCredentialsByIDExpectation
+	expectations       []*UserRepositoryMockGetCredentialsByIDExpectation
+
+	callArgs []*UserRepositoryMockGetCredentialsByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockGetCredentialsByIDExpectation specifies expectation struct of the UserRepository.GetCredentialsByID
+type UserRepositoryMockGetCredentialsByIDExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockGetCredentialsByIDParams
+	paramPtrs          *UserRepositoryMockGetCredentialsByIDParamPtrs
+	expectationOrigins UserRepositoryMockGetCredentialsByIDExpectationOrigins
+	results            *UserRepositoryMockGetCredentialsByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockGetCredentialsByIDParams contains parameters of the UserRepository.GetCredentialsByID
+type UserRepositoryMockGetCredentialsByIDParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockGetCredentialsByIDParamPtrs contains pointers to parameters of the UserRepository.GetCredentialsByID
+type UserRepositoryMockGetCredentialsByIDParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockGetCredentialsByIDResults contains results of the UserRepository.GetCredentialsByID
+type UserRepositoryMockGetCredentialsByIDResults struct {
+	up1 *model.UserCredentials
+	err error
+}
+
+// UserRepositoryMockGetCredentialsByIDOrigins contains origins of expectations of the UserRepository.GetCredentialsByID
+type UserRepositoryMockGetCredentialsByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) Optional() *mUserRepositoryMockGetCredentialsByID {
+	mmGetCredentialsByID.optional = true
+	return mmGetCredentialsByID
+}
+
+// Expect sets up expected params for UserRepository.GetCredentialsByID
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) Expect(ctx context.Context, id int64) *mUserRepositoryMockGetCredentialsByID {
+	if mmGetCredentialsByID.mock.funcGetCredentialsByID != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("UserRepositoryMock.GetCredentialsByID mock is already set by Set")
+	}
+
+	if mmGetCredentialsByID.defaultExpectation == nil {
+		mmGetCredentialsByID.defaultExpectation = &UserRepositoryMockGetCredentialsByIDExpectation{}
+	}
+
+	if mmGetCredentialsByID.defaultExpectation.paramPtrs != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("UserRepositoryMock.GetCredentialsByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetCredentialsByID.defaultExpectation.params = &UserRepositoryMockGetCredentialsByIDParams{ctx, id}
+	mmGetCredentialsByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCredentialsByID.expectations {
+		if minimock.Equal(e.params, mmGetCredentialsByID.defaultExpectation.params) {
+			mmGetCredentialsByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCredentialsByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCredentialsByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetCredentialsByID
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetCredentialsByID {
+	if mmGetCredentialsByID.mock.funcGetCredentialsByID != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("UserRepositoryMock.GetCredentialsByID mock is already set by Set")
+	}
+
+	if mmGetCredentialsByID.defaultExpectation == nil {
+		mmGetCredentialsByID.defaultExpectation = &UserRepositoryMockGetCredentialsByIDExpectation{}
+	}
+
+	if mmGetCredentialsByID.defaultExpectation.params != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("UserRepositoryMock.GetCredentialsByID mock is already set by Expect")
+	}
+
+	if mmGetCredentialsByID.defaultExpectation.paramPtrs == nil {
+		mmGetCredentialsByID.defaultExpectation.paramPtrs = &UserRepositoryMockGetCredentialsByIDParamPtrs{}
+	}
+	mmGetCredentialsByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCredentialsByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCredentialsByID
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.GetCredentialsByID
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) ExpectIdParam2(id int64) *mUserRepositoryMockGetCredentialsByID {
+	if mmGetCredentialsByID.mock.funcGetCredentialsByID != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("UserRepositoryMock.GetCredentialsByID mock is already set by Set")
+	}
+
+	if mmGetCredentialsByID.defaultExpectation == nil {
+		mmGetCredentialsByID.defaultExpectation = &UserRepositoryMockGetCredentialsByIDExpectation{}
+	}
+
+	if mmGetCredentialsByID.defaultExpectation.params != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("UserRepositoryMock.GetCredentialsByID mock is already set by Expect")
+	}
+
+	if mmGetCredentialsByID.defaultExpectation.paramPtrs == nil {
+		mmGetCredentialsByID.defaultExpectation.paramPtrs = &UserRepositoryMockGetCredentialsByIDParamPtrs{}
+	}
+	mmGetCredentialsByID.defaultExpectation.paramPtrs.id = &id
+	mmGetCredentialsByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetCredentialsByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetCredentialsByID
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockGetCredentialsByID {
+	if mmGetCredentialsByID.mock.inspectFuncGetCredentialsByID != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetCredentialsByID")
+	}
+
+	mmGetCredentialsByID.mock.inspectFuncGetCredentialsByID = f
+
+	return mmGetCredentialsByID
+}
+
+// Return sets up results that will be returned by UserRepository.GetCredentialsByID
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) Return(up1 *model.UserCredentials, err error) *UserRepositoryMock {
+	if mmGetCredentialsByID.mock.funcGetCredentialsByID != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("UserRepositoryMock.GetCredentialsByID mock is already set by Set")
+	}
+
+	if mmGetCredentialsByID.defaultExpectation == nil {
+		mmGetCredentialsByID.defaultExpectation = &UserRepositoryMockGetCredentialsByIDExpectation{mock: mmGetCredentialsByID.mock}
+	}
+	mmGetCredentialsByID.defaultExpectation.results = &UserRepositoryMockGetCredentialsByIDResults{up1, err}
+	mmGetCredentialsByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCredentialsByID.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetCredentialsByID method
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) Set(f func(ctx context.Context, id int64) (up1 *model.UserCredentials, err error)) *UserRepositoryMock {
+	if mmGetCredentialsByID.defaultExpectation != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetCredentialsByID method")
+	}
+
+	if len(mmGetCredentialsByID.expectations) > 0 {
+		mmGetCredentialsByID.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetCredentialsByID method")
+	}
+
+	mmGetCredentialsByID.mock.funcGetCredentialsByID = f
+	mmGetCredentialsByID.mock.funcGetCredentialsByIDOrigin = minimock.CallerInfo(1)
+	return mmGetCredentialsByID.mock
+}
+
+// When sets expectation for the UserRepository.GetCredentialsByID which will trigger the result defined by the following
+// Then helper
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) When(ctx context.Context, id int64) *UserRepositoryMockGetCredentialsByIDExpectation {
+	if mmGetCredentialsByID.mock.funcGetCredentialsByID != nil {
+		mmGetCredentialsByID.mock.t.Fatalf("UserRepositoryMock.GetCredentialsByID mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetCredentialsByIDExpectation{
+		mock:               mmGetCredentialsByID.mock,
+		params:             &UserRepositoryMockGetCredentialsByIDParams{ctx, id},
+		expectationOrigins: UserRepositoryMockGetCredentialsByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCredentialsByID.expectations = append(mmGetCredentialsByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetCredentialsByID return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetCredentialsByIDExpectation) Then(up1 *model.UserCredentials, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetCredentialsByIDResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetCredentialsByID should be invoked
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) Times(n uint64) *mUserRepositoryMockGetCredentialsByID {
+	if n == 0 {
+		mmGetCredentialsByID.mock.t.Fatalf("Times of UserRepositoryMock.GetCredentialsByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCredentialsByID.expectedInvocations, n)
+	mmGetCredentialsByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCredentialsByID
+}
+
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) invocationsDone() bool {
+	if len(mmGetCredentialsByID.expectations) == 0 && mmGetCredentialsByID.defaultExpectation == nil && mmGetCredentialsByID.mock.funcGetCredentialsByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCredentialsByID.mock.afterGetCredentialsByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCredentialsByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCredentialsByID implements mm_repository.UserRepository
+func (mmGetCredentialsByID *UserRepositoryMock) GetCredentialsByID(ctx context.Context, id int64) (up1 *model.UserCredentials, err error) {
+	mm_atomic.AddUint64(&mmGetCredentialsByID.beforeGetCredentialsByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCredentialsByID.afterGetCredentialsByIDCounter, 1)
+
+	mmGetCredentialsByID.t.Helper()
+
+	if mmGetCredentialsByID.inspectFuncGetCredentialsByID != nil {
+		mmGetCredentialsByID.inspectFuncGetCredentialsByID(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockGetCredentialsByIDParams{ctx, id}
+
+	// Record call args
+	mmGetCredentialsByID.GetCredentialsByIDMock.mutex.Lock()
+	mmGetCredentialsByID.GetCredentialsByIDMock.callArgs = append(mmGetCredentialsByID.GetCredentialsByIDMock.callArgs, &mm_params)
+	mmGetCredentialsByID.GetCredentialsByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetCredentialsByID.GetCredentialsByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetCredentialsByID.GetCredentialsByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCredentialsByID.GetCredentialsByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCredentialsByID.GetCredentialsByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCredentialsByID.GetCredentialsByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetCredentialsByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCredentialsByID.t.Errorf("UserRepositoryMock.GetCredentialsByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCredentialsByID.GetCredentialsByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetCredentialsByID.t.Errorf("UserRepositoryMock.GetCredentialsByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCredentialsByID.GetCredentialsByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCredentialsByID.t.Errorf("UserRepositoryMock.GetCredentialsByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCredentialsByID.GetCredentialsByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCredentialsByID.GetCredentialsByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCredentialsByID.t.Fatal("No results are set for the UserRepositoryMock.GetCredentialsByID")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetCredentialsByID.funcGetCredentialsByID != nil {
+		return mmGetCredentialsByID.funcGetCredentialsByID(ctx, id)
+	}
+	mmGetCredentialsByID.t.Fatalf("Unexpected call to UserRepositoryMock.GetCredentialsByID. %v %v", ctx, id)
+	return
+}
+
+// GetCredentialsByIDAfterCounter returns a count of finished UserRepositoryMock.GetCredentialsByID invocations
+func (mmGetCredentialsByID *UserRepositoryMock) GetCredentialsByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCredentialsByID.afterGetCredentialsByIDCounter)
+}
+
+// GetCredentialsByIDBeforeCounter returns a count of UserRepositoryMock.GetCredentialsByID invocations
+func (mmGetCredentialsByID *UserRepositoryMock) GetCredentialsByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCredentialsByID.beforeGetCredentialsByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetCredentialsByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCredentialsByID *mUserRepositoryMockGetCredentialsByID) Calls() []*UserRepositoryMockGetCredentialsByIDParams {
+	mmGetCredentialsByID.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetCredentialsByIDParams, len(mmGetCredentialsByID.callArgs))
+	copy(argCopy, mmGetCredentialsByID.callArgs)
+
+	mmGetCredentialsByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCredentialsByIDDone returns true if the count of the GetCredentialsByID invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetCredentialsByIDDone() bool {
+	if m.GetCredentialsByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCredentialsByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCredentialsByIDMock.invocationsDone()
+}
+
+// MinimockGetCredentialsByIDInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetCredentialsByIDInspect() {
+	for _, e := range m.GetCredentialsByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetCredentialsByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCredentialsByIDCounter := mm_atomic.LoadUint64(&m.afterGetCredentialsByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCredentialsByIDMock.defaultExpectation != nil && afterGetCredentialsByIDCounter < 1 {
+		if m.GetCredentialsByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetCredentialsByID at\n%s", m.GetCredentialsByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetCredentialsByID at\n%s with params: %#v", m.GetCredentialsByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCredentialsByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCredentialsByID != nil && afterGetCredentialsByIDCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.GetCredentialsByID at\n%s", m.funcGetCredentialsByIDOrigin)
+	}
+
+	if !m.GetCredentialsByIDMock.invocationsDone() && afterGetCredentialsByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetCredentialsByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCredentialsByIDMock.expectedInvocations), m.GetCredentialsByIDMock.expectedInvocationsOrigin, afterGetCredentialsByIDCounter)
+	}
+}
+
+type mUserRepositoryMockList struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockListExpectation
+	expectations       []*UserRepositoryMockListExpectation
+
+	callArgs []*UserRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockListExpectation specifies expectation struct of the UserRepository.List
+type UserRepositoryMockListExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockListParams
+	paramPtrs          *UserRepositoryMockListParamPtrs
+	expectationOrigins UserRepositoryMockListExpectationOrigins
+	results            *UserRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockListParams contains parameters of the UserRepository.List
+type UserRepositoryMockListParams struct {
+	ctx   context.Context
+	query *model.UserListQuery
+}
+
+// UserRepositoryMockListParamPtrs contains pointers to parameters of the UserRepository.List
+type UserRepositoryMockListParamPtrs struct {
+	ctx   *context.Context
+	query **model.UserListQuery
+}
+
+// UserRepositoryMockListResults contains results of the UserRepository.List
+type UserRepositoryMockListResults struct {
+	upa1 []*model.User
+	err  error
+}
+
+// UserRepositoryMockListOrigins contains origins of expectations of the UserRepository.List
+type UserRepositoryMockListExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mUserRepositoryMockList) Optional() *mUserRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for UserRepository.List
+func (mmList *mUserRepositoryMockList) Expect(ctx context.Context, query *model.UserListQuery) *mUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UserRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &UserRepositoryMockListParams{ctx, query}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.List
+func (mmList *mUserRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UserRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &UserRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectQueryParam2 sets up expected param query for UserRepository.List
+func (mmList *mUserRepositoryMockList) ExpectQueryParam2(query *model.UserListQuery) *mUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UserRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &UserRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.query = &query
+	mmList.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.List
+func (mmList *mUserRepositoryMockList) Inspect(f func(ctx context.Context, query *model.UserListQuery)) *mUserRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by UserRepository.List
+func (mmList *mUserRepositoryMockList) Return(upa1 []*model.User, err error) *UserRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &UserRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &UserRepositoryMockListResults{upa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the UserRepository.List method
+func (mmList *mUserRepositoryMockList) Set(f func(ctx context.Context, query *model.UserListQuery) (upa1 []*model.User, err error)) *UserRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the UserRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the UserRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the UserRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mUserRepositoryMockList) When(ctx context.Context, query *model.UserListQuery) *UserRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("UserRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &UserRepositoryMockListParams{ctx, query},
+		expectationOrigins: UserRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.List return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockListExpectation) Then(upa1 []*model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockListResults{upa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.List should be invoked
+func (mmList *mUserRepositoryMockList) Times(n uint64) *mUserRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of UserRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mUserRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.UserRepository
+func (mmList *UserRepositoryMock) List(ctx context.Context, query *model.UserListQuery) (upa1 []*model.User, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, query)
+	}
+
+	mm_params := UserRepositoryMockListParams{ctx, query}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.upa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockListParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("UserRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmList.t.Errorf("UserRepositoryMock.List got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("UserRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the UserRepositoryMock.List")
+		}
+		return (*mm_results).upa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, query)
+	}
+	mmList.t.Fatalf("Unexpected call to UserRepositoryMock.List. %v %v", ctx, query)
+	return
+}
+
+// ListAfterCounter returns a count of finished UserRepositoryMock.List invocations
+func (mmList *UserRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of UserRepositoryMock.List invocations
+func (mmList *UserRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mUserRepositoryMockList) Calls() []*UserRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mUserRepositoryMockLockByID struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockLockByIDExpectation
+	expectations       []*UserRepositoryMockLockByIDExpectation
+
+	callArgs []*UserRepositoryMockLockByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockLockByIDExpectation specifies expectation struct of the UserRepository.LockByID
+type UserRepositoryMockLockByIDExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockLockByIDParams
+	paramPtrs          *UserRepositoryMockLockByIDParamPtrs
+	expectationOrigins UserRepositoryMockLockByIDExpectationOrigins
+	results            *UserRepositoryMockLockByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockLockByIDParams contains parameters of the UserRepository.LockByID
+type UserRepositoryMockLockByIDParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockLockByIDParamPtrs contains pointers to parameters of the UserRepository.LockByID
+type UserRepositoryMockLockByIDParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockLockByIDResults contains results of the UserRepository.LockByID
+type UserRepositoryMockLockByIDResults struct {
+	err error
+}
+
+// UserRepositoryMockLockByIDOrigins contains origins of expectations of the UserRepository.LockByID
+type UserRepositoryMockLockByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockByID *mUserRepositoryMockLockByID) Optional() *mUserRepositoryMockLockByID {
+	mmLockByID.optional = true
+	return mmLockByID
+}
+
+// Expect sets up expected params for UserRepository.LockByID
+func (mmLockByID *mUserRepositoryMockLockByID) Expect(ctx context.Context, id int64) *mUserRepositoryMockLockByID {
+	if mmLockByID.mock.funcLockByID != nil {
+		mmLockByID.mock.t.Fatalf("UserRepositoryMock.LockByID mock is already set by Set")
+	}
+
+	if mmLockByID.defaultExpectation == nil {
+		mmLockByID.defaultExpectation = &UserRepositoryMockLockByIDExpectation{}
+	}
+
+	if mmLockByID.defaultExpectation.paramPtrs != nil {
+		mmLockByID.mock.t.Fatalf("UserRepositoryMock.LockByID mock is already set by ExpectParams functions")
+	}
+
+	mmLockByID.defaultExpectation.params = &UserRepositoryMockLockByIDParams{ctx, id}
+	mmLockByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockByID.expectations {
+		if minimock.Equal(e.params, mmLockByID.defaultExpectation.params) {
+			mmLockByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockByID.defaultExpectation.params)
+		}
+	}
+
+	return mmLockByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.LockByID
+func (mmLockByID *mUserRepositoryMockLockByID) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockLockByID {
+	if mmLockByID.mock.funcLockByID != nil {
+		mmLockByID.mock.t.Fatalf("UserRepositoryMock.LockByID mock is already set by Set")
+	}
+
+	if mmLockByID.defaultExpectation == nil {
+		mmLockByID.defaultExpectation = &UserRepositoryMockLockByIDExpectation{}
+	}
+
+	if mmLockByID.defaultExpectation.params != nil {
+		mmLockByID.mock.t.Fatalf("UserRepositoryMock.LockByID mock is already set by Expect")
+	}
+
+	if mmLockByID.defaultExpectation.paramPtrs == nil {
+		mmLockByID.defaultExpectation.paramPtrs = &UserRepositoryMockLockByIDParamPtrs{}
+	}
+	mmLockByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockByID
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.LockByID
+func (mmLockByID *mUserRepositoryMockLockByID) ExpectIdParam2(id int64) *mUserRepositoryMockLockByID {
+	if mmLockByID.mock.funcLockByID != nil {
+		mmLockByID.mock.t.Fatalf("UserRepositoryMock.LockByID mock is already set by Set")
+	}
+
+	if mmLockByID.defaultExpectation == nil {
+		mmLockByID.defaultExpectation = &UserRepositoryMockLockByIDExpectation{}
+	}
+
+	if mmLockByID.defaultExpectation.params != nil {
+		mmLockByID.mock.t.Fatalf("UserRepositoryMock.LockByID mock is already set by Expect")
+	}
+
+	if mmLockByID.defaultExpectation.paramPtrs == nil {
+		mmLockByID.defaultExpectation.paramPtrs = &UserRepositoryMockLockByIDParamPtrs{}
+	}
+	mmLockByID.defaultExpectation.paramPtrs.id = &id
+	mmLockByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmLockByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.LockByID
+func (mmLockByID *mUserRepositoryMockLockByID) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockLockByID {
+	if mmLockByID.mock.inspectFuncLockByID != nil {
+		mmLockByID.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.LockByID")
+	}
+
+	mmLockByID.mock.inspectFuncLockByID = f
+
+	return mmLockByID
+}
+
+// Return sets up results that will be returned by UserRepository.LockByID
+func (mmLockByID *mUserRepositoryMockLockByID) Return(err error) *UserRepositoryMock {
+	if mmLockByID.mock.funcLockByID != nil {
+		mmLockByID.mock.t.Fatalf("UserRepositoryMock.LockByID mock is already set by Set")
+	}
+
+	if mmLockByID.defaultExpectation == nil {
+		mmLockByID.defaultExpectation = &UserRepositoryMockLockByIDExpectation{mock: mmLockByID.mock}
+	}
+	mmLockByID.defaultExpectation.results = &UserRepositoryMockLockByIDResults{err}
+	mmLockByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockByID.mock
+}
+
+// Set uses given function f to mock the UserRepository.LockByID method
+func (mmLockByID *mUserRepositoryMockLockByID) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
+	if mmLockByID.defaultExpectation != nil {
+		mmLockByID.mock.t.Fatalf("Default expectation is already set for the UserRepository.LockByID method")
+	}
+
+	if len(mmLockByID.expectations) > 0 {
+		mmLockByID.mock.t.Fatalf("Some expectations are already set for the UserRepository.LockByID method")
+	}
+
+	mmLockByID.mock.funcLockByID = f
+	mmLockByID.mock.funcLockByIDOrigin = minimock.CallerInfo(1)
+	return mmLockByID.mock
+}
+
+// When sets expectation for the UserRepository.LockByID which will trigger the result defined by the following
+// Then helper
+func (mmLockByID *mUserRepositoryMockLockByID) When(ctx context.Context, id int64) *UserRepositoryMockLockByIDExpectation {
+	if mmLockByID.mock.funcLockByID != nil {
+		mmLockByID.mock.t.Fatalf("UserRepositoryMock.LockByID mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockLockByIDExpectation{
+		mock:               mmLockByID.mock,
+		params:             &UserRepositoryMockLockByIDParams{ctx, id},
+		expectationOrigins: UserRepositoryMockLockByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockByID.expectations = append(mmLockByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.LockByID return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockLockByIDExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockLockByIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.LockByID should be invoked
+func (mmLockByID *mUserRepositoryMockLockByID) Times(n uint64) *mUserRepositoryMockLockByID {
+	if n == 0 {
+		mmLockByID.mock.t.Fatalf("Times of UserRepositoryMock.LockByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockByID.expectedInvocations, n)
+	mmLockByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockByID
+}
+
+func (mmLockByID *mUserRepositoryMockLockByID) invocationsDone() bool {
+	if len(mmLockByID.expectations) == 0 && mmLockByID.defaultExpectation == nil && mmLockByID.mock.funcLockByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockByID.mock.afterLockByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockByID implements mm_repository.UserRepository
+func (mmLockByID *UserRepositoryMock) LockByID(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmLockByID.beforeLockByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockByID.afterLockByIDCounter, 1)
+
+	mmLockByID.t.Helper()
+
+	if mmLockByID.inspectFuncLockByID != nil {
+		mmLockByID.inspectFuncLockByID(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockLockByIDParams{ctx, id}
+
+	// Record call args
+	mmLockByID.LockByIDMock.mutex.Lock()
+	mmLockByID.LockByIDMock.callArgs = append(mmLockByID.LockByIDMock.callArgs, &mm_params)
+	mmLockByID.LockByIDMock.mutex.Unlock()
+
+	for _, e := range mmLockByID.LockByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLockByID.LockByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockByID.LockByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockByID.LockByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmLockByID.LockByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockLockByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockByID.t.Errorf("UserRepositoryMock.LockByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockByID.LockByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmLockByID.t.Errorf("UserRepositoryMock.LockByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockByID.LockByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockByID.t.Errorf("UserRepositoryMock.LockByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockByID.LockByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockByID.LockByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockByID.t.Fatal("No results are set for the UserRepositoryMock.LockByID")
+		}
+		return (*mm_results).err
+	}
+	if mmLockByID.funcLockByID != nil {
+		return mmLockByID.funcLockByID(ctx, id)
+	}
+	mmLockByID.t.Fatalf("Unexpected call to UserRepositoryMock.LockByID. %v %v", ctx, id)
+	return
+}
+
+// LockByIDAfterCounter returns a count of finished UserRepositoryMock.LockByID invocations
+func (mmLockByID *UserRepositoryMock) LockByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockByID.afterLockByIDCounter)
+}
+
+// LockByIDBeforeCounter returns a count of UserRepositoryMock.LockByID invocations
+func (mmLockByID *UserRepositoryMock) LockByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockByID.beforeLockByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.LockByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockByID *mUserRepositoryMockLockByID) Calls() []*UserRepositoryMockLockByIDParams {
+	mmLockByID.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockLockByIDParams, len(mmLockByID.callArgs))
+	copy(argCopy, mmLockByID.callArgs)
+
+	mmLockByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockByIDDone returns true if the count of the LockByID invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockLockByIDDone() bool {
+	if m.LockByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockByIDMock.invocationsDone()
+}
+
+// MinimockLockByIDInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockLockByIDInspect() {
+	for _, e := range m.LockByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.LockByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockByIDCounter := mm_atomic.LoadUint64(&m.afterLockByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockByIDMock.defaultExpectation != nil && afterLockByIDCounter < 1 {
+		if m.LockByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.LockByID at\n%s", m.LockByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.LockByID at\n%s with params: %#v", m.LockByIDMock.defaultExpectation.expectationOrigins.origin, *m.LockByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockByID != nil && afterLockByIDCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.LockByID at\n%s", m.funcLockByIDOrigin)
+	}
+
+	if !m.LockByIDMock.invocationsDone() && afterLockByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.LockByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockByIDMock.expectedInvocations), m.LockByIDMock.expectedInvocationsOrigin, afterLockByIDCounter)
+	}
+}
+
+type mUserRepositoryMockLockByRole struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockLockByRoleExpectation
+	expectations       []*UserRepositoryMockLockByRoleExpectation
+
+	callArgs []*UserRepositoryMockLockByRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockLockByRoleExpectation specifies expectation struct of the UserRepository.LockByRole
+type UserRepositoryMockLockByRoleExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockLockByRoleParams
+	paramPtrs          *UserRepositoryMockLockByRoleParamPtrs
+	expectationOrigins UserRepositoryMockLockByRoleExpectationOrigins
+	results            *UserRepositoryMockLockByRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockLockByRoleParams contains parameters of the UserRepository.LockByRole
+type UserRepositoryMockLockByRoleParams struct {
+	ctx  context.Context
+	role model.Role
+}
+
+// UserRepositoryMockLockByRoleParamPtrs contains pointers to parameters of the UserRepository.LockByRole
+type UserRepositoryMockLockByRoleParamPtrs struct {
+	ctx  *context.Context
+	role *model.Role
+}
+
+// UserRepositoryMockLockByRoleResults contains results of the UserRepository.LockByRole
+type UserRepositoryMockLockByRoleResults struct {
+	ia1 []int64
+	err error
+}
+
+// UserRepositoryMockLockByRoleOrigins contains origins of expectations of the UserRepository.LockByRole
+type UserRepositoryMockLockByRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockByRole *mUserRepositoryMockLockByRole) Optional() *mUserRepositoryMockLockByRole {
+	mmLockByRole.optional = true
+	return mmLockByRole
+}
+
+// Expect sets up expected params for UserRepository.LockByRole
+func (mmLockByRole *mUserRepositoryMockLockByRole) Expect(ctx context.Context, role model.Role) *mUserRepositoryMockLockByRole {
+	if mmLockByRole.mock.funcLockByRole != nil {
+		mmLockByRole.mock.t.Fatalf("UserRepositoryMock.LockByRole mock is already set by Set")
+	}
+
+	if mmLockByRole.defaultExpectation == nil {
+		mmLockByRole.defaultExpectation = &UserRepositoryMockLockByRoleExpectation{}
+	}
+
+	if mmLockByRole.defaultExpectation.paramPtrs != nil {
+		mmLockByRole.mock.t.Fatalf("UserRepositoryMock.LockByRole mock is already set by ExpectParams functions")
+	}
+
+	mmLockByRole.defaultExpectation.params = &UserRepositoryMockLockByRoleParams{ctx, role}
+	mmLockByRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockByRole.expectations {
+		if minimock.Equal(e.params, mmLockByRole.defaultExpectation.params) {
+			mmLockByRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockByRole.defaultExpectation.params)
+		}
+	}
+
+	return mmLockByRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.LockByRole
+func (mmLockByRole *mUserRepositoryMockLockByRole) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockLockByRole {
+	if mmLockByRole.mock.funcLockByRole != nil {
+		mmLockByRole.mock.t.Fatalf("UserRepositoryMock.LockByRole mock is already set by Set")
+	}
+
+	if mmLockByRole.defaultExpectation == nil {
+		mmLockByRole.defaultExpectation = &UserRepositoryMockLockByRoleExpectation{}
+	}
+
+	if mmLockByRole.defaultExpectation.params != nil {
+		mmLockByRole.mock.t.Fatalf("UserRepositoryMock.LockByRole mock is already set by Expect")
+	}
+
+	if mmLockByRole.defaultExpectation.paramPtrs == nil {
+		mmLockByRole.defaultExpectation.paramPtrs = &UserRepositoryMockLockByRoleParamPtrs{}
+	}
+	mmLockByRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockByRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockByRole
+}
+
+// ExpectRoleParam2 sets up expected param role for UserRepository.LockByRole
+func (mmLockByRole *mUserRepositoryMockLockByRole) ExpectRoleParam2(role model.Role) *mUserRepositoryMockLockByRole {
+	if mmLockByRole.mock.funcLockByRole != nil {
+		mmLockByRole.mock.t.Fatalf("UserRepositoryMock.LockByRole mock is already set by Set")
+	}
+
+	if mmLockByRole.defaultExpectation == nil {
+		mmLockByRole.defaultExpectation = &UserRepositoryMockLockByRoleExpectation{}
+	}
+
+	if mmLockByRole.defaultExpectation.params != nil {
+		mmLockByRole.mock.t.Fatalf("UserRepositoryMock.LockByRole mock is already set by Expect")
+	}
+
+	if mmLockByRole.defaultExpectation.paramPtrs == nil {
+		mmLockByRole.defaultExpectation.paramPtrs = &UserRepositoryMockLockByRoleParamPtrs{}
+	}
+	mmLockByRole.defaultExpectation.paramPtrs.role = &role
+	mmLockByRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmLockByRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.LockByRole
+func (mmLockByRole *mUserRepositoryMockLockByRole) Inspect(f func(ctx context.Context, role model.Role)) *mUserRepositoryMockLockByRole {
+	if mmLockByRole.mock.inspectFuncLockByRole != nil {
+		mmLockByRole.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.LockByRole")
+	}
+
+	mmLockByRole.mock.inspectFuncLockByRole = f
+
+	return mmLockByRole
+}
+
+// Return sets up results that will be returned by UserRepository.LockByRole
+func (mmLockByRole *mUserRepositoryMockLockByRole) Return(ia1 []int64, err error) *UserRepositoryMock {
+	if mmLockByRole.mock.funcLockByRole != nil {
+		mmLockByRole.mock.t.Fatalf("UserRepositoryMock.LockByRole mock is already set by Set")
+	}
+
+	if mmLockByRole.defaultExpectation == nil {
+		mmLockByRole.defaultExpectation = &UserRepositoryMockLockByRoleExpectation{mock: mmLockByRole.mock}
+	}
+	mmLockByRole.defaultExpectation.results = &UserRepositoryMockLockByRoleResults{ia1, err}
+	mmLockByRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockByRole.mock
+}
+
+// Set uses given function f to mock the UserRepository.LockByRole method
+func (mmLockByRole *mUserRepositoryMockLockByRole) Set(f func(ctx context.Context, role model.Role) (ia1 []int64, err error)) *UserRepositoryMock {
+	if mmLockByRole.defaultExpectation != nil {
+		mmLockByRole.mock.t.Fatalf("Default expectation is already set for the UserRepository.LockByRole method")
+	}
+
+	if len(mmLockByRole.expectations) > 0 {
+		mmLockByRole.mock.t.Fatalf("Some expectations are already set for the UserRepository.LockByRole method")
+	}
+
+	mmLockByRole.mock.funcLockByRole = f
+	mmLockByRole.mock.funcLockByRoleOrigin = minimock.CallerInfo(1)
+	return mmLockByRole.mock
+}
+
+// When sets expectation for the UserRepository.LockByRole which will trigger the result defined by the following
+// Then helper
+func (mmLockByRole *mUserRepositoryMockLockByRole) When(ctx context.Context, role model.Role) *UserRepositoryMockLockByRoleExpectation {
+	if mmLockByRole.mock.funcLockByRole != nil {
+		mmLockByRole.mock.t.Fatalf("UserRepositoryMock.LockByRole mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockLockByRoleExpectation{
+		mock:               mmLockByRole.mock,
+		params:             &UserRepositoryMockLockByRoleParams{ctx, role},
+		expectationOrigins: UserRepositoryMockLockByRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockByRole.expectations = append(mmLockByRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.LockByRole return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockLockByRoleExpectation) Then(ia1 []int64, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockLockByRoleResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.LockByRole should be invoked
+func (mmLockByRole *mUserRepositoryMockLockByRole) Times(n uint64) *mUserRepositoryMockLockByRole {
+	if n == 0 {
+		mmLockByRole.mock.t.Fatalf("Times of UserRepositoryMock.LockByRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockByRole.expectedInvocations, n)
+	mmLockByRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockByRole
+}
+
+func (mmLockByRole *mUserRepositoryMockLockByRole) invocationsDone() bool {
+	if len(mmLockByRole.expectations) == 0 && mmLockByRole.defaultExpectation == nil && mmLockByRole.mock.funcLockByRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockByRole.mock.afterLockByRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockByRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockByRole implements mm_repository.UserRepository
+func (mmLockByRole *UserRepositoryMock) LockByRole(ctx context.Context, role model.Role) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmLockByRole.beforeLockByRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockByRole.afterLockByRoleCounter, 1)
+
+	mmLockByRole.t.Helper()
+
+	if mmLockByRole.inspectFuncLockByRole != nil {
+		mmLockByRole.inspectFuncLockByRole(ctx, role)
+	}
+
+	mm_params := UserRepositoryMockLockByRoleParams{ctx, role}
+
+	// Record call args
+	mmLockByRole.LockByRoleMock.mutex.Lock()
+	mmLockByRole.LockByRoleMock.callArgs = append(mmLockByRole.LockByRoleMock.callArgs, &mm_params)
+	mmLockByRole.LockByRoleMock.mutex.Unlock()
+
+	for _, e := range mmLockByRole.LockByRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmLockByRole.LockByRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockByRole.LockByRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockByRole.LockByRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmLockByRole.LockByRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockLockByRoleParams{ctx, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockByRole.t.Errorf("UserRepositoryMock.LockByRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockByRole.LockByRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmLockByRole.t.Errorf("UserRepositoryMock.LockByRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockByRole.LockByRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockByRole.t.Errorf("UserRepositoryMock.LockByRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockByRole.LockByRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockByRole.LockByRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockByRole.t.Fatal("No results are set for the UserRepositoryMock.LockByRole")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmLockByRole.funcLockByRole != nil {
+		return mmLockByRole.funcLockByRole(ctx, role)
+	}
+	mmLockByRole.t.Fatalf("Unexpected call to UserRepositoryMock.LockByRole. %v %v", ctx, role)
+	return
+}
+
+// LockByRoleAfterCounter returns a count of finished UserRepositoryMock.LockByRole invocations
+func (mmLockByRole *UserRepositoryMock) LockByRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockByRole.afterLockByRoleCounter)
+}
+
+// LockByRoleBeforeCounter returns a count of UserRepositoryMock.LockByRole invocations
+func (mmLockByRole *UserRepositoryMock) LockByRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockByRole.beforeLockByRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.LockByRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockByRole *mUserRepositoryMockLockByRole) Calls() []*UserRepositoryMockLockByRoleParams {
+	mmLockByRole.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockLockByRoleParams, len(mmLockByRole.callArgs))
+	copy(argCopy, mmLockByRole.callArgs)
+
+	mmLockByRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockByRoleDone returns true if the count of the LockByRole invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockLockByRoleDone() bool {
+	if m.LockByRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockByRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockByRoleMock.invocationsDone()
+}
+
+// MinimockLockByRoleInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockLockByRoleInspect() {
+	for _, e := range m.LockByRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.LockByRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockByRoleCounter := mm_atomic.LoadUint64(&m.afterLockByRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockByRoleMock.defaultExpectation != nil && afterLockByRoleCounter < 1 {
+		if m.LockByRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.LockByRole at\n%s", m.LockByRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.LockByRole at\n%s with params: %#v", m.LockByRoleMock.defaultExpectation.expectationOrigins.origin, *m.LockByRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockByRole != nil && afterLockByRoleCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.LockByRole at\n%s", m.funcLockByRoleOrigin)
+	}
+
+	if !m.LockByRoleMock.invocationsDone() && afterLockByRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.LockByRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockByRoleMock.expectedInvocations), m.LockByRoleMock.expectedInvocationsOrigin, afterLockByRoleCounter)
+	}
+}
+
+type mUserRepositoryMockMarkEmailVerified struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockMarkEmailVerifiedExpectation
+	expectations       []*UserRepositoryMockMarkEmailVerifiedExpectation
+
+	callArgs []*UserRepositoryMockMarkEmailVerifiedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockMarkEmailVerifiedExpectation specifies expectation struct of the UserRepository.MarkEmailVerified
+type UserRepositoryMockMarkEmailVerifiedExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockMarkEmailVerifiedParams
+	paramPtrs          *UserRepositoryMockMarkEmailVerifiedParamPtrs
+	expectationOrigins UserRepositoryMockMarkEmailVerifiedExpectationOrigins
+	results            *UserRepositoryMockMarkEmailVerifiedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockMarkEmailVerifiedParams contains parameters of the UserRepository.MarkEmailVerified
+type UserRepositoryMockMarkEmailVerifiedParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// UserRepositoryMockMarkEmailVerifiedParamPtrs contains pointers to parameters of the UserRepository.MarkEmailVerified
+type UserRepositoryMockMarkEmailVerifiedParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// UserRepositoryMockMarkEmailVerifiedResults contains results of the UserRepository.MarkEmailVerified
+type UserRepositoryMockMarkEmailVerifiedResults struct {
+	err error
+}
+
+// UserRepositoryMockMarkEmailVerifiedOrigins contains origins of expectations of the UserRepository.MarkEmailVerified
+type UserRepositoryMockMarkEmailVerifiedExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) Optional() *mUserRepositoryMockMarkEmailVerified {
+	mmMarkEmailVerified.optional = true
+	return mmMarkEmailVerified
+}
+
+// Expect sets up expected params for UserRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) Expect(ctx context.Context, id int64) *mUserRepositoryMockMarkEmailVerified {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UserRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation == nil {
+		mmMarkEmailVerified.defaultExpectation = &UserRepositoryMockMarkEmailVerifiedExpectation{}
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.paramPtrs != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UserRepositoryMock.MarkEmailVerified mock is already set by ExpectParams functions")
+	}
+
+	mmMarkEmailVerified.defaultExpectation.params = &UserRepositoryMockMarkEmailVerifiedParams{ctx, id}
+	mmMarkEmailVerified.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkEmailVerified.expectations {
+		if minimock.Equal(e.params, mmMarkEmailVerified.defaultExpectation.params) {
+			mmMarkEmailVerified.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkEmailVerified.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkEmailVerified
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockMarkEmailVerified {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UserRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation == nil {
+		mmMarkEmailVerified.defaultExpectation = &UserRepositoryMockMarkEmailVerifiedExpectation{}
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.params != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UserRepositoryMock.MarkEmailVerified mock is already set by Expect")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmMarkEmailVerified.defaultExpectation.paramPtrs = &UserRepositoryMockMarkEmailVerifiedParamPtrs{}
+	}
+	mmMarkEmailVerified.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkEmailVerified.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkEmailVerified
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) ExpectIdParam2(id int64) *mUserRepositoryMockMarkEmailVerified {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UserRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation == nil {
+		mmMarkEmailVerified.defaultExpectation = &UserRepositoryMockMarkEmailVerifiedExpectation{}
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.params != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UserRepositoryMock.MarkEmailVerified mock is already set by Expect")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmMarkEmailVerified.defaultExpectation.paramPtrs = &UserRepositoryMockMarkEmailVerifiedParamPtrs{}
+	}
+	mmMarkEmailVerified.defaultExpectation.paramPtrs.id = &id
+	mmMarkEmailVerified.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkEmailVerified
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) Inspect(f func(ctx context.Context, id int64)) *mUserRepositoryMockMarkEmailVerified {
+	if mmMarkEmailVerified.mock.inspectFuncMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.MarkEmailVerified")
+	}
+
+	mmMarkEmailVerified.mock.inspectFuncMarkEmailVerified = f
+
+	return mmMarkEmailVerified
+}
+
+// Return sets up results that will be returned by UserRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) Return(err error) *UserRepositoryMock {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UserRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation == nil {
+		mmMarkEmailVerified.defaultExpectation = &UserRepositoryMockMarkEmailVerifiedExpectation{mock: mmMarkEmailVerified.mock}
+	}
+	mmMarkEmailVerified.defaultExpectation.results = &UserRepositoryMockMarkEmailVerifiedResults{err}
+	mmMarkEmailVerified.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkEmailVerified.mock
+}
+
+// Set uses given function f to mock the UserRepository.MarkEmailVerified method
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) Set(f func(ctx context.Context, id int64) (err error)) *UserRepositoryMock {
+	if mmMarkEmailVerified.defaultExpectation != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("Default expectation is already set for the UserRepository.MarkEmailVerified method")
+	}
+
+	if len(mmMarkEmailVerified.expectations) > 0 {
+		mmMarkEmailVerified.mock.t.Fatalf("Some expectations are already set for the UserRepository.MarkEmailVerified method")
+	}
+
+	mmMarkEmailVerified.mock.funcMarkEmailVerified = f
+	mmMarkEmailVerified.mock.funcMarkEmailVerifiedOrigin = minimock.CallerInfo(1)
+	return mmMarkEmailVerified.mock
+}
+
+// When sets expectation for the UserRepository.MarkEmailVerified which will trigger the result defined by the following
+// Then helper
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) When(ctx context.Context, id int64) *UserRepositoryMockMarkEmailVerifiedExpectation {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UserRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockMarkEmailVerifiedExpectation{
+		mock:               mmMarkEmailVerified.mock,
+		params:             &UserRepositoryMockMarkEmailVerifiedParams{ctx, id},
+		expectationOrigins: UserRepositoryMockMarkEmailVerifiedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkEmailVerified.expectations = append(mmMarkEmailVerified.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.MarkEmailVerified return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockMarkEmailVerifiedExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockMarkEmailVerifiedResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.MarkEmailVerified should be invoked
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) Times(n uint64) *mUserRepositoryMockMarkEmailVerified {
+	if n == 0 {
+		mmMarkEmailVerified.mock.t.Fatalf("Times of UserRepositoryMock.MarkEmailVerified mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkEmailVerified.expectedInvocations, n)
+	mmMarkEmailVerified.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkEmailVerified
+}
+
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) invocationsDone() bool {
+	if len(mmMarkEmailVerified.expectations) == 0 && mmMarkEmailVerified.defaultExpectation == nil && mmMarkEmailVerified.mock.funcMarkEmailVerified == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkEmailVerified.mock.afterMarkEmailVerifiedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkEmailVerified.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkEmailVerified implements mm_repository.UserRepository
+func (mmMarkEmailVerified *UserRepositoryMock) MarkEmailVerified(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmMarkEmailVerified.beforeMarkEmailVerifiedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkEmailVerified.afterMarkEmailVerifiedCounter, 1)
+
+	mmMarkEmailVerified.t.Helper()
+
+	if mmMarkEmailVerified.inspectFuncMarkEmailVerified != nil {
+		mmMarkEmailVerified.inspectFuncMarkEmailVerified(ctx, id)
+	}
+
+	mm_params := UserRepositoryMockMarkEmailVerifiedParams{ctx, id}
+
+	// Record call args
+	mmMarkEmailVerified.MarkEmailVerifiedMock.mutex.Lock()
+	mmMarkEmailVerified.MarkEmailVerifiedMock.callArgs = append(mmMarkEmailVerified.MarkEmailVerifiedMock.callArgs, &mm_params)
+	mmMarkEmailVerified.MarkEmailVerifiedMock.mutex.Unlock()
+
+	for _, e := range mmMarkEmailVerified.MarkEmailVerifiedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockMarkEmailVerifiedParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkEmailVerified.t.Errorf("UserRepositoryMock.MarkEmailVerified got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkEmailVerified.t.Errorf("UserRepositoryMock.MarkEmailVerified got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkEmailVerified.t.Errorf("UserRepositoryMock.MarkEmailVerified got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkEmailVerified.t.Fatal("No results are set for the UserRepositoryMock.MarkEmailVerified")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkEmailVerified.funcMarkEmailVerified != nil {
+		return mmMarkEmailVerified.funcMarkEmailVerified(ctx, id)
+	}
+	mmMarkEmailVerified.t.Fatalf("Unexpected call to UserRepositoryMock.MarkEmailVerified. %v %v", ctx, id)
+	return
+}
+
+// MarkEmailVerifiedAfterCounter returns a count of finished UserRepositoryMock.MarkEmailVerified invocations
+func (mmMarkEmailVerified *UserRepositoryMock) MarkEmailVerifiedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEmailVerified.afterMarkEmailVerifiedCounter)
+}
+
+// MarkEmailVerifiedBeforeCounter returns a count of UserRepositoryMock.MarkEmailVerified invocations
+func (mmMarkEmailVerified *UserRepositoryMock) MarkEmailVerifiedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEmailVerified.beforeMarkEmailVerifiedCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.MarkEmailVerified.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkEmailVerified *mUserRepositoryMockMarkEmailVerified) Calls() []*UserRepositoryMockMarkEmailVerifiedParams {
+	mmMarkEmailVerified.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockMarkEmailVerifiedParams, len(mmMarkEmailVerified.callArgs))
+	copy(argCopy, mmMarkEmailVerified.callArgs)
+
+	mmMarkEmailVerified.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkEmailVerifiedDone returns true if the count of the MarkEmailVerified invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockMarkEmailVerifiedDone() bool {
+	if m.MarkEmailVerifiedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkEmailVerifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkEmailVerifiedMock.invocationsDone()
+}
+
+// MinimockMarkEmailVerifiedInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockMarkEmailVerifiedInspect() {
+	for _, e := range m.MarkEmailVerifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.MarkEmailVerified at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkEmailVerifiedCounter := mm_atomic.LoadUint64(&m.afterMarkEmailVerifiedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkEmailVerifiedMock.defaultExpectation != nil && afterMarkEmailVerifiedCounter < 1 {
+		if m.MarkEmailVerifiedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.MarkEmailVerified at\n%s", m.MarkEmailVerifiedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.MarkEmailVerified at\n%s with params: %#v", m.MarkEmailVerifiedMock.defaultExpectation.expectationOrigins.origin, *m.MarkEmailVerifiedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkEmailVerified != nil && afterMarkEmailVerifiedCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.MarkEmailVerified at\n%s", m.funcMarkEmailVerifiedOrigin)
+	}
+
+	if !m.MarkEmailVerifiedMock.invocationsDone() && afterMarkEmailVerifiedCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.MarkEmailVerified at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkEmailVerifiedMock.expectedInvocations), m.MarkEmailVerifiedMock.expectedInvocationsOrigin, afterMarkEmailVerifiedCounter)
+	}
+}
+
+type mUserRepositoryMockUpdate struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateExpectation
+	expectations       []*UserRepositoryMockUpdateExpectation
+
+	callArgs []*UserRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockUpdateExpectation specifies expectation struct of the UserRepository.Update
+type UserRepositoryMockUpdateExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockUpdateParams
+	paramPtrs          *UserRepositoryMockUpdateParamPtrs
+	expectationOrigins UserRepositoryMockUpdateExpectationOrigins
+	results            *UserRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockUpdateParams contains parameters of the UserRepository.Update
+type UserRepositoryMockUpdateParams struct {
+	ctx        context.Context
+	id         int64
+	updateUser *model.UpdateUserData
+}
+
+// UserRepositoryMockUpdateParamPtrs contains pointers to parameters of the UserRepository.Update
+type UserRepositoryMockUpdateParamPtrs struct {
+	ctx        *context.Context
+	id         *int64
+	updateUser **model.UpdateUserData
+}
+
+// UserRepositoryMockUpdateResults contains results of the UserRepository.Update
+type UserRepositoryMockUpdateResults struct {
+	err error
+}
+
+// UserRepositoryMockUpdateOrigins contains origins of expectations of the UserRepository.Update
+type UserRepositoryMockUpdateExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originId         string
+	originUpdateUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mUserRepositoryMockUpdate) Optional() *mUserRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) Expect(ctx context.Context, id int64, updateUser *model.UpdateUserData) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &UserRepositoryMockUpdateParams{ctx, id, updateUser}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) ExpectIdParam2(id int64) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.id = &id
+	mmUpdate.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectUpdateUserParam3 sets up expected param updateUser for UserRepository.Update
 func (mmUpdate *mUserRepositoryMockUpdate) ExpectUpdateUserParam3(updateUser *model.UpdateUserData) *mUserRepositoryMockUpdate {
 	if mmUpdate.mock.funcUpdate != nil {
 		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.updateUser = &updateUser
+	mmUpdate.defaultExpectation.expectationOrigins.originUpdateUser = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) Inspect(f func(ctx context.Context, id int64, updateUser *model.UpdateUserData)) *mUserRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by UserRepository.Update
+func (mmUpdate *mUserRepositoryMockUpdate) Return(err error) *UserRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &UserRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the UserRepository.Update method
+func (mmUpdate *mUserRepositoryMockUpdate) Set(f func(ctx context.Context, id int64, updateUser *model.UpdateUserData) (err error)) *UserRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the UserRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the UserRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the UserRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mUserRepositoryMockUpdate) When(ctx context.Context, id int64, updateUser *model.UpdateUserData) *UserRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &UserRepositoryMockUpdateParams{ctx, id, updateUser},
+		expectationOrigins: UserRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.Update return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdateExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.Update should be invoked
+func (mmUpdate *mUserRepositoryMockUpdate) Times(n uint64) *mUserRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of UserRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mUserRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_repository.UserRepository
+func (mmUpdate *UserRepositoryMock) Update(ctx context.Context, id int64, updateUser *model.UpdateUserData) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, id, updateUser)
+	}
+
+	mm_params := UserRepositoryMockUpdateParams{ctx, id, updateUser}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdateParams{ctx, id, updateUser}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.updateUser != nil && !minimock.Equal(*mm_want_ptrs.updateUser, mm_got.updateUser) {
+				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter updateUser, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originUpdateUser, *mm_want_ptrs.updateUser, mm_got.updateUser, minimock.Diff(*mm_want_ptrs.updateUser, mm_got.updateUser))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the UserRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, id, updateUser)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to UserRepositoryMock.Update. %v %v %v", ctx, id, updateUser)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished UserRepositoryMock.Update invocations
+func (mmUpdate *UserRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of UserRepositoryMock.Update invocations
+func (mmUpdate *UserRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mUserRepositoryMockUpdate) Calls() []*UserRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+type mUserRepositoryMockUpdatePassword struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdatePasswordExpectation
+	expectations       []*UserRepositoryMockUpdatePasswordExpectation
+
+	callArgs []*UserRepositoryMockUpdatePasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockUpdatePasswordExpectation specifies expectation struct of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockUpdatePasswordParams
+	paramPtrs          *UserRepositoryMockUpdatePasswordParamPtrs
+	expectationOrigins UserRepositoryMockUpdatePasswordExpectationOrigins
+	results            *UserRepositoryMockUpdatePasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockUpdatePasswordParams contains parameters of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordParams struct {
+	ctx            context.Context
+	id             int64
+	hashedPassword string
+}
+
+// UserRepositoryMockUpdatePasswordParamPtrs contains pointers to parameters of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordParamPtrs struct {
+	ctx            *context.Context
+	id             *int64
+	hashedPassword *string
+}
+
+// UserRepositoryMockUpdatePasswordResults contains results of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordResults struct {
+	err error
+}
+
+// UserRepositoryMockUpdatePasswordOrigins contains origins of expectations of the UserRepository.UpdatePassword
+type UserRepositoryMockUpdatePasswordExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originId             string
+	originHashedPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Optional() *mUserRepositoryMockUpdatePassword {
+	mmUpdatePassword.optional = true
+	return mmUpdatePassword
+}
+
+// Expect sets up expected params for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Expect(ctx context.Context, id int64, hashedPassword string) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePassword.defaultExpectation.params = &UserRepositoryMockUpdatePasswordParams{ctx, id, hashedPassword}
+	mmUpdatePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePassword.expectations {
+		if minimock.Equal(e.params, mmUpdatePassword.defaultExpectation.params) {
+			mmUpdatePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePassword.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// ExpectIdParam2 sets up expected param id for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectIdParam2(id int64) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.id = &id
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// ExpectHashedPasswordParam3 sets up expected param hashedPassword for UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) ExpectHashedPasswordParam3(hashedPassword string) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.hashedPassword = &hashedPassword
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originHashedPassword = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Inspect(f func(ctx context.Context, id int64, hashedPassword string)) *mUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdatePassword")
+	}
+
+	mmUpdatePassword.mock.inspectFuncUpdatePassword = f
+
+	return mmUpdatePassword
+}
+
+// Return sets up results that will be returned by UserRepository.UpdatePassword
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Return(err error) *UserRepositoryMock {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &UserRepositoryMockUpdatePasswordExpectation{mock: mmUpdatePassword.mock}
+	}
+	mmUpdatePassword.defaultExpectation.results = &UserRepositoryMockUpdatePasswordResults{err}
+	mmUpdatePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdatePassword method
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Set(f func(ctx context.Context, id int64, hashedPassword string) (err error)) *UserRepositoryMock {
+	if mmUpdatePassword.defaultExpectation != nil {
+		mmUpdatePassword.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdatePassword method")
+	}
+
+	if len(mmUpdatePassword.expectations) > 0 {
+		mmUpdatePassword.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdatePassword method")
+	}
+
+	mmUpdatePassword.mock.funcUpdatePassword = f
+	mmUpdatePassword.mock.funcUpdatePasswordOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword.mock
+}
+
+// When sets expectation for the UserRepository.UpdatePassword which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) When(ctx context.Context, id int64, hashedPassword string) *UserRepositoryMockUpdatePasswordExpectation {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("UserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdatePasswordExpectation{
+		mock:               mmUpdatePassword.mock,
+		params:             &UserRepositoryMockUpdatePasswordParams{ctx, id, hashedPassword},
+		expectationOrigins: UserRepositoryMockUpdatePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdatePassword.expectations = append(mmUpdatePassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.UpdatePassword return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdatePasswordExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdatePasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.UpdatePassword should be invoked
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Times(n uint64) *mUserRepositoryMockUpdatePassword {
+	if n == 0 {
+		mmUpdatePassword.mock.t.Fatalf("Times of UserRepositoryMock.UpdatePassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdatePassword.expectedInvocations, n)
+	mmUpdatePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword
+}
+
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) invocationsDone() bool {
+	if len(mmUpdatePassword.expectations) == 0 && mmUpdatePassword.defaultExpectation == nil && mmUpdatePassword.mock.funcUpdatePassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.mock.afterUpdatePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdatePassword implements mm_repository.UserRepository
+func (mmUpdatePassword *UserRepositoryMock) UpdatePassword(ctx context.Context, id int64, hashedPassword string) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePassword.beforeUpdatePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePassword.afterUpdatePasswordCounter, 1)
+
+	mmUpdatePassword.t.Helper()
+
+	if mmUpdatePassword.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.inspectFuncUpdatePassword(ctx, id, hashedPassword)
+	}
+
+	mm_params := UserRepositoryMockUpdatePasswordParams{ctx, id, hashedPassword}
+
+	// Record call args
+	mmUpdatePassword.UpdatePasswordMock.mutex.Lock()
+	mmUpdatePassword.UpdatePasswordMock.callArgs = append(mmUpdatePassword.UpdatePasswordMock.callArgs, &mm_params)
+	mmUpdatePassword.UpdatePasswordMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePassword.UpdatePasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdatePassword.UpdatePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePassword.UpdatePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdatePasswordParams{ctx, id, hashedPassword}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.hashedPassword != nil && !minimock.Equal(*mm_want_ptrs.hashedPassword, mm_got.hashedPassword) {
+				mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameter hashedPassword, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originHashedPassword, *mm_want_ptrs.hashedPassword, mm_got.hashedPassword, minimock.Diff(*mm_want_ptrs.hashedPassword, mm_got.hashedPassword))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePassword.t.Errorf("UserRepositoryMock.UpdatePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePassword.t.Fatal("No results are set for the UserRepositoryMock.UpdatePassword")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePassword.funcUpdatePassword != nil {
+		return mmUpdatePassword.funcUpdatePassword(ctx, id, hashedPassword)
 	}
+	mmUpdatePassword.t.Fatalf("Unexpected call to UserRepositoryMock.UpdatePassword. %v %v %v", ctx, id, hashedPassword)
+	return
+}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Expect")
+// UpdatePasswordAfterCounter returns a count of finished UserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *UserRepositoryMock) UpdatePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.afterUpdatePasswordCounter)
+}
+
+// UpdatePasswordBeforeCounter returns a count of UserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *UserRepositoryMock) UpdatePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.beforeUpdatePasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdatePassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePassword *mUserRepositoryMockUpdatePassword) Calls() []*UserRepositoryMockUpdatePasswordParams {
+	mmUpdatePassword.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdatePasswordParams, len(mmUpdatePassword.callArgs))
+	copy(argCopy, mmUpdatePassword.callArgs)
+
+	mmUpdatePassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePasswordDone returns true if the count of the UpdatePassword invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdatePasswordDone() bool {
+	if m.UpdatePasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateParamPtrs{}
+	for _, e := range m.UpdatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.updateUser = &updateUser
-	mmUpdate.defaultExpectation.expectationOrigins.originUpdateUser = minimock.CallerInfo(1)
 
-	return mmUpdate
+	return m.UpdatePasswordMock.invocationsDone()
 }
 
-// Inspect accepts an inspector function that has same arguments as the UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) Inspect(f func(ctx context.Context, id int64, updateUser *model.UpdateUserData)) *mUserRepositoryMockUpdate {
-	if mmUpdate.mock.inspectFuncUpdate != nil {
-		mmUpdate.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.Update")
+// MinimockUpdatePasswordInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdatePasswordInspect() {
+	for _, e := range m.UpdatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	mmUpdate.mock.inspectFuncUpdate = f
+	afterUpdatePasswordCounter := mm_atomic.LoadUint64(&m.afterUpdatePasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePasswordMock.defaultExpectation != nil && afterUpdatePasswordCounter < 1 {
+		if m.UpdatePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s", m.UpdatePasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s with params: %#v", m.UpdatePasswordMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePassword != nil && afterUpdatePasswordCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.UpdatePassword at\n%s", m.funcUpdatePasswordOrigin)
+	}
 
-	return mmUpdate
+	if !m.UpdatePasswordMock.invocationsDone() && afterUpdatePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdatePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePasswordMock.expectedInvocations), m.UpdatePasswordMock.expectedInvocationsOrigin, afterUpdatePasswordCounter)
+	}
 }
 
-// Return sets up results that will be returned by UserRepository.Update
-func (mmUpdate *mUserRepositoryMockUpdate) Return(err error) *UserRepositoryMock {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+type mUserRepositoryMockUpdateRole struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateRoleExpectation
+	expectations       []*UserRepositoryMockUpdateRoleExpectation
+
+	callArgs []*UserRepositoryMockUpdateRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserRepositoryMockUpdateRoleExpectation specifies expectation struct of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleExpectation struct {
+	mock               *UserRepositoryMock
+	params             *UserRepositoryMockUpdateRoleParams
+	paramPtrs          *UserRepositoryMockUpdateRoleParamPtrs
+	expectationOrigins UserRepositoryMockUpdateRoleExpectationOrigins
+	results            *UserRepositoryMockUpdateRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserRepositoryMockUpdateRoleParams contains parameters of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleParams struct {
+	ctx  context.Context
+	id   int64
+	role model.Role
+}
+
+// UserRepositoryMockUpdateRoleParamPtrs contains pointers to parameters of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleParamPtrs struct {
+	ctx  *context.Context
+	id   *int64
+	role *model.Role
+}
+
+// UserRepositoryMockUpdateRoleResults contains results of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleResults struct {
+	err error
+}
+
+// UserRepositoryMockUpdateRoleOrigins contains origins of expectations of the UserRepository.UpdateRole
+type UserRepositoryMockUpdateRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originId   string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Optional() *mUserRepositoryMockUpdateRole {
+	mmUpdateRole.optional = true
+	return mmUpdateRole
+}
+
+// Expect sets up expected params for UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Expect(ctx context.Context, id int64, role model.Role) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &UserRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{}
 	}
-	mmUpdate.defaultExpectation.results = &UserRepositoryMockUpdateResults{err}
-	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdate.mock
+
+	if mmUpdateRole.defaultExpectation.paramPtrs != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRole.defaultExpectation.params = &UserRepositoryMockUpdateRoleParams{ctx, id, role}
+	mmUpdateRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateRole.expectations {
+		if minimock.Equal(e.params, mmUpdateRole.defaultExpectation.params) {
+			mmUpdateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRole.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRole
 }
 
-// Set uses given function f to mock the UserRepository.Update method
-func (mmUpdate *mUserRepositoryMockUpdate) Set(f func(ctx context.Context, id int64, updateUser *model.UpdateUserData) (err error)) *UserRepositoryMock {
-	if mmUpdate.defaultExpectation != nil {
-		mmUpdate.mock.t.Fatalf("Default expectation is already set for the UserRepository.Update method")
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
 	}
 
-	if len(mmUpdate.expectations) > 0 {
-		mmUpdate.mock.t.Fatalf("Some expectations are already set for the UserRepository.Update method")
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{}
 	}
 
-	mmUpdate.mock.funcUpdate = f
-	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
-	return mmUpdate.mock
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateRole
 }
 
-// When sets expectation for the UserRepository.Update which will trigger the result defined by the following
+// ExpectIdParam2 sets up expected param id for UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) ExpectIdParam2(id int64) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.id = &id
+	mmUpdateRole.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateRole
+}
+
+// ExpectRoleParam3 sets up expected param role for UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) ExpectRoleParam3(role model.Role) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.role = &role
+	mmUpdateRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmUpdateRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Inspect(f func(ctx context.Context, id int64, role model.Role)) *mUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.inspectFuncUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateRole")
+	}
+
+	mmUpdateRole.mock.inspectFuncUpdateRole = f
+
+	return mmUpdateRole
+}
+
+// Return sets up results that will be returned by UserRepository.UpdateRole
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Return(err error) *UserRepositoryMock {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &UserRepositoryMockUpdateRoleExpectation{mock: mmUpdateRole.mock}
+	}
+	mmUpdateRole.defaultExpectation.results = &UserRepositoryMockUpdateRoleResults{err}
+	mmUpdateRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateRole.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdateRole method
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Set(f func(ctx context.Context, id int64, role model.Role) (err error)) *UserRepositoryMock {
+	if mmUpdateRole.defaultExpectation != nil {
+		mmUpdateRole.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdateRole method")
+	}
+
+	if len(mmUpdateRole.expectations) > 0 {
+		mmUpdateRole.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdateRole method")
+	}
+
+	mmUpdateRole.mock.funcUpdateRole = f
+	mmUpdateRole.mock.funcUpdateRoleOrigin = minimock.CallerInfo(1)
+	return mmUpdateRole.mock
+}
+
+// When sets expectation for the UserRepository.UpdateRole which will trigger the result defined by the following
 // Then helper
-func (mmUpdate *mUserRepositoryMockUpdate) When(ctx context.Context, id int64, updateUser *model.UpdateUserData) *UserRepositoryMockUpdateExpectation {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("UserRepositoryMock.Update mock is already set by Set")
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) When(ctx context.Context, id int64, role model.Role) *UserRepositoryMockUpdateRoleExpectation {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("UserRepositoryMock.UpdateRole mock is already set by Set")
 	}
 
-	expectation := &UserRepositoryMockUpdateExpectation{
-		mock:               mmUpdate.mock,
-		params:             &UserRepositoryMockUpdateParams{ctx, id, updateUser},
-		expectationOrigins: UserRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UserRepositoryMockUpdateRoleExpectation{
+		mock:               mmUpdateRole.mock,
+		params:             &UserRepositoryMockUpdateRoleParams{ctx, id, role},
+		expectationOrigins: UserRepositoryMockUpdateRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	mmUpdateRole.expectations = append(mmUpdateRole.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UserRepository.Update return parameters for the expectation previously defined by the When method
-func (e *UserRepositoryMockUpdateExpectation) Then(err error) *UserRepositoryMock {
-	e.results = &UserRepositoryMockUpdateResults{err}
+// Then sets up UserRepository.UpdateRole return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdateRoleExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdateRoleResults{err}
 	return e.mock
 }
 
-// Times sets number of times UserRepository.Update should be invoked
-func (mmUpdate *mUserRepositoryMockUpdate) Times(n uint64) *mUserRepositoryMockUpdate {
+// Times sets number of times UserRepository.UpdateRole should be invoked
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Times(n uint64) *mUserRepositoryMockUpdateRole {
 	if n == 0 {
-		mmUpdate.mock.t.Fatalf("Times of UserRepositoryMock.Update mock can not be zero")
+		mmUpdateRole.mock.t.Fatalf("Times of UserRepositoryMock.UpdateRole mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
-	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUpdate
+	mm_atomic.StoreUint64(&mmUpdateRole.expectedInvocations, n)
+	mmUpdateRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateRole
 }
 
-func (mmUpdate *mUserRepositoryMockUpdate) invocationsDone() bool {
-	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) invocationsDone() bool {
+	if len(mmUpdateRole.expectations) == 0 && mmUpdateRole.defaultExpectation == nil && mmUpdateRole.mock.funcUpdateRole == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRole.mock.afterUpdateRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRole.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Update implements mm_repository.UserRepository
-func (mmUpdate *UserRepositoryMock) Update(ctx context.Context, id int64, updateUser *model.UpdateUserData) (err error) {
-	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+// UpdateRole implements mm_repository.UserRepository
+func (mmUpdateRole *UserRepositoryMock) UpdateRole(ctx context.Context, id int64, role model.Role) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRole.beforeUpdateRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRole.afterUpdateRoleCounter, 1)
 
-	mmUpdate.t.Helper()
+	mmUpdateRole.t.Helper()
 
-	if mmUpdate.inspectFuncUpdate != nil {
-		mmUpdate.inspectFuncUpdate(ctx, id, updateUser)
+	if mmUpdateRole.inspectFuncUpdateRole != nil {
+		mmUpdateRole.inspectFuncUpdateRole(ctx, id, role)
 	}
 
-	mm_params := UserRepositoryMockUpdateParams{ctx, id, updateUser}
+	mm_params := UserRepositoryMockUpdateRoleParams{ctx, id, role}
 
 	// Record call args
-	mmUpdate.UpdateMock.mutex.Lock()
-	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
-	mmUpdate.UpdateMock.mutex.Unlock()
+	mmUpdateRole.UpdateRoleMock.mutex.Lock()
+	mmUpdateRole.UpdateRoleMock.callArgs = append(mmUpdateRole.UpdateRoleMock.callArgs, &mm_params)
+	mmUpdateRole.UpdateRoleMock.mutex.Unlock()
 
-	for _, e := range mmUpdate.UpdateMock.expectations {
+	for _, e := range mmUpdateRole.UpdateRoleMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUpdate.UpdateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+	if mmUpdateRole.UpdateRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRole.UpdateRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRole.UpdateRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRole.UpdateRoleMock.defaultExpectation.paramPtrs
 
-		mm_got := UserRepositoryMockUpdateParams{ctx, id, updateUser}
+		mm_got := UserRepositoryMockUpdateRoleParams{ctx, id, role}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateRole.t.Errorf("UserRepositoryMock.UpdateRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRole.UpdateRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+				mmUpdateRole.t.Errorf("UserRepositoryMock.UpdateRole got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRole.UpdateRoleMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.updateUser != nil && !minimock.Equal(*mm_want_ptrs.updateUser, mm_got.updateUser) {
-				mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameter updateUser, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originUpdateUser, *mm_want_ptrs.updateUser, mm_got.updateUser, minimock.Diff(*mm_want_ptrs.updateUser, mm_got.updateUser))
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmUpdateRole.t.Errorf("UserRepositoryMock.UpdateRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRole.UpdateRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdate.t.Errorf("UserRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateRole.t.Errorf("UserRepositoryMock.UpdateRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateRole.UpdateRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		mm_results := mmUpdateRole.UpdateRoleMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdate.t.Fatal("No results are set for the UserRepositoryMock.Update")
+			mmUpdateRole.t.Fatal("No results are set for the UserRepositoryMock.UpdateRole")
 		}
 		return (*mm_results).err
 	}
-	if mmUpdate.funcUpdate != nil {
-		return mmUpdate.funcUpdate(ctx, id, updateUser)
+	if mmUpdateRole.funcUpdateRole != nil {
+		return mmUpdateRole.funcUpdateRole(ctx, id, role)
 	}
-	mmUpdate.t.Fatalf("Unexpected call to UserRepositoryMock.Update. %v %v %v", ctx, id, updateUser)
+	mmUpdateRole.t.Fatalf("Unexpected call to UserRepositoryMock.UpdateRole. %v %v %v", ctx, id, role)
 	return
 }
 
-// UpdateAfterCounter returns a count of finished UserRepositoryMock.Update invocations
-func (mmUpdate *UserRepositoryMock) UpdateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+// UpdateRoleAfterCounter returns a count of finished UserRepositoryMock.UpdateRole invocations
+func (mmUpdateRole *UserRepositoryMock) UpdateRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRole.afterUpdateRoleCounter)
 }
 
-// UpdateBeforeCounter returns a count of UserRepositoryMock.Update invocations
-func (mmUpdate *UserRepositoryMock) UpdateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+// UpdateRoleBeforeCounter returns a count of UserRepositoryMock.UpdateRole invocations
+func (mmUpdateRole *UserRepositoryMock) UpdateRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRole.beforeUpdateRoleCounter)
 }
 
-// Calls returns a list of arguments used in each call to UserRepositoryMock.Update.
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdateRole.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdate *mUserRepositoryMockUpdate) Calls() []*UserRepositoryMockUpdateParams {
-	mmUpdate.mutex.RLock()
+func (mmUpdateRole *mUserRepositoryMockUpdateRole) Calls() []*UserRepositoryMockUpdateRoleParams {
+	mmUpdateRole.mutex.RLock()
 
-	argCopy := make([]*UserRepositoryMockUpdateParams, len(mmUpdate.callArgs))
-	copy(argCopy, mmUpdate.callArgs)
+	argCopy := make([]*UserRepositoryMockUpdateRoleParams, len(mmUpdateRole.callArgs))
+	copy(argCopy, mmUpdateRole.callArgs)
 
-	mmUpdate.mutex.RUnlock()
+	mmUpdateRole.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// MinimockUpdateRoleDone returns true if the count of the UpdateRole invocations corresponds
 // the number of defined expectations
-func (m *UserRepositoryMock) MinimockUpdateDone() bool {
-	if m.UpdateMock.optional {
+func (m *UserRepositoryMock) MinimockUpdateRoleDone() bool {
+	if m.UpdateRoleMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateMock.expectations {
+	for _, e := range m.UpdateRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateMock.invocationsDone()
+	return m.UpdateRoleMock.invocationsDone()
 }
 
-// MinimockUpdateInspect logs each unmet expectation
-func (m *UserRepositoryMock) MinimockUpdateInspect() {
-	for _, e := range m.UpdateMock.expectations {
+// MinimockUpdateRoleInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdateRoleInspect() {
+	for _, e := range m.UpdateRoleMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UserRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	afterUpdateRoleCounter := mm_atomic.LoadUint64(&m.afterUpdateRoleCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
-		if m.UpdateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UserRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+	if m.UpdateRoleMock.defaultExpectation != nil && afterUpdateRoleCounter < 1 {
+		if m.UpdateRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateRole at\n%s", m.UpdateRoleMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UserRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateRole at\n%s with params: %#v", m.UpdateRoleMock.defaultExpectation.expectationOrigins.origin, *m.UpdateRoleMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdate != nil && afterUpdateCounter < 1 {
-		m.t.Errorf("Expected call to UserRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	if m.funcUpdateRole != nil && afterUpdateRoleCounter < 1 {
+		m.t.Errorf("Expected call to UserRepositoryMock.UpdateRole at\n%s", m.funcUpdateRoleOrigin)
 	}
 
-	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
-		m.t.Errorf("Expected %d calls to UserRepositoryMock.Update at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	if !m.UpdateRoleMock.invocationsDone() && afterUpdateRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdateRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRoleMock.expectedInvocations), m.UpdateRoleMock.expectedInvocationsOrigin, afterUpdateRoleCounter)
 	}
 }
 
@@ -1478,13 +5419,35 @@ func (m *UserRepositoryMock) MinimockUpdateInspect() {
 func (m *UserRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCountInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockDeleteInspect()
 
 			m.MinimockGetInspect()
 
+			m.MinimockGetByEmailsInspect()
+
+			m.MinimockGetByNamesInspect()
+
+			m.MinimockGetCredentialsInspect()
+
+			m.MinimockGetCredentialsByIDInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockLockByIDInspect()
+
+			m.MinimockLockByRoleInspect()
+
+			m.MinimockMarkEmailVerifiedInspect()
+
 			m.MinimockUpdateInspect()
+
+			m.MinimockUpdatePasswordInspect()
+
+			m.MinimockUpdateRoleInspect()
 		}
 	})
 }
@@ -1508,8 +5471,19 @@ func (m *UserRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UserRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCountDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
 		m.MinimockGetDone() &&
-		m.MinimockUpdateDone()
+		m.MinimockGetByEmailsDone() &&
+		m.MinimockGetByNamesDone() &&
+		m.MinimockGetCredentialsDone() &&
+		m.MinimockGetCredentialsByIDDone() &&
+		m.MinimockListDone() &&
+		m.MinimockLockByIDDone() &&
+		m.MinimockLockByRoleDone() &&
+		m.MinimockMarkEmailVerifiedDone() &&
+		m.MinimockUpdateDone() &&
+		m.MinimockUpdatePasswordDone() &&
+		m.MinimockUpdateRoleDone()
 }
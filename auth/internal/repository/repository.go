@@ -3,6 +3,7 @@ package repository
 import (
 	"auth/internal/model"
 	"context"
+	"time"
 
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 )
@@ -10,8 +11,46 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, createUser *model.CreateUserData) (int64, error)
 	Get(ctx context.Context, id int64) (*model.User, error)
+	GetByNames(ctx context.Context, names []string) ([]*model.User, error)
+	GetByEmails(ctx context.Context, emails []string) ([]*model.User, error)
+	GetCredentials(ctx context.Context, email string) (*model.UserCredentials, error)
+	GetCredentialsByID(ctx context.Context, id int64) (*model.UserCredentials, error)
+	UpdatePassword(ctx context.Context, id int64, hashedPassword string) error
+	UpdateRole(ctx context.Context, id int64, role model.Role) error
+	LockByID(ctx context.Context, id int64) error
+	LockByRole(ctx context.Context, role model.Role) ([]int64, error)
+	MarkEmailVerified(ctx context.Context, id int64) error
 	Delete(ctx context.Context, id int64) error
 	Update(ctx context.Context, id int64, updateUser *model.UpdateUserData) error
+	List(ctx context.Context, query *model.UserListQuery) ([]*model.User, error)
+	Count(ctx context.Context, filter model.UserFilter) (int64, error)
+}
+
+type AccessRepository interface {
+	GetEndpointPermissions(ctx context.Context, endpoint string) ([]model.Permission, error)
+	GetRolePermissions(ctx context.Context, role model.Role) ([]model.Permission, error)
+}
+
+type PasswordResetRepository interface {
+	Create(ctx context.Context, token *model.PasswordResetToken) error
+	Consume(ctx context.Context, tokenHash string) (int64, error)
+	RevokeByUser(ctx context.Context, userID int64) error
+	LastCreatedAt(ctx context.Context, userID int64) (time.Time, error)
+}
+
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, token *model.EmailVerificationToken) error
+	Consume(ctx context.Context, tokenHash string) (int64, error)
+	RevokeByUser(ctx context.Context, userID int64) error
+	LastCreatedAt(ctx context.Context, userID int64) (time.Time, error)
+}
+
+type LoginThrottleRepository interface {
+	Lock(ctx context.Context, key model.ThrottleKey) (*model.LoginThrottle, error)
+	RecordFailure(ctx context.Context, key model.ThrottleKey, at, windowStart time.Time) (int, error)
+	Block(ctx context.Context, key model.ThrottleKey, until time.Time) error
+	Reset(ctx context.Context, key model.ThrottleKey) error
+	Prune(ctx context.Context, windowStart, now time.Time) (int64, error)
 }
 
 type LogRepository interface {
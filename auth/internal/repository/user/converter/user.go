@@ -11,9 +11,21 @@ func ToUserFromRepo(user *modelRepo.User) *model.User {
 		Info:      ToUserInfoFromRepo(user.Info),
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
+
+		SessionVersion:  user.SessionVersion,
+		EmailVerifiedAt: user.EmailVerifiedAt,
 	}
 }
 
+func ToUsersFromRepo(users []*modelRepo.User) []*model.User {
+	res := make([]*model.User, 0, len(users))
+	for _, user := range users {
+		res = append(res, ToUserFromRepo(user))
+	}
+
+	return res
+}
+
 func ToUserInfoFromRepo(info modelRepo.UserInfo) model.UserInfo {
 	return model.UserInfo{
 		Name:  info.Name,
@@ -10,6 +10,9 @@ type User struct {
 	Info      UserInfo     `db:""`
 	CreatedAt time.Time    `db:"created_at"`
 	UpdatedAt sql.NullTime `db:"updated_at"`
+
+	SessionVersion  int64        `db:"session_version"`
+	EmailVerifiedAt sql.NullTime `db:"email_verified_at"`
 }
 
 type UserInfo struct {
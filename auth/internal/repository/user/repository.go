@@ -6,12 +6,16 @@ import (
 	repoConverter "auth/internal/repository/user/converter"
 	modelRepo "auth/internal/repository/user/model"
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/makxtr/go-common/pkg/db"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 )
 
 const (
@@ -24,6 +28,9 @@ const (
 	roleColumn      = "role"
 	createdAtColumn = "created_at"
 	updatedAtColumn = "updated_at"
+
+	sessionVersionColumn  = "session_version"
+	emailVerifiedAtColumn = "email_verified_at"
 )
 
 type repo struct {
@@ -58,7 +65,7 @@ func (r *repo) Create(ctx context.Context, createUser *model.CreateUserData) (in
 }
 
 func (r *repo) Get(ctx context.Context, id int64) (*model.User, error) {
-	builder := sq.Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn).
+	builder := sq.Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn, sessionVersionColumn, emailVerifiedAtColumn).
 		PlaceholderFormat(sq.Dollar).
 		From(tableName).
 		Where(sq.Eq{idColumn: id}).
@@ -75,14 +82,91 @@ func (r *repo) Get(ctx context.Context, id int64) (*model.User, error) {
 	}
 
 	var user modelRepo.User
-	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&user.ID, &user.Info.Name, &user.Info.Email, &user.Info.Role, &user.CreatedAt, &user.UpdatedAt)
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&user.ID, &user.Info.Name, &user.Info.Email, &user.Info.Role, &user.CreatedAt, &user.UpdatedAt, &user.SessionVersion, &user.EmailVerifiedAt)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
 		return nil, err
 	}
 
 	return repoConverter.ToUserFromRepo(&user), nil
 }
 
+func (r *repo) GetByNames(ctx context.Context, names []string) ([]*model.User, error) {
+	return r.getByColumn(ctx, "user_repository.GetByNames", nameColumn, names)
+}
+
+func (r *repo) GetByEmails(ctx context.Context, emails []string) ([]*model.User, error) {
+	return r.getByColumn(ctx, "user_repository.GetByEmails", emailColumn, emails)
+}
+
+// getByColumn fetches every user whose column matches one of the values in a
+// single round trip, the values are sent as one array parameter.
+func (r *repo) getByColumn(ctx context.Context, name, column string, values []string) ([]*model.User, error) {
+	builder := sq.Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn, emailVerifiedAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Expr(column+" = ANY(?)", values)).
+		OrderBy(idColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, repository.ErrQueryBuild
+	}
+
+	var users []*modelRepo.User
+	err = r.db.DB().ScanAllContext(ctx, &users, db.Query{Name: name, QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to get users by %s: %v", column, err)
+		return nil, repository.ErrQueryExec
+	}
+
+	return repoConverter.ToUsersFromRepo(users), nil
+}
+
+func (r *repo) GetCredentials(ctx context.Context, email string) (*model.UserCredentials, error) {
+	return r.getCredentials(ctx, "user_repository.GetCredentials", sq.Eq{emailColumn: email})
+}
+
+func (r *repo) GetCredentialsByID(ctx context.Context, id int64) (*model.UserCredentials, error) {
+	return r.getCredentials(ctx, "user_repository.GetCredentialsByID", sq.Eq{idColumn: id})
+}
+
+func (r *repo) getCredentials(ctx context.Context, name string, where sq.Eq) (*model.UserCredentials, error) {
+	builder := sq.Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn, sessionVersionColumn, emailVerifiedAtColumn, passColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(where).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, repository.ErrQueryBuild
+	}
+
+	q := db.Query{
+		Name:     name,
+		QueryRaw: query,
+	}
+
+	var user modelRepo.User
+	var hashedPassword string
+	err = r.db.DB().QueryRowContext(ctx, q, args...).Scan(&user.ID, &user.Info.Name, &user.Info.Email, &user.Info.Role, &user.CreatedAt, &user.UpdatedAt, &user.SessionVersion, &user.EmailVerifiedAt, &hashedPassword)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &model.UserCredentials{
+		User:           *repoConverter.ToUserFromRepo(&user),
+		HashedPassword: hashedPassword,
+	}, nil
+}
+
 func (r *repo) Update(ctx context.Context, id int64, updateUser *model.UpdateUserData) error {
 	builder := sq.Update(tableName).
 		PlaceholderFormat(sq.Dollar).
@@ -94,7 +178,11 @@ func (r *repo) Update(ctx context.Context, id int64, updateUser *model.UpdateUse
 	}
 
 	if updateUser.Email != nil {
-		builder = builder.Set(emailColumn, updateUser.Email)
+		// A new address has to be verified again, the old row values are
+		// visible on the right-hand side.
+		builder = builder.
+			Set(emailColumn, updateUser.Email).
+			Set(emailVerifiedAtColumn, sq.Expr("CASE WHEN "+emailColumn+" = ? THEN "+emailVerifiedAtColumn+" END", updateUser.Email))
 	}
 
 	query, args, err := builder.ToSql()
@@ -116,6 +204,146 @@ func (r *repo) Update(ctx context.Context, id int64, updateUser *model.UpdateUse
 	return nil
 }
 
+// UpdatePassword stores the new hash and bumps the session version, which
+// invalidates the tokens issued so far.
+func (r *repo) UpdatePassword(ctx context.Context, id int64, hashedPassword string) error {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(passColumn, hashedPassword).
+		Set(sessionVersionColumn, sq.Expr(sessionVersionColumn+" + 1")).
+		Set(updatedAtColumn, time.Now()).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return repository.ErrQueryBuild
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "user_repository.UpdatePassword", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to update password: %v", err)
+		return repository.ErrUpdateFailed
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// UpdateRole stores the role and bumps the session version, tokens issued
+// for the old role are no longer accepted.
+func (r *repo) UpdateRole(ctx context.Context, id int64, role model.Role) error {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(roleColumn, int32(role)).
+		Set(sessionVersionColumn, sq.Expr(sessionVersionColumn+" + 1")).
+		Set(updatedAtColumn, time.Now()).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return repository.ErrQueryBuild
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "user_repository.UpdateRole", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to update role: %v", err)
+		return repository.ErrUpdateFailed
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// LockByID locks the user until the transaction ends, requests acting on the
+// user one at a time wait for each other.
+func (r *repo) LockByID(ctx context.Context, id int64) error {
+	builder := sq.Select(idColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{idColumn: id}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return repository.ErrQueryBuild
+	}
+
+	var lockedID int64
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "user_repository.LockByID", QueryRaw: query}, args...).Scan(&lockedID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrNotFound
+		}
+		log.Printf("failed to lock user: %v", err)
+		return repository.ErrQueryExec
+	}
+
+	return nil
+}
+
+// LockByRole locks the users with the role until the transaction ends and
+// returns their ids. A concurrent role change waits for the lock and then
+// sees the committed roles.
+func (r *repo) LockByRole(ctx context.Context, role model.Role) ([]int64, error) {
+	builder := sq.Select(idColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{roleColumn: int32(role)}).
+		OrderBy(idColumn).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, repository.ErrQueryBuild
+	}
+
+	var ids []int64
+	err = r.db.DB().ScanAllContext(ctx, &ids, db.Query{Name: "user_repository.LockByRole", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to lock users by role: %v", err)
+		return nil, repository.ErrQueryExec
+	}
+
+	return ids, nil
+}
+
+// MarkEmailVerified records that the user owns their email, verifying twice
+// keeps the first timestamp.
+func (r *repo) MarkEmailVerified(ctx context.Context, id int64) error {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(emailVerifiedAtColumn, sq.Expr("COALESCE("+emailVerifiedAtColumn+", ?)", time.Now())).
+		Where(sq.Eq{idColumn: id})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return repository.ErrQueryBuild
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "user_repository.MarkEmailVerified", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to mark email verified: %v", err)
+		return repository.ErrUpdateFailed
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *repo) Delete(ctx context.Context, id int64) error {
 	builder := sq.Delete(tableName).
 		PlaceholderFormat(sq.Dollar).
@@ -139,3 +367,136 @@ func (r *repo) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+func (r *repo) List(ctx context.Context, params *model.UserListQuery) ([]*model.User, error) {
+	column := sortColumn(params.SortField)
+	direction := "ASC"
+	if params.SortDesc {
+		direction = "DESC"
+	}
+
+	builder := sq.Select(idColumn, nameColumn, emailColumn, roleColumn, createdAtColumn, updatedAtColumn, emailVerifiedAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(filterCondition(params.Filter))
+
+	if params.After != nil {
+		builder = builder.Where(afterCondition(column, params.SortDesc, params.After))
+	}
+
+	// id breaks ties so that the keyset order is total.
+	builder = builder.OrderBy(column + " " + direction)
+	if column != idColumn {
+		builder = builder.OrderBy(idColumn + " " + direction)
+	}
+	builder = builder.Limit(params.Limit)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, repository.ErrQueryBuild
+	}
+
+	q := db.Query{
+		Name:     "user_repository.List",
+		QueryRaw: query,
+	}
+
+	var users []*modelRepo.User
+	err = r.db.DB().ScanAllContext(ctx, &users, q, args...)
+	if err != nil {
+		log.Printf("failed to list users: %v", err)
+		return nil, repository.ErrQueryExec
+	}
+
+	return repoConverter.ToUsersFromRepo(users), nil
+}
+
+func (r *repo) Count(ctx context.Context, filter model.UserFilter) (int64, error) {
+	builder := sq.Select("COUNT(*)").
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(filterCondition(filter))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, repository.ErrQueryBuild
+	}
+
+	var count int64
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "user_repository.Count", QueryRaw: query}, args...).Scan(&count)
+	if err != nil {
+		log.Printf("failed to count users: %v", err)
+		return 0, repository.ErrQueryExec
+	}
+
+	return count, nil
+}
+
+func sortColumn(field model.UserSortField) string {
+	switch field {
+	case model.UserSortFieldName:
+		return nameColumn
+	case model.UserSortFieldEmail:
+		return emailColumn
+	case model.UserSortFieldCreatedAt:
+		return createdAtColumn
+	default:
+		return idColumn
+	}
+}
+
+func filterCondition(filter model.UserFilter) sq.And {
+	cond := sq.And{}
+
+	if filter.Role != model.RoleUnspecified {
+		cond = append(cond, sq.Eq{roleColumn: int32(filter.Role)})
+	}
+
+	if len(filter.Search) > 0 {
+		pattern := "%" + likeEscaper.Replace(filter.Search) + "%"
+		cond = append(cond, sq.Or{
+			sq.ILike{nameColumn: pattern},
+			sq.ILike{emailColumn: pattern},
+		})
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		cond = append(cond, sq.GtOrEq{createdAtColumn: filter.CreatedFrom})
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		cond = append(cond, sq.Lt{createdAtColumn: filter.CreatedTo})
+	}
+
+	return cond
+}
+
+// likeEscaper keeps LIKE wildcards typed by the caller literal.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// afterCondition selects the rows that follow the given user in the
+// (column, id) keyset order.
+func afterCondition(column string, desc bool, after *model.User) sq.Sqlizer {
+	op := ">"
+	if desc {
+		op = "<"
+	}
+
+	if column == idColumn {
+		return sq.Expr(fmt.Sprintf("%s %s ?", idColumn, op), after.ID)
+	}
+
+	var value any
+	switch column {
+	case nameColumn:
+		value = after.Info.Name
+	case emailColumn:
+		value = after.Info.Email
+	default:
+		value = after.CreatedAt
+	}
+
+	return sq.Expr(fmt.Sprintf("(%s, %s) %s (?, ?)", column, idColumn, op), value, after.ID)
+}
@@ -0,0 +1,41 @@
+package access
+
+import (
+	"auth/internal/model"
+	"auth/internal/utils"
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serv) Check(ctx context.Context, accessToken, endpoint string) (*model.UserClaims, error) {
+	claims, err := utils.VerifyToken(accessToken, s.jwtConfig.AccessTokenSecretKey())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	required, err := s.accessRepository.GetEndpointPermissions(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Endpoints without explicit permissions are open to any authenticated user.
+	if len(required) == 0 {
+		return claims, nil
+	}
+
+	held, err := s.accessRepository.GetRolePermissions(ctx, claims.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.ContainsFunc(required, func(permission model.Permission) bool {
+		return slices.Contains(held, permission)
+	}) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return claims, nil
+}
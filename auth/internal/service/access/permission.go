@@ -0,0 +1,54 @@
+package access
+
+import (
+	"auth/internal/model"
+	"auth/internal/utils"
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CheckPermission lets the caller through when their role holds the
+// permission, unlike endpoints a permission nobody holds denies everyone.
+func (s *serv) CheckPermission(ctx context.Context, accessToken string, permission model.Permission) (*model.UserClaims, error) {
+	if len(permission) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "permission is required")
+	}
+
+	claims, permissions, err := s.permissions(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(permissions, permission) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return claims, nil
+}
+
+// ListPermissions returns the permissions held by the caller's role.
+func (s *serv) ListPermissions(ctx context.Context, accessToken string) ([]model.Permission, error) {
+	_, permissions, err := s.permissions(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (s *serv) permissions(ctx context.Context, accessToken string) (*model.UserClaims, []model.Permission, error) {
+	claims, err := utils.VerifyToken(accessToken, s.jwtConfig.AccessTokenSecretKey())
+	if err != nil {
+		return nil, nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	permissions, err := s.accessRepository.GetRolePermissions(ctx, claims.Role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return claims, permissions, nil
+}
@@ -0,0 +1,22 @@
+package access
+
+import (
+	"auth/internal/config"
+	"auth/internal/repository"
+	"auth/internal/service"
+)
+
+type serv struct {
+	accessRepository repository.AccessRepository
+	jwtConfig        config.JWTConfig
+}
+
+func NewService(
+	accessRepository repository.AccessRepository,
+	jwtConfig config.JWTConfig,
+) service.AccessService {
+	return &serv{
+		accessRepository: accessRepository,
+		jwtConfig:        jwtConfig,
+	}
+}
@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyCredentials checks the email and password without issuing tokens.
+func (s *serv) VerifyCredentials(ctx context.Context, email, password string) (*model.User, error) {
+	return s.verifyCredentials(ctx, email, password)
+}
+
+// UnlockUser lifts the lockout of the user's account, the caller is checked
+// to be an admin by the auth interceptor. Lockouts of client addresses run
+// out on their own.
+func (s *serv) UnlockUser(ctx context.Context, id int64) error {
+	user, err := s.userRepository.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return status.Error(codes.NotFound, "user not found")
+		}
+		return err
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.loginThrottleRepository.Reset(ctx, accountThrottleKey(user.Info.Email))
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "account_unlocked",
+			EntityID: id,
+		})
+	})
+}
+
+// verifyCredentials checks the password unless the account or the client
+// address is held off by earlier failures. Every failure delays the next
+// attempt exponentially, enough of them lock the key out.
+//
+// The keys stay locked from the block check until the outcome is recorded,
+// so a burst of concurrent attempts is checked one at a time and every one
+// after a failure finds the key blocked.
+func (s *serv) verifyCredentials(ctx context.Context, email, password string) (*model.User, error) {
+	keys := throttleKeys(ctx, email, s.loginThrottleConfig.TrustedProxyHops())
+
+	var (
+		user    *model.User
+		retryIn time.Duration
+	)
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		for _, key := range keys {
+			throttle, errTx := s.loginThrottleRepository.Lock(ctx, key)
+			if errTx != nil {
+				return errTx
+			}
+
+			now := time.Now()
+			if throttle.BlockedUntil.Valid && throttle.BlockedUntil.Time.After(now) {
+				retryIn = max(throttle.BlockedUntil.Time.Sub(now).Round(time.Second), time.Second)
+				return nil
+			}
+		}
+
+		credentials, errTx := s.userRepository.GetCredentials(ctx, email)
+		if errTx != nil && !errors.Is(errTx, repository.ErrNotFound) {
+			return errTx
+		}
+
+		var (
+			found *model.User
+			valid bool
+		)
+		if errTx != nil {
+			// Unknown emails pay for a password check as well, the response
+			// time must not tell whether the account exists.
+			valid = utils.VerifyNoPassword(password)
+		} else {
+			found = &credentials.User
+			valid = utils.VerifyPassword(credentials.HashedPassword, password)
+		}
+
+		if !valid {
+			return s.recordFailure(ctx, keys, found)
+		}
+
+		// The address keeps its failures, one good account must not clear
+		// the record of a client guessing at others.
+		errTx = s.loginThrottleRepository.Reset(ctx, keys[0])
+		if errTx != nil {
+			return errTx
+		}
+
+		user = found
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if retryIn > 0 {
+		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("too many failed attempts, retry in %s", retryIn))
+	}
+
+	// The failure is committed with the transaction, only then the caller
+	// hears about it.
+	if user == nil {
+		s.pruneThrottles(ctx)
+		return nil, errInvalidCredentials
+	}
+
+	return user, nil
+}
+
+// recordFailure counts the failure against every key and blocks each for its
+// backoff delay, or locks it out once it reached its limit. Callers are
+// expected to run it inside the transaction holding the keys.
+func (s *serv) recordFailure(ctx context.Context, keys []model.ThrottleKey, user *model.User) error {
+	now := time.Now()
+	windowStart := now.Add(-s.loginThrottleConfig.FailureWindow())
+
+	for _, key := range keys {
+		failures, err := s.loginThrottleRepository.RecordFailure(ctx, key, now, windowStart)
+		if err != nil {
+			return err
+		}
+
+		delay, locked := s.blockDelay(key.Scope, failures)
+		err = s.loginThrottleRepository.Block(ctx, key, now.Add(delay))
+		if err != nil {
+			return err
+		}
+
+		if !locked {
+			continue
+		}
+
+		if key.Scope == model.ThrottleScopeIP {
+			log.Printf("client %s locked out for %s after %d failed attempts", key.Subject, delay, failures)
+			continue
+		}
+
+		// Unknown emails are locked out all the same, there is just no user
+		// to log it for.
+		if user == nil {
+			continue
+		}
+
+		log.Printf("user %d locked out for %s after %d failed attempts", user.ID, delay, failures)
+		err = s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "account_locked",
+			EntityID: user.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneThrottles drops the keys whose failures left the window, every
+// failed attempt adds a key, unknown emails included. A failed prune is left
+// to the next failure.
+func (s *serv) pruneThrottles(ctx context.Context) {
+	now := time.Now()
+	pruned, err := s.loginThrottleRepository.Prune(ctx, now.Add(-s.loginThrottleConfig.FailureWindow()), now)
+	if err != nil {
+		log.Printf("failed to prune login throttles: %v", err)
+		return
+	}
+
+	if pruned > 0 {
+		log.Printf("pruned %d login throttles", pruned)
+	}
+}
+
+// blockDelay is the lockout once the failures reach the scope's limit and
+// the doubling backoff delay before that.
+func (s *serv) blockDelay(scope model.ThrottleScope, failures int) (time.Duration, bool) {
+	limit := s.loginThrottleConfig.MaxAccountFailures()
+	if scope == model.ThrottleScopeIP {
+		limit = s.loginThrottleConfig.MaxIPFailures()
+	}
+
+	if failures >= limit {
+		return s.loginThrottleConfig.LockoutDuration(), true
+	}
+
+	backoffMax := s.loginThrottleConfig.BackoffMax()
+	delay := s.loginThrottleConfig.BackoffBase()
+	for i := 1; i < failures && delay < backoffMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, backoffMax), false
+}
+
+// throttleKeys returns the account key first, followed by the client address
+// key when the address is known.
+func throttleKeys(ctx context.Context, email string, trustedProxyHops int) []model.ThrottleKey {
+	keys := []model.ThrottleKey{accountThrottleKey(email)}
+
+	if ip := utils.ClientIPFromContext(ctx, trustedProxyHops); len(ip) > 0 {
+		keys = append(keys, model.ThrottleKey{Scope: model.ThrottleScopeIP, Subject: ip})
+	}
+
+	return keys
+}
+
+// accountThrottleKey normalizes the email so that changing its case doesn't
+// buy new attempts.
+func accountThrottleKey(email string) model.ThrottleKey {
+	return model.ThrottleKey{
+		Scope:   model.ThrottleScopeAccount,
+		Subject: strings.ToLower(strings.TrimSpace(email)),
+	}
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"auth/internal/mailer"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalidResetToken = status.Error(codes.InvalidArgument, "invalid or expired token")
+
+// RequestPasswordReset mails a reset token to the user with the email. The
+// caller learns nothing about the account: unknown emails and mail delivery
+// failures end the same way as a sent token, and the token is issued in the
+// background so that the response time doesn't tell either.
+func (s *serv) RequestPasswordReset(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	if len(email) == 0 {
+		return status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	users, err := s.userRepository.GetByEmails(ctx, []string{email})
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return nil
+	}
+	user := users[0]
+
+	utils.InBackground(ctx, func(ctx context.Context) {
+		errReset := s.sendPasswordReset(ctx, user)
+		if errReset != nil {
+			log.Printf("failed to issue password reset for user %d: %v", user.ID, errReset)
+		}
+	})
+
+	return nil
+}
+
+// sendPasswordReset replaces the outstanding reset tokens of the user with a
+// new one and mails it, unless the last mail went out within the resend
+// interval. The user stays locked from the check until the new token is
+// stored, so concurrent requests send one mail.
+func (s *serv) sendPasswordReset(ctx context.Context, user *model.User) error {
+	token, tokenHash, err := utils.NewToken()
+	if err != nil {
+		return err
+	}
+
+	var issued bool
+	ttl := s.passwordResetConfig.TokenTTL()
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.userRepository.LockByID(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		lastSentAt, errTx := s.passwordResetRepository.LastCreatedAt(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+		if time.Since(lastSentAt) < s.passwordResetConfig.ResendInterval() {
+			log.Printf("password reset mail to user %d throttled, last one was sent at %s", user.ID, lastSentAt)
+			return nil
+		}
+
+		// Only the newest mail carries a usable token.
+		errTx = s.passwordResetRepository.RevokeByUser(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		now := time.Now()
+		errTx = s.passwordResetRepository.Create(ctx, &model.PasswordResetToken{
+			UserID:    user.ID,
+			TokenHash: tokenHash,
+			ExpiresAt: now.Add(ttl),
+			CreatedAt: now,
+		})
+		if errTx != nil {
+			return errTx
+		}
+		issued = true
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "password_reset_requested",
+			EntityID: user.ID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if !issued {
+		return nil
+	}
+
+	return s.mailer.Send(ctx, &mailer.Message{
+		To:      user.Info.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\n"+
+			"use this token to reset your password, it expires in %s:\n\n%s\n\n"+
+			"If you did not ask for a reset, ignore this email, your password stays the same.\n",
+			user.Info.Name, ttl, token),
+	})
+}
+
+// ConfirmPasswordReset sets the new password of the token's user, the token
+// and any other outstanding token of the user can't be used again.
+func (s *serv) ConfirmPasswordReset(ctx context.Context, command *model.PasswordResetCommand) error {
+	if err := command.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hashedPassword, err := utils.HashPassword(command.Password)
+	if err != nil {
+		log.Printf("failed to hash password: %v", err)
+		return status.Error(codes.Internal, "failed to hash password")
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		userID, errTx := s.passwordResetRepository.Consume(ctx, utils.HashToken(command.Token))
+		if errTx != nil {
+			if errors.Is(errTx, repository.ErrNotFound) {
+				return errInvalidResetToken
+			}
+			return errTx
+		}
+
+		errTx = s.userRepository.UpdatePassword(ctx, userID, hashedPassword)
+		if errTx != nil {
+			if errors.Is(errTx, repository.ErrNotFound) {
+				return errInvalidResetToken
+			}
+			return errTx
+		}
+
+		errTx = s.passwordResetRepository.RevokeByUser(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "password_reset_confirmed",
+			EntityID: userID,
+		})
+	})
+}
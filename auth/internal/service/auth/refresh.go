@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/utils"
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serv) GetRefreshToken(ctx context.Context, refreshToken string) (string, error) {
+	user, err := s.userFromRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := utils.GenerateToken(user, s.jwtConfig.RefreshTokenSecretKey(), s.jwtConfig.RefreshTokenExpiration())
+	if err != nil {
+		log.Printf("failed to generate refresh token: %v", err)
+		return "", status.Error(codes.Internal, "failed to generate token")
+	}
+
+	return token, nil
+}
+
+func (s *serv) GetAccessToken(ctx context.Context, refreshToken string) (string, error) {
+	user, err := s.userFromRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := utils.GenerateToken(user, s.jwtConfig.AccessTokenSecretKey(), s.jwtConfig.AccessTokenExpiration())
+	if err != nil {
+		log.Printf("failed to generate access token: %v", err)
+		return "", status.Error(codes.Internal, "failed to generate token")
+	}
+
+	return token, nil
+}
+
+// userFromRefreshToken validates the refresh token and reloads the user so that
+// new tokens carry the current name and role rather than the ones baked into the old token.
+// Tokens issued before the last password change are rejected, and so are the
+// tokens of unverified users while verification is required.
+func (s *serv) userFromRefreshToken(ctx context.Context, refreshToken string) (*model.User, error) {
+	claims, err := utils.VerifyToken(refreshToken, s.jwtConfig.RefreshTokenSecretKey())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	user, err := s.userRepository.Get(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		}
+		return nil, err
+	}
+
+	if claims.SessionVersion != user.SessionVersion {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	if !s.emailVerified(user) {
+		return nil, errEmailNotVerified
+	}
+
+	return user, nil
+}
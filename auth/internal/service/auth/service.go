@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"auth/internal/config"
+	"auth/internal/mailer"
+	"auth/internal/repository"
+	"auth/internal/service"
+
+	"github.com/makxtr/go-common/pkg/db"
+)
+
+type serv struct {
+	userRepository          repository.UserRepository
+	passwordResetRepository repository.PasswordResetRepository
+	loginThrottleRepository repository.LoginThrottleRepository
+	logRepository           repository.LogRepository
+	txManager               db.TxManager
+	mailer                  mailer.Mailer
+	jwtConfig               config.JWTConfig
+	passwordResetConfig     config.PasswordResetConfig
+	emailVerificationConfig config.EmailVerificationConfig
+	loginThrottleConfig     config.LoginThrottleConfig
+}
+
+func NewService(
+	userRepository repository.UserRepository,
+	passwordResetRepository repository.PasswordResetRepository,
+	loginThrottleRepository repository.LoginThrottleRepository,
+	logRepository repository.LogRepository,
+	txManager db.TxManager,
+	mailer mailer.Mailer,
+	jwtConfig config.JWTConfig,
+	passwordResetConfig config.PasswordResetConfig,
+	emailVerificationConfig config.EmailVerificationConfig,
+	loginThrottleConfig config.LoginThrottleConfig,
+) service.AuthService {
+	return &serv{
+		userRepository:          userRepository,
+		passwordResetRepository: passwordResetRepository,
+		loginThrottleRepository: loginThrottleRepository,
+		logRepository:           logRepository,
+		txManager:               txManager,
+		mailer:                  mailer,
+		jwtConfig:               jwtConfig,
+		passwordResetConfig:     passwordResetConfig,
+		emailVerificationConfig: emailVerificationConfig,
+		loginThrottleConfig:     loginThrottleConfig,
+	}
+}
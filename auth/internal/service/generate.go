@@ -0,0 +1,3 @@
+package service
+
+//go:generate minimock -i AuthService -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,2613 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i auth/internal/service.AuthService -o auth_service_minimock.go -n AuthServiceMock -p mocks
+
+import (
+	"auth/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuthServiceMock implements mm_service.AuthService
+type AuthServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConfirmPasswordReset          func(ctx context.Context, command *model.PasswordResetCommand) (err error)
+	funcConfirmPasswordResetOrigin    string
+	inspectFuncConfirmPasswordReset   func(ctx context.Context, command *model.PasswordResetCommand)
+	afterConfirmPasswordResetCounter  uint64
+	beforeConfirmPasswordResetCounter uint64
+	ConfirmPasswordResetMock          mAuthServiceMockConfirmPasswordReset
+
+	funcGetAccessToken          func(ctx context.Context, refreshToken string) (s1 string, err error)
+	funcGetAccessTokenOrigin    string
+	inspectFuncGetAccessToken   func(ctx context.Context, refreshToken string)
+	afterGetAccessTokenCounter  uint64
+	beforeGetAccessTokenCounter uint64
+	GetAccessTokenMock          mAuthServiceMockGetAccessToken
+
+	funcGetRefreshToken          func(ctx context.Context, refreshToken string) (s1 string, err error)
+	funcGetRefreshTokenOrigin    string
+	inspectFuncGetRefreshToken   func(ctx context.Context, refreshToken string)
+	afterGetRefreshTokenCounter  uint64
+	beforeGetRefreshTokenCounter uint64
+	GetRefreshTokenMock          mAuthServiceMockGetRefreshToken
+
+	funcLogin          func(ctx context.Context, email string, password string) (ap1 *model.AuthTokens, err error)
+	funcLoginOrigin    string
+	inspectFuncLogin   func(ctx context.Context, email string, password string)
+	afterLoginCounter  uint64
+	beforeLoginCounter uint64
+	LoginMock          mAuthServiceMockLogin
+
+	funcRequestPasswordReset          func(ctx context.Context, email string) (err error)
+	funcRequestPasswordResetOrigin    string
+	inspectFuncRequestPasswordReset   func(ctx context.Context, email string)
+	afterRequestPasswordResetCounter  uint64
+	beforeRequestPasswordResetCounter uint64
+	RequestPasswordResetMock          mAuthServiceMockRequestPasswordReset
+
+	funcUnlockUser          func(ctx context.Context, id int64) (err error)
+	funcUnlockUserOrigin    string
+	inspectFuncUnlockUser   func(ctx context.Context, id int64)
+	afterUnlockUserCounter  uint64
+	beforeUnlockUserCounter uint64
+	UnlockUserMock          mAuthServiceMockUnlockUser
+
+	funcVerifyCredentials          func(ctx context.Context, email string, password string) (up1 *model.User, err error)
+	funcVerifyCredentialsOrigin    string
+	inspectFuncVerifyCredentials   func(ctx context.Context, email string, password string)
+	afterVerifyCredentialsCounter  uint64
+	beforeVerifyCredentialsCounter uint64
+	VerifyCredentialsMock          mAuthServiceMockVerifyCredentials
+}
+
+// NewAuthServiceMock returns a mock for mm_service.AuthService
+func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
+	m := &AuthServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConfirmPasswordResetMock = mAuthServiceMockConfirmPasswordReset{mock: m}
+	m.ConfirmPasswordResetMock.callArgs = []*AuthServiceMockConfirmPasswordResetParams{}
+
+	m.GetAccessTokenMock = mAuthServiceMockGetAccessToken{mock: m}
+	m.GetAccessTokenMock.callArgs = []*AuthServiceMockGetAccessTokenParams{}
+
+	m.GetRefreshTokenMock = mAuthServiceMockGetRefreshToken{mock: m}
+	m.GetRefreshTokenMock.callArgs = []*AuthServiceMockGetRefreshTokenParams{}
+
+	m.LoginMock = mAuthServiceMockLogin{mock: m}
+	m.LoginMock.callArgs = []*AuthServiceMockLoginParams{}
+
+	m.RequestPasswordResetMock = mAuthServiceMockRequestPasswordReset{mock: m}
+	m.RequestPasswordResetMock.callArgs = []*AuthServiceMockRequestPasswordResetParams{}
+
+	m.UnlockUserMock = mAuthServiceMockUnlockUser{mock: m}
+	m.UnlockUserMock.callArgs = []*AuthServiceMockUnlockUserParams{}
+
+	m.VerifyCredentialsMock = mAuthServiceMockVerifyCredentials{mock: m}
+	m.VerifyCredentialsMock.callArgs = []*AuthServiceMockVerifyCredentialsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthServiceMockConfirmPasswordReset struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockConfirmPasswordResetExpectation
+	expectations       []*AuthServiceMockConfirmPasswordResetExpectation
+
+	callArgs []*AuthServiceMockConfirmPasswordResetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockConfirmPasswordResetExpectation specifies expectation struct of the AuthService.ConfirmPasswordReset
+type AuthServiceMockConfirmPasswordResetExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockConfirmPasswordResetParams
+	paramPtrs          *AuthServiceMockConfirmPasswordResetParamPtrs
+	expectationOrigins AuthServiceMockConfirmPasswordResetExpectationOrigins
+	results            *AuthServiceMockConfirmPasswordResetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockConfirmPasswordResetParams contains parameters of the AuthService.ConfirmPasswordReset
+type AuthServiceMockConfirmPasswordResetParams struct {
+	ctx     context.Context
+	command *model.PasswordResetCommand
+}
+
+// AuthServiceMockConfirmPasswordResetParamPtrs contains pointers to parameters of the AuthService.ConfirmPasswordReset
+type AuthServiceMockConfirmPasswordResetParamPtrs struct {
+	ctx     *context.Context
+	command **model.PasswordResetCommand
+}
+
+// AuthServiceMockConfirmPasswordResetResults contains results of the AuthService.ConfirmPasswordReset
+type AuthServiceMockConfirmPasswordResetResults struct {
+	err error
+}
+
+// AuthServiceMockConfirmPasswordResetOrigins contains origins of expectations of the AuthService.ConfirmPasswordReset
+type AuthServiceMockConfirmPasswordResetExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originCommand string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) Optional() *mAuthServiceMockConfirmPasswordReset {
+	mmConfirmPasswordReset.optional = true
+	return mmConfirmPasswordReset
+}
+
+// Expect sets up expected params for AuthService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) Expect(ctx context.Context, command *model.PasswordResetCommand) *mAuthServiceMockConfirmPasswordReset {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("AuthServiceMock.ConfirmPasswordReset mock is already set by Set")
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation == nil {
+		mmConfirmPasswordReset.defaultExpectation = &AuthServiceMockConfirmPasswordResetExpectation{}
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation.paramPtrs != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("AuthServiceMock.ConfirmPasswordReset mock is already set by ExpectParams functions")
+	}
+
+	mmConfirmPasswordReset.defaultExpectation.params = &AuthServiceMockConfirmPasswordResetParams{ctx, command}
+	mmConfirmPasswordReset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConfirmPasswordReset.expectations {
+		if minimock.Equal(e.params, mmConfirmPasswordReset.defaultExpectation.params) {
+			mmConfirmPasswordReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConfirmPasswordReset.defaultExpectation.params)
+		}
+	}
+
+	return mmConfirmPasswordReset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockConfirmPasswordReset {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("AuthServiceMock.ConfirmPasswordReset mock is already set by Set")
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation == nil {
+		mmConfirmPasswordReset.defaultExpectation = &AuthServiceMockConfirmPasswordResetExpectation{}
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation.params != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("AuthServiceMock.ConfirmPasswordReset mock is already set by Expect")
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmConfirmPasswordReset.defaultExpectation.paramPtrs = &AuthServiceMockConfirmPasswordResetParamPtrs{}
+	}
+	mmConfirmPasswordReset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConfirmPasswordReset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConfirmPasswordReset
+}
+
+// ExpectCommandParam2 sets up expected param command for AuthService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) ExpectCommandParam2(command *model.PasswordResetCommand) *mAuthServiceMockConfirmPasswordReset {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("AuthServiceMock.ConfirmPasswordReset mock is already set by Set")
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation == nil {
+		mmConfirmPasswordReset.defaultExpectation = &AuthServiceMockConfirmPasswordResetExpectation{}
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation.params != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("AuthServiceMock.ConfirmPasswordReset mock is already set by Expect")
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmConfirmPasswordReset.defaultExpectation.paramPtrs = &AuthServiceMockConfirmPasswordResetParamPtrs{}
+	}
+	mmConfirmPasswordReset.defaultExpectation.paramPtrs.command = &command
+	mmConfirmPasswordReset.defaultExpectation.expectationOrigins.originCommand = minimock.CallerInfo(1)
+
+	return mmConfirmPasswordReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) Inspect(f func(ctx context.Context, command *model.PasswordResetCommand)) *mAuthServiceMockConfirmPasswordReset {
+	if mmConfirmPasswordReset.mock.inspectFuncConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.ConfirmPasswordReset")
+	}
+
+	mmConfirmPasswordReset.mock.inspectFuncConfirmPasswordReset = f
+
+	return mmConfirmPasswordReset
+}
+
+// Return sets up results that will be returned by AuthService.ConfirmPasswordReset
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) Return(err error) *AuthServiceMock {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("AuthServiceMock.ConfirmPasswordReset mock is already set by Set")
+	}
+
+	if mmConfirmPasswordReset.defaultExpectation == nil {
+		mmConfirmPasswordReset.defaultExpectation = &AuthServiceMockConfirmPasswordResetExpectation{mock: mmConfirmPasswordReset.mock}
+	}
+	mmConfirmPasswordReset.defaultExpectation.results = &AuthServiceMockConfirmPasswordResetResults{err}
+	mmConfirmPasswordReset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConfirmPasswordReset.mock
+}
+
+// Set uses given function f to mock the AuthService.ConfirmPasswordReset method
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) Set(f func(ctx context.Context, command *model.PasswordResetCommand) (err error)) *AuthServiceMock {
+	if mmConfirmPasswordReset.defaultExpectation != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("Default expectation is already set for the AuthService.ConfirmPasswordReset method")
+	}
+
+	if len(mmConfirmPasswordReset.expectations) > 0 {
+		mmConfirmPasswordReset.mock.t.Fatalf("Some expectations are already set for the AuthService.ConfirmPasswordReset method")
+	}
+
+	mmConfirmPasswordReset.mock.funcConfirmPasswordReset = f
+	mmConfirmPasswordReset.mock.funcConfirmPasswordResetOrigin = minimock.CallerInfo(1)
+	return mmConfirmPasswordReset.mock
+}
+
+// When sets expectation for the AuthService.ConfirmPasswordReset which will trigger the result defined by the following
+// Then helper
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) When(ctx context.Context, command *model.PasswordResetCommand) *AuthServiceMockConfirmPasswordResetExpectation {
+	if mmConfirmPasswordReset.mock.funcConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.mock.t.Fatalf("AuthServiceMock.ConfirmPasswordReset mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockConfirmPasswordResetExpectation{
+		mock:               mmConfirmPasswordReset.mock,
+		params:             &AuthServiceMockConfirmPasswordResetParams{ctx, command},
+		expectationOrigins: AuthServiceMockConfirmPasswordResetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConfirmPasswordReset.expectations = append(mmConfirmPasswordReset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.ConfirmPasswordReset return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockConfirmPasswordResetExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockConfirmPasswordResetResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.ConfirmPasswordReset should be invoked
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) Times(n uint64) *mAuthServiceMockConfirmPasswordReset {
+	if n == 0 {
+		mmConfirmPasswordReset.mock.t.Fatalf("Times of AuthServiceMock.ConfirmPasswordReset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConfirmPasswordReset.expectedInvocations, n)
+	mmConfirmPasswordReset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConfirmPasswordReset
+}
+
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) invocationsDone() bool {
+	if len(mmConfirmPasswordReset.expectations) == 0 && mmConfirmPasswordReset.defaultExpectation == nil && mmConfirmPasswordReset.mock.funcConfirmPasswordReset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConfirmPasswordReset.mock.afterConfirmPasswordResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConfirmPasswordReset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConfirmPasswordReset implements mm_service.AuthService
+func (mmConfirmPasswordReset *AuthServiceMock) ConfirmPasswordReset(ctx context.Context, command *model.PasswordResetCommand) (err error) {
+	mm_atomic.AddUint64(&mmConfirmPasswordReset.beforeConfirmPasswordResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmConfirmPasswordReset.afterConfirmPasswordResetCounter, 1)
+
+	mmConfirmPasswordReset.t.Helper()
+
+	if mmConfirmPasswordReset.inspectFuncConfirmPasswordReset != nil {
+		mmConfirmPasswordReset.inspectFuncConfirmPasswordReset(ctx, command)
+	}
+
+	mm_params := AuthServiceMockConfirmPasswordResetParams{ctx, command}
+
+	// Record call args
+	mmConfirmPasswordReset.ConfirmPasswordResetMock.mutex.Lock()
+	mmConfirmPasswordReset.ConfirmPasswordResetMock.callArgs = append(mmConfirmPasswordReset.ConfirmPasswordResetMock.callArgs, &mm_params)
+	mmConfirmPasswordReset.ConfirmPasswordResetMock.mutex.Unlock()
+
+	for _, e := range mmConfirmPasswordReset.ConfirmPasswordResetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.params
+		mm_want_ptrs := mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockConfirmPasswordResetParams{ctx, command}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConfirmPasswordReset.t.Errorf("AuthServiceMock.ConfirmPasswordReset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.command != nil && !minimock.Equal(*mm_want_ptrs.command, mm_got.command) {
+				mmConfirmPasswordReset.t.Errorf("AuthServiceMock.ConfirmPasswordReset got unexpected parameter command, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.expectationOrigins.originCommand, *mm_want_ptrs.command, mm_got.command, minimock.Diff(*mm_want_ptrs.command, mm_got.command))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConfirmPasswordReset.t.Errorf("AuthServiceMock.ConfirmPasswordReset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConfirmPasswordReset.ConfirmPasswordResetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConfirmPasswordReset.t.Fatal("No results are set for the AuthServiceMock.ConfirmPasswordReset")
+		}
+		return (*mm_results).err
+	}
+	if mmConfirmPasswordReset.funcConfirmPasswordReset != nil {
+		return mmConfirmPasswordReset.funcConfirmPasswordReset(ctx, command)
+	}
+	mmConfirmPasswordReset.t.Fatalf("Unexpected call to AuthServiceMock.ConfirmPasswordReset. %v %v", ctx, command)
+	return
+}
+
+// ConfirmPasswordResetAfterCounter returns a count of finished AuthServiceMock.ConfirmPasswordReset invocations
+func (mmConfirmPasswordReset *AuthServiceMock) ConfirmPasswordResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmPasswordReset.afterConfirmPasswordResetCounter)
+}
+
+// ConfirmPasswordResetBeforeCounter returns a count of AuthServiceMock.ConfirmPasswordReset invocations
+func (mmConfirmPasswordReset *AuthServiceMock) ConfirmPasswordResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmPasswordReset.beforeConfirmPasswordResetCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.ConfirmPasswordReset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConfirmPasswordReset *mAuthServiceMockConfirmPasswordReset) Calls() []*AuthServiceMockConfirmPasswordResetParams {
+	mmConfirmPasswordReset.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockConfirmPasswordResetParams, len(mmConfirmPasswordReset.callArgs))
+	copy(argCopy, mmConfirmPasswordReset.callArgs)
+
+	mmConfirmPasswordReset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConfirmPasswordResetDone returns true if the count of the ConfirmPasswordReset invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockConfirmPasswordResetDone() bool {
+	if m.ConfirmPasswordResetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConfirmPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConfirmPasswordResetMock.invocationsDone()
+}
+
+// MinimockConfirmPasswordResetInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockConfirmPasswordResetInspect() {
+	for _, e := range m.ConfirmPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.ConfirmPasswordReset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConfirmPasswordResetCounter := mm_atomic.LoadUint64(&m.afterConfirmPasswordResetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConfirmPasswordResetMock.defaultExpectation != nil && afterConfirmPasswordResetCounter < 1 {
+		if m.ConfirmPasswordResetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.ConfirmPasswordReset at\n%s", m.ConfirmPasswordResetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.ConfirmPasswordReset at\n%s with params: %#v", m.ConfirmPasswordResetMock.defaultExpectation.expectationOrigins.origin, *m.ConfirmPasswordResetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConfirmPasswordReset != nil && afterConfirmPasswordResetCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.ConfirmPasswordReset at\n%s", m.funcConfirmPasswordResetOrigin)
+	}
+
+	if !m.ConfirmPasswordResetMock.invocationsDone() && afterConfirmPasswordResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.ConfirmPasswordReset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConfirmPasswordResetMock.expectedInvocations), m.ConfirmPasswordResetMock.expectedInvocationsOrigin, afterConfirmPasswordResetCounter)
+	}
+}
+
+type mAuthServiceMockGetAccessToken struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetAccessTokenExpectation
+	expectations       []*AuthServiceMockGetAccessTokenExpectation
+
+	callArgs []*AuthServiceMockGetAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetAccessTokenExpectation specifies expectation struct of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetAccessTokenParams
+	paramPtrs          *AuthServiceMockGetAccessTokenParamPtrs
+	expectationOrigins AuthServiceMockGetAccessTokenExpectationOrigins
+	results            *AuthServiceMockGetAccessTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetAccessTokenParams contains parameters of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenParams struct {
+	ctx          context.Context
+	refreshToken string
+}
+
+// AuthServiceMockGetAccessTokenParamPtrs contains pointers to parameters of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
+}
+
+// AuthServiceMockGetAccessTokenResults contains results of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenResults struct {
+	s1  string
+	err error
+}
+
+// AuthServiceMockGetAccessTokenOrigins contains origins of expectations of the AuthService.GetAccessToken
+type AuthServiceMockGetAccessTokenExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Optional() *mAuthServiceMockGetAccessToken {
+	mmGetAccessToken.optional = true
+	return mmGetAccessToken
+}
+
+// Expect sets up expected params for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Expect(ctx context.Context, refreshToken string) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmGetAccessToken.defaultExpectation.params = &AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+	mmGetAccessToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAccessToken.expectations {
+		if minimock.Equal(e.params, mmGetAccessToken.defaultExpectation.params) {
+			mmGetAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAccessToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.params != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Expect")
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs == nil {
+		mmGetAccessToken.defaultExpectation.paramPtrs = &AuthServiceMockGetAccessTokenParamPtrs{}
+	}
+	mmGetAccessToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAccessToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAccessToken
+}
+
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) ExpectRefreshTokenParam2(refreshToken string) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{}
+	}
+
+	if mmGetAccessToken.defaultExpectation.params != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Expect")
+	}
+
+	if mmGetAccessToken.defaultExpectation.paramPtrs == nil {
+		mmGetAccessToken.defaultExpectation.paramPtrs = &AuthServiceMockGetAccessTokenParamPtrs{}
+	}
+	mmGetAccessToken.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmGetAccessToken.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmGetAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Inspect(f func(ctx context.Context, refreshToken string)) *mAuthServiceMockGetAccessToken {
+	if mmGetAccessToken.mock.inspectFuncGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetAccessToken")
+	}
+
+	mmGetAccessToken.mock.inspectFuncGetAccessToken = f
+
+	return mmGetAccessToken
+}
+
+// Return sets up results that will be returned by AuthService.GetAccessToken
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Return(s1 string, err error) *AuthServiceMock {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	if mmGetAccessToken.defaultExpectation == nil {
+		mmGetAccessToken.defaultExpectation = &AuthServiceMockGetAccessTokenExpectation{mock: mmGetAccessToken.mock}
+	}
+	mmGetAccessToken.defaultExpectation.results = &AuthServiceMockGetAccessTokenResults{s1, err}
+	mmGetAccessToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken.mock
+}
+
+// Set uses given function f to mock the AuthService.GetAccessToken method
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Set(f func(ctx context.Context, refreshToken string) (s1 string, err error)) *AuthServiceMock {
+	if mmGetAccessToken.defaultExpectation != nil {
+		mmGetAccessToken.mock.t.Fatalf("Default expectation is already set for the AuthService.GetAccessToken method")
+	}
+
+	if len(mmGetAccessToken.expectations) > 0 {
+		mmGetAccessToken.mock.t.Fatalf("Some expectations are already set for the AuthService.GetAccessToken method")
+	}
+
+	mmGetAccessToken.mock.funcGetAccessToken = f
+	mmGetAccessToken.mock.funcGetAccessTokenOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken.mock
+}
+
+// When sets expectation for the AuthService.GetAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) When(ctx context.Context, refreshToken string) *AuthServiceMockGetAccessTokenExpectation {
+	if mmGetAccessToken.mock.funcGetAccessToken != nil {
+		mmGetAccessToken.mock.t.Fatalf("AuthServiceMock.GetAccessToken mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetAccessTokenExpectation{
+		mock:               mmGetAccessToken.mock,
+		params:             &AuthServiceMockGetAccessTokenParams{ctx, refreshToken},
+		expectationOrigins: AuthServiceMockGetAccessTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAccessToken.expectations = append(mmGetAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetAccessToken return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetAccessTokenExpectation) Then(s1 string, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetAccessTokenResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetAccessToken should be invoked
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Times(n uint64) *mAuthServiceMockGetAccessToken {
+	if n == 0 {
+		mmGetAccessToken.mock.t.Fatalf("Times of AuthServiceMock.GetAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAccessToken.expectedInvocations, n)
+	mmGetAccessToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAccessToken
+}
+
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) invocationsDone() bool {
+	if len(mmGetAccessToken.expectations) == 0 && mmGetAccessToken.defaultExpectation == nil && mmGetAccessToken.mock.funcGetAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAccessToken.mock.afterGetAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAccessToken implements mm_service.AuthService
+func (mmGetAccessToken *AuthServiceMock) GetAccessToken(ctx context.Context, refreshToken string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetAccessToken.beforeGetAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAccessToken.afterGetAccessTokenCounter, 1)
+
+	mmGetAccessToken.t.Helper()
+
+	if mmGetAccessToken.inspectFuncGetAccessToken != nil {
+		mmGetAccessToken.inspectFuncGetAccessToken(ctx, refreshToken)
+	}
+
+	mm_params := AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+
+	// Record call args
+	mmGetAccessToken.GetAccessTokenMock.mutex.Lock()
+	mmGetAccessToken.GetAccessTokenMock.callArgs = append(mmGetAccessToken.GetAccessTokenMock.callArgs, &mm_params)
+	mmGetAccessToken.GetAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmGetAccessToken.GetAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetAccessToken.GetAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAccessToken.GetAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetAccessTokenParams{ctx, refreshToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAccessToken.t.Errorf("AuthServiceMock.GetAccessToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAccessToken.GetAccessTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAccessToken.GetAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAccessToken.t.Fatal("No results are set for the AuthServiceMock.GetAccessToken")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetAccessToken.funcGetAccessToken != nil {
+		return mmGetAccessToken.funcGetAccessToken(ctx, refreshToken)
+	}
+	mmGetAccessToken.t.Fatalf("Unexpected call to AuthServiceMock.GetAccessToken. %v %v", ctx, refreshToken)
+	return
+}
+
+// GetAccessTokenAfterCounter returns a count of finished AuthServiceMock.GetAccessToken invocations
+func (mmGetAccessToken *AuthServiceMock) GetAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessToken.afterGetAccessTokenCounter)
+}
+
+// GetAccessTokenBeforeCounter returns a count of AuthServiceMock.GetAccessToken invocations
+func (mmGetAccessToken *AuthServiceMock) GetAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccessToken.beforeGetAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAccessToken *mAuthServiceMockGetAccessToken) Calls() []*AuthServiceMockGetAccessTokenParams {
+	mmGetAccessToken.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetAccessTokenParams, len(mmGetAccessToken.callArgs))
+	copy(argCopy, mmGetAccessToken.callArgs)
+
+	mmGetAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAccessTokenDone returns true if the count of the GetAccessToken invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetAccessTokenDone() bool {
+	if m.GetAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAccessTokenMock.invocationsDone()
+}
+
+// MinimockGetAccessTokenInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetAccessTokenInspect() {
+	for _, e := range m.GetAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAccessTokenCounter := mm_atomic.LoadUint64(&m.afterGetAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAccessTokenMock.defaultExpectation != nil && afterGetAccessTokenCounter < 1 {
+		if m.GetAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s", m.GetAccessTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s with params: %#v", m.GetAccessTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAccessToken != nil && afterGetAccessTokenCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetAccessToken at\n%s", m.funcGetAccessTokenOrigin)
+	}
+
+	if !m.GetAccessTokenMock.invocationsDone() && afterGetAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetAccessToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAccessTokenMock.expectedInvocations), m.GetAccessTokenMock.expectedInvocationsOrigin, afterGetAccessTokenCounter)
+	}
+}
+
+type mAuthServiceMockGetRefreshToken struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockGetRefreshTokenExpectation
+	expectations       []*AuthServiceMockGetRefreshTokenExpectation
+
+	callArgs []*AuthServiceMockGetRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockGetRefreshTokenExpectation specifies expectation struct of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockGetRefreshTokenParams
+	paramPtrs          *AuthServiceMockGetRefreshTokenParamPtrs
+	expectationOrigins AuthServiceMockGetRefreshTokenExpectationOrigins
+	results            *AuthServiceMockGetRefreshTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockGetRefreshTokenParams contains parameters of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenParams struct {
+	ctx          context.Context
+	refreshToken string
+}
+
+// AuthServiceMockGetRefreshTokenParamPtrs contains pointers to parameters of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenParamPtrs struct {
+	ctx          *context.Context
+	refreshToken *string
+}
+
+// AuthServiceMockGetRefreshTokenResults contains results of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenResults struct {
+	s1  string
+	err error
+}
+
+// AuthServiceMockGetRefreshTokenOrigins contains origins of expectations of the AuthService.GetRefreshToken
+type AuthServiceMockGetRefreshTokenExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originRefreshToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Optional() *mAuthServiceMockGetRefreshToken {
+	mmGetRefreshToken.optional = true
+	return mmGetRefreshToken
+}
+
+// Expect sets up expected params for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Expect(ctx context.Context, refreshToken string) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmGetRefreshToken.defaultExpectation.params = &AuthServiceMockGetRefreshTokenParams{ctx, refreshToken}
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRefreshToken.expectations {
+		if minimock.Equal(e.params, mmGetRefreshToken.defaultExpectation.params) {
+			mmGetRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// ExpectRefreshTokenParam2 sets up expected param refreshToken for AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) ExpectRefreshTokenParam2(refreshToken string) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.refreshToken = &refreshToken
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originRefreshToken = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Inspect(f func(ctx context.Context, refreshToken string)) *mAuthServiceMockGetRefreshToken {
+	if mmGetRefreshToken.mock.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.GetRefreshToken")
+	}
+
+	mmGetRefreshToken.mock.inspectFuncGetRefreshToken = f
+
+	return mmGetRefreshToken
+}
+
+// Return sets up results that will be returned by AuthService.GetRefreshToken
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Return(s1 string, err error) *AuthServiceMock {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &AuthServiceMockGetRefreshTokenExpectation{mock: mmGetRefreshToken.mock}
+	}
+	mmGetRefreshToken.defaultExpectation.results = &AuthServiceMockGetRefreshTokenResults{s1, err}
+	mmGetRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken.mock
+}
+
+// Set uses given function f to mock the AuthService.GetRefreshToken method
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Set(f func(ctx context.Context, refreshToken string) (s1 string, err error)) *AuthServiceMock {
+	if mmGetRefreshToken.defaultExpectation != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Default expectation is already set for the AuthService.GetRefreshToken method")
+	}
+
+	if len(mmGetRefreshToken.expectations) > 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Some expectations are already set for the AuthService.GetRefreshToken method")
+	}
+
+	mmGetRefreshToken.mock.funcGetRefreshToken = f
+	mmGetRefreshToken.mock.funcGetRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken.mock
+}
+
+// When sets expectation for the AuthService.GetRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) When(ctx context.Context, refreshToken string) *AuthServiceMockGetRefreshTokenExpectation {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("AuthServiceMock.GetRefreshToken mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockGetRefreshTokenExpectation{
+		mock:               mmGetRefreshToken.mock,
+		params:             &AuthServiceMockGetRefreshTokenParams{ctx, refreshToken},
+		expectationOrigins: AuthServiceMockGetRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRefreshToken.expectations = append(mmGetRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.GetRefreshToken return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockGetRefreshTokenExpectation) Then(s1 string, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockGetRefreshTokenResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.GetRefreshToken should be invoked
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Times(n uint64) *mAuthServiceMockGetRefreshToken {
+	if n == 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Times of AuthServiceMock.GetRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRefreshToken.expectedInvocations, n)
+	mmGetRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken
+}
+
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) invocationsDone() bool {
+	if len(mmGetRefreshToken.expectations) == 0 && mmGetRefreshToken.defaultExpectation == nil && mmGetRefreshToken.mock.funcGetRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.mock.afterGetRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRefreshToken implements mm_service.AuthService
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshToken(ctx context.Context, refreshToken string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter, 1)
+
+	mmGetRefreshToken.t.Helper()
+
+	if mmGetRefreshToken.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.inspectFuncGetRefreshToken(ctx, refreshToken)
+	}
+
+	mm_params := AuthServiceMockGetRefreshTokenParams{ctx, refreshToken}
+
+	// Record call args
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Lock()
+	mmGetRefreshToken.GetRefreshTokenMock.callArgs = append(mmGetRefreshToken.GetRefreshTokenMock.callArgs, &mm_params)
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmGetRefreshToken.GetRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockGetRefreshTokenParams{ctx, refreshToken}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.refreshToken != nil && !minimock.Equal(*mm_want_ptrs.refreshToken, mm_got.refreshToken) {
+				mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameter refreshToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originRefreshToken, *mm_want_ptrs.refreshToken, mm_got.refreshToken, minimock.Diff(*mm_want_ptrs.refreshToken, mm_got.refreshToken))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRefreshToken.t.Errorf("AuthServiceMock.GetRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRefreshToken.t.Fatal("No results are set for the AuthServiceMock.GetRefreshToken")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetRefreshToken.funcGetRefreshToken != nil {
+		return mmGetRefreshToken.funcGetRefreshToken(ctx, refreshToken)
+	}
+	mmGetRefreshToken.t.Fatalf("Unexpected call to AuthServiceMock.GetRefreshToken. %v %v", ctx, refreshToken)
+	return
+}
+
+// GetRefreshTokenAfterCounter returns a count of finished AuthServiceMock.GetRefreshToken invocations
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter)
+}
+
+// GetRefreshTokenBeforeCounter returns a count of AuthServiceMock.GetRefreshToken invocations
+func (mmGetRefreshToken *AuthServiceMock) GetRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.GetRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRefreshToken *mAuthServiceMockGetRefreshToken) Calls() []*AuthServiceMockGetRefreshTokenParams {
+	mmGetRefreshToken.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockGetRefreshTokenParams, len(mmGetRefreshToken.callArgs))
+	copy(argCopy, mmGetRefreshToken.callArgs)
+
+	mmGetRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRefreshTokenDone returns true if the count of the GetRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockGetRefreshTokenDone() bool {
+	if m.GetRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRefreshTokenMock.invocationsDone()
+}
+
+// MinimockGetRefreshTokenInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockGetRefreshTokenInspect() {
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterGetRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRefreshTokenMock.defaultExpectation != nil && afterGetRefreshTokenCounter < 1 {
+		if m.GetRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s", m.GetRefreshTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s with params: %#v", m.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRefreshToken != nil && afterGetRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.GetRefreshToken at\n%s", m.funcGetRefreshTokenOrigin)
+	}
+
+	if !m.GetRefreshTokenMock.invocationsDone() && afterGetRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.GetRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRefreshTokenMock.expectedInvocations), m.GetRefreshTokenMock.expectedInvocationsOrigin, afterGetRefreshTokenCounter)
+	}
+}
+
+type mAuthServiceMockLogin struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockLoginExpectation
+	expectations       []*AuthServiceMockLoginExpectation
+
+	callArgs []*AuthServiceMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockLoginExpectation specifies expectation struct of the AuthService.Login
+type AuthServiceMockLoginExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockLoginParams
+	paramPtrs          *AuthServiceMockLoginParamPtrs
+	expectationOrigins AuthServiceMockLoginExpectationOrigins
+	results            *AuthServiceMockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockLoginParams contains parameters of the AuthService.Login
+type AuthServiceMockLoginParams struct {
+	ctx      context.Context
+	email    string
+	password string
+}
+
+// AuthServiceMockLoginParamPtrs contains pointers to parameters of the AuthService.Login
+type AuthServiceMockLoginParamPtrs struct {
+	ctx      *context.Context
+	email    *string
+	password *string
+}
+
+// AuthServiceMockLoginResults contains results of the AuthService.Login
+type AuthServiceMockLoginResults struct {
+	ap1 *model.AuthTokens
+	err error
+}
+
+// AuthServiceMockLoginOrigins contains origins of expectations of the AuthService.Login
+type AuthServiceMockLoginExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originEmail    string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mAuthServiceMockLogin) Optional() *mAuthServiceMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Expect(ctx context.Context, email string, password string) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &AuthServiceMockLoginParams{ctx, email, password}
+	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectEmailParam2 sets up expected param email for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectEmailParam2(email string) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.email = &email
+	mmLogin.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectPasswordParam3 sets up expected param password for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectPasswordParam3(password string) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.password = &password
+	mmLogin.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Inspect(f func(ctx context.Context, email string, password string)) *mAuthServiceMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Return(ap1 *model.AuthTokens, err error) *AuthServiceMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &AuthServiceMockLoginResults{ap1, err}
+	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the AuthService.Login method
+func (mmLogin *mAuthServiceMockLogin) Set(f func(ctx context.Context, email string, password string) (ap1 *model.AuthTokens, err error)) *AuthServiceMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// When sets expectation for the AuthService.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mAuthServiceMockLogin) When(ctx context.Context, email string, password string) *AuthServiceMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockLoginExpectation{
+		mock:               mmLogin.mock,
+		params:             &AuthServiceMockLoginParams{ctx, email, password},
+		expectationOrigins: AuthServiceMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.Login return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockLoginExpectation) Then(ap1 *model.AuthTokens, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockLoginResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.Login should be invoked
+func (mmLogin *mAuthServiceMockLogin) Times(n uint64) *mAuthServiceMockLogin {
+	if n == 0 {
+		mmLogin.mock.t.Fatalf("Times of AuthServiceMock.Login mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogin
+}
+
+func (mmLogin *mAuthServiceMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements mm_service.AuthService
+func (mmLogin *AuthServiceMock) Login(ctx context.Context, email string, password string) (ap1 *model.AuthTokens, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	mmLogin.t.Helper()
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, email, password)
+	}
+
+	mm_params := AuthServiceMockLoginParams{ctx, email, password}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockLoginParams{ctx, email, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the AuthServiceMock.Login")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, email, password)
+	}
+	mmLogin.t.Fatalf("Unexpected call to AuthServiceMock.Login. %v %v %v", ctx, email, password)
+	return
+}
+
+// LoginAfterCounter returns a count of finished AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mAuthServiceMockLogin) Calls() []*AuthServiceMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockLoginInspect() {
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.Login at\n%s", m.funcLoginOrigin)
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.Login at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	}
+}
+
+type mAuthServiceMockRequestPasswordReset struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockRequestPasswordResetExpectation
+	expectations       []*AuthServiceMockRequestPasswordResetExpectation
+
+	callArgs []*AuthServiceMockRequestPasswordResetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockRequestPasswordResetExpectation specifies expectation struct of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockRequestPasswordResetParams
+	paramPtrs          *AuthServiceMockRequestPasswordResetParamPtrs
+	expectationOrigins AuthServiceMockRequestPasswordResetExpectationOrigins
+	results            *AuthServiceMockRequestPasswordResetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockRequestPasswordResetParams contains parameters of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetParams struct {
+	ctx   context.Context
+	email string
+}
+
+// AuthServiceMockRequestPasswordResetParamPtrs contains pointers to parameters of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// AuthServiceMockRequestPasswordResetResults contains results of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetResults struct {
+	err error
+}
+
+// AuthServiceMockRequestPasswordResetOrigins contains origins of expectations of the AuthService.RequestPasswordReset
+type AuthServiceMockRequestPasswordResetExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Optional() *mAuthServiceMockRequestPasswordReset {
+	mmRequestPasswordReset.optional = true
+	return mmRequestPasswordReset
+}
+
+// Expect sets up expected params for AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Expect(ctx context.Context, email string) *mAuthServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &AuthServiceMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by ExpectParams functions")
+	}
+
+	mmRequestPasswordReset.defaultExpectation.params = &AuthServiceMockRequestPasswordResetParams{ctx, email}
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequestPasswordReset.expectations {
+		if minimock.Equal(e.params, mmRequestPasswordReset.defaultExpectation.params) {
+			mmRequestPasswordReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestPasswordReset.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestPasswordReset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &AuthServiceMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.params != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Expect")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmRequestPasswordReset.defaultExpectation.paramPtrs = &AuthServiceMockRequestPasswordResetParamPtrs{}
+	}
+	mmRequestPasswordReset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequestPasswordReset
+}
+
+// ExpectEmailParam2 sets up expected param email for AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) ExpectEmailParam2(email string) *mAuthServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &AuthServiceMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.params != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Expect")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmRequestPasswordReset.defaultExpectation.paramPtrs = &AuthServiceMockRequestPasswordResetParamPtrs{}
+	}
+	mmRequestPasswordReset.defaultExpectation.paramPtrs.email = &email
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmRequestPasswordReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Inspect(f func(ctx context.Context, email string)) *mAuthServiceMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.inspectFuncRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.RequestPasswordReset")
+	}
+
+	mmRequestPasswordReset.mock.inspectFuncRequestPasswordReset = f
+
+	return mmRequestPasswordReset
+}
+
+// Return sets up results that will be returned by AuthService.RequestPasswordReset
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Return(err error) *AuthServiceMock {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &AuthServiceMockRequestPasswordResetExpectation{mock: mmRequestPasswordReset.mock}
+	}
+	mmRequestPasswordReset.defaultExpectation.results = &AuthServiceMockRequestPasswordResetResults{err}
+	mmRequestPasswordReset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset.mock
+}
+
+// Set uses given function f to mock the AuthService.RequestPasswordReset method
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Set(f func(ctx context.Context, email string) (err error)) *AuthServiceMock {
+	if mmRequestPasswordReset.defaultExpectation != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("Default expectation is already set for the AuthService.RequestPasswordReset method")
+	}
+
+	if len(mmRequestPasswordReset.expectations) > 0 {
+		mmRequestPasswordReset.mock.t.Fatalf("Some expectations are already set for the AuthService.RequestPasswordReset method")
+	}
+
+	mmRequestPasswordReset.mock.funcRequestPasswordReset = f
+	mmRequestPasswordReset.mock.funcRequestPasswordResetOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset.mock
+}
+
+// When sets expectation for the AuthService.RequestPasswordReset which will trigger the result defined by the following
+// Then helper
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) When(ctx context.Context, email string) *AuthServiceMockRequestPasswordResetExpectation {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("AuthServiceMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockRequestPasswordResetExpectation{
+		mock:               mmRequestPasswordReset.mock,
+		params:             &AuthServiceMockRequestPasswordResetParams{ctx, email},
+		expectationOrigins: AuthServiceMockRequestPasswordResetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequestPasswordReset.expectations = append(mmRequestPasswordReset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.RequestPasswordReset return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockRequestPasswordResetExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockRequestPasswordResetResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.RequestPasswordReset should be invoked
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Times(n uint64) *mAuthServiceMockRequestPasswordReset {
+	if n == 0 {
+		mmRequestPasswordReset.mock.t.Fatalf("Times of AuthServiceMock.RequestPasswordReset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequestPasswordReset.expectedInvocations, n)
+	mmRequestPasswordReset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset
+}
+
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) invocationsDone() bool {
+	if len(mmRequestPasswordReset.expectations) == 0 && mmRequestPasswordReset.defaultExpectation == nil && mmRequestPasswordReset.mock.funcRequestPasswordReset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequestPasswordReset.mock.afterRequestPasswordResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequestPasswordReset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequestPasswordReset implements mm_service.AuthService
+func (mmRequestPasswordReset *AuthServiceMock) RequestPasswordReset(ctx context.Context, email string) (err error) {
+	mm_atomic.AddUint64(&mmRequestPasswordReset.beforeRequestPasswordResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestPasswordReset.afterRequestPasswordResetCounter, 1)
+
+	mmRequestPasswordReset.t.Helper()
+
+	if mmRequestPasswordReset.inspectFuncRequestPasswordReset != nil {
+		mmRequestPasswordReset.inspectFuncRequestPasswordReset(ctx, email)
+	}
+
+	mm_params := AuthServiceMockRequestPasswordResetParams{ctx, email}
+
+	// Record call args
+	mmRequestPasswordReset.RequestPasswordResetMock.mutex.Lock()
+	mmRequestPasswordReset.RequestPasswordResetMock.callArgs = append(mmRequestPasswordReset.RequestPasswordResetMock.callArgs, &mm_params)
+	mmRequestPasswordReset.RequestPasswordResetMock.mutex.Unlock()
+
+	for _, e := range mmRequestPasswordReset.RequestPasswordResetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.params
+		mm_want_ptrs := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockRequestPasswordResetParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequestPasswordReset.t.Errorf("AuthServiceMock.RequestPasswordReset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmRequestPasswordReset.t.Errorf("AuthServiceMock.RequestPasswordReset got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestPasswordReset.t.Errorf("AuthServiceMock.RequestPasswordReset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestPasswordReset.t.Fatal("No results are set for the AuthServiceMock.RequestPasswordReset")
+		}
+		return (*mm_results).err
+	}
+	if mmRequestPasswordReset.funcRequestPasswordReset != nil {
+		return mmRequestPasswordReset.funcRequestPasswordReset(ctx, email)
+	}
+	mmRequestPasswordReset.t.Fatalf("Unexpected call to AuthServiceMock.RequestPasswordReset. %v %v", ctx, email)
+	return
+}
+
+// RequestPasswordResetAfterCounter returns a count of finished AuthServiceMock.RequestPasswordReset invocations
+func (mmRequestPasswordReset *AuthServiceMock) RequestPasswordResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestPasswordReset.afterRequestPasswordResetCounter)
+}
+
+// RequestPasswordResetBeforeCounter returns a count of AuthServiceMock.RequestPasswordReset invocations
+func (mmRequestPasswordReset *AuthServiceMock) RequestPasswordResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestPasswordReset.beforeRequestPasswordResetCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.RequestPasswordReset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestPasswordReset *mAuthServiceMockRequestPasswordReset) Calls() []*AuthServiceMockRequestPasswordResetParams {
+	mmRequestPasswordReset.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockRequestPasswordResetParams, len(mmRequestPasswordReset.callArgs))
+	copy(argCopy, mmRequestPasswordReset.callArgs)
+
+	mmRequestPasswordReset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestPasswordResetDone returns true if the count of the RequestPasswordReset invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockRequestPasswordResetDone() bool {
+	if m.RequestPasswordResetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestPasswordResetMock.invocationsDone()
+}
+
+// MinimockRequestPasswordResetInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockRequestPasswordResetInspect() {
+	for _, e := range m.RequestPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.RequestPasswordReset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequestPasswordResetCounter := mm_atomic.LoadUint64(&m.afterRequestPasswordResetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestPasswordResetMock.defaultExpectation != nil && afterRequestPasswordResetCounter < 1 {
+		if m.RequestPasswordResetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.RequestPasswordReset at\n%s", m.RequestPasswordResetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.RequestPasswordReset at\n%s with params: %#v", m.RequestPasswordResetMock.defaultExpectation.expectationOrigins.origin, *m.RequestPasswordResetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestPasswordReset != nil && afterRequestPasswordResetCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.RequestPasswordReset at\n%s", m.funcRequestPasswordResetOrigin)
+	}
+
+	if !m.RequestPasswordResetMock.invocationsDone() && afterRequestPasswordResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.RequestPasswordReset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestPasswordResetMock.expectedInvocations), m.RequestPasswordResetMock.expectedInvocationsOrigin, afterRequestPasswordResetCounter)
+	}
+}
+
+type mAuthServiceMockUnlockUser struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockUnlockUserExpectation
+	expectations       []*AuthServiceMockUnlockUserExpectation
+
+	callArgs []*AuthServiceMockUnlockUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockUnlockUserExpectation specifies expectation struct of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockUnlockUserParams
+	paramPtrs          *AuthServiceMockUnlockUserParamPtrs
+	expectationOrigins AuthServiceMockUnlockUserExpectationOrigins
+	results            *AuthServiceMockUnlockUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockUnlockUserParams contains parameters of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AuthServiceMockUnlockUserParamPtrs contains pointers to parameters of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AuthServiceMockUnlockUserResults contains results of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserResults struct {
+	err error
+}
+
+// AuthServiceMockUnlockUserOrigins contains origins of expectations of the AuthService.UnlockUser
+type AuthServiceMockUnlockUserExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Optional() *mAuthServiceMockUnlockUser {
+	mmUnlockUser.optional = true
+	return mmUnlockUser
+}
+
+// Expect sets up expected params for AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Expect(ctx context.Context, id int64) *mAuthServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &AuthServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by ExpectParams functions")
+	}
+
+	mmUnlockUser.defaultExpectation.params = &AuthServiceMockUnlockUserParams{ctx, id}
+	mmUnlockUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnlockUser.expectations {
+		if minimock.Equal(e.params, mmUnlockUser.defaultExpectation.params) {
+			mmUnlockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnlockUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUnlockUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &AuthServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.params != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Expect")
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs == nil {
+		mmUnlockUser.defaultExpectation.paramPtrs = &AuthServiceMockUnlockUserParamPtrs{}
+	}
+	mmUnlockUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnlockUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUnlockUser
+}
+
+// ExpectIdParam2 sets up expected param id for AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) ExpectIdParam2(id int64) *mAuthServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &AuthServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.params != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Expect")
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs == nil {
+		mmUnlockUser.defaultExpectation.paramPtrs = &AuthServiceMockUnlockUserParamPtrs{}
+	}
+	mmUnlockUser.defaultExpectation.paramPtrs.id = &id
+	mmUnlockUser.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUnlockUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Inspect(f func(ctx context.Context, id int64)) *mAuthServiceMockUnlockUser {
+	if mmUnlockUser.mock.inspectFuncUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.UnlockUser")
+	}
+
+	mmUnlockUser.mock.inspectFuncUnlockUser = f
+
+	return mmUnlockUser
+}
+
+// Return sets up results that will be returned by AuthService.UnlockUser
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Return(err error) *AuthServiceMock {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &AuthServiceMockUnlockUserExpectation{mock: mmUnlockUser.mock}
+	}
+	mmUnlockUser.defaultExpectation.results = &AuthServiceMockUnlockUserResults{err}
+	mmUnlockUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnlockUser.mock
+}
+
+// Set uses given function f to mock the AuthService.UnlockUser method
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Set(f func(ctx context.Context, id int64) (err error)) *AuthServiceMock {
+	if mmUnlockUser.defaultExpectation != nil {
+		mmUnlockUser.mock.t.Fatalf("Default expectation is already set for the AuthService.UnlockUser method")
+	}
+
+	if len(mmUnlockUser.expectations) > 0 {
+		mmUnlockUser.mock.t.Fatalf("Some expectations are already set for the AuthService.UnlockUser method")
+	}
+
+	mmUnlockUser.mock.funcUnlockUser = f
+	mmUnlockUser.mock.funcUnlockUserOrigin = minimock.CallerInfo(1)
+	return mmUnlockUser.mock
+}
+
+// When sets expectation for the AuthService.UnlockUser which will trigger the result defined by the following
+// Then helper
+func (mmUnlockUser *mAuthServiceMockUnlockUser) When(ctx context.Context, id int64) *AuthServiceMockUnlockUserExpectation {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("AuthServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockUnlockUserExpectation{
+		mock:               mmUnlockUser.mock,
+		params:             &AuthServiceMockUnlockUserParams{ctx, id},
+		expectationOrigins: AuthServiceMockUnlockUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUnlockUser.expectations = append(mmUnlockUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.UnlockUser return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockUnlockUserExpectation) Then(err error) *AuthServiceMock {
+	e.results = &AuthServiceMockUnlockUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.UnlockUser should be invoked
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Times(n uint64) *mAuthServiceMockUnlockUser {
+	if n == 0 {
+		mmUnlockUser.mock.t.Fatalf("Times of AuthServiceMock.UnlockUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnlockUser.expectedInvocations, n)
+	mmUnlockUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnlockUser
+}
+
+func (mmUnlockUser *mAuthServiceMockUnlockUser) invocationsDone() bool {
+	if len(mmUnlockUser.expectations) == 0 && mmUnlockUser.defaultExpectation == nil && mmUnlockUser.mock.funcUnlockUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnlockUser.mock.afterUnlockUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnlockUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnlockUser implements mm_service.AuthService
+func (mmUnlockUser *AuthServiceMock) UnlockUser(ctx context.Context, id int64) (err error) {
+	mm_atomic.AddUint64(&mmUnlockUser.beforeUnlockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnlockUser.afterUnlockUserCounter, 1)
+
+	mmUnlockUser.t.Helper()
+
+	if mmUnlockUser.inspectFuncUnlockUser != nil {
+		mmUnlockUser.inspectFuncUnlockUser(ctx, id)
+	}
+
+	mm_params := AuthServiceMockUnlockUserParams{ctx, id}
+
+	// Record call args
+	mmUnlockUser.UnlockUserMock.mutex.Lock()
+	mmUnlockUser.UnlockUserMock.callArgs = append(mmUnlockUser.UnlockUserMock.callArgs, &mm_params)
+	mmUnlockUser.UnlockUserMock.mutex.Unlock()
+
+	for _, e := range mmUnlockUser.UnlockUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnlockUser.UnlockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnlockUser.UnlockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnlockUser.UnlockUserMock.defaultExpectation.params
+		mm_want_ptrs := mmUnlockUser.UnlockUserMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockUnlockUserParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnlockUser.t.Errorf("AuthServiceMock.UnlockUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnlockUser.UnlockUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUnlockUser.t.Errorf("AuthServiceMock.UnlockUser got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnlockUser.UnlockUserMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnlockUser.t.Errorf("AuthServiceMock.UnlockUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnlockUser.UnlockUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnlockUser.UnlockUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnlockUser.t.Fatal("No results are set for the AuthServiceMock.UnlockUser")
+		}
+		return (*mm_results).err
+	}
+	if mmUnlockUser.funcUnlockUser != nil {
+		return mmUnlockUser.funcUnlockUser(ctx, id)
+	}
+	mmUnlockUser.t.Fatalf("Unexpected call to AuthServiceMock.UnlockUser. %v %v", ctx, id)
+	return
+}
+
+// UnlockUserAfterCounter returns a count of finished AuthServiceMock.UnlockUser invocations
+func (mmUnlockUser *AuthServiceMock) UnlockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnlockUser.afterUnlockUserCounter)
+}
+
+// UnlockUserBeforeCounter returns a count of AuthServiceMock.UnlockUser invocations
+func (mmUnlockUser *AuthServiceMock) UnlockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnlockUser.beforeUnlockUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.UnlockUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnlockUser *mAuthServiceMockUnlockUser) Calls() []*AuthServiceMockUnlockUserParams {
+	mmUnlockUser.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockUnlockUserParams, len(mmUnlockUser.callArgs))
+	copy(argCopy, mmUnlockUser.callArgs)
+
+	mmUnlockUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnlockUserDone returns true if the count of the UnlockUser invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockUnlockUserDone() bool {
+	if m.UnlockUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnlockUserMock.invocationsDone()
+}
+
+// MinimockUnlockUserInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockUnlockUserInspect() {
+	for _, e := range m.UnlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.UnlockUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUnlockUserCounter := mm_atomic.LoadUint64(&m.afterUnlockUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnlockUserMock.defaultExpectation != nil && afterUnlockUserCounter < 1 {
+		if m.UnlockUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.UnlockUser at\n%s", m.UnlockUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.UnlockUser at\n%s with params: %#v", m.UnlockUserMock.defaultExpectation.expectationOrigins.origin, *m.UnlockUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnlockUser != nil && afterUnlockUserCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.UnlockUser at\n%s", m.funcUnlockUserOrigin)
+	}
+
+	if !m.UnlockUserMock.invocationsDone() && afterUnlockUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.UnlockUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnlockUserMock.expectedInvocations), m.UnlockUserMock.expectedInvocationsOrigin, afterUnlockUserCounter)
+	}
+}
+
+type mAuthServiceMockVerifyCredentials struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockVerifyCredentialsExpectation
+	expectations       []*AuthServiceMockVerifyCredentialsExpectation
+
+	callArgs []*AuthServiceMockVerifyCredentialsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthServiceMockVerifyCredentialsExpectation specifies expectation struct of the AuthService.VerifyCredentials
+type AuthServiceMockVerifyCredentialsExpectation struct {
+	mock               *AuthServiceMock
+	params             *AuthServiceMockVerifyCredentialsParams
+	paramPtrs          *AuthServiceMockVerifyCredentialsParamPtrs
+	expectationOrigins AuthServiceMockVerifyCredentialsExpectationOrigins
+	results            *AuthServiceMockVerifyCredentialsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthServiceMockVerifyCredentialsParams contains parameters of the AuthService.VerifyCredentials
+type AuthServiceMockVerifyCredentialsParams struct {
+	ctx      context.Context
+	email    string
+	password string
+}
+
+// AuthServiceMockVerifyCredentialsParamPtrs contains pointers to parameters of the AuthService.VerifyCredentials
+type AuthServiceMockVerifyCredentialsParamPtrs struct {
+	ctx      *context.Context
+	email    *string
+	password *string
+}
+
+// AuthServiceMockVerifyCredentialsResults contains results of the AuthService.VerifyCredentials
+type AuthServiceMockVerifyCredentialsResults struct {
+	up1 *model.User
+	err error
+}
+
+// AuthServiceMockVerifyCredentialsOrigins contains origins of expectations of the AuthService.VerifyCredentials
+type AuthServiceMockVerifyCredentialsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originEmail    string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) Optional() *mAuthServiceMockVerifyCredentials {
+	mmVerifyCredentials.optional = true
+	return mmVerifyCredentials
+}
+
+// Expect sets up expected params for AuthService.VerifyCredentials
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) Expect(ctx context.Context, email string, password string) *mAuthServiceMockVerifyCredentials {
+	if mmVerifyCredentials.mock.funcVerifyCredentials != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Set")
+	}
+
+	if mmVerifyCredentials.defaultExpectation == nil {
+		mmVerifyCredentials.defaultExpectation = &AuthServiceMockVerifyCredentialsExpectation{}
+	}
+
+	if mmVerifyCredentials.defaultExpectation.paramPtrs != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by ExpectParams functions")
+	}
+
+	mmVerifyCredentials.defaultExpectation.params = &AuthServiceMockVerifyCredentialsParams{ctx, email, password}
+	mmVerifyCredentials.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVerifyCredentials.expectations {
+		if minimock.Equal(e.params, mmVerifyCredentials.defaultExpectation.params) {
+			mmVerifyCredentials.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyCredentials.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyCredentials
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.VerifyCredentials
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockVerifyCredentials {
+	if mmVerifyCredentials.mock.funcVerifyCredentials != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Set")
+	}
+
+	if mmVerifyCredentials.defaultExpectation == nil {
+		mmVerifyCredentials.defaultExpectation = &AuthServiceMockVerifyCredentialsExpectation{}
+	}
+
+	if mmVerifyCredentials.defaultExpectation.params != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Expect")
+	}
+
+	if mmVerifyCredentials.defaultExpectation.paramPtrs == nil {
+		mmVerifyCredentials.defaultExpectation.paramPtrs = &AuthServiceMockVerifyCredentialsParamPtrs{}
+	}
+	mmVerifyCredentials.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVerifyCredentials.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmVerifyCredentials
+}
+
+// ExpectEmailParam2 sets up expected param email for AuthService.VerifyCredentials
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) ExpectEmailParam2(email string) *mAuthServiceMockVerifyCredentials {
+	if mmVerifyCredentials.mock.funcVerifyCredentials != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Set")
+	}
+
+	if mmVerifyCredentials.defaultExpectation == nil {
+		mmVerifyCredentials.defaultExpectation = &AuthServiceMockVerifyCredentialsExpectation{}
+	}
+
+	if mmVerifyCredentials.defaultExpectation.params != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Expect")
+	}
+
+	if mmVerifyCredentials.defaultExpectation.paramPtrs == nil {
+		mmVerifyCredentials.defaultExpectation.paramPtrs = &AuthServiceMockVerifyCredentialsParamPtrs{}
+	}
+	mmVerifyCredentials.defaultExpectation.paramPtrs.email = &email
+	mmVerifyCredentials.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmVerifyCredentials
+}
+
+// ExpectPasswordParam3 sets up expected param password for AuthService.VerifyCredentials
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) ExpectPasswordParam3(password string) *mAuthServiceMockVerifyCredentials {
+	if mmVerifyCredentials.mock.funcVerifyCredentials != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Set")
+	}
+
+	if mmVerifyCredentials.defaultExpectation == nil {
+		mmVerifyCredentials.defaultExpectation = &AuthServiceMockVerifyCredentialsExpectation{}
+	}
+
+	if mmVerifyCredentials.defaultExpectation.params != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Expect")
+	}
+
+	if mmVerifyCredentials.defaultExpectation.paramPtrs == nil {
+		mmVerifyCredentials.defaultExpectation.paramPtrs = &AuthServiceMockVerifyCredentialsParamPtrs{}
+	}
+	mmVerifyCredentials.defaultExpectation.paramPtrs.password = &password
+	mmVerifyCredentials.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmVerifyCredentials
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.VerifyCredentials
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) Inspect(f func(ctx context.Context, email string, password string)) *mAuthServiceMockVerifyCredentials {
+	if mmVerifyCredentials.mock.inspectFuncVerifyCredentials != nil {
+		mmVerifyCredentials.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.VerifyCredentials")
+	}
+
+	mmVerifyCredentials.mock.inspectFuncVerifyCredentials = f
+
+	return mmVerifyCredentials
+}
+
+// Return sets up results that will be returned by AuthService.VerifyCredentials
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) Return(up1 *model.User, err error) *AuthServiceMock {
+	if mmVerifyCredentials.mock.funcVerifyCredentials != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Set")
+	}
+
+	if mmVerifyCredentials.defaultExpectation == nil {
+		mmVerifyCredentials.defaultExpectation = &AuthServiceMockVerifyCredentialsExpectation{mock: mmVerifyCredentials.mock}
+	}
+	mmVerifyCredentials.defaultExpectation.results = &AuthServiceMockVerifyCredentialsResults{up1, err}
+	mmVerifyCredentials.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVerifyCredentials.mock
+}
+
+// Set uses given function f to mock the AuthService.VerifyCredentials method
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) Set(f func(ctx context.Context, email string, password string) (up1 *model.User, err error)) *AuthServiceMock {
+	if mmVerifyCredentials.defaultExpectation != nil {
+		mmVerifyCredentials.mock.t.Fatalf("Default expectation is already set for the AuthService.VerifyCredentials method")
+	}
+
+	if len(mmVerifyCredentials.expectations) > 0 {
+		mmVerifyCredentials.mock.t.Fatalf("Some expectations are already set for the AuthService.VerifyCredentials method")
+	}
+
+	mmVerifyCredentials.mock.funcVerifyCredentials = f
+	mmVerifyCredentials.mock.funcVerifyCredentialsOrigin = minimock.CallerInfo(1)
+	return mmVerifyCredentials.mock
+}
+
+// When sets expectation for the AuthService.VerifyCredentials which will trigger the result defined by the following
+// Then helper
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) When(ctx context.Context, email string, password string) *AuthServiceMockVerifyCredentialsExpectation {
+	if mmVerifyCredentials.mock.funcVerifyCredentials != nil {
+		mmVerifyCredentials.mock.t.Fatalf("AuthServiceMock.VerifyCredentials mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockVerifyCredentialsExpectation{
+		mock:               mmVerifyCredentials.mock,
+		params:             &AuthServiceMockVerifyCredentialsParams{ctx, email, password},
+		expectationOrigins: AuthServiceMockVerifyCredentialsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVerifyCredentials.expectations = append(mmVerifyCredentials.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.VerifyCredentials return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockVerifyCredentialsExpectation) Then(up1 *model.User, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockVerifyCredentialsResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.VerifyCredentials should be invoked
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) Times(n uint64) *mAuthServiceMockVerifyCredentials {
+	if n == 0 {
+		mmVerifyCredentials.mock.t.Fatalf("Times of AuthServiceMock.VerifyCredentials mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerifyCredentials.expectedInvocations, n)
+	mmVerifyCredentials.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVerifyCredentials
+}
+
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) invocationsDone() bool {
+	if len(mmVerifyCredentials.expectations) == 0 && mmVerifyCredentials.defaultExpectation == nil && mmVerifyCredentials.mock.funcVerifyCredentials == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyCredentials.mock.afterVerifyCredentialsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyCredentials.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VerifyCredentials implements mm_service.AuthService
+func (mmVerifyCredentials *AuthServiceMock) VerifyCredentials(ctx context.Context, email string, password string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmVerifyCredentials.beforeVerifyCredentialsCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyCredentials.afterVerifyCredentialsCounter, 1)
+
+	mmVerifyCredentials.t.Helper()
+
+	if mmVerifyCredentials.inspectFuncVerifyCredentials != nil {
+		mmVerifyCredentials.inspectFuncVerifyCredentials(ctx, email, password)
+	}
+
+	mm_params := AuthServiceMockVerifyCredentialsParams{ctx, email, password}
+
+	// Record call args
+	mmVerifyCredentials.VerifyCredentialsMock.mutex.Lock()
+	mmVerifyCredentials.VerifyCredentialsMock.callArgs = append(mmVerifyCredentials.VerifyCredentialsMock.callArgs, &mm_params)
+	mmVerifyCredentials.VerifyCredentialsMock.mutex.Unlock()
+
+	for _, e := range mmVerifyCredentials.VerifyCredentialsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockVerifyCredentialsParams{ctx, email, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVerifyCredentials.t.Errorf("AuthServiceMock.VerifyCredentials got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmVerifyCredentials.t.Errorf("AuthServiceMock.VerifyCredentials got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmVerifyCredentials.t.Errorf("AuthServiceMock.VerifyCredentials got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyCredentials.t.Errorf("AuthServiceMock.VerifyCredentials got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyCredentials.VerifyCredentialsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyCredentials.t.Fatal("No results are set for the AuthServiceMock.VerifyCredentials")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmVerifyCredentials.funcVerifyCredentials != nil {
+		return mmVerifyCredentials.funcVerifyCredentials(ctx, email, password)
+	}
+	mmVerifyCredentials.t.Fatalf("Unexpected call to AuthServiceMock.VerifyCredentials. %v %v %v", ctx, email, password)
+	return
+}
+
+// VerifyCredentialsAfterCounter returns a count of finished AuthServiceMock.VerifyCredentials invocations
+func (mmVerifyCredentials *AuthServiceMock) VerifyCredentialsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyCredentials.afterVerifyCredentialsCounter)
+}
+
+// VerifyCredentialsBeforeCounter returns a count of AuthServiceMock.VerifyCredentials invocations
+func (mmVerifyCredentials *AuthServiceMock) VerifyCredentialsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyCredentials.beforeVerifyCredentialsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.VerifyCredentials.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyCredentials *mAuthServiceMockVerifyCredentials) Calls() []*AuthServiceMockVerifyCredentialsParams {
+	mmVerifyCredentials.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockVerifyCredentialsParams, len(mmVerifyCredentials.callArgs))
+	copy(argCopy, mmVerifyCredentials.callArgs)
+
+	mmVerifyCredentials.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyCredentialsDone returns true if the count of the VerifyCredentials invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockVerifyCredentialsDone() bool {
+	if m.VerifyCredentialsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyCredentialsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyCredentialsMock.invocationsDone()
+}
+
+// MinimockVerifyCredentialsInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockVerifyCredentialsInspect() {
+	for _, e := range m.VerifyCredentialsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.VerifyCredentials at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVerifyCredentialsCounter := mm_atomic.LoadUint64(&m.afterVerifyCredentialsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyCredentialsMock.defaultExpectation != nil && afterVerifyCredentialsCounter < 1 {
+		if m.VerifyCredentialsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthServiceMock.VerifyCredentials at\n%s", m.VerifyCredentialsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.VerifyCredentials at\n%s with params: %#v", m.VerifyCredentialsMock.defaultExpectation.expectationOrigins.origin, *m.VerifyCredentialsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyCredentials != nil && afterVerifyCredentialsCounter < 1 {
+		m.t.Errorf("Expected call to AuthServiceMock.VerifyCredentials at\n%s", m.funcVerifyCredentialsOrigin)
+	}
+
+	if !m.VerifyCredentialsMock.invocationsDone() && afterVerifyCredentialsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.VerifyCredentials at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyCredentialsMock.expectedInvocations), m.VerifyCredentialsMock.expectedInvocationsOrigin, afterVerifyCredentialsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConfirmPasswordResetInspect()
+
+			m.MinimockGetAccessTokenInspect()
+
+			m.MinimockGetRefreshTokenInspect()
+
+			m.MinimockLoginInspect()
+
+			m.MinimockRequestPasswordResetInspect()
+
+			m.MinimockUnlockUserInspect()
+
+			m.MinimockVerifyCredentialsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConfirmPasswordResetDone() &&
+		m.MinimockGetAccessTokenDone() &&
+		m.MinimockGetRefreshTokenDone() &&
+		m.MinimockLoginDone() &&
+		m.MinimockRequestPasswordResetDone() &&
+		m.MinimockUnlockUserDone() &&
+		m.MinimockVerifyCredentialsDone()
+}
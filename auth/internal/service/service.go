@@ -10,4 +10,28 @@ type UserService interface {
 	Get(ctx context.Context, id int64) (*model.User, error)
 	Update(ctx context.Context, id int64, updateUser *model.UpdateUserData) error
 	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context, params *model.ListUsersParams) (*model.UserPage, error)
+	GetByNames(ctx context.Context, names []string) ([]*model.User, error)
+	GetByEmails(ctx context.Context, emails []string) ([]*model.User, error)
+	ChangePassword(ctx context.Context, command *model.ChangePasswordCommand) error
+	ResetPassword(ctx context.Context, id int64) (string, error)
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerificationEmail(ctx context.Context, email string) error
+	SetRole(ctx context.Context, id int64, role model.Role) error
+}
+
+type AuthService interface {
+	Login(ctx context.Context, email, password string) (*model.AuthTokens, error)
+	VerifyCredentials(ctx context.Context, email, password string) (*model.User, error)
+	UnlockUser(ctx context.Context, id int64) error
+	GetRefreshToken(ctx context.Context, refreshToken string) (string, error)
+	GetAccessToken(ctx context.Context, refreshToken string) (string, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, command *model.PasswordResetCommand) error
+}
+
+type AccessService interface {
+	Check(ctx context.Context, accessToken, endpoint string) (*model.UserClaims, error)
+	CheckPermission(ctx context.Context, accessToken string, permission model.Permission) (*model.UserClaims, error)
+	ListPermissions(ctx context.Context, accessToken string) ([]model.Permission, error)
 }
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"auth/internal/identity"
+	"auth/internal/model"
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authorize lets the authenticated caller act on their own account, or on
+// any account when their role holds the permission.
+func (s *serv) authorize(ctx context.Context, id int64, permission model.Permission) error {
+	claims, ok := identity.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	if claims.UserID == id {
+		return nil
+	}
+
+	permissions, err := s.accessRepository.GetRolePermissions(ctx, claims.Role)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(permissions, permission) {
+		return status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return nil
+}
@@ -2,32 +2,37 @@ package user
 
 import (
 	"auth/internal/model"
+	"auth/internal/utils"
 	"context"
 	"log"
 
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *serv) Create(ctx context.Context, command *model.CreateUserCommand) (int64, error) {
 	var id int64
+	var token string
 
 	if err := command.Validate(); err != nil {
 		return 0, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(command.Password), bcrypt.DefaultCost)
+	hashedPassword, err := utils.HashPassword(command.Password)
 	if err != nil {
 		log.Printf("failed to hash password: %v", err)
 		return 0, status.Error(codes.Internal, "failed to hash password")
 	}
 
+	// Signing up is open to anyone, admins are only made with SetRole.
+	info := command.Info
+	info.Role = model.RoleUser
+
 	createData := &model.CreateUserData{
-		Info:           command.Info,
-		HashedPassword: string(hashedPassword),
+		Info:           info,
+		HashedPassword: hashedPassword,
 	}
 
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
@@ -42,6 +47,11 @@ func (s *serv) Create(ctx context.Context, command *model.CreateUserCommand) (in
 			return errTx
 		}
 
+		token, errTx = s.issueVerificationToken(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
 		errTx = s.logRepository.Log(ctx, &logModel.Log{
 			Action:   "user_created",
 			EntityID: id,
@@ -57,5 +67,11 @@ func (s *serv) Create(ctx context.Context, command *model.CreateUserCommand) (in
 		return 0, err
 	}
 
+	// The user is created either way, a slow mail server must not hold up
+	// the sign up. The mail can be resent if it doesn't arrive.
+	utils.InBackground(ctx, func(ctx context.Context) {
+		s.sendVerificationEmail(ctx, id, &info, token)
+	})
+
 	return id, nil
 }
@@ -1,13 +1,21 @@
 package user
 
 import (
+	"auth/internal/model"
 	"context"
 
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 )
 
+// Delete removes the caller's account, or any account for callers who may
+// manage every account.
 func (s *serv) Delete(ctx context.Context, id int64) error {
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+	err := s.authorize(ctx, id, model.PermissionUserManageAny)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		errTx := s.userRepository.Delete(ctx, id)
 		if errTx != nil {
 			return errTx
@@ -0,0 +1,175 @@
+package user
+
+import (
+	"auth/internal/mailer"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalidVerificationToken = status.Error(codes.InvalidArgument, "invalid or expired token")
+
+// VerifyEmail marks the email of the token's user as verified, the token and
+// any other outstanding token of the user can't be used again.
+func (s *serv) VerifyEmail(ctx context.Context, token string) error {
+	if len(token) == 0 {
+		return status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		userID, errTx := s.emailVerificationRepository.Consume(ctx, utils.HashToken(token))
+		if errTx != nil {
+			if errors.Is(errTx, repository.ErrNotFound) {
+				return errInvalidVerificationToken
+			}
+			return errTx
+		}
+
+		errTx = s.userRepository.MarkEmailVerified(ctx, userID)
+		if errTx != nil {
+			if errors.Is(errTx, repository.ErrNotFound) {
+				return errInvalidVerificationToken
+			}
+			return errTx
+		}
+
+		errTx = s.emailVerificationRepository.RevokeByUser(ctx, userID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "email_verified",
+			EntityID: userID,
+		})
+	})
+}
+
+// ResendVerificationEmail mails a fresh token to an unverified user. Unknown
+// and verified emails and requests within the resend interval end the same
+// way as a sent mail, so the caller learns nothing about the account. The
+// mail is prepared in the background for the response time not to tell
+// either.
+func (s *serv) ResendVerificationEmail(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	if len(email) == 0 {
+		return status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	users, err := s.userRepository.GetByEmails(ctx, []string{email})
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 || users[0].EmailVerifiedAt.Valid {
+		return nil
+	}
+	user := users[0]
+
+	utils.InBackground(ctx, func(ctx context.Context) {
+		errResend := s.resendVerificationEmail(ctx, user)
+		if errResend != nil {
+			log.Printf("failed to resend verification mail to user %d: %v", user.ID, errResend)
+		}
+	})
+
+	return nil
+}
+
+// resendVerificationEmail replaces the outstanding tokens of the user with a
+// new one and mails it, unless the last mail went out within the resend
+// interval. The user stays locked from the check until the new token is
+// stored, so concurrent resends send one mail.
+func (s *serv) resendVerificationEmail(ctx context.Context, user *model.User) error {
+	var token string
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.userRepository.LockByID(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		lastSentAt, errTx := s.emailVerificationRepository.LastCreatedAt(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+		if time.Since(lastSentAt) < s.emailVerificationConfig.ResendInterval() {
+			log.Printf("verification mail to user %d throttled, last one was sent at %s", user.ID, lastSentAt)
+			return nil
+		}
+
+		// Only the newest mail carries a usable token.
+		errTx = s.emailVerificationRepository.RevokeByUser(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		token, errTx = s.issueVerificationToken(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "email_verification_resent",
+			EntityID: user.ID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(token) == 0 {
+		return nil
+	}
+
+	s.sendVerificationEmail(ctx, user.ID, &user.Info, token)
+
+	return nil
+}
+
+// issueVerificationToken stores the hash of a new token for the user and
+// returns the token to be mailed.
+func (s *serv) issueVerificationToken(ctx context.Context, userID int64) (string, error) {
+	token, tokenHash, err := utils.NewToken()
+	if err != nil {
+		log.Printf("failed to generate email verification token: %v", err)
+		return "", status.Error(codes.Internal, "failed to generate token")
+	}
+
+	now := time.Now()
+	err = s.emailVerificationRepository.Create(ctx, &model.EmailVerificationToken{
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(s.emailVerificationConfig.TokenTTL()),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// sendVerificationEmail only logs delivery failures, the user can ask for
+// another mail.
+func (s *serv) sendVerificationEmail(ctx context.Context, userID int64, info *model.UserInfo, token string) {
+	err := s.mailer.Send(ctx, &mailer.Message{
+		To:      info.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\n"+
+			"use this token to verify your email, it expires in %s:\n\n%s\n\n"+
+			"If you did not sign up, ignore this email.\n",
+			info.Name, s.emailVerificationConfig.TokenTTL(), token),
+	})
+	if err != nil {
+		log.Printf("failed to send verification mail to user %d: %v", userID, err)
+	}
+}
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"auth/internal/model"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBatchSize = 100
+
+func (s *serv) GetByNames(ctx context.Context, names []string) ([]*model.User, error) {
+	err := validateBatch(names)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return []*model.User{}, nil
+	}
+
+	return s.userRepository.GetByNames(ctx, names)
+}
+
+func (s *serv) GetByEmails(ctx context.Context, emails []string) ([]*model.User, error) {
+	err := validateBatch(emails)
+	if err != nil {
+		return nil, err
+	}
+	if len(emails) == 0 {
+		return []*model.User{}, nil
+	}
+
+	return s.userRepository.GetByEmails(ctx, emails)
+}
+
+func validateBatch(values []string) error {
+	if len(values) > maxBatchSize {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d users can be requested at once", maxBatchSize))
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package user
+
+import (
+	"auth/internal/model"
+	"auth/internal/pagination"
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// userCursor is the keyset position after the last user of a page. The sort
+// order is stored too, a cursor is only valid for the order it was issued for.
+type userCursor struct {
+	SortField model.UserSortField `json:"sort_field"`
+	SortDesc  bool                `json:"sort_desc"`
+	ID        int64               `json:"id"`
+	Name      string              `json:"name,omitempty"`
+	Email     string              `json:"email,omitempty"`
+	CreatedAt time.Time           `json:"created_at,omitzero"`
+}
+
+func (s *serv) List(ctx context.Context, params *model.ListUsersParams) (*model.UserPage, error) {
+	filter := params.Filter
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		return nil, status.Error(codes.InvalidArgument, "created_from must be before created_to")
+	}
+
+	query := &model.UserListQuery{
+		Filter:    filter,
+		SortField: params.SortField,
+		SortDesc:  params.SortDesc,
+		Limit:     pageSize(params.Limit),
+	}
+	if query.SortField == model.UserSortFieldUnspecified {
+		query.SortField = model.UserSortFieldID
+	}
+
+	if len(params.Cursor) > 0 {
+		var position userCursor
+		err := pagination.DecodeCursor(params.Cursor, &position)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if position.SortField != query.SortField || position.SortDesc != query.SortDesc {
+			return nil, status.Error(codes.InvalidArgument, "cursor does not match the sort order")
+		}
+
+		query.After = &model.User{
+			ID:        position.ID,
+			Info:      model.UserInfo{Name: position.Name, Email: position.Email},
+			CreatedAt: position.CreatedAt,
+		}
+	}
+
+	total, err := s.userRepository.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+
+	// Fetch one extra row to find out whether there is another page.
+	query.Limit++
+	users, err := s.userRepository.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.UserPage{
+		Users:      users,
+		TotalCount: total,
+	}
+
+	if uint64(len(users)) <= limit {
+		return page, nil
+	}
+
+	page.Users = users[:limit]
+	page.NextCursor, err = pagination.EncodeCursor(cursorAfter(page.Users[limit-1], query))
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func cursorAfter(user *model.User, query *model.UserListQuery) userCursor {
+	position := userCursor{
+		SortField: query.SortField,
+		SortDesc:  query.SortDesc,
+		ID:        user.ID,
+	}
+
+	switch query.SortField {
+	case model.UserSortFieldName:
+		position.Name = user.Info.Name
+	case model.UserSortFieldEmail:
+		position.Email = user.Info.Email
+	case model.UserSortFieldCreatedAt:
+		position.CreatedAt = user.CreatedAt
+	}
+
+	return position
+}
+
+func pageSize(limit uint64) uint64 {
+	switch {
+	case limit == 0:
+		return defaultPageSize
+	case limit > maxPageSize:
+		return maxPageSize
+	default:
+		return limit
+	}
+}
@@ -0,0 +1,95 @@
+package user
+
+import (
+	"auth/internal/identity"
+	"auth/internal/model"
+	"auth/internal/repository"
+	"auth/internal/utils"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// temporaryPasswordBytes gives a 22 character password once encoded.
+const temporaryPasswordBytes = 16
+
+// ChangePassword replaces the password of the authenticated caller, who has
+// to prove they know the current one.
+func (s *serv) ChangePassword(ctx context.Context, command *model.ChangePasswordCommand) error {
+	claims, ok := identity.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	if err := command.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	credentials, err := s.userRepository.GetCredentialsByID(ctx, claims.UserID)
+	if err != nil {
+		// The token outlived its user, the caller is no one anymore.
+		if errors.Is(err, repository.ErrNotFound) {
+			return status.Error(codes.Unauthenticated, "user is not authenticated")
+		}
+		return err
+	}
+
+	// The old password is checked like a sign-in, a stolen access token must
+	// not buy unthrottled guesses at it.
+	_, err = s.authService.VerifyCredentials(ctx, credentials.User.Info.Email, command.OldPassword)
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return status.Error(codes.PermissionDenied, "old password is incorrect")
+		}
+		return err
+	}
+
+	return s.setPassword(ctx, claims.UserID, command.Password, "password_changed")
+}
+
+// ResetPassword sets a generated password for the user and returns it, the
+// caller is checked to be an admin by the auth interceptor.
+func (s *serv) ResetPassword(ctx context.Context, id int64) (string, error) {
+	raw := make([]byte, temporaryPasswordBytes)
+	_, err := rand.Read(raw)
+	if err != nil {
+		log.Printf("failed to generate password: %v", err)
+		return "", status.Error(codes.Internal, "failed to generate password")
+	}
+	password := base64.RawURLEncoding.EncodeToString(raw)
+
+	err = s.setPassword(ctx, id, password, "password_reset")
+	if err != nil {
+		return "", err
+	}
+
+	return password, nil
+}
+
+// setPassword stores the hash of the password, signing the user out of every
+// session, and logs the action.
+func (s *serv) setPassword(ctx context.Context, id int64, password, action string) error {
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		log.Printf("failed to hash password: %v", err)
+		return status.Error(codes.Internal, "failed to hash password")
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.userRepository.UpdatePassword(ctx, id, hashedPassword)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   action,
+			EntityID: id,
+		})
+	})
+}
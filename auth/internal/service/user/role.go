@@ -0,0 +1,44 @@
+package user
+
+import (
+	"auth/internal/model"
+	"context"
+	"slices"
+	"strings"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetRole changes the role of the user and signs them out, the caller is
+// checked to be an admin by the auth interceptor. The last admin can't be
+// demoted so that someone is always left to manage roles.
+func (s *serv) SetRole(ctx context.Context, id int64, role model.Role) error {
+	if role != model.RoleUser && role != model.RoleAdmin {
+		return status.Error(codes.InvalidArgument, "unknown role")
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if role != model.RoleAdmin {
+			admins, errTx := s.userRepository.LockByRole(ctx, model.RoleAdmin)
+			if errTx != nil {
+				return errTx
+			}
+
+			if len(admins) == 1 && slices.Contains(admins, id) {
+				return status.Error(codes.FailedPrecondition, "the last admin can't be demoted")
+			}
+		}
+
+		errTx := s.userRepository.UpdateRole(ctx, id, role)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "role_changed_to_" + strings.ToLower(role.String()),
+			EntityID: id,
+		})
+	})
+}
@@ -1,6 +1,8 @@
 package user
 
 import (
+	"auth/internal/config"
+	"auth/internal/mailer"
 	"auth/internal/repository"
 	"auth/internal/service"
 
@@ -8,19 +10,34 @@ import (
 )
 
 type serv struct {
-	userRepository repository.UserRepository
-	logRepository  repository.LogRepository
-	txManager      db.TxManager
+	userRepository              repository.UserRepository
+	emailVerificationRepository repository.EmailVerificationRepository
+	accessRepository            repository.AccessRepository
+	logRepository               repository.LogRepository
+	txManager                   db.TxManager
+	authService                 service.AuthService
+	mailer                      mailer.Mailer
+	emailVerificationConfig     config.EmailVerificationConfig
 }
 
 func NewService(
 	userRepository repository.UserRepository,
+	emailVerificationRepository repository.EmailVerificationRepository,
+	accessRepository repository.AccessRepository,
 	logRepository repository.LogRepository,
 	txManager db.TxManager,
+	authService service.AuthService,
+	mailer mailer.Mailer,
+	emailVerificationConfig config.EmailVerificationConfig,
 ) service.UserService {
 	return &serv{
-		userRepository: userRepository,
-		logRepository:  logRepository,
-		txManager:      txManager,
+		userRepository:              userRepository,
+		emailVerificationRepository: emailVerificationRepository,
+		accessRepository:            accessRepository,
+		logRepository:               logRepository,
+		txManager:                   txManager,
+		authService:                 authService,
+		mailer:                      mailer,
+		emailVerificationConfig:     emailVerificationConfig,
 	}
 }
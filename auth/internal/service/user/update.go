@@ -2,14 +2,33 @@ package user
 
 import (
 	"auth/internal/model"
+	"auth/internal/utils"
 	"context"
 
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 )
 
+// Update changes the profile of the caller, or of any user for callers who
+// may manage every account. A new email has to be verified again, the
+// tokens mailed to the old one stop working and a new one is mailed in the
+// background.
 func (s *serv) Update(ctx context.Context, id int64, updateUser *model.UpdateUserData) error {
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		errTx := s.userRepository.Update(ctx, id, updateUser)
+	err := s.authorize(ctx, id, model.PermissionUserManageAny)
+	if err != nil {
+		return err
+	}
+
+	var (
+		updated *model.User
+		token   string
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, errTx := s.userRepository.Get(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = s.userRepository.Update(ctx, id, updateUser)
 		if errTx != nil {
 			return errTx
 		}
@@ -22,12 +41,32 @@ func (s *serv) Update(ctx context.Context, id int64, updateUser *model.UpdateUse
 			return errTx
 		}
 
-		return nil
-	})
+		if updateUser.Email == nil || *updateUser.Email == current.Info.Email {
+			return nil
+		}
+
+		updated, errTx = s.userRepository.Get(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
 
+		errTx = s.emailVerificationRepository.RevokeByUser(ctx, id)
+		if errTx != nil {
+			return errTx
+		}
+
+		token, errTx = s.issueVerificationToken(ctx, id)
+		return errTx
+	})
 	if err != nil {
 		return err
 	}
 
+	if updated != nil {
+		utils.InBackground(ctx, func(ctx context.Context) {
+			s.sendVerificationEmail(ctx, id, &updated.Info, token)
+		})
+	}
+
 	return nil
 }
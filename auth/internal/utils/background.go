@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"context"
+	"time"
+)
+
+// backgroundTimeout bounds the work left running after the response.
+const backgroundTimeout = time.Minute
+
+// InBackground runs fn once the request is answered, detached from the
+// request's cancellation. Work that only happens for existing accounts goes
+// here, so that the response time doesn't tell whether an account exists.
+func InBackground(ctx context.Context, fn func(ctx context.Context)) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), backgroundTimeout)
+
+	go func() {
+		defer cancel()
+		fn(ctx)
+	}()
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"auth/internal/model"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+func GenerateToken(user *model.User, secretKey []byte, duration time.Duration) (string, error) {
+	now := time.Now()
+	claims := model.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatInt(user.ID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+		},
+		UserID: user.ID,
+		Name:   user.Info.Name,
+		Role:   user.Info.Role,
+
+		SessionVersion: user.SessionVersion,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString(secretKey)
+}
+
+func VerifyToken(tokenStr string, secretKey []byte) (*model.UserClaims, error) {
+	token, err := jwt.ParseWithClaims(
+		tokenStr,
+		&model.UserClaims{},
+		func(_ *jwt.Token) (interface{}, error) {
+			return secretKey, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid token")
+	}
+
+	claims, ok := token.Claims.(*model.UserClaims)
+	if !ok {
+		return nil, errors.New("invalid token claims")
+	}
+
+	return claims, nil
+}
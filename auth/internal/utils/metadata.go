@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	authPrefix          = "Bearer "
+)
+
+// AccessTokenFromContext takes the bearer token from the incoming metadata.
+func AccessTokenFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	authHeader, ok := md[authorizationHeader]
+	if !ok || len(authHeader) == 0 {
+		return "", status.Error(codes.Unauthenticated, "authorization header is not provided")
+	}
+
+	if !strings.HasPrefix(authHeader[0], authPrefix) {
+		return "", status.Error(codes.Unauthenticated, "invalid authorization header format")
+	}
+
+	return strings.TrimPrefix(authHeader[0], authPrefix), nil
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyHash stands in for the hash of a user that doesn't exist, it is made
+// at the cost real passwords are hashed with so that comparing against it
+// takes just as long.
+var dummyHash = sync.OnceValue(func() []byte {
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+	return hashedPassword
+})
+
+func HashPassword(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hashedPassword), nil
+}
+
+func VerifyPassword(hashedPassword string, candidatePassword string) bool {
+	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(candidatePassword))
+	return err == nil
+}
+
+// VerifyNoPassword does the work of VerifyPassword for a user that doesn't
+// exist, so that unknown emails can't be told apart by the response time.
+// It always fails.
+func VerifyNoPassword(candidatePassword string) bool {
+	_ = bcrypt.CompareHashAndPassword(dummyHash(), []byte(candidatePassword))
+	return false
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const forwardedForHeader = "x-forwarded-for"
+
+// ClientIPFromContext returns the address of the client without the port,
+// empty when it is unknown. With no trusted proxies in front it is the gRPC
+// peer. Behind them the peer is the last proxy, every proxy appends the
+// address it was called from to x-forwarded-for, so the client is the entry
+// trustedHops from the end. Entries further left are sent by the client
+// and can't be trusted.
+func ClientIPFromContext(ctx context.Context, trustedHops int) string {
+	if trustedHops > 0 {
+		return forwardedClientIP(ctx, trustedHops)
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+func forwardedClientIP(ctx context.Context, trustedHops int) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	var hops []string
+	for _, value := range md.Get(forwardedForHeader) {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	if len(hops) < trustedHops {
+		return ""
+	}
+
+	hop := strings.TrimSpace(hops[len(hops)-trustedHops])
+	if host, _, err := net.SplitHostPort(hop); err == nil {
+		return host
+	}
+
+	return hop
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const tokenBytes = 32
+
+// NewToken generates a random token to hand out to the user together with the
+// hash to store in its place.
+func NewToken() (string, string, error) {
+	raw := make([]byte, tokenBytes)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return token, HashToken(token), nil
+}
+
+// HashToken is enough for random tokens, unlike passwords they can't be guessed
+// from a dictionary.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
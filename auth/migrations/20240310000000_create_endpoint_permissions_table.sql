@@ -0,0 +1,17 @@
+-- +goose Up
+-- Endpoints listed here may only be called by the given roles,
+-- endpoints that are not listed are open to any authenticated user.
+create table endpoint_permissions (
+    id serial primary key,
+    endpoint text not null,
+    role int not null,
+    created_at timestamp not null default now(),
+    unique (endpoint, role)
+);
+
+-- role 2 is ADMIN
+insert into endpoint_permissions (endpoint, role) values
+    ('/chat_server_v1.ChatServerV1/Delete', 2);
+
+-- +goose Down
+drop table endpoint_permissions;
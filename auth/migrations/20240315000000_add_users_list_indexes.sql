@@ -0,0 +1,8 @@
+-- +goose Up
+-- Keyset indexes for ListUsers, email is already covered by its unique index.
+create index users_name_id_idx on users (name, id);
+create index users_created_at_id_idx on users (created_at, id);
+
+-- +goose Down
+drop index users_created_at_id_idx;
+drop index users_name_id_idx;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- session_version is embedded into issued tokens, changing the password bumps
+-- it and so invalidates every refresh token of the user.
+alter table users add column session_version int not null default 0;
+
+-- role 2 is ADMIN
+insert into endpoint_permissions (endpoint, role) values
+    ('/user_v1.UserV1/ResetPassword', 2);
+
+-- +goose Down
+delete from endpoint_permissions where endpoint = '/user_v1.UserV1/ResetPassword';
+alter table users drop column session_version;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Only the sha256 of a token is stored, used_at makes a token single-use.
+create table password_reset_tokens (
+    id serial primary key,
+    user_id int not null references users (id) on delete cascade,
+    token_hash text not null unique,
+    expires_at timestamp not null,
+    used_at timestamp,
+    created_at timestamp not null default now()
+);
+
+create index password_reset_tokens_user_id_idx on password_reset_tokens (user_id);
+
+-- +goose Down
+drop table password_reset_tokens;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Accounts created before verification existed keep working, they count as
+-- verified from the day they were created.
+alter table users add column email_verified_at timestamp;
+update users set email_verified_at = created_at;
+
+-- Only the sha256 of a token is stored, used_at makes a token single-use.
+create table email_verification_tokens (
+    id serial primary key,
+    user_id int not null references users (id) on delete cascade,
+    token_hash text not null unique,
+    expires_at timestamp not null,
+    used_at timestamp,
+    created_at timestamp not null default now()
+);
+
+create index email_verification_tokens_user_id_idx on email_verification_tokens (user_id, created_at);
+
+-- +goose Down
+drop table email_verification_tokens;
+alter table users drop column email_verified_at;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Named permissions of each role, services ask for a permission instead of
+-- comparing roles themselves.
+create table role_permissions (
+    id serial primary key,
+    role int not null,
+    permission text not null,
+    created_at timestamp not null default now(),
+    unique (role, permission)
+);
+
+-- role 2 is ADMIN
+insert into role_permissions (role, permission) values
+    (2, 'chat.delete_any'),
+    (2, 'user.list'),
+    (2, 'user.reset_password'),
+    (2, 'user.set_role');
+
+insert into endpoint_permissions (endpoint, role) values
+    ('/user_v1.UserV1/SetRole', 2);
+
+-- +goose Down
+delete from endpoint_permissions where endpoint = '/user_v1.UserV1/SetRole';
+drop table role_permissions;
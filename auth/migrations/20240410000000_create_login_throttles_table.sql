@@ -0,0 +1,24 @@
+-- +goose Up
+-- Failed credential checks per account (scope 'account', the normalized
+-- email) and per client address (scope 'ip'). blocked_until holds off the
+-- next attempt, by the backoff delay or by a lockout.
+create table login_throttles (
+    scope text not null,
+    subject text not null,
+    failures int not null default 0,
+    last_failure_at timestamp not null,
+    blocked_until timestamp,
+    primary key (scope, subject)
+);
+
+-- role 2 is ADMIN
+insert into role_permissions (role, permission) values
+    (2, 'user.unlock');
+
+insert into endpoint_permissions (endpoint, role) values
+    ('/auth_v1.AuthV1/UnlockUser', 2);
+
+-- +goose Down
+delete from endpoint_permissions where endpoint = '/auth_v1.AuthV1/UnlockUser';
+delete from role_permissions where permission = 'user.unlock';
+drop table login_throttles;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- The user listing exposes emails and roles, it is an admin dashboard
+-- endpoint. role 2 is ADMIN
+insert into endpoint_permissions (endpoint, role) values
+    ('/user_v1.UserV1/ListUsers', 2);
+
+-- +goose Down
+delete from endpoint_permissions where endpoint = '/user_v1.UserV1/ListUsers';
@@ -0,0 +1,43 @@
+-- +goose Up
+-- Endpoints name the permission they need instead of the roles allowed, the
+-- roles holding a permission are only kept in role_permissions. Endpoints
+-- without a named permission get one after themselves, held by the roles
+-- they were open to.
+alter table endpoint_permissions add column permission text;
+
+update endpoint_permissions set permission = case endpoint
+    when '/chat_server_v1.ChatServerV1/Delete' then 'chat.delete_any'
+    when '/user_v1.UserV1/ListUsers' then 'user.list'
+    when '/user_v1.UserV1/ResetPassword' then 'user.reset_password'
+    when '/user_v1.UserV1/SetRole' then 'user.set_role'
+    when '/auth_v1.AuthV1/UnlockUser' then 'user.unlock'
+    else endpoint
+end;
+
+insert into role_permissions (role, permission)
+select role, permission from endpoint_permissions
+on conflict (role, permission) do nothing;
+
+delete from endpoint_permissions a
+using endpoint_permissions b
+where a.endpoint = b.endpoint and a.permission = b.permission and a.id > b.id;
+
+alter table endpoint_permissions drop column role;
+alter table endpoint_permissions alter column permission set not null;
+alter table endpoint_permissions add unique (endpoint, permission);
+
+-- +goose Down
+alter table endpoint_permissions drop constraint endpoint_permissions_endpoint_permission_key;
+alter table endpoint_permissions add column role int;
+
+insert into endpoint_permissions (endpoint, permission, role)
+select e.endpoint, e.permission, r.role
+from endpoint_permissions e
+join role_permissions r on r.permission = e.permission
+where e.role is null;
+
+delete from endpoint_permissions where role is null;
+
+alter table endpoint_permissions drop column permission;
+alter table endpoint_permissions alter column role set not null;
+alter table endpoint_permissions add unique (endpoint, role);
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Users update and delete their own account, user.manage_any lets a role do
+-- it for every account. role 2 is ADMIN
+insert into role_permissions (role, permission) values
+    (2, 'user.manage_any');
+
+-- +goose Down
+delete from role_permissions where permission = 'user.manage_any';
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Keys whose failures left the window are pruned after failed attempts.
+create index login_throttles_last_failure_at_idx on login_throttles (last_failure_at);
+
+-- +goose Down
+drop index login_throttles_last_failure_at_idx;
@@ -3,19 +3,21 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 	"time"
 
 	"github.com/brianvoe/gofakeit"
 	"github.com/fatih/color"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/grpc/metadata"
 
 	desc "chat-server/pkg/chat_server_v1"
 )
 
 const (
 	address = "localhost:50052"
+	chatID  = 1
 )
 
 func main() {
@@ -27,19 +29,20 @@ func main() {
 
 	c := desc.NewChatServerV1Client(conn)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	// The sender is taken from the access token, obtained via AuthV1.Login
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+os.Getenv("ACCESS_TOKEN"))
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 
-	_, err = c.SendMessage(ctx, &desc.SendMessageRequest{
+	r, err := c.SendMessage(ctx, &desc.SendMessageRequest{
+		ChatId: chatID,
 		Message: &desc.Message{
-			From:      gofakeit.Name(),
-			Text:      gofakeit.Sentence(5),
-			Timestamp: timestamppb.New(gofakeit.Date()),
+			Text: gofakeit.Sentence(5),
 		},
 	})
 	if err != nil {
 		log.Fatalf("failed to send message: %v", err)
 	}
 
-	log.Printf("%s\n", color.RedString("Message sent"))
+	log.Printf("%s %s\n", color.RedString("Message sent:"), color.GreenString("id=%d at %v", r.GetId(), r.GetTimestamp().AsTime()))
 }
@@ -5,12 +5,18 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 )
 
-var configPath string
+var (
+	configPath           string
+	resolveLegacyMembers bool
+)
 
 func init() {
 	flag.StringVar(&configPath, "config-path", ".env", "path to config file")
+	flag.BoolVar(&resolveLegacyMembers, "resolve-legacy-members", false,
+		"look the members of legacy chats up in auth and exit, needs an access token in LEGACY_MEMBERS_ACCESS_TOKEN")
 }
 
 func main() {
@@ -22,6 +28,14 @@ func main() {
 		log.Fatalf("failed to init app: %s", err.Error())
 	}
 
+	if resolveLegacyMembers {
+		err = a.ResolveLegacyMembers(ctx, os.Getenv("LEGACY_MEMBERS_ACCESS_TOKEN"))
+		if err != nil {
+			log.Fatalf("failed to resolve legacy members: %s", err.Error())
+		}
+		return
+	}
+
 	err = a.Run()
 	if err != nil {
 		log.Fatalf("failed to run app: %s", err.Error())
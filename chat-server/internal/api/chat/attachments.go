@@ -0,0 +1,77 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const downloadChunkSize = 64 << 10
+
+func (i *Implementation) UploadAttachment(stream desc.ChatServerV1_UploadAttachmentServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	info := req.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "upload must start with the attachment info")
+	}
+
+	attachment, err := i.chatService.UploadAttachment(stream.Context(), converter.ToAttachmentFromDesc(info), func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if req.GetInfo() != nil {
+			return nil, status.Error(codes.InvalidArgument, "attachment info is sent more than once")
+		}
+
+		return req.GetChunk(), nil
+	})
+	if err != nil {
+		return mapError(err)
+	}
+
+	return stream.SendAndClose(&desc.UploadAttachmentResponse{
+		Attachment: converter.ToDescFromAttachment(attachment),
+	})
+}
+
+func (i *Implementation) DownloadAttachment(req *desc.DownloadAttachmentRequest, stream desc.ChatServerV1_DownloadAttachmentServer) error {
+	attachment, content, err := i.chatService.DownloadAttachment(stream.Context(), req.GetAttachmentId())
+	if err != nil {
+		return mapError(err)
+	}
+	defer content.Close()
+
+	err = stream.Send(&desc.DownloadAttachmentResponse{
+		Data: &desc.DownloadAttachmentResponse_Info{Info: converter.ToDescFromAttachment(attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&desc.DownloadAttachmentResponse{
+				Data: &desc.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return mapError(err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *Implementation) ConnectChat(req *desc.ConnectChatRequest, stream desc.ChatServerV1_ConnectChatServer) error {
+	ctx := stream.Context()
+
+	events, err := i.chatService.ConnectChat(ctx, req.GetChatId(), req.GetThreadRootId())
+	if err != nil {
+		return mapError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				// The hub closes streams that fall behind and streams of removed members.
+				return status.Error(codes.Aborted, "stream closed by server, reconnect to continue")
+			}
+
+			err = stream.Send(converter.ToDescFromChatEvent(event))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
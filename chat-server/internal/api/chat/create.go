@@ -10,7 +10,7 @@ import (
 func (i *Implementation) Create(ctx context.Context, req *desc.CreateRequest) (*desc.CreateResponse, error) {
 	id, err := i.chatService.Create(ctx, converter.ToChatFromDesc(req))
 	if err != nil {
-		return nil, err
+		return nil, mapError(err)
 	}
 
 	log.Printf("created chat with id: %d", id)
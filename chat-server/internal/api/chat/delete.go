@@ -10,7 +10,7 @@ import (
 func (i *Implementation) Delete(ctx context.Context, req *desc.DeleteRequest) (*emptypb.Empty, error) {
 	err := i.chatService.Delete(ctx, req.GetId())
 	if err != nil {
-		return nil, err
+		return nil, mapError(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -0,0 +1,17 @@
+package chat
+
+import (
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) DeleteMessage(ctx context.Context, req *desc.DeleteMessageRequest) (*emptypb.Empty, error) {
+	err := i.chatService.DeleteMessage(ctx, req.GetMessageId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
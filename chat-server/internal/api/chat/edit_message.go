@@ -0,0 +1,18 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+)
+
+func (i *Implementation) EditMessage(ctx context.Context, req *desc.EditMessageRequest) (*desc.EditMessageResponse, error) {
+	message, err := i.chatService.EditMessage(ctx, req.GetMessageId(), req.GetText())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.EditMessageResponse{
+		Message: converter.ToDescFromMessage(message),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package chat
+
+import (
+	"chat-server/internal/repository"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func mapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, "chat not found")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,18 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+)
+
+func (i *Implementation) GetChat(ctx context.Context, req *desc.GetChatRequest) (*desc.GetChatResponse, error) {
+	chat, lastMessage, err := i.chatService.GetChat(ctx, req.GetId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.GetChatResponse{
+		Chat: converter.ToDescFromChat(chat, lastMessage),
+	}, nil
+}
@@ -0,0 +1,19 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+)
+
+func (i *Implementation) ListMessages(ctx context.Context, req *desc.ListMessagesRequest) (*desc.ListMessagesResponse, error) {
+	messages, next, err := i.chatService.ListMessages(ctx, req.GetChatId(), req.GetBeforeCursor(), uint64(req.GetLimit()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.ListMessagesResponse{
+		Messages:   converter.ToDescFromMessages(messages),
+		NextCursor: next,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+)
+
+func (i *Implementation) ListMyChats(ctx context.Context, req *desc.ListMyChatsRequest) (*desc.ListMyChatsResponse, error) {
+	chats, next, err := i.chatService.ListMyChats(ctx, req.GetCursor(), uint64(req.GetLimit()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.ListMyChatsResponse{
+		Chats:      converter.ToDescFromChatSummaries(chats),
+		NextCursor: next,
+	}, nil
+}
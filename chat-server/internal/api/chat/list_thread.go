@@ -0,0 +1,20 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+)
+
+func (i *Implementation) ListThread(ctx context.Context, req *desc.ListThreadRequest) (*desc.ListThreadResponse, error) {
+	root, replies, next, err := i.chatService.ListThread(ctx, req.GetRootId(), req.GetCursor(), uint64(req.GetLimit()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.ListThreadResponse{
+		Root:       converter.ToDescFromMessage(root),
+		Replies:    converter.ToDescFromMessages(replies),
+		NextCursor: next,
+	}, nil
+}
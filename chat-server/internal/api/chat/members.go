@@ -0,0 +1,36 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) AddMembers(ctx context.Context, req *desc.AddMembersRequest) (*emptypb.Empty, error) {
+	err := i.chatService.AddMembers(ctx, req.GetChatId(), req.GetUsernames(), model.ChatRole(req.GetRole()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) RemoveMember(ctx context.Context, req *desc.RemoveMemberRequest) (*emptypb.Empty, error) {
+	err := i.chatService.RemoveMember(ctx, req.GetChatId(), req.GetUsername())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) LeaveChat(ctx context.Context, req *desc.LeaveChatRequest) (*emptypb.Empty, error) {
+	err := i.chatService.LeaveChat(ctx, req.GetChatId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
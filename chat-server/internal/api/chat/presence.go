@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *Implementation) GetPresence(ctx context.Context, req *desc.GetPresenceRequest) (*desc.GetPresenceResponse, error) {
+	presence, err := i.chatService.GetPresence(ctx, req.GetUserIds())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.GetPresenceResponse{
+		Presence: converter.ToDescFromPresenceList(presence),
+	}, nil
+}
+
+func (i *Implementation) WatchPresence(req *desc.WatchPresenceRequest, stream desc.ChatServerV1_WatchPresenceServer) error {
+	ctx := stream.Context()
+
+	changes, err := i.chatService.WatchPresence(ctx, req.GetUserIds())
+	if err != nil {
+		return mapError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return status.Error(codes.Aborted, "stream closed by server, reconnect to continue")
+			}
+
+			err = stream.Send(converter.ToDescFromPresence(change))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	"chat-server/internal/model"
+	desc "chat-server/pkg/chat_server_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) PublishSignals(stream desc.ChatServerV1_PublishSignalsServer) error {
+	err := i.chatService.PublishSignals(stream.Context(), func() (*model.Signal, error) {
+		signal, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		return converter.ToSignalFromDesc(signal), nil
+	})
+	if err != nil {
+		return mapError(err)
+	}
+
+	return stream.SendAndClose(&emptypb.Empty{})
+}
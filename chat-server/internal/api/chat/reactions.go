@@ -0,0 +1,26 @@
+package chat
+
+import (
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) AddReaction(ctx context.Context, req *desc.AddReactionRequest) (*emptypb.Empty, error) {
+	err := i.chatService.AddReaction(ctx, req.GetMessageId(), req.GetEmoji())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) RemoveReaction(ctx context.Context, req *desc.RemoveReactionRequest) (*emptypb.Empty, error) {
+	err := i.chatService.RemoveReaction(ctx, req.GetMessageId(), req.GetEmoji())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,29 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) MarkRead(ctx context.Context, req *desc.MarkReadRequest) (*emptypb.Empty, error) {
+	err := i.chatService.MarkRead(ctx, req.GetChatId(), req.GetMessageId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) GetReadReceipts(ctx context.Context, req *desc.GetReadReceiptsRequest) (*desc.GetReadReceiptsResponse, error) {
+	readers, err := i.chatService.GetReadReceipts(ctx, req.GetMessageId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.GetReadReceiptsResponse{
+		Receipts: converter.ToDescFromReadReceipts(readers),
+	}, nil
+}
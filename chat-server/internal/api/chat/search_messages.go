@@ -0,0 +1,19 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+)
+
+func (i *Implementation) SearchMessages(ctx context.Context, req *desc.SearchMessagesRequest) (*desc.SearchMessagesResponse, error) {
+	results, next, err := i.chatService.SearchMessages(ctx, converter.ToMessageSearchFromDesc(req), req.GetCursor(), uint64(req.GetLimit()))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &desc.SearchMessagesResponse{
+		Results:    converter.ToDescFromSearchResults(results),
+		NextCursor: next,
+	}, nil
+}
@@ -5,14 +5,17 @@ import (
 	desc "chat-server/pkg/chat_server_v1"
 	"context"
 
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (i *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*emptypb.Empty, error) {
-	err := i.chatService.SendMessage(ctx, converter.ToMessageFromDesc(req))
+func (i *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*desc.SendMessageResponse, error) {
+	message, err := i.chatService.SendMessage(ctx, converter.ToMessageFromDesc(req))
 	if err != nil {
-		return nil, err
+		return nil, mapError(err)
 	}
 
-	return &emptypb.Empty{}, nil
+	return &desc.SendMessageResponse{
+		Id:        message.ID,
+		Timestamp: timestamppb.New(message.Timestamp),
+	}, nil
 }
@@ -0,0 +1,580 @@
+package chat_test
+
+import (
+	"bytes"
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	"chat-server/internal/service"
+	chatService "chat-server/internal/service/chat"
+	"chat-server/internal/storage"
+	"chat-server/internal/storage/local"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_UploadAttachment(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	var (
+		mc        = minimock.NewController(t)
+		timestamp = time.Now()
+
+		content = []byte("hello, attachments")
+		digest  = sha256.Sum256(content)
+
+		info = &desc.AttachmentInfo{ChatId: membersChatID, FileName: "notes.txt"}
+
+		// expiredBlobKey is the blob of an expired upload, pruned by the next
+		// upload.
+		expiredBlobKey = "abcdef0123456789abcdef0123456789"
+
+		repoErr = errors.New("repository error")
+	)
+
+	getChat := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+		mock := mocks.NewChatRepositoryMock(mc)
+		mock.GetMock.Expect(minimock.AnyContext, membersChatID).Return(membersChat(), nil)
+		return mock
+	}
+
+	// withinQuota lets the upload through the quota checks, usage is what
+	// the user holds before it.
+	withinQuota := func(mock *mocks.MessageRepositoryMock, usage *model.UploadUsage) *mocks.MessageRepositoryMock {
+		mock.DeleteUnsentAttachmentsMock.Expect(memberCtx, 24*time.Hour).Return(nil, nil)
+		mock.GetUploadUsageMock.Expect(minimock.AnyContext, 3, 24*time.Hour).Return(usage, nil)
+		mock.LockUploadsMock.Optional().Expect(memberCtx, 3).Return(nil)
+		return mock
+	}
+	uploadRejected := func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+		return withinQuota(mocks.NewMessageRepositoryMock(mc), &model.UploadUsage{})
+	}
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		stream                *uploadAttachmentStreamMock
+		want                  *desc.Attachment
+		code                  codes.Code
+		err                   error
+		expiredBlob           bool
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+	}{
+		{
+			name:               "success case",
+			ctx:                memberCtx,
+			stream:             newUploadAttachmentStreamMock(memberCtx, info, content[:5], nil, content[5:]),
+			expiredBlob:        true,
+			chatRepositoryMock: getChat,
+			want: &desc.Attachment{
+				Id:         5,
+				ChatId:     membersChatID,
+				UploadedBy: "member",
+				FileName:   "notes.txt",
+				MimeType:   "text/plain; charset=utf-8",
+				Size:       int64(len(content)),
+				Sha256:     hex.EncodeToString(digest[:]),
+				CreatedAt:  timestamppb.New(timestamp),
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.DeleteUnsentAttachmentsMock.Expect(memberCtx, 24*time.Hour).Return([]string{expiredBlobKey}, nil)
+				mock.GetUploadUsageMock.Expect(minimock.AnyContext, 3, 24*time.Hour).Return(&model.UploadUsage{Count: 3, Size: 1 << 20}, nil)
+				mock.LockUploadsMock.Expect(memberCtx, 3).Return(nil)
+				mock.CreateAttachmentMock.Set(func(_ context.Context, attachment *model.Attachment) (*model.Attachment, error) {
+					require.Len(t, attachment.BlobKey, 32)
+					require.Equal(t, int64(3), attachment.UploaderID)
+
+					created := *attachment
+					created.ID = 5
+					created.CreatedAt = timestamp
+					return &created, nil
+				})
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(memberCtx, &logModel.Log{Action: "attachment_uploaded", EntityID: 5}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "declared mime type is kept",
+			ctx:  memberCtx,
+			stream: newUploadAttachmentStreamMock(memberCtx,
+				&desc.AttachmentInfo{ChatId: membersChatID, FileName: "data.json", MimeType: "Application/JSON"},
+				[]byte(`{"a":1}`),
+			),
+			chatRepositoryMock: getChat,
+			want: &desc.Attachment{
+				Id:         6,
+				ChatId:     membersChatID,
+				UploadedBy: "member",
+				FileName:   "data.json",
+				MimeType:   "application/json",
+				Size:       7,
+				Sha256:     "015abd7f5cc57a2dd94b7590f04ad8084273905ee33ec5cebeae62276a97f862",
+				CreatedAt:  timestamppb.New(timestamp),
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := withinQuota(mocks.NewMessageRepositoryMock(mc), &model.UploadUsage{})
+				mock.CreateAttachmentMock.Set(func(_ context.Context, attachment *model.Attachment) (*model.Attachment, error) {
+					created := *attachment
+					created.ID = 6
+					created.CreatedAt = timestamp
+					return &created, nil
+				})
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name:                  "too large",
+			ctx:                   memberCtx,
+			stream:                newUploadAttachmentStreamMock(memberCtx, info, make([]byte, 10<<20), make([]byte, 10<<20), []byte{1}),
+			code:                  codes.InvalidArgument,
+			err:                   errors.New("attachment is larger than 20 MiB"),
+			chatRepositoryMock:    getChat,
+			messageRepositoryMock: uploadRejected,
+		},
+		{
+			name:                  "empty attachment",
+			ctx:                   memberCtx,
+			stream:                newUploadAttachmentStreamMock(memberCtx, info),
+			code:                  codes.InvalidArgument,
+			err:                   errors.New("attachment is empty"),
+			chatRepositoryMock:    getChat,
+			messageRepositoryMock: uploadRejected,
+		},
+		{
+			name:               "too many unsent attachments",
+			ctx:                memberCtx,
+			stream:             newUploadAttachmentStreamMock(memberCtx, info, content),
+			code:               codes.ResourceExhausted,
+			err:                errors.New("too many unsent attachments, send them or wait for them to expire"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return withinQuota(mocks.NewMessageRepositoryMock(mc), &model.UploadUsage{Count: 50, Size: 50})
+			},
+		},
+		{
+			name:               "upload doesn't fit into the quota",
+			ctx:                memberCtx,
+			stream:             newUploadAttachmentStreamMock(memberCtx, info, content),
+			code:               codes.ResourceExhausted,
+			err:                errors.New("too many unsent attachments, send them or wait for them to expire"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return withinQuota(mocks.NewMessageRepositoryMock(mc), &model.UploadUsage{Count: 10, Size: 200<<20 - 5})
+			},
+		},
+		{
+			name:   "content before info",
+			ctx:    memberCtx,
+			stream: newUploadAttachmentStreamMock(memberCtx, nil, content),
+			code:   codes.InvalidArgument,
+			err:    errors.New("upload must start with the attachment info"),
+		},
+		{
+			name: "info sent twice",
+			ctx:  memberCtx,
+			stream: &uploadAttachmentStreamMock{ctx: memberCtx, requests: []*desc.UploadAttachmentRequest{
+				{Data: &desc.UploadAttachmentRequest_Info{Info: info}},
+				{Data: &desc.UploadAttachmentRequest_Info{Info: info}},
+			}},
+			code:                  codes.InvalidArgument,
+			err:                   errors.New("attachment info is sent more than once"),
+			chatRepositoryMock:    getChat,
+			messageRepositoryMock: uploadRejected,
+		},
+		{
+			name:   "empty file name",
+			ctx:    memberCtx,
+			stream: newUploadAttachmentStreamMock(memberCtx, &desc.AttachmentInfo{ChatId: membersChatID}, content),
+			code:   codes.InvalidArgument,
+			err:    errors.New("file name is empty"),
+		},
+		{
+			name:   "file name with a path",
+			ctx:    memberCtx,
+			stream: newUploadAttachmentStreamMock(memberCtx, &desc.AttachmentInfo{ChatId: membersChatID, FileName: "../etc/passwd"}, content),
+			code:   codes.InvalidArgument,
+			err:    errors.New("invalid file name"),
+		},
+		{
+			name:   "file name too long",
+			ctx:    memberCtx,
+			stream: newUploadAttachmentStreamMock(memberCtx, &desc.AttachmentInfo{ChatId: membersChatID, FileName: strings.Repeat("a", 256)}, content),
+			code:   codes.InvalidArgument,
+			err:    errors.New("file name is too long"),
+		},
+		{
+			name:   "invalid mime type",
+			ctx:    memberCtx,
+			stream: newUploadAttachmentStreamMock(memberCtx, &desc.AttachmentInfo{ChatId: membersChatID, FileName: "a.txt", MimeType: "not a type"}, content),
+			code:   codes.InvalidArgument,
+			err:    errors.New("invalid mime type"),
+		},
+		{
+			name:               "not a member of the chat",
+			ctx:                outsiderCtx,
+			stream:             newUploadAttachmentStreamMock(outsiderCtx, info, content),
+			code:               codes.PermissionDenied,
+			err:                errors.New("user is not a member of the chat"),
+			chatRepositoryMock: getChat,
+		},
+		{
+			name:   "unauthenticated",
+			ctx:    context.Background(),
+			stream: newUploadAttachmentStreamMock(context.Background(), info, content),
+			code:   codes.Unauthenticated,
+			err:    errors.New("user is not authenticated"),
+		},
+		{
+			name:               "repository error",
+			ctx:                memberCtx,
+			stream:             newUploadAttachmentStreamMock(memberCtx, info, content),
+			code:               codes.Internal,
+			err:                repoErr,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := withinQuota(mocks.NewMessageRepositoryMock(mc), &model.UploadUsage{})
+				mock.CreateAttachmentMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			blobStore, err := local.NewBlobStore(dir)
+			require.NoError(t, err)
+			if tt.expiredBlob {
+				require.NoError(t, blobStore.Put(context.Background(), expiredBlobKey, bytes.NewReader(content)))
+			}
+
+			api := chat.NewImplementation(newAttachmentsService(mc, blobStore, tt.chatRepositoryMock, tt.messageRepositoryMock, tt.logRepositoryMock))
+
+			err = api.UploadAttachment(tt.stream)
+			requireStatus(t, tt.code, tt.err, err)
+
+			blobs := blobFiles(t, dir)
+			if tt.err != nil {
+				require.Empty(t, blobs, "failed upload left a blob behind")
+				return
+			}
+
+			require.True(t, proto.Equal(tt.want, tt.stream.response.GetAttachment()), "got %v", tt.stream.response)
+			require.Len(t, blobs, 1, "expired blob is left behind")
+		})
+	}
+}
+
+func TestImplementation_DownloadAttachment(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+
+	var (
+		mc        = minimock.NewController(t)
+		timestamp = time.Now()
+		blobKey   = "0123456789abcdef0123456789abcdef"
+
+		// Large enough to be sent in two chunks.
+		content = bytes.Repeat([]byte("attachment"), 10<<10)
+
+		attachment = func() *model.Attachment {
+			return &model.Attachment{
+				ID:         5,
+				ChatID:     membersChatID,
+				MessageID:  editedMessageID,
+				UploaderID: 3,
+				UploadedBy: "member",
+				FileName:   "notes.txt",
+				MimeType:   "text/plain",
+				Size:       int64(len(content)),
+				BlobKey:    blobKey,
+				CreatedAt:  timestamp,
+			}
+		}
+	)
+
+	getChat := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+		mock := mocks.NewChatRepositoryMock(mc)
+		mock.GetMock.Expect(minimock.AnyContext, membersChatID).Return(membersChat(), nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		code                  codes.Code
+		err                   error
+		missingBlob           bool
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+	}{
+		{
+			name:               "success case",
+			ctx:                adminCtx,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetAttachmentMock.Expect(adminCtx, 5).Return(attachment(), nil)
+				mock.GetMock.Expect(adminCtx, editedMessageID).Return(memberMessage(), nil)
+				return mock
+			},
+		},
+		{
+			name:               "attachment of a deleted message",
+			ctx:                adminCtx,
+			code:               codes.NotFound,
+			err:                errors.New("attachment not found"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				message := memberMessage()
+				message.DeletedAt = sql.NullTime{Time: timestamp, Valid: true}
+
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetAttachmentMock.Return(attachment(), nil)
+				mock.GetMock.Return(message, nil)
+				return mock
+			},
+		},
+		{
+			name: "attachment not found",
+			ctx:  adminCtx,
+			code: codes.NotFound,
+			err:  errors.New("attachment not found"),
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetAttachmentMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name:               "blob is missing",
+			ctx:                adminCtx,
+			code:               codes.NotFound,
+			err:                errors.New("attachment not found"),
+			missingBlob:        true,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetAttachmentMock.Return(attachment(), nil)
+				mock.GetMock.Return(memberMessage(), nil)
+				return mock
+			},
+		},
+		{
+			name:               "not a member of the chat",
+			ctx:                outsiderCtx,
+			code:               codes.PermissionDenied,
+			err:                errors.New("user is not a member of the chat"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetAttachmentMock.Return(attachment(), nil)
+				return mock
+			},
+		},
+		{
+			name: "unauthenticated",
+			ctx:  context.Background(),
+			code: codes.Unauthenticated,
+			err:  errors.New("user is not authenticated"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobStore, err := local.NewBlobStore(t.TempDir())
+			require.NoError(t, err)
+			if !tt.missingBlob {
+				require.NoError(t, blobStore.Put(context.Background(), blobKey, bytes.NewReader(content)))
+			}
+
+			api := chat.NewImplementation(newAttachmentsService(mc, blobStore, tt.chatRepositoryMock, tt.messageRepositoryMock, nil))
+
+			stream := &downloadAttachmentStreamMock{ctx: tt.ctx}
+			err = api.DownloadAttachment(&desc.DownloadAttachmentRequest{AttachmentId: 5}, stream)
+			requireStatus(t, tt.code, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+
+			require.Len(t, stream.responses, 3)
+			require.True(t, proto.Equal(&desc.Attachment{
+				Id:         5,
+				ChatId:     membersChatID,
+				MessageId:  editedMessageID,
+				UploadedBy: "member",
+				FileName:   "notes.txt",
+				MimeType:   "text/plain",
+				Size:       int64(len(content)),
+				CreatedAt:  timestamppb.New(timestamp),
+			}, stream.responses[0].GetInfo()))
+
+			var downloaded []byte
+			for _, res := range stream.responses[1:] {
+				downloaded = append(downloaded, res.GetChunk()...)
+			}
+			require.Equal(t, content, downloaded)
+		})
+	}
+}
+
+func TestImplementation_SendMessage_Attachments(t *testing.T) {
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+
+	var (
+		mc        = minimock.NewController(t)
+		messageID = int64(456)
+		timestamp = time.Now()
+
+		created = func() *model.Message {
+			return &model.Message{ID: messageID, ChatID: membersChatID, From: "member", Timestamp: timestamp}
+		}
+
+		attached = []*model.Attachment{
+			{ID: 5, ChatID: membersChatID, MessageID: messageID, UploaderID: 3, UploadedBy: "member", FileName: "a.png"},
+			{ID: 6, ChatID: membersChatID, MessageID: messageID, UploaderID: 3, UploadedBy: "member", FileName: "b.png"},
+		}
+	)
+
+	tests := []struct {
+		name                  string
+		ids                   []int64
+		code                  codes.Code
+		err                   error
+		messageRepositoryMock messageRepositoryMockFunc
+	}{
+		{
+			name: "attachments without text",
+			ids:  []int64{5, 6, 5},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Return(created(), nil)
+				mock.AttachToMessageMock.Expect(memberCtx, created(), 3, []int64{5, 6}, 24*time.Hour).Return(attached, nil)
+				return mock
+			},
+		},
+		{
+			name: "attachment of someone else, already sent or expired",
+			ids:  []int64{5, 6},
+			code: codes.InvalidArgument,
+			err:  errors.New("attachment not found, already sent or expired"),
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Return(created(), nil)
+				mock.AttachToMessageMock.Return(attached[:1], nil)
+				return mock
+			},
+		},
+		{
+			name: "too many attachments",
+			ids:  []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			code: codes.InvalidArgument,
+			err:  errors.New("too many attachments"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepositoryMock := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(membersChat(), nil)
+				mock.UpdateLastMessageMock.Optional().Return(nil)
+				return mock
+			}
+			logRepositoryMock := func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Optional().Return(nil)
+				return mock
+			}
+			if tt.messageRepositoryMock == nil {
+				chatRepositoryMock, logRepositoryMock = nil, nil
+			}
+
+			api := chat.NewImplementation(newMembersService(mc, chatRepositoryMock, tt.messageRepositoryMock, logRepositoryMock, nil))
+
+			res, err := api.SendMessage(memberCtx, &desc.SendMessageRequest{
+				ChatId:        membersChatID,
+				Message:       &desc.Message{},
+				AttachmentIds: tt.ids,
+			})
+			requireStatus(t, tt.code, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, messageID, res.GetId())
+			}
+		})
+	}
+}
+
+func newAttachmentsService(
+	mc *minimock.Controller,
+	blobStore storage.BlobStore,
+	chatRepositoryMock func(mc *minimock.Controller) *mocks.ChatRepositoryMock,
+	messageRepositoryMock func(mc *minimock.Controller) *mocks.MessageRepositoryMock,
+	logRepositoryMock func(mc *minimock.Controller) *mocks.LogRepositoryMock,
+) service.ChatService {
+	chatRepo := mocks.NewChatRepositoryMock(mc)
+	if chatRepositoryMock != nil {
+		chatRepo = chatRepositoryMock(mc)
+	}
+	messageRepo := mocks.NewMessageRepositoryMock(mc)
+	if messageRepositoryMock != nil {
+		messageRepo = messageRepositoryMock(mc)
+	}
+	logRepo := mocks.NewLogRepositoryMock(mc)
+	if logRepositoryMock != nil {
+		logRepo = logRepositoryMock(mc)
+	}
+
+	return chatService.NewService(chatRepo, messageRepo, newPresenceRepositoryMock(mc), logRepo, &txManagerMock{}, rpcMocks.NewUserClientMock(mc), blobStore)
+}
+
+// blobFiles lists the files the blob store left under dir.
+func blobFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	return files
+}
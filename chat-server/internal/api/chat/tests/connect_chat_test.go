@@ -0,0 +1,207 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_ConnectChat(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+
+	var (
+		mc     = minimock.NewController(t)
+		chatID = int64(123)
+
+		chatModel = &model.Chat{
+			ID: chatID,
+			Members: []*model.ChatMember{
+				{ChatID: chatID, UserID: 1, Username: "user1", Role: model.ChatRoleMember},
+				{ChatID: chatID, UserID: 2, Username: "user2", Role: model.ChatRoleMember},
+			},
+		}
+
+		memberCtx   = identity.WithUser(context.Background(), &model.User{ID: 1, Username: "user1"})
+		strangerCtx = identity.WithUser(context.Background(), &model.User{ID: 3, Username: "stranger"})
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		req                *desc.ConnectChatRequest
+		code               codes.Code
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "unauthenticated",
+			ctx:  context.Background(),
+			req:  &desc.ConnectChatRequest{ChatId: chatID},
+			code: codes.Unauthenticated,
+			err:  errors.New("user is not authenticated"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "chat not found",
+			ctx:  memberCtx,
+			req:  &desc.ConnectChatRequest{ChatId: chatID},
+			code: codes.NotFound,
+			err:  errors.New("chat not found"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "user is not a member",
+			ctx:  strangerCtx,
+			req:  &desc.ConnectChatRequest{ChatId: chatID},
+			code: codes.PermissionDenied,
+			err:  errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(chatModel, nil)
+				return mock
+			},
+		},
+		{
+			name: "repository error",
+			ctx:  memberCtx,
+			req:  &desc.ConnectChatRequest{ChatId: chatID},
+			code: codes.Internal,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := chatService.NewService(
+				tt.chatRepositoryMock(mc),
+				mocks.NewMessageRepositoryMock(mc),
+				newPresenceRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				rpcMocks.NewUserClientMock(mc),
+				storageMocks.NewBlobStoreMock(mc),
+			)
+
+			api := chat.NewImplementation(service)
+
+			err := api.ConnectChat(tt.req, newConnectChatStreamMock(tt.ctx))
+
+			require.Error(t, err)
+			require.Equal(t, tt.code, status.Code(err))
+			require.Contains(t, err.Error(), tt.err.Error())
+		})
+	}
+}
+
+func TestImplementation_ConnectChat_Delivery(t *testing.T) {
+	var (
+		mc        = minimock.NewController(t)
+		chatID    = int64(123)
+		messageID = int64(456)
+		timestamp = time.Now()
+
+		chatModel = &model.Chat{
+			ID: chatID,
+			Members: []*model.ChatMember{
+				{ChatID: chatID, UserID: 1, Username: "user1", Role: model.ChatRoleMember},
+				{ChatID: chatID, UserID: 2, Username: "user2", Role: model.ChatRoleMember},
+			},
+		}
+
+		createdMessage = &model.Message{
+			ID:        messageID,
+			ChatID:    chatID,
+			From:      "user1",
+			Text:      "Hello, World!",
+			Timestamp: timestamp,
+		}
+
+		want = &desc.Message{
+			Id:        messageID,
+			ChatId:    chatID,
+			From:      "user1",
+			Text:      "Hello, World!",
+			Timestamp: timestamppb.New(timestamp),
+		}
+	)
+
+	chatRepoMock := mocks.NewChatRepositoryMock(mc)
+	chatRepoMock.GetMock.Return(chatModel, nil)
+	chatRepoMock.UpdateLastMessageMock.Return(nil)
+
+	messageRepoMock := mocks.NewMessageRepositoryMock(mc)
+	messageRepoMock.CreateMock.Return(createdMessage, nil)
+
+	logRepoMock := mocks.NewLogRepositoryMock(mc)
+	logRepoMock.LogMock.Return(nil)
+
+	service := chatService.NewService(chatRepoMock, messageRepoMock, newPresenceRepositoryMock(mc), logRepoMock, &txManagerMock{}, rpcMocks.NewUserClientMock(mc), storageMocks.NewBlobStoreMock(mc))
+	api := chat.NewImplementation(service)
+
+	senderCtx := identity.WithUser(context.Background(), &model.User{ID: 1, Username: "user1"})
+
+	ctx, cancel := context.WithCancel(identity.WithUser(context.Background(), &model.User{ID: 2, Username: "user2"}))
+	stream := newConnectChatStreamMock(ctx)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- api.ConnectChat(&desc.ConnectChatRequest{ChatId: chatID}, stream)
+	}()
+
+	// The subscription is registered asynchronously, so keep sending until it is delivered.
+	var got *desc.Message
+	require.Eventually(t, func() bool {
+		_, err := api.SendMessage(senderCtx, &desc.SendMessageRequest{
+			ChatId:  chatID,
+			Message: &desc.Message{Text: "Hello, World!"},
+		})
+		require.NoError(t, err)
+
+		select {
+		case event := <-stream.events:
+			got = event.GetMessage()
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	require.Equal(t, want, got)
+
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("stream did not finish after context cancellation")
+	}
+}
@@ -2,9 +2,13 @@ package chat_test
 
 import (
 	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
 	"chat-server/internal/model"
+	"chat-server/internal/repository"
 	"chat-server/internal/repository/mocks"
 	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
 	desc "chat-server/pkg/chat_server_v1"
 	"context"
 	"errors"
@@ -13,11 +17,14 @@ import (
 	"github.com/gojuno/minimock/v3"
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestImplementation_Create(t *testing.T) {
 	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
 	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	type userClientMockFunc func(mc *minimock.Controller) *rpcMocks.UserClientMock
 
 	type args struct {
 		ctx context.Context
@@ -25,18 +32,29 @@ func TestImplementation_Create(t *testing.T) {
 	}
 
 	var (
-		ctx = context.Background()
+		ctx = identity.WithUser(context.Background(), &model.User{ID: 10, Username: "owner"})
 		mc  = minimock.NewController(t)
 
 		usernames = []string{"user1", "user2"}
 		chatID    = int64(123)
 
+		// Duplicates and the creator are dropped before the lookup.
 		req = &desc.CreateRequest{
-			Usernames: usernames,
+			Usernames: []string{"user1", "user2", "user1", "owner"},
+		}
+
+		users = []*model.User{
+			{ID: 2, Username: "user2"},
+			{ID: 1, Username: "user1"},
 		}
 
 		chatModel = &model.Chat{
-			Usernames: usernames,
+			Type: model.ChatTypeGroup,
+			Members: []*model.ChatMember{
+				{UserID: 10, Username: "owner", Role: model.ChatRoleOwner},
+				{UserID: 1, Username: "user1", Role: model.ChatRoleMember},
+				{UserID: 2, Username: "user2", Role: model.ChatRoleMember},
+			},
 		}
 
 		logEntry = &logModel.Log{
@@ -48,13 +66,21 @@ func TestImplementation_Create(t *testing.T) {
 		logErr  = errors.New("log error")
 	)
 
+	resolved := func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+		mock := rpcMocks.NewUserClientMock(mc)
+		mock.GetByNamesMock.Expect(ctx, usernames).Return(users, nil)
+		return mock
+	}
+
 	tests := []struct {
 		name               string
 		args               args
 		want               int64
+		code               codes.Code
 		err                error
 		chatRepositoryMock chatRepositoryMockFunc
 		logRepositoryMock  logRepositoryMockFunc
+		userClientMock     userClientMockFunc
 	}{
 		{
 			name: "success case",
@@ -74,6 +100,7 @@ func TestImplementation_Create(t *testing.T) {
 				mock.LogMock.Expect(ctx, logEntry).Return(nil)
 				return mock
 			},
+			userClientMock: resolved,
 		},
 		{
 			name: "repository error",
@@ -92,6 +119,7 @@ func TestImplementation_Create(t *testing.T) {
 				mock := mocks.NewLogRepositoryMock(mc)
 				return mock
 			},
+			userClientMock: resolved,
 		},
 		{
 			name: "log error",
@@ -111,6 +139,248 @@ func TestImplementation_Create(t *testing.T) {
 				mock.LogMock.Expect(ctx, logEntry).Return(logErr)
 				return mock
 			},
+			userClientMock: resolved,
+		},
+		{
+			name: "unknown username",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("unknown usernames: user1"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(ctx, usernames).Return(users[:1], nil)
+				return mock
+			},
+		},
+		{
+			name: "ambiguous username",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New(`username "user1" is ambiguous`),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(ctx, usernames).Return(append(users, &model.User{ID: 3, Username: "user1"}), nil)
+				return mock
+			},
+		},
+		{
+			name: "creator only",
+			args: args{
+				ctx: ctx,
+				req: &desc.CreateRequest{},
+			},
+			want: chatID,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.CreateMock.Expect(ctx, &model.Chat{
+					Type: model.ChatTypeGroup,
+					Members: []*model.ChatMember{
+						{UserID: 10, Username: "owner", Role: model.ChatRoleOwner},
+					},
+				}).Return(chatID, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, logEntry).Return(nil)
+				return mock
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				return rpcMocks.NewUserClientMock(mc)
+			},
+		},
+		{
+			name: "new direct chat",
+			args: args{
+				ctx: ctx,
+				req: &desc.CreateRequest{Usernames: []string{"user1"}, Type: desc.ChatType_CHAT_TYPE_DIRECT},
+			},
+			want: chatID,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetDirectMock.Expect(ctx, int64(10), int64(1)).Return(0, repository.ErrNotFound)
+				mock.CreateMock.Expect(ctx, &model.Chat{
+					Type: model.ChatTypeDirect,
+					Members: []*model.ChatMember{
+						{UserID: 10, Username: "owner", Role: model.ChatRoleMember},
+						{UserID: 1, Username: "user1", Role: model.ChatRoleMember},
+					},
+				}).Return(chatID, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ctx, logEntry).Return(nil)
+				return mock
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(ctx, []string{"user1"}).Return(users[1:], nil)
+				return mock
+			},
+		},
+		{
+			name: "existing direct chat",
+			args: args{
+				ctx: ctx,
+				req: &desc.CreateRequest{Usernames: []string{"user1"}, Type: desc.ChatType_CHAT_TYPE_DIRECT},
+			},
+			want: 77,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetDirectMock.Expect(ctx, int64(10), int64(1)).Return(77, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(ctx, []string{"user1"}).Return(users[1:], nil)
+				return mock
+			},
+		},
+		{
+			name: "direct chat created concurrently",
+			args: args{
+				ctx: ctx,
+				req: &desc.CreateRequest{Usernames: []string{"user1"}, Type: desc.ChatType_CHAT_TYPE_DIRECT},
+			},
+			want: 77,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				// The other request commits between the lookup and the insert.
+				created := false
+				mock.GetDirectMock.Set(func(_ context.Context, userID, otherUserID int64) (int64, error) {
+					require.Equal(t, int64(10), userID)
+					require.Equal(t, int64(1), otherUserID)
+					if !created {
+						return 0, repository.ErrNotFound
+					}
+					return 77, nil
+				})
+				mock.CreateMock.Set(func(_ context.Context, _ *model.Chat) (int64, error) {
+					created = true
+					return 0, repository.ErrAlreadyExists
+				})
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(ctx, []string{"user1"}).Return(users[1:], nil)
+				return mock
+			},
+		},
+		{
+			name: "direct chat with several users",
+			args: args{
+				ctx: ctx,
+				req: &desc.CreateRequest{Usernames: usernames, Type: desc.ChatType_CHAT_TYPE_DIRECT},
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("a direct chat needs exactly one other user"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: resolved,
+		},
+		{
+			name: "direct chat with title",
+			args: args{
+				ctx: ctx,
+				req: &desc.CreateRequest{Usernames: []string{"user1"}, Type: desc.ChatType_CHAT_TYPE_DIRECT, Title: "us"},
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("direct chats have no title, description or avatar"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				return rpcMocks.NewUserClientMock(mc)
+			},
+		},
+		{
+			name: "invalid avatar url",
+			args: args{
+				ctx: ctx,
+				req: &desc.CreateRequest{Usernames: usernames, AvatarUrl: "/avatars/1.png"},
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("avatar url must be an absolute http(s) url"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				return rpcMocks.NewUserClientMock(mc)
+			},
+		},
+		{
+			name: "unauthenticated",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			code: codes.Unauthenticated,
+			err:  errors.New("user is not authenticated"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				return rpcMocks.NewUserClientMock(mc)
+			},
+		},
+		{
+			name: "auth unavailable",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			code: codes.Unavailable,
+			err:  errors.New("connection refused"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(ctx, usernames).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+				return mock
+			},
 		},
 	}
 
@@ -118,13 +388,17 @@ func TestImplementation_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			chatRepoMock := tt.chatRepositoryMock(mc)
 			logRepoMock := tt.logRepositoryMock(mc)
-			
+
 			txManager := &txManagerMock{}
 
 			service := chatService.NewService(
 				chatRepoMock,
+				mocks.NewMessageRepositoryMock(mc),
+				newPresenceRepositoryMock(mc),
 				logRepoMock,
 				txManager,
+				tt.userClientMock(mc),
+				storageMocks.NewBlobStoreMock(mc),
 			)
 
 			api := chat.NewImplementation(service)
@@ -133,6 +407,9 @@ func TestImplementation_Create(t *testing.T) {
 
 			if tt.err != nil {
 				require.Error(t, err)
+				if tt.code != codes.OK {
+					require.Equal(t, tt.code, status.Code(err))
+				}
 				require.Contains(t, err.Error(), tt.err.Error())
 				require.Nil(t, resp)
 			} else {
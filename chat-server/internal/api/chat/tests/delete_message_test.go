@@ -0,0 +1,165 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	"chat-server/internal/storage/local"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestImplementation_DeleteMessage(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	var (
+		mc = minimock.NewController(t)
+
+		tombstone = &model.Message{ID: editedMessageID, ChatID: membersChatID, From: "member"}
+		blobKey   = "0123456789abcdef0123456789abcdef"
+
+		repoErr = errors.New("repository error")
+	)
+
+	getChat := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+		mock := mocks.NewChatRepositoryMock(mc)
+		mock.GetMock.Expect(minimock.AnyContext, membersChatID).Return(membersChat(), nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		code                  codes.Code
+		err                   error
+		blobDeleted           bool
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+	}{
+		{
+			name:               "author deletes",
+			ctx:                memberCtx,
+			blobDeleted:        true,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, editedMessageID).Return(memberMessage(), nil)
+				mock.DeleteMock.Expect(memberCtx, editedMessageID, "member").Return(tombstone, nil)
+				mock.DeleteMessageAttachmentsMock.Expect(memberCtx, editedMessageID).Return([]string{blobKey}, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(memberCtx, &logModel.Log{Action: "message_deleted", EntityID: editedMessageID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:               "owner deletes someone else's message",
+			ctx:                ownerCtx,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, editedMessageID).Return(memberMessage(), nil)
+				mock.DeleteMock.Expect(ownerCtx, editedMessageID, "owner").Return(tombstone, nil)
+				mock.DeleteMessageAttachmentsMock.Expect(ownerCtx, editedMessageID).Return(nil, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name:               "member can't delete someone else's message",
+			ctx:                memberCtx,
+			code:               codes.PermissionDenied,
+			err:                errors.New("only the author and chat admins can change the message"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(&model.Message{ID: editedMessageID, ChatID: membersChatID, From: "owner", Text: "hi"}, nil)
+				return mock
+			},
+		},
+		{
+			name:               "deleted concurrently",
+			ctx:                memberCtx,
+			code:               codes.NotFound,
+			err:                errors.New("message not found"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(memberMessage(), nil)
+				mock.DeleteMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name:               "attachments error",
+			ctx:                memberCtx,
+			code:               codes.Internal,
+			err:                repoErr,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(memberMessage(), nil)
+				mock.DeleteMock.Return(tombstone, nil)
+				mock.DeleteMessageAttachmentsMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+		{
+			name:               "log error",
+			ctx:                memberCtx,
+			code:               codes.Internal,
+			err:                repoErr,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(memberMessage(), nil)
+				mock.DeleteMock.Return(tombstone, nil)
+				mock.DeleteMessageAttachmentsMock.Return([]string{blobKey}, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			blobStore, err := local.NewBlobStore(dir)
+			require.NoError(t, err)
+			require.NoError(t, blobStore.Put(context.Background(), blobKey, strings.NewReader("attachment")))
+
+			api := chat.NewImplementation(newAttachmentsService(mc, blobStore, tt.chatRepositoryMock, tt.messageRepositoryMock, tt.logRepositoryMock))
+
+			_, err = api.DeleteMessage(tt.ctx, &desc.DeleteMessageRequest{MessageId: editedMessageID})
+			requireStatus(t, tt.code, tt.err, err)
+
+			if tt.blobDeleted {
+				require.Empty(t, blobFiles(t, dir))
+			} else {
+				require.Len(t, blobFiles(t, dir), 1)
+			}
+		})
+	}
+}
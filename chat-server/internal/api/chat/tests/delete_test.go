@@ -2,8 +2,10 @@ package chat_test
 
 import (
 	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
 	"chat-server/internal/repository/mocks"
 	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
 	desc "chat-server/pkg/chat_server_v1"
 	"context"
 	"errors"
@@ -16,7 +18,9 @@ import (
 
 func TestImplementation_Delete(t *testing.T) {
 	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
 	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	type blobStoreMockFunc func(mc *minimock.Controller) *storageMocks.BlobStoreMock
 
 	type args struct {
 		ctx context.Context
@@ -37,16 +41,28 @@ func TestImplementation_Delete(t *testing.T) {
 			EntityID: chatID,
 		}
 
+		blobKey = "0123456789abcdef0123456789abcdef"
+
 		repoErr = errors.New("repository error")
 		logErr  = errors.New("log error")
 	)
 
+	// deleteAttachments deletes the attachment rows, their blobs are only
+	// removed once the transaction committed.
+	deleteAttachments := func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+		mock := mocks.NewMessageRepositoryMock(mc)
+		mock.DeleteChatAttachmentsMock.Expect(ctx, chatID).Return([]string{blobKey}, nil)
+		return mock
+	}
+
 	tests := []struct {
-		name               string
-		args               args
-		err                error
-		chatRepositoryMock chatRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
+		name                  string
+		args                  args
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+		blobStoreMock         blobStoreMockFunc
 	}{
 		{
 			name: "success case",
@@ -60,11 +76,36 @@ func TestImplementation_Delete(t *testing.T) {
 				mock.DeleteMock.Expect(ctx, chatID).Return(nil)
 				return mock
 			},
+			messageRepositoryMock: deleteAttachments,
 			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
 				mock := mocks.NewLogRepositoryMock(mc)
 				mock.LogMock.Expect(ctx, logEntry).Return(nil)
 				return mock
 			},
+			blobStoreMock: func(mc *minimock.Controller) *storageMocks.BlobStoreMock {
+				mock := storageMocks.NewBlobStoreMock(mc)
+				mock.DeleteMock.Expect(minimock.AnyContext, blobKey).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "attachments error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.DeleteChatAttachmentsMock.Expect(ctx, chatID).Return(nil, repoErr)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
 		},
 		{
 			name: "repository error",
@@ -78,6 +119,7 @@ func TestImplementation_Delete(t *testing.T) {
 				mock.DeleteMock.Expect(ctx, chatID).Return(repoErr)
 				return mock
 			},
+			messageRepositoryMock: deleteAttachments,
 			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
 				mock := mocks.NewLogRepositoryMock(mc)
 				return mock
@@ -95,6 +137,7 @@ func TestImplementation_Delete(t *testing.T) {
 				mock.DeleteMock.Expect(ctx, chatID).Return(nil)
 				return mock
 			},
+			messageRepositoryMock: deleteAttachments,
 			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
 				mock := mocks.NewLogRepositoryMock(mc)
 				mock.LogMock.Expect(ctx, logEntry).Return(logErr)
@@ -106,14 +149,24 @@ func TestImplementation_Delete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			chatRepoMock := tt.chatRepositoryMock(mc)
+			messageRepoMock := tt.messageRepositoryMock(mc)
 			logRepoMock := tt.logRepositoryMock(mc)
 
+			blobStoreMock := storageMocks.NewBlobStoreMock(mc)
+			if tt.blobStoreMock != nil {
+				blobStoreMock = tt.blobStoreMock(mc)
+			}
+
 			txManager := &txManagerMock{}
 
 			service := chatService.NewService(
 				chatRepoMock,
+				messageRepoMock,
+				newPresenceRepositoryMock(mc),
 				logRepoMock,
 				txManager,
+				rpcMocks.NewUserClientMock(mc),
+				blobStoreMock,
 			)
 
 			api := chat.NewImplementation(service)
@@ -0,0 +1,279 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const editedMessageID = int64(456)
+
+func memberMessage() *model.Message {
+	return &model.Message{ID: editedMessageID, ChatID: membersChatID, From: "member", Text: "helo"}
+}
+
+func TestImplementation_EditMessage(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	var (
+		mc       = minimock.NewController(t)
+		editedAt = time.Now()
+
+		edited = &model.Message{
+			ID:       editedMessageID,
+			ChatID:   membersChatID,
+			From:     "member",
+			Text:     "hello",
+			EditedAt: sql.NullTime{Time: editedAt, Valid: true},
+		}
+
+		repoErr = errors.New("repository error")
+	)
+
+	getMessage := func(message *model.Message) messageRepositoryMockFunc {
+		return func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+			mock := mocks.NewMessageRepositoryMock(mc)
+			mock.GetMock.Expect(minimock.AnyContext, editedMessageID).Return(message, nil)
+			return mock
+		}
+	}
+
+	getChat := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+		mock := mocks.NewChatRepositoryMock(mc)
+		mock.GetMock.Expect(minimock.AnyContext, membersChatID).Return(membersChat(), nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		text                  string
+		want                  *desc.EditMessageResponse
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+	}{
+		{
+			name: "author edits",
+			ctx:  memberCtx,
+			text: "hello",
+			want: &desc.EditMessageResponse{
+				Message: &desc.Message{
+					Id:        editedMessageID,
+					ChatId:    membersChatID,
+					From:      "member",
+					Text:      "hello",
+					Timestamp: timestamppb.New(time.Time{}),
+					EditedAt:  timestamppb.New(editedAt),
+				},
+			},
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, editedMessageID).Return(memberMessage(), nil)
+				mock.EditMock.Expect(memberCtx, editedMessageID, "hello", "member").Return(edited, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(memberCtx, &logModel.Log{Action: "message_edited", EntityID: editedMessageID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "admin edits someone else's message",
+			ctx:  adminCtx,
+			text: "hello",
+			want: &desc.EditMessageResponse{
+				Message: &desc.Message{
+					Id:        editedMessageID,
+					ChatId:    membersChatID,
+					From:      "member",
+					Text:      "hello",
+					Timestamp: timestamppb.New(time.Time{}),
+					EditedAt:  timestamppb.New(editedAt),
+				},
+			},
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(adminCtx, editedMessageID).Return(memberMessage(), nil)
+				mock.EditMock.Expect(adminCtx, editedMessageID, "hello", "admin").Return(edited, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Return(nil)
+				return mock
+			},
+		},
+		{
+			name:                  "other member can't edit",
+			ctx:                   memberCtx,
+			text:                  "hello",
+			code:                  codes.PermissionDenied,
+			err:                   errors.New("only the author and chat admins can change the message"),
+			chatRepositoryMock:    getChat,
+			messageRepositoryMock: getMessage(&model.Message{ID: editedMessageID, ChatID: membersChatID, From: "admin", Text: "hi"}),
+		},
+		{
+			name:                  "outsider",
+			ctx:                   outsiderCtx,
+			text:                  "hello",
+			code:                  codes.PermissionDenied,
+			err:                   errors.New("user is not a member of the chat"),
+			chatRepositoryMock:    getChat,
+			messageRepositoryMock: getMessage(memberMessage()),
+		},
+		{
+			name:                  "system message",
+			ctx:                   ownerCtx,
+			text:                  "hello",
+			code:                  codes.FailedPrecondition,
+			err:                   errors.New("system messages can't be changed"),
+			chatRepositoryMock:    getChat,
+			messageRepositoryMock: getMessage(&model.Message{ID: editedMessageID, ChatID: membersChatID, Text: "owner added member", System: true}),
+		},
+		{
+			name: "deleted message",
+			ctx:  memberCtx,
+			text: "hello",
+			code: codes.FailedPrecondition,
+			err:  errors.New("message is deleted"),
+			messageRepositoryMock: getMessage(&model.Message{
+				ID:        editedMessageID,
+				ChatID:    membersChatID,
+				From:      "member",
+				DeletedAt: sql.NullTime{Time: editedAt, Valid: true},
+			}),
+			chatRepositoryMock: getChat,
+		},
+		{
+			name: "message not found",
+			ctx:  memberCtx,
+			text: "hello",
+			code: codes.NotFound,
+			err:  errors.New("message not found"),
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "empty text",
+			ctx:  memberCtx,
+			code: codes.InvalidArgument,
+			err:  errors.New("message text is empty"),
+		},
+		{
+			name:               "repository error",
+			ctx:                memberCtx,
+			text:               "hello",
+			code:               codes.Internal,
+			err:                repoErr,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(memberMessage(), nil)
+				mock.EditMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, tt.logRepositoryMock, nil))
+
+			resp, err := api.EditMessage(tt.ctx, &desc.EditMessageRequest{MessageId: editedMessageID, Text: tt.text})
+			requireStatus(t, tt.code, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
+
+func TestImplementation_EditMessage_PublishesEvent(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	chatRepoMock := mocks.NewChatRepositoryMock(mc)
+	chatRepoMock.GetMock.Return(membersChat(), nil)
+	chatRepoMock.UpdateLastMessageMock.Return(nil)
+
+	messageRepoMock := mocks.NewMessageRepositoryMock(mc)
+	messageRepoMock.CreateMock.Return(memberMessage(), nil)
+	messageRepoMock.GetMock.Return(memberMessage(), nil)
+	messageRepoMock.EditMock.Return(&model.Message{ID: editedMessageID, ChatID: membersChatID, From: "member", Text: "hello"}, nil)
+	messageRepoMock.DeleteMock.Return(&model.Message{ID: editedMessageID, ChatID: membersChatID, From: "member"}, nil)
+	messageRepoMock.DeleteMessageAttachmentsMock.Return(nil, nil)
+
+	logRepoMock := mocks.NewLogRepositoryMock(mc)
+	logRepoMock.LogMock.Return(nil)
+
+	service := chatService.NewService(chatRepoMock, messageRepoMock, newPresenceRepositoryMock(mc), logRepoMock, &txManagerMock{}, rpcMocks.NewUserClientMock(mc), storageMocks.NewBlobStoreMock(mc))
+	api := chat.NewImplementation(service)
+
+	ctx, cancel := context.WithCancel(ownerCtx)
+	defer cancel()
+	stream := newConnectChatStreamMock(ctx)
+
+	go func() {
+		_ = api.ConnectChat(&desc.ConnectChatRequest{ChatId: membersChatID}, stream)
+	}()
+
+	// Wait until the subscription is registered.
+	require.Eventually(t, func() bool {
+		_, err := api.SendMessage(memberCtx, &desc.SendMessageRequest{
+			ChatId:  membersChatID,
+			Message: &desc.Message{Text: "helo"},
+		})
+		require.NoError(t, err)
+
+		select {
+		case <-stream.events:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	_, err := api.EditMessage(memberCtx, &desc.EditMessageRequest{MessageId: editedMessageID, Text: "hello"})
+	require.NoError(t, err)
+
+	_, err = api.DeleteMessage(memberCtx, &desc.DeleteMessageRequest{MessageId: editedMessageID})
+	require.NoError(t, err)
+
+	var events []*desc.ChatEvent
+	require.Eventually(t, func() bool {
+		for len(stream.events) > 0 {
+			event := <-stream.events
+			if event.GetMessage() == nil {
+				events = append(events, event)
+			}
+		}
+		return len(events) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	require.Equal(t, "hello", events[0].GetMessageEdited().GetText())
+	require.Equal(t, editedMessageID, events[1].GetMessageDeleted().GetId())
+}
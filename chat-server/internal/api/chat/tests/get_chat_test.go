@@ -0,0 +1,169 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_GetChat(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+
+	var (
+		mc     = minimock.NewController(t)
+		chatID = int64(123)
+		now    = time.Now()
+
+		ctx         = identity.WithUser(context.Background(), &model.User{ID: 1, Username: "user1"})
+		strangerCtx = identity.WithUser(context.Background(), &model.User{ID: 3, Username: "stranger"})
+
+		chatModel = &model.Chat{
+			ID:    chatID,
+			Title: "team",
+			Members: []*model.ChatMember{
+				{ChatID: chatID, UserID: 1, Username: "user1", Role: model.ChatRoleOwner, JoinedAt: now},
+				{ChatID: chatID, UserID: 2, Username: "user2", Role: model.ChatRoleMember, JoinedAt: now},
+			},
+			CreatedAt:      now,
+			LastMessageID:  456,
+			LastActivityAt: now,
+		}
+
+		lastMessage = &model.Message{ID: 456, ChatID: chatID, From: "user2", Text: "hi", Timestamp: now}
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		want                  *desc.GetChatResponse
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  ctx,
+			want: &desc.GetChatResponse{
+				Chat: &desc.Chat{
+					Id:        chatID,
+					Title:     "team",
+					CreatedAt: timestamppb.New(now),
+					Members: []*desc.ChatMember{
+						{UserId: 1, Username: "user1", Role: desc.ChatRole_CHAT_ROLE_OWNER, JoinedAt: timestamppb.New(now)},
+						{UserId: 2, Username: "user2", Role: desc.ChatRole_CHAT_ROLE_MEMBER, JoinedAt: timestamppb.New(now)},
+					},
+					LastMessage: &desc.Message{
+						Id:        456,
+						ChatId:    chatID,
+						From:      "user2",
+						Text:      "hi",
+						Timestamp: timestamppb.New(now),
+					},
+					LastActivityAt: timestamppb.New(now),
+				},
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, int64(456)).Return(lastMessage, nil)
+				return mock
+			},
+		},
+		{
+			name: "not a member",
+			ctx:  strangerCtx,
+			code: codes.PermissionDenied,
+			err:  errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(strangerCtx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+		},
+		{
+			name: "chat not found",
+			ctx:  ctx,
+			code: codes.NotFound,
+			err:  errors.New("chat not found"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(nil, repository.ErrNotFound)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+		},
+		{
+			name: "message repository error",
+			ctx:  ctx,
+			code: codes.Internal,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, int64(456)).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := chatService.NewService(
+				tt.chatRepositoryMock(mc),
+				tt.messageRepositoryMock(mc),
+				newPresenceRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				rpcMocks.NewUserClientMock(mc),
+				storageMocks.NewBlobStoreMock(mc),
+			)
+
+			api := chat.NewImplementation(service)
+
+			resp, err := api.GetChat(tt.ctx, &desc.GetChatRequest{Id: chatID})
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, resp)
+			}
+		})
+	}
+}
@@ -1,9 +1,16 @@
 package chat_test
 
 import (
+	"chat-server/internal/repository/mocks"
+	desc "chat-server/pkg/chat_server_v1"
 	"context"
+	"io"
 
+	"github.com/gojuno/minimock/v3"
 	"github.com/makxtr/go-common/pkg/db"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // txManagerMock is a simple mock for TxManager that executes the function without transaction
@@ -12,3 +19,162 @@ type txManagerMock struct{}
 func (tm *txManagerMock) ReadCommitted(ctx context.Context, fn db.Handler) error {
 	return fn(ctx)
 }
+
+// connectChatStreamMock collects events sent to a ConnectChat stream
+type connectChatStreamMock struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *desc.ChatEvent
+}
+
+func newConnectChatStreamMock(ctx context.Context) *connectChatStreamMock {
+	return &connectChatStreamMock{
+		ctx:    ctx,
+		events: make(chan *desc.ChatEvent, 16),
+	}
+}
+
+func (s *connectChatStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectChatStreamMock) Send(event *desc.ChatEvent) error {
+	s.events <- event
+	return nil
+}
+
+// publishSignalsStreamMock feeds the signals of a PublishSignals stream, the
+// stream ends with io.EOF once signals is closed.
+type publishSignalsStreamMock struct {
+	grpc.ServerStream
+	ctx     context.Context
+	signals chan *desc.Signal
+	closed  bool
+}
+
+func newPublishSignalsStreamMock(ctx context.Context, signals ...*desc.Signal) *publishSignalsStreamMock {
+	stream := &publishSignalsStreamMock{
+		ctx:     ctx,
+		signals: make(chan *desc.Signal, len(signals)),
+	}
+	for _, signal := range signals {
+		stream.signals <- signal
+	}
+	close(stream.signals)
+
+	return stream
+}
+
+func (s *publishSignalsStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *publishSignalsStreamMock) Recv() (*desc.Signal, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case signal, ok := <-s.signals:
+		if !ok {
+			return nil, io.EOF
+		}
+		return signal, nil
+	}
+}
+
+func (s *publishSignalsStreamMock) SendAndClose(*emptypb.Empty) error {
+	s.closed = true
+	return nil
+}
+
+// newPresenceRepositoryMock accepts the last seen updates of streams that
+// close during or after a test.
+func newPresenceRepositoryMock(mc *minimock.Controller) *mocks.PresenceRepositoryMock {
+	mock := mocks.NewPresenceRepositoryMock(mc)
+	mock.SaveLastSeenMock.Optional().Return(nil)
+	return mock
+}
+
+// watchPresenceStreamMock collects the presence sent to a WatchPresence stream
+type watchPresenceStreamMock struct {
+	grpc.ServerStream
+	ctx      context.Context
+	presence chan *desc.Presence
+}
+
+func newWatchPresenceStreamMock(ctx context.Context) *watchPresenceStreamMock {
+	return &watchPresenceStreamMock{
+		ctx:      ctx,
+		presence: make(chan *desc.Presence, 16),
+	}
+}
+
+func (s *watchPresenceStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchPresenceStreamMock) Send(presence *desc.Presence) error {
+	s.presence <- presence
+	return nil
+}
+
+// uploadAttachmentStreamMock feeds the requests of an UploadAttachment stream
+// and keeps the response, the stream ends with io.EOF after the last request.
+type uploadAttachmentStreamMock struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*desc.UploadAttachmentRequest
+	response *desc.UploadAttachmentResponse
+}
+
+func newUploadAttachmentStreamMock(ctx context.Context, info *desc.AttachmentInfo, chunks ...[]byte) *uploadAttachmentStreamMock {
+	stream := &uploadAttachmentStreamMock{ctx: ctx}
+	if info != nil {
+		stream.requests = append(stream.requests, &desc.UploadAttachmentRequest{
+			Data: &desc.UploadAttachmentRequest_Info{Info: info},
+		})
+	}
+	for _, chunk := range chunks {
+		stream.requests = append(stream.requests, &desc.UploadAttachmentRequest{
+			Data: &desc.UploadAttachmentRequest_Chunk{Chunk: chunk},
+		})
+	}
+
+	return stream
+}
+
+func (s *uploadAttachmentStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *uploadAttachmentStreamMock) Recv() (*desc.UploadAttachmentRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *uploadAttachmentStreamMock) SendAndClose(res *desc.UploadAttachmentResponse) error {
+	s.response = res
+	return nil
+}
+
+// downloadAttachmentStreamMock collects the responses sent to a
+// DownloadAttachment stream, copied like grpc marshals them on Send
+type downloadAttachmentStreamMock struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*desc.DownloadAttachmentResponse
+}
+
+func (s *downloadAttachmentStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *downloadAttachmentStreamMock) Send(res *desc.DownloadAttachmentResponse) error {
+	s.responses = append(s.responses, proto.Clone(res).(*desc.DownloadAttachmentResponse))
+	return nil
+}
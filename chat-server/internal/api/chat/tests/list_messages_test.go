@@ -0,0 +1,266 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
+	"chat-server/internal/model"
+	"chat-server/internal/pagination"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_ListMessages(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+
+	type args struct {
+		ctx context.Context
+		req *desc.ListMessagesRequest
+	}
+
+	var (
+		ctx       = identity.WithUser(context.Background(), &model.User{ID: 1, Username: "user1"})
+		mc        = minimock.NewController(t)
+		chatID    = int64(123)
+		timestamp = time.Now()
+
+		strangerCtx = identity.WithUser(context.Background(), &model.User{ID: 3, Username: "stranger"})
+
+		chatModel = &model.Chat{
+			ID: chatID,
+			Members: []*model.ChatMember{
+				{ChatID: chatID, UserID: 1, Username: "user1", Role: model.ChatRoleMember},
+				{ChatID: chatID, UserID: 2, Username: "user2", Role: model.ChatRoleMember},
+			},
+		}
+
+		messages = []*model.Message{
+			{ID: 12, ChatID: chatID, From: "user1", Text: "third", Timestamp: timestamp},
+			{ID: 11, ChatID: chatID, From: "user2", Text: "second", Timestamp: timestamp},
+			{ID: 10, ChatID: chatID, From: "user1", Text: "first", Timestamp: timestamp},
+		}
+
+		cursor, _ = pagination.EncodeCursor(map[string]int64{"before_id": 11})
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name                  string
+		args                  args
+		want                  *desc.ListMessagesResponse
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+	}{
+		{
+			name: "first page with more messages",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListMessagesRequest{ChatId: chatID, Limit: 2},
+			},
+			want: &desc.ListMessagesResponse{
+				Messages: []*desc.Message{
+					{
+						Id:        12,
+						ChatId:    chatID,
+						From:      "user1",
+						Text:      "third",
+						Timestamp: timestamppb.New(timestamp),
+						Attachments: []*desc.Attachment{
+							{Id: 5, ChatId: chatID, MessageId: 12, UploadedBy: "user1", FileName: "plan.pdf", MimeType: "application/pdf", Size: 1024, CreatedAt: timestamppb.New(timestamp)},
+						},
+					},
+					{
+						Id:        11,
+						ChatId:    chatID,
+						From:      "user2",
+						Text:      "second",
+						Timestamp: timestamppb.New(timestamp),
+						Reactions: []*desc.Reaction{
+							{Emoji: "👍", Count: 2, Reacted: true},
+							{Emoji: "🎉", Count: 1},
+						},
+					},
+				},
+				NextCursor: cursor,
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, chatID, 0, 3).Return(messages, nil)
+				mock.ListReactionsMock.Expect(ctx, []int64{12, 11}, 1).Return(map[int64][]*model.Reaction{
+					11: {
+						{Emoji: "👍", Count: 2, Reacted: true},
+						{Emoji: "🎉", Count: 1},
+					},
+				}, nil)
+				mock.ListAttachmentsMock.Expect(ctx, []int64{12, 11}).Return(map[int64][]*model.Attachment{
+					12: {
+						{ID: 5, ChatID: chatID, MessageID: 12, UploaderID: 1, UploadedBy: "user1", FileName: "plan.pdf", MimeType: "application/pdf", Size: 1024, BlobKey: "ab12", CreatedAt: timestamp},
+					},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "last page",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListMessagesRequest{ChatId: chatID, BeforeCursor: cursor, Limit: 2},
+			},
+			want: &desc.ListMessagesResponse{
+				Messages: []*desc.Message{
+					{Id: 10, ChatId: chatID, From: "user1", Text: "first", Timestamp: timestamppb.New(timestamp)},
+				},
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, chatID, 11, 3).Return(messages[2:], nil)
+				mock.ListReactionsMock.Expect(ctx, []int64{10}, 1).Return(map[int64][]*model.Reaction{}, nil)
+				mock.ListAttachmentsMock.Expect(ctx, []int64{10}).Return(map[int64][]*model.Attachment{}, nil)
+				return mock
+			},
+		},
+		{
+			name: "default limit",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListMessagesRequest{ChatId: chatID},
+			},
+			want: &desc.ListMessagesResponse{
+				Messages: []*desc.Message{},
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, chatID, 0, 51).Return([]*model.Message{}, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid cursor",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListMessagesRequest{ChatId: chatID, BeforeCursor: "not a cursor"},
+			},
+			code: codes.InvalidArgument,
+			err:  pagination.ErrInvalidCursor,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+		},
+		{
+			name: "chat not found",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListMessagesRequest{ChatId: chatID},
+			},
+			code: codes.NotFound,
+			err:  errors.New("chat not found"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(nil, repository.ErrNotFound)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+		},
+		{
+			name: "user is not a member",
+			args: args{
+				ctx: strangerCtx,
+				req: &desc.ListMessagesRequest{ChatId: chatID},
+			},
+			code: codes.PermissionDenied,
+			err:  errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(strangerCtx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repository error",
+			args: args{
+				ctx: ctx,
+				req: &desc.ListMessagesRequest{ChatId: chatID},
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.ListMock.Expect(ctx, chatID, 0, 51).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := chatService.NewService(
+				tt.chatRepositoryMock(mc),
+				tt.messageRepositoryMock(mc),
+				newPresenceRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				rpcMocks.NewUserClientMock(mc),
+				storageMocks.NewBlobStoreMock(mc),
+			)
+
+			api := chat.NewImplementation(service)
+
+			resp, err := api.ListMessages(tt.args.ctx, tt.args.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
+	"chat-server/internal/model"
+	"chat-server/internal/pagination"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_ListMyChats(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+
+	var (
+		mc     = minimock.NewController(t)
+		userID = int64(1)
+		ctx    = identity.WithUser(context.Background(), &model.User{ID: userID, Username: "user1"})
+
+		active = time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+		quiet  = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+		summaries = []*model.ChatSummary{
+			{
+				Chat:        &model.Chat{ID: 7, Title: "team", LastActivityAt: active},
+				LastMessage: &model.Message{ID: 70, ChatID: 7, From: "user2", Text: "hi", Timestamp: active},
+				UnreadCount: 3,
+			},
+			{
+				Chat: &model.Chat{ID: 5, Title: "empty", LastActivityAt: quiet},
+			},
+		}
+
+		repoErr = errors.New("repository error")
+	)
+
+	type cursor struct {
+		LastActivityAt time.Time `json:"last_activity_at"`
+		ID             int64     `json:"id"`
+	}
+
+	nextCursor, err := pagination.EncodeCursor(cursor{LastActivityAt: active, ID: 7})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		req                *desc.ListMyChatsRequest
+		want               *desc.ListMyChatsResponse
+		code               codes.Code
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "first page has next cursor",
+			ctx:  ctx,
+			req:  &desc.ListMyChatsRequest{Limit: 1},
+			want: &desc.ListMyChatsResponse{
+				Chats: []*desc.ChatSummary{
+					{
+						Id:    7,
+						Title: "team",
+						LastMessage: &desc.Message{
+							Id:        70,
+							ChatId:    7,
+							From:      "user2",
+							Text:      "hi",
+							Timestamp: timestamppb.New(active),
+						},
+						LastActivityAt: timestamppb.New(active),
+						UnreadCount:    3,
+					},
+				},
+				NextCursor: nextCursor,
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListByUserMock.Expect(ctx, userID, nil, 2).Return(summaries, nil)
+				return mock
+			},
+		},
+		{
+			name: "next page resumes after cursor",
+			ctx:  ctx,
+			req:  &desc.ListMyChatsRequest{Cursor: nextCursor, Limit: 1},
+			want: &desc.ListMyChatsResponse{
+				Chats: []*desc.ChatSummary{
+					{Id: 5, Title: "empty", LastActivityAt: timestamppb.New(quiet)},
+				},
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListByUserMock.Expect(ctx, userID, &model.Chat{ID: 7, LastActivityAt: active}, 2).Return(summaries[1:], nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid cursor",
+			ctx:  ctx,
+			req:  &desc.ListMyChatsRequest{Cursor: "not a cursor"},
+			code: codes.InvalidArgument,
+			err:  errors.New("invalid cursor"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "unauthenticated",
+			ctx:  context.Background(),
+			req:  &desc.ListMyChatsRequest{},
+			code: codes.Unauthenticated,
+			err:  errors.New("user is not authenticated"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repository error",
+			ctx:  ctx,
+			req:  &desc.ListMyChatsRequest{},
+			code: codes.Internal,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListByUserMock.Expect(ctx, userID, nil, 51).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := chatService.NewService(
+				tt.chatRepositoryMock(mc),
+				mocks.NewMessageRepositoryMock(mc),
+				newPresenceRepositoryMock(mc),
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				rpcMocks.NewUserClientMock(mc),
+				storageMocks.NewBlobStoreMock(mc),
+			)
+
+			api := chat.NewImplementation(service)
+
+			resp, err := api.ListMyChats(tt.ctx, tt.req)
+
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
+				require.Contains(t, err.Error(), tt.err.Error())
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/model"
+	"chat-server/internal/pagination"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_ListThread(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+
+	var (
+		mc     = minimock.NewController(t)
+		rootID = int64(10)
+
+		root    = &model.Message{ID: rootID, ChatID: membersChatID, From: "owner", Text: "release?", ReplyCount: 2}
+		replies = []*model.Message{
+			{ID: 11, ChatID: membersChatID, From: "admin", Text: "friday", ReplyToMessageID: rootID, ThreadRootID: rootID},
+			{ID: 12, ChatID: membersChatID, From: "member", Text: "ok", ReplyToMessageID: 11, ThreadRootID: rootID},
+		}
+	)
+
+	nextCursor, err := pagination.EncodeCursor(struct {
+		AfterID int64 `json:"after_id"`
+	}{AfterID: 11})
+	require.NoError(t, err)
+
+	descMessage := func(message *model.Message) *desc.Message {
+		return &desc.Message{
+			Id:               message.ID,
+			ChatId:           message.ChatID,
+			From:             message.From,
+			Text:             message.Text,
+			Timestamp:        timestamppb.New(time.Time{}),
+			ReplyToMessageId: message.ReplyToMessageID,
+			ThreadRootId:     message.ThreadRootID,
+			ReplyCount:       message.ReplyCount,
+		}
+	}
+
+	getChat := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+		mock := mocks.NewChatRepositoryMock(mc)
+		mock.GetMock.Expect(minimock.AnyContext, membersChatID).Return(membersChat(), nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		req                   *desc.ListThreadRequest
+		want                  *desc.ListThreadResponse
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+	}{
+		{
+			name: "first page has next cursor",
+			ctx:  memberCtx,
+			req:  &desc.ListThreadRequest{RootId: rootID, Limit: 1},
+			want: &desc.ListThreadResponse{
+				Root:       descMessage(root),
+				Replies:    []*desc.Message{descMessage(replies[0])},
+				NextCursor: nextCursor,
+			},
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, rootID).Return(root, nil)
+				mock.ListRepliesMock.Expect(memberCtx, rootID, 0, 2).Return(replies, nil)
+				mock.ListReactionsMock.Expect(memberCtx, []int64{10, 11}, 3).Return(map[int64][]*model.Reaction{}, nil)
+				mock.ListAttachmentsMock.Expect(memberCtx, []int64{10, 11}).Return(map[int64][]*model.Attachment{}, nil)
+				return mock
+			},
+		},
+		{
+			name: "next page resumes after cursor",
+			ctx:  memberCtx,
+			req:  &desc.ListThreadRequest{RootId: rootID, Cursor: nextCursor, Limit: 1},
+			want: &desc.ListThreadResponse{
+				Root:    descMessage(root),
+				Replies: []*desc.Message{descMessage(replies[1])},
+			},
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, rootID).Return(root, nil)
+				mock.ListRepliesMock.Expect(memberCtx, rootID, 11, 2).Return(replies[1:], nil)
+				mock.ListReactionsMock.Expect(memberCtx, []int64{10, 12}, 3).Return(map[int64][]*model.Reaction{}, nil)
+				mock.ListAttachmentsMock.Expect(memberCtx, []int64{10, 12}).Return(map[int64][]*model.Attachment{}, nil)
+				return mock
+			},
+		},
+		{
+			name: "reply is not a root",
+			ctx:  memberCtx,
+			req:  &desc.ListThreadRequest{RootId: 11},
+			code: codes.InvalidArgument,
+			err:  errors.New("message is not a thread root"),
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, int64(11)).Return(replies[0], nil)
+				return mock
+			},
+		},
+		{
+			name:               "outsider",
+			ctx:                outsiderCtx,
+			req:                &desc.ListThreadRequest{RootId: rootID},
+			code:               codes.PermissionDenied,
+			err:                errors.New("user is not a member of the chat"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(outsiderCtx, rootID).Return(root, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid cursor",
+			ctx:  memberCtx,
+			req:  &desc.ListThreadRequest{RootId: rootID, Cursor: "not a cursor"},
+			code: codes.InvalidArgument,
+			err:  errors.New("invalid cursor"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, nil, nil))
+
+			resp, err := api.ListThread(tt.ctx, tt.req)
+			requireStatus(t, tt.code, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
+
+func TestImplementation_ConnectChat_Thread(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	rootID := int64(10)
+	root := &model.Message{ID: rootID, ChatID: membersChatID, From: "owner", Text: "release?"}
+
+	chatRepoMock := mocks.NewChatRepositoryMock(mc)
+	chatRepoMock.GetMock.Return(membersChat(), nil)
+	chatRepoMock.UpdateLastMessageMock.Return(nil)
+
+	messageRepoMock := mocks.NewMessageRepositoryMock(mc)
+	messageRepoMock.GetMock.Return(root, nil)
+	messageRepoMock.CreateMock.Set(func(_ context.Context, message *model.Message) (*model.Message, error) {
+		created := *message
+		created.ID = 11
+		return &created, nil
+	})
+	messageRepoMock.AddReplyMock.Return(&model.Message{ID: rootID, ChatID: membersChatID, ReplyCount: 1}, nil)
+
+	logRepoMock := mocks.NewLogRepositoryMock(mc)
+	logRepoMock.LogMock.Return(nil)
+
+	service := chatService.NewService(chatRepoMock, messageRepoMock, newPresenceRepositoryMock(mc), logRepoMock, &txManagerMock{}, rpcMocks.NewUserClientMock(mc), storageMocks.NewBlobStoreMock(mc))
+	api := chat.NewImplementation(service)
+
+	chatCtx, cancelChat := context.WithCancel(ownerCtx)
+	defer cancelChat()
+	threadCtx, cancelThread := context.WithCancel(adminCtx)
+	defer cancelThread()
+
+	chatStream := newConnectChatStreamMock(chatCtx)
+	threadStream := newConnectChatStreamMock(threadCtx)
+
+	go func() {
+		_ = api.ConnectChat(&desc.ConnectChatRequest{ChatId: membersChatID}, chatStream)
+	}()
+	go func() {
+		_ = api.ConnectChat(&desc.ConnectChatRequest{ChatId: membersChatID, ThreadRootId: rootID}, threadStream)
+	}()
+
+	// Keep replying until both subscriptions are registered.
+	var reply, update *desc.ChatEvent
+	require.Eventually(t, func() bool {
+		_, err := api.SendMessage(memberCtx, &desc.SendMessageRequest{
+			ChatId:  membersChatID,
+			Message: &desc.Message{Text: "ok", ReplyToMessageId: rootID},
+		})
+		require.NoError(t, err)
+
+		for len(chatStream.events) > 0 {
+			event := <-chatStream.events
+			require.Nil(t, event.GetMessage(), "replies must not reach the chat stream")
+			update = event
+		}
+		for len(threadStream.events) > 0 {
+			event := <-threadStream.events
+			if event.GetMessage() != nil {
+				reply = event
+			}
+		}
+		return reply != nil && update != nil
+	}, time.Second, 10*time.Millisecond)
+
+	require.Equal(t, rootID, reply.GetMessage().GetThreadRootId())
+	require.Equal(t, int64(1), update.GetThreadUpdated().GetReplyCount())
+}
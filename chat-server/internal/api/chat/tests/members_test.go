@@ -0,0 +1,540 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
+	"chat-server/internal/model"
+	"chat-server/internal/repository/mocks"
+	"chat-server/internal/service"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const membersChatID = int64(123)
+
+var (
+	ownerCtx    = identity.WithUser(context.Background(), &model.User{ID: 1, Username: "owner"})
+	adminCtx    = identity.WithUser(context.Background(), &model.User{ID: 2, Username: "admin"})
+	memberCtx   = identity.WithUser(context.Background(), &model.User{ID: 3, Username: "member"})
+	outsiderCtx = identity.WithUser(context.Background(), &model.User{ID: 4, Username: "outsider"})
+)
+
+func membersChat() *model.Chat {
+	return &model.Chat{
+		ID:   membersChatID,
+		Type: model.ChatTypeGroup,
+		Members: []*model.ChatMember{
+			{ChatID: membersChatID, UserID: 1, Username: "owner", Role: model.ChatRoleOwner},
+			{ChatID: membersChatID, UserID: 2, Username: "admin", Role: model.ChatRoleAdmin},
+			{ChatID: membersChatID, UserID: 3, Username: "member", Role: model.ChatRoleMember},
+		},
+	}
+}
+
+func directChat() *model.Chat {
+	return &model.Chat{
+		ID:   membersChatID,
+		Type: model.ChatTypeDirect,
+		Members: []*model.ChatMember{
+			{ChatID: membersChatID, UserID: 1, Username: "owner", Role: model.ChatRoleMember},
+			{ChatID: membersChatID, UserID: 3, Username: "member", Role: model.ChatRoleMember},
+		},
+	}
+}
+
+func systemMessage(text string) *model.Message {
+	return &model.Message{ChatID: membersChatID, Text: text, System: true}
+}
+
+func TestImplementation_AddMembers(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+	type userClientMockFunc func(mc *minimock.Controller) *rpcMocks.UserClientMock
+
+	var (
+		mc = minimock.NewController(t)
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		req                   *desc.AddMembersRequest
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+		userClientMock        userClientMockFunc
+	}{
+		{
+			name: "admin adds members, existing ones are skipped",
+			ctx:  adminCtx,
+			req:  &desc.AddMembersRequest{ChatId: membersChatID, Usernames: []string{"dave", "member"}},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(adminCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(adminCtx, membersChatID).Return(membersChat().Members, nil)
+				mock.AddMembersMock.Expect(adminCtx, membersChatID, []*model.ChatMember{
+					{ChatID: membersChatID, UserID: 5, Username: "dave", Role: model.ChatRoleMember},
+				}).Return(nil)
+				mock.UpdateLastMessageMock.Expect(adminCtx, membersChatID, 1).Return(nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Expect(adminCtx, systemMessage("admin added dave")).Return(&model.Message{ID: 1}, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(adminCtx, &logModel.Log{Action: "chat_members_added", EntityID: membersChatID}).Return(nil)
+				return mock
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(adminCtx, []string{"dave", "member"}).Return([]*model.User{
+					{ID: 5, Username: "dave"},
+					{ID: 3, Username: "member"},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "admin demoted while the names were looked up",
+			ctx:  adminCtx,
+			req:  &desc.AddMembersRequest{ChatId: membersChatID, Usernames: []string{"dave"}},
+			code: codes.PermissionDenied,
+			err:  errors.New("only the owner and admins can add members"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(adminCtx, membersChatID).Return(membersChat(), nil)
+				demoted := membersChat().Members
+				demoted[1].Role = model.ChatRoleMember
+				mock.LockMembersMock.Expect(adminCtx, membersChatID).Return(demoted, nil)
+				return mock
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(adminCtx, []string{"dave"}).Return([]*model.User{{ID: 5, Username: "dave"}}, nil)
+				return mock
+			},
+		},
+		{
+			name: "member can't add members",
+			ctx:  memberCtx,
+			req:  &desc.AddMembersRequest{ChatId: membersChatID, Usernames: []string{"dave"}},
+			code: codes.PermissionDenied,
+			err:  errors.New("only the owner and admins can add members"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, membersChatID).Return(membersChat(), nil)
+				return mock
+			},
+		},
+		{
+			name: "admin can't add admins",
+			ctx:  adminCtx,
+			req:  &desc.AddMembersRequest{ChatId: membersChatID, Usernames: []string{"dave"}, Role: desc.ChatRole_CHAT_ROLE_ADMIN},
+			code: codes.PermissionDenied,
+			err:  errors.New("only the owner can add admins"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(adminCtx, membersChatID).Return(membersChat(), nil)
+				return mock
+			},
+		},
+		{
+			name: "second owner",
+			ctx:  ownerCtx,
+			req:  &desc.AddMembersRequest{ChatId: membersChatID, Usernames: []string{"dave"}, Role: desc.ChatRole_CHAT_ROLE_OWNER},
+			code: codes.InvalidArgument,
+			err:  errors.New("a chat has exactly one owner"),
+		},
+		{
+			name: "direct chat",
+			ctx:  ownerCtx,
+			req:  &desc.AddMembersRequest{ChatId: membersChatID, Usernames: []string{"dave"}},
+			code: codes.FailedPrecondition,
+			err:  errors.New("members of a direct chat can't be changed"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(directChat(), nil)
+				return mock
+			},
+		},
+		{
+			name: "outsider",
+			ctx:  outsiderCtx,
+			req:  &desc.AddMembersRequest{ChatId: membersChatID, Usernames: []string{"dave"}},
+			code: codes.PermissionDenied,
+			err:  errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(outsiderCtx, membersChatID).Return(membersChat(), nil)
+				return mock
+			},
+		},
+		{
+			name: "repository error",
+			ctx:  ownerCtx,
+			req:  &desc.AddMembersRequest{ChatId: membersChatID, Usernames: []string{"dave"}},
+			code: codes.Internal,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(ownerCtx, membersChatID).Return(membersChat().Members, nil)
+				mock.AddMembersMock.Return(repoErr)
+				return mock
+			},
+			userClientMock: func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(ownerCtx, []string{"dave"}).Return([]*model.User{{ID: 5, Username: "dave"}}, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, tt.logRepositoryMock, tt.userClientMock))
+
+			_, err := api.AddMembers(tt.ctx, tt.req)
+			requireStatus(t, tt.code, tt.err, err)
+		})
+	}
+}
+
+func TestImplementation_RemoveMember(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	mc := minimock.NewController(t)
+
+	chatWithMembers := func(ctx context.Context) chatRepositoryMockFunc {
+		return func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+			mock := mocks.NewChatRepositoryMock(mc)
+			mock.GetMock.Expect(ctx, membersChatID).Return(membersChat(), nil)
+			mock.LockMembersMock.Expect(ctx, membersChatID).Return(membersChat().Members, nil)
+			return mock
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		req                   *desc.RemoveMemberRequest
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+	}{
+		{
+			name: "owner removes admin",
+			ctx:  ownerCtx,
+			req:  &desc.RemoveMemberRequest{ChatId: membersChatID, Username: "admin"},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(ownerCtx, membersChatID).Return(membersChat().Members, nil)
+				mock.RemoveMemberMock.Expect(ownerCtx, membersChatID, 2).Return(nil)
+				mock.UpdateLastMessageMock.Expect(ownerCtx, membersChatID, 1).Return(nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Expect(ownerCtx, systemMessage("owner removed admin")).Return(&model.Message{ID: 1}, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ownerCtx, &logModel.Log{Action: "chat_member_removed", EntityID: membersChatID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:               "admin can't remove owner",
+			ctx:                adminCtx,
+			req:                &desc.RemoveMemberRequest{ChatId: membersChatID, Username: "owner"},
+			code:               codes.PermissionDenied,
+			err:                errors.New("not allowed to remove this member"),
+			chatRepositoryMock: chatWithMembers(adminCtx),
+		},
+		{
+			name:               "member can't remove members",
+			ctx:                memberCtx,
+			req:                &desc.RemoveMemberRequest{ChatId: membersChatID, Username: "admin"},
+			code:               codes.PermissionDenied,
+			err:                errors.New("not allowed to remove this member"),
+			chatRepositoryMock: chatWithMembers(memberCtx),
+		},
+		{
+			name:               "removing self",
+			ctx:                adminCtx,
+			req:                &desc.RemoveMemberRequest{ChatId: membersChatID, Username: "admin"},
+			code:               codes.InvalidArgument,
+			err:                errors.New("use LeaveChat to leave the chat"),
+			chatRepositoryMock: chatWithMembers(adminCtx),
+		},
+		{
+			name:               "unknown member",
+			ctx:                ownerCtx,
+			req:                &desc.RemoveMemberRequest{ChatId: membersChatID, Username: "dave"},
+			code:               codes.NotFound,
+			err:                errors.New("member not found"),
+			chatRepositoryMock: chatWithMembers(ownerCtx),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, tt.logRepositoryMock, nil))
+
+			_, err := api.RemoveMember(tt.ctx, tt.req)
+			requireStatus(t, tt.code, tt.err, err)
+		})
+	}
+}
+
+func TestImplementation_LeaveChat(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+	}{
+		{
+			name: "member leaves",
+			ctx:  memberCtx,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(memberCtx, membersChatID).Return(membersChat().Members, nil)
+				mock.RemoveMemberMock.Expect(memberCtx, membersChatID, 3).Return(nil)
+				mock.UpdateLastMessageMock.Expect(memberCtx, membersChatID, 1).Return(nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Expect(memberCtx, systemMessage("member left the chat")).Return(&model.Message{ID: 1}, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(memberCtx, &logModel.Log{Action: "chat_member_left", EntityID: membersChatID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "owner leaves, admin takes over",
+			ctx:  ownerCtx,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(ownerCtx, membersChatID).Return(membersChat().Members, nil)
+				mock.RemoveMemberMock.Expect(ownerCtx, membersChatID, 1).Return(nil)
+				mock.UpdateMemberRoleMock.Expect(ownerCtx, membersChatID, 2, model.ChatRoleOwner).Return(nil)
+				mock.UpdateLastMessageMock.Expect(ownerCtx, membersChatID, 2).Return(nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.When(ownerCtx, systemMessage("owner left the chat")).Then(&model.Message{ID: 1}, nil)
+				mock.CreateMock.When(ownerCtx, systemMessage("admin is now the owner")).Then(&model.Message{ID: 2}, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ownerCtx, &logModel.Log{Action: "chat_member_left", EntityID: membersChatID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "owner leaves after the admin left, member takes over",
+			ctx:  ownerCtx,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(membersChat(), nil)
+				members := membersChat().Members
+				mock.LockMembersMock.Expect(ownerCtx, membersChatID).Return([]*model.ChatMember{members[0], members[2]}, nil)
+				mock.RemoveMemberMock.Expect(ownerCtx, membersChatID, 1).Return(nil)
+				mock.UpdateMemberRoleMock.Expect(ownerCtx, membersChatID, 3, model.ChatRoleOwner).Return(nil)
+				mock.UpdateLastMessageMock.Expect(ownerCtx, membersChatID, 2).Return(nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.When(ownerCtx, systemMessage("owner left the chat")).Then(&model.Message{ID: 1}, nil)
+				mock.CreateMock.When(ownerCtx, systemMessage("member is now the owner")).Then(&model.Message{ID: 2}, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ownerCtx, &logModel.Log{Action: "chat_member_left", EntityID: membersChatID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "outsider",
+			ctx:  outsiderCtx,
+			code: codes.PermissionDenied,
+			err:  errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(outsiderCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(outsiderCtx, membersChatID).Return(membersChat().Members, nil)
+				return mock
+			},
+		},
+		{
+			name: "direct chat",
+			ctx:  memberCtx,
+			code: codes.FailedPrecondition,
+			err:  errors.New("members of a direct chat can't be changed"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, membersChatID).Return(directChat(), nil)
+				mock.LockMembersMock.Expect(memberCtx, membersChatID).Return(directChat().Members, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, tt.logRepositoryMock, nil))
+
+			_, err := api.LeaveChat(tt.ctx, &desc.LeaveChatRequest{ChatId: membersChatID})
+			requireStatus(t, tt.code, tt.err, err)
+		})
+	}
+}
+
+func TestImplementation_RemoveMember_ClosesStream(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	chatRepoMock := mocks.NewChatRepositoryMock(mc)
+	chatRepoMock.GetMock.Return(membersChat(), nil)
+	chatRepoMock.LockMembersMock.Return(membersChat().Members, nil)
+	chatRepoMock.RemoveMemberMock.Return(nil)
+	chatRepoMock.UpdateLastMessageMock.Return(nil)
+
+	messageRepoMock := mocks.NewMessageRepositoryMock(mc)
+	messageRepoMock.CreateMock.Set(func(_ context.Context, message *model.Message) (*model.Message, error) {
+		return message, nil
+	})
+
+	logRepoMock := mocks.NewLogRepositoryMock(mc)
+	logRepoMock.LogMock.Return(nil)
+
+	service := chatService.NewService(chatRepoMock, messageRepoMock, newPresenceRepositoryMock(mc), logRepoMock, &txManagerMock{}, rpcMocks.NewUserClientMock(mc), storageMocks.NewBlobStoreMock(mc))
+	api := chat.NewImplementation(service)
+
+	stream := newConnectChatStreamMock(memberCtx)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- api.ConnectChat(&desc.ConnectChatRequest{ChatId: membersChatID}, stream)
+	}()
+
+	// Wait until the subscription is registered.
+	require.Eventually(t, func() bool {
+		_, err := api.SendMessage(ownerCtx, &desc.SendMessageRequest{
+			ChatId:  membersChatID,
+			Message: &desc.Message{Text: "ping"},
+		})
+		require.NoError(t, err)
+
+		select {
+		case <-stream.events:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	_, err := api.RemoveMember(ownerCtx, &desc.RemoveMemberRequest{ChatId: membersChatID, Username: "member"})
+	require.NoError(t, err)
+
+	select {
+	case err = <-done:
+		require.Equal(t, codes.Aborted, status.Code(err))
+	case <-time.After(time.Second):
+		t.Fatal("stream of the removed member was not closed")
+	}
+
+	// The removal notice is delivered before the stream closes.
+	var last *desc.Message
+	for len(stream.events) > 0 {
+		last = (<-stream.events).GetMessage()
+	}
+	require.NotNil(t, last)
+	require.True(t, last.GetSystem())
+	require.Equal(t, "owner removed member", last.GetText())
+}
+
+func newMembersService(
+	mc *minimock.Controller,
+	chatRepositoryMock func(mc *minimock.Controller) *mocks.ChatRepositoryMock,
+	messageRepositoryMock func(mc *minimock.Controller) *mocks.MessageRepositoryMock,
+	logRepositoryMock func(mc *minimock.Controller) *mocks.LogRepositoryMock,
+	userClientMock func(mc *minimock.Controller) *rpcMocks.UserClientMock,
+) service.ChatService {
+	chatRepo := mocks.NewChatRepositoryMock(mc)
+	if chatRepositoryMock != nil {
+		chatRepo = chatRepositoryMock(mc)
+	}
+	messageRepo := mocks.NewMessageRepositoryMock(mc)
+	if messageRepositoryMock != nil {
+		messageRepo = messageRepositoryMock(mc)
+	}
+	logRepo := mocks.NewLogRepositoryMock(mc)
+	if logRepositoryMock != nil {
+		logRepo = logRepositoryMock(mc)
+	}
+	userClient := rpcMocks.NewUserClientMock(mc)
+	if userClientMock != nil {
+		userClient = userClientMock(mc)
+	}
+
+	return chatService.NewService(chatRepo, messageRepo, newPresenceRepositoryMock(mc), logRepo, &txManagerMock{}, userClient, storageMocks.NewBlobStoreMock(mc))
+}
+
+func requireStatus(t *testing.T, code codes.Code, want, err error) {
+	t.Helper()
+
+	if want == nil {
+		require.NoError(t, err)
+		return
+	}
+
+	require.Error(t, err)
+	require.Equal(t, code, status.Code(err))
+	require.Contains(t, err.Error(), want.Error())
+}
@@ -0,0 +1,226 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
+	"chat-server/internal/model"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_GetPresence(t *testing.T) {
+	type presenceRepositoryMockFunc func(mc *minimock.Controller) *mocks.PresenceRepositoryMock
+
+	var (
+		mc       = minimock.NewController(t)
+		lastSeen = time.Now().Add(-time.Hour)
+
+		repoErr = errors.New("repository error")
+	)
+
+	tooMany := make([]int64, 0, 101)
+	for i := 0; i < 101; i++ {
+		tooMany = append(tooMany, int64(i+1))
+	}
+
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		userIDs                []int64
+		want                   *desc.GetPresenceResponse
+		code                   codes.Code
+		err                    error
+		presenceRepositoryMock presenceRepositoryMockFunc
+	}{
+		{
+			name:    "success case",
+			ctx:     ownerCtx,
+			userIDs: []int64{3, 2, 3},
+			want: &desc.GetPresenceResponse{
+				Presence: []*desc.Presence{
+					{UserId: 3, LastSeenAt: timestamppb.New(lastSeen)},
+					{UserId: 2},
+				},
+			},
+			presenceRepositoryMock: func(mc *minimock.Controller) *mocks.PresenceRepositoryMock {
+				mock := mocks.NewPresenceRepositoryMock(mc)
+				mock.ListLastSeenMock.Expect(ownerCtx, []int64{3, 2}).
+					Return(map[int64]sql.NullTime{3: {Time: lastSeen, Valid: true}}, nil)
+				return mock
+			},
+		},
+		{
+			name: "no user ids",
+			ctx:  ownerCtx,
+			code: codes.InvalidArgument,
+			err:  errors.New("no user ids given"),
+		},
+		{
+			name:    "too many user ids",
+			ctx:     ownerCtx,
+			userIDs: tooMany,
+			code:    codes.InvalidArgument,
+			err:     errors.New("too many user ids"),
+		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			userIDs: []int64{3},
+			code:    codes.Unauthenticated,
+			err:     errors.New("user is not authenticated"),
+		},
+		{
+			name:    "repository error",
+			ctx:     ownerCtx,
+			userIDs: []int64{3},
+			code:    codes.Internal,
+			err:     repoErr,
+			presenceRepositoryMock: func(mc *minimock.Controller) *mocks.PresenceRepositoryMock {
+				mock := mocks.NewPresenceRepositoryMock(mc)
+				mock.ListLastSeenMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			presenceRepo := mocks.NewPresenceRepositoryMock(mc)
+			if tt.presenceRepositoryMock != nil {
+				presenceRepo = tt.presenceRepositoryMock(mc)
+			}
+
+			service := chatService.NewService(
+				mocks.NewChatRepositoryMock(mc),
+				mocks.NewMessageRepositoryMock(mc),
+				presenceRepo,
+				mocks.NewLogRepositoryMock(mc),
+				&txManagerMock{},
+				rpcMocks.NewUserClientMock(mc),
+				storageMocks.NewBlobStoreMock(mc),
+			)
+
+			api := chat.NewImplementation(service)
+
+			res, err := api.GetPresence(tt.ctx, &desc.GetPresenceRequest{UserIds: tt.userIDs})
+			requireStatus(t, tt.code, tt.err, err)
+			if tt.err == nil {
+				require.True(t, proto.Equal(tt.want, res), "got %v", res)
+			}
+		})
+	}
+}
+
+func TestImplementation_WatchPresence(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	chatRepoMock := mocks.NewChatRepositoryMock(mc)
+	chatRepoMock.GetMock.Return(membersChat(), nil)
+
+	saved := make(chan time.Time, 4)
+	presenceRepoMock := mocks.NewPresenceRepositoryMock(mc)
+	presenceRepoMock.ListLastSeenMock.Return(map[int64]sql.NullTime{}, nil)
+	presenceRepoMock.SaveLastSeenMock.Set(func(_ context.Context, user *model.User, at time.Time) error {
+		// The watcher's own stream closes when the test ends.
+		if user.ID == 3 {
+			saved <- at
+		}
+		return nil
+	})
+
+	service := chatService.NewService(chatRepoMock, mocks.NewMessageRepositoryMock(mc), presenceRepoMock, mocks.NewLogRepositoryMock(mc), &txManagerMock{}, rpcMocks.NewUserClientMock(mc), storageMocks.NewBlobStoreMock(mc))
+	api := chat.NewImplementation(service)
+
+	watchCtx, cancelWatch := context.WithCancel(ownerCtx)
+	defer cancelWatch()
+	watcher := newWatchPresenceStreamMock(watchCtx)
+
+	go func() {
+		_ = api.WatchPresence(&desc.WatchPresenceRequest{UserIds: []int64{3}}, watcher)
+	}()
+
+	next := func() *desc.Presence {
+		select {
+		case p := <-watcher.presence:
+			return p
+		case <-time.After(time.Second):
+			t.Fatal("no presence delivered")
+			return nil
+		}
+	}
+
+	require.True(t, proto.Equal(&desc.Presence{UserId: 3}, next()))
+
+	// Another user with the same username going online leaves the member
+	// offline. It watches itself to tell when its stream is counted.
+	namesakeCtx, cancelNamesake := context.WithCancel(identity.WithUser(context.Background(), &model.User{ID: 5, Username: "member"}))
+	defer cancelNamesake()
+	namesake := newWatchPresenceStreamMock(namesakeCtx)
+
+	go func() {
+		_ = api.WatchPresence(&desc.WatchPresenceRequest{UserIds: []int64{5}}, namesake)
+	}()
+
+	for _, want := range []*desc.Presence{{UserId: 5}, {UserId: 5, Online: true}} {
+		select {
+		case p := <-namesake.presence:
+			require.True(t, proto.Equal(want, p), "got %v", p)
+		case <-time.After(time.Second):
+			t.Fatal("no presence delivered")
+		}
+	}
+
+	res, err := api.GetPresence(ownerCtx, &desc.GetPresenceRequest{UserIds: []int64{3}})
+	require.NoError(t, err)
+	require.False(t, res.GetPresence()[0].GetOnline())
+
+	// The member opens two streams, the user stays online until both close.
+	streams := make([]context.CancelFunc, 0, 2)
+	for range 2 {
+		ctx, cancel := context.WithCancel(memberCtx)
+		defer cancel()
+		streams = append(streams, cancel)
+
+		_, err := service.ConnectChat(ctx, membersChatID, 0)
+		require.NoError(t, err)
+	}
+
+	require.True(t, proto.Equal(&desc.Presence{UserId: 3, Online: true}, next()))
+
+	res, err = api.GetPresence(ownerCtx, &desc.GetPresenceRequest{UserIds: []int64{3}})
+	require.NoError(t, err)
+	require.True(t, res.GetPresence()[0].GetOnline())
+
+	streams[0]()
+	select {
+	case <-saved:
+		t.Fatal("last seen saved while a stream is open")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	streams[1]()
+	offline := next()
+	require.False(t, offline.GetOnline())
+	require.NotNil(t, offline.GetLastSeenAt())
+
+	select {
+	case at := <-saved:
+		require.True(t, at.Equal(offline.GetLastSeenAt().AsTime()))
+	case <-time.After(time.Second):
+		t.Fatal("last seen not saved")
+	}
+}
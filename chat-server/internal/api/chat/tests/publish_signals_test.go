@@ -0,0 +1,197 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestImplementation_PublishSignals(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+
+	var (
+		mc = minimock.NewController(t)
+
+		typing = &desc.Signal{ChatId: membersChatID, Type: desc.SignalType_SIGNAL_TYPE_TYPING}
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		signals            []*desc.Signal
+		code               codes.Code
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name:    "success case",
+			ctx:     memberCtx,
+			signals: []*desc.Signal{typing, typing, typing},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				// Refreshes of an active signal don't check the membership again.
+				mock.GetMock.Expect(memberCtx, membersChatID).Times(1).Return(membersChat(), nil)
+				return mock
+			},
+		},
+		{
+			name:    "stop without a signal",
+			ctx:     memberCtx,
+			signals: []*desc.Signal{{ChatId: membersChatID, Type: desc.SignalType_SIGNAL_TYPE_AWAY, Stop: true}},
+		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			signals: []*desc.Signal{typing},
+			code:    codes.Unauthenticated,
+			err:     errors.New("user is not authenticated"),
+		},
+		{
+			name:    "unknown signal type",
+			ctx:     memberCtx,
+			signals: []*desc.Signal{{ChatId: membersChatID}},
+			code:    codes.InvalidArgument,
+			err:     errors.New("unknown signal type"),
+		},
+		{
+			name:    "outsider",
+			ctx:     outsiderCtx,
+			signals: []*desc.Signal{typing},
+			code:    codes.PermissionDenied,
+			err:     errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(membersChat(), nil)
+				return mock
+			},
+		},
+		{
+			name:    "chat not found",
+			ctx:     memberCtx,
+			signals: []*desc.Signal{typing},
+			code:    codes.NotFound,
+			err:     errors.New("chat not found"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name:    "repository error",
+			ctx:     memberCtx,
+			signals: []*desc.Signal{typing},
+			code:    codes.Internal,
+			err:     repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, nil, nil, nil))
+
+			stream := newPublishSignalsStreamMock(tt.ctx, tt.signals...)
+			err := api.PublishSignals(stream)
+			requireStatus(t, tt.code, tt.err, err)
+			require.Equal(t, tt.err == nil, stream.closed)
+		})
+	}
+}
+
+func TestImplementation_PublishSignals_FanOut(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	chatRepoMock := mocks.NewChatRepositoryMock(mc)
+	chatRepoMock.GetMock.Return(membersChat(), nil)
+
+	service := chatService.NewService(chatRepoMock, mocks.NewMessageRepositoryMock(mc), newPresenceRepositoryMock(mc), mocks.NewLogRepositoryMock(mc), &txManagerMock{}, rpcMocks.NewUserClientMock(mc), storageMocks.NewBlobStoreMock(mc))
+	api := chat.NewImplementation(service)
+
+	ctx, cancel := context.WithCancel(memberCtx)
+	defer cancel()
+	listener := newConnectChatStreamMock(ctx)
+
+	go func() {
+		_ = api.ConnectChat(&desc.ConnectChatRequest{ChatId: membersChatID}, listener)
+	}()
+
+	signal := func(signalType desc.SignalType, stop bool) *desc.Signal {
+		return &desc.Signal{ChatId: membersChatID, Type: signalType, Stop: stop}
+	}
+	event := func(signalType desc.SignalType, active bool) *desc.SignalEvent {
+		return &desc.SignalEvent{UserId: 1, Username: "owner", Type: signalType, Active: active}
+	}
+	next := func() *desc.SignalEvent {
+		select {
+		case e := <-listener.events:
+			return e.GetSignal()
+		case <-time.After(time.Second):
+			t.Fatal("no signal event delivered")
+			return nil
+		}
+	}
+
+	// The subscription is registered asynchronously, so keep signalling until
+	// it is delivered. Every attempt ends with its stream and clears the signal,
+	// the events of the attempts are drained before the actual run.
+	require.Eventually(t, func() bool {
+		err := api.PublishSignals(newPublishSignalsStreamMock(ownerCtx, signal(desc.SignalType_SIGNAL_TYPE_TYPING, false)))
+		require.NoError(t, err)
+
+		select {
+		case <-listener.events:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+	for drained := false; !drained; {
+		select {
+		case <-listener.events:
+		case <-time.After(50 * time.Millisecond):
+			drained = true
+		}
+	}
+
+	err := api.PublishSignals(newPublishSignalsStreamMock(ownerCtx,
+		signal(desc.SignalType_SIGNAL_TYPE_TYPING, false),
+		signal(desc.SignalType_SIGNAL_TYPE_TYPING, false),
+		signal(desc.SignalType_SIGNAL_TYPE_ONLINE, false),
+		signal(desc.SignalType_SIGNAL_TYPE_AWAY, false),
+		signal(desc.SignalType_SIGNAL_TYPE_TYPING, true),
+	))
+	require.NoError(t, err)
+
+	want := []*desc.SignalEvent{
+		event(desc.SignalType_SIGNAL_TYPE_TYPING, true),
+		event(desc.SignalType_SIGNAL_TYPE_ONLINE, true),
+		event(desc.SignalType_SIGNAL_TYPE_AWAY, true),
+		event(desc.SignalType_SIGNAL_TYPE_TYPING, false),
+		// The stream ended, its presence goes with it.
+		event(desc.SignalType_SIGNAL_TYPE_AWAY, false),
+	}
+	for _, w := range want {
+		got := next()
+		require.True(t, proto.Equal(w, got), "want %v, got %v", w, got)
+	}
+}
@@ -0,0 +1,243 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/model"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestImplementation_AddReaction(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	var (
+		mc = minimock.NewController(t)
+
+		repoErr = errors.New("repository error")
+	)
+
+	getChat := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+		mock := mocks.NewChatRepositoryMock(mc)
+		mock.GetMock.Expect(minimock.AnyContext, membersChatID).Return(membersChat(), nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		emoji                 string
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+	}{
+		{
+			name:               "success case",
+			ctx:                ownerCtx,
+			emoji:              "👍",
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, editedMessageID).Return(memberMessage(), nil)
+				mock.AddReactionMock.Expect(ownerCtx, editedMessageID, 1, "👍").Return(true, nil)
+				mock.CountReactionsMock.Expect(ownerCtx, editedMessageID, "👍").Return(2, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ownerCtx, &logModel.Log{Action: "reaction_added", EntityID: editedMessageID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:               "repeated reaction is a no-op",
+			ctx:                ownerCtx,
+			emoji:              "👍",
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(memberMessage(), nil)
+				mock.AddReactionMock.Return(false, nil)
+				return mock
+			},
+		},
+		{
+			name:  "empty emoji",
+			ctx:   ownerCtx,
+			code:  codes.InvalidArgument,
+			err:   errors.New("emoji is empty"),
+			emoji: "",
+		},
+		{
+			name:  "not an emoji",
+			ctx:   ownerCtx,
+			emoji: "thumbs up",
+			code:  codes.InvalidArgument,
+			err:   errors.New("invalid emoji"),
+		},
+		{
+			name:               "outsider",
+			ctx:                outsiderCtx,
+			emoji:              "👍",
+			code:               codes.PermissionDenied,
+			err:                errors.New("user is not a member of the chat"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(memberMessage(), nil)
+				return mock
+			},
+		},
+		{
+			name:               "deleted message",
+			ctx:                ownerCtx,
+			emoji:              "👍",
+			code:               codes.FailedPrecondition,
+			err:                errors.New("message is deleted"),
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(&model.Message{ID: editedMessageID, ChatID: membersChatID, DeletedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+				return mock
+			},
+		},
+		{
+			name:               "repository error",
+			ctx:                ownerCtx,
+			emoji:              "👍",
+			code:               codes.Internal,
+			err:                repoErr,
+			chatRepositoryMock: getChat,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(memberMessage(), nil)
+				mock.AddReactionMock.Return(false, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, tt.logRepositoryMock, nil))
+
+			_, err := api.AddReaction(tt.ctx, &desc.AddReactionRequest{MessageId: editedMessageID, Emoji: tt.emoji})
+			requireStatus(t, tt.code, tt.err, err)
+		})
+	}
+}
+
+func TestImplementation_RemoveReaction(t *testing.T) {
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	mc := minimock.NewController(t)
+
+	tests := []struct {
+		name                  string
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, editedMessageID).Return(memberMessage(), nil)
+				mock.RemoveReactionMock.Expect(memberCtx, editedMessageID, 3, "👍").Return(true, nil)
+				mock.CountReactionsMock.Expect(memberCtx, editedMessageID, "👍").Return(0, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(memberCtx, &logModel.Log{Action: "reaction_removed", EntityID: editedMessageID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "missing reaction is a no-op",
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(memberMessage(), nil)
+				mock.RemoveReactionMock.Return(false, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepoMock := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(membersChat(), nil)
+				return mock
+			}
+
+			api := chat.NewImplementation(newMembersService(mc, chatRepoMock, tt.messageRepositoryMock, tt.logRepositoryMock, nil))
+
+			_, err := api.RemoveReaction(memberCtx, &desc.RemoveReactionRequest{MessageId: editedMessageID, Emoji: "👍"})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestImplementation_AddReaction_PublishesEvent(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	chatRepoMock := mocks.NewChatRepositoryMock(mc)
+	chatRepoMock.GetMock.Return(membersChat(), nil)
+
+	messageRepoMock := mocks.NewMessageRepositoryMock(mc)
+	messageRepoMock.GetMock.Return(memberMessage(), nil)
+	messageRepoMock.AddReactionMock.Return(true, nil)
+	messageRepoMock.CountReactionsMock.Return(1, nil)
+
+	logRepoMock := mocks.NewLogRepositoryMock(mc)
+	logRepoMock.LogMock.Return(nil)
+
+	service := chatService.NewService(chatRepoMock, messageRepoMock, newPresenceRepositoryMock(mc), logRepoMock, &txManagerMock{}, rpcMocks.NewUserClientMock(mc), storageMocks.NewBlobStoreMock(mc))
+	api := chat.NewImplementation(service)
+
+	ctx, cancel := context.WithCancel(memberCtx)
+	defer cancel()
+	stream := newConnectChatStreamMock(ctx)
+
+	go func() {
+		_ = api.ConnectChat(&desc.ConnectChatRequest{ChatId: membersChatID}, stream)
+	}()
+
+	want := &desc.ReactionEvent{MessageId: editedMessageID, Emoji: "🎉", UserId: 1, Username: "owner", Count: 1}
+
+	// The subscription is registered asynchronously, so keep reacting until it is delivered.
+	var got *desc.ReactionEvent
+	require.Eventually(t, func() bool {
+		_, err := api.AddReaction(ownerCtx, &desc.AddReactionRequest{MessageId: editedMessageID, Emoji: "🎉"})
+		require.NoError(t, err)
+
+		select {
+		case event := <-stream.events:
+			got = event.GetReactionAdded()
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	require.True(t, proto.Equal(want, got), "got %v", got)
+}
@@ -0,0 +1,258 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_MarkRead(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+
+	var (
+		mc = minimock.NewController(t)
+
+		repoErr = errors.New("repository error")
+	)
+
+	getMessage := func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+		mock := mocks.NewMessageRepositoryMock(mc)
+		mock.GetMock.Expect(minimock.AnyContext, editedMessageID).Return(memberMessage(), nil)
+		return mock
+	}
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		chatID                int64
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+	}{
+		{
+			name:   "success case",
+			ctx:    ownerCtx,
+			chatID: membersChatID,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(membersChat(), nil)
+				mock.MarkReadMock.Expect(ownerCtx, membersChatID, 1, editedMessageID).Return(true, nil)
+				return mock
+			},
+			messageRepositoryMock: getMessage,
+		},
+		{
+			name:   "already read",
+			ctx:    ownerCtx,
+			chatID: membersChatID,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				chatModel := membersChat()
+				chatModel.Members[0].LastReadMessageID = editedMessageID + 1
+
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: getMessage,
+		},
+		{
+			name:   "concurrent read moved further",
+			ctx:    ownerCtx,
+			chatID: membersChatID,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(membersChat(), nil)
+				mock.MarkReadMock.Return(false, nil)
+				return mock
+			},
+			messageRepositoryMock: getMessage,
+		},
+		{
+			name:                  "message in another chat",
+			ctx:                   ownerCtx,
+			chatID:                membersChatID + 1,
+			code:                  codes.InvalidArgument,
+			err:                   errors.New("message is in another chat"),
+			messageRepositoryMock: getMessage,
+		},
+		{
+			name:   "message not found",
+			ctx:    ownerCtx,
+			chatID: membersChatID,
+			code:   codes.NotFound,
+			err:    errors.New("message not found"),
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name:   "outsider",
+			ctx:    outsiderCtx,
+			chatID: membersChatID,
+			code:   codes.PermissionDenied,
+			err:    errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(membersChat(), nil)
+				return mock
+			},
+			messageRepositoryMock: getMessage,
+		},
+		{
+			name:   "repository error",
+			ctx:    ownerCtx,
+			chatID: membersChatID,
+			code:   codes.Internal,
+			err:    repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(membersChat(), nil)
+				mock.MarkReadMock.Return(false, repoErr)
+				return mock
+			},
+			messageRepositoryMock: getMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, nil, nil))
+
+			_, err := api.MarkRead(tt.ctx, &desc.MarkReadRequest{ChatId: tt.chatID, MessageId: editedMessageID})
+			requireStatus(t, tt.code, tt.err, err)
+		})
+	}
+}
+
+func TestImplementation_MarkRead_PublishesEvent(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	chatRepoMock := mocks.NewChatRepositoryMock(mc)
+	chatRepoMock.GetMock.Return(membersChat(), nil)
+	chatRepoMock.MarkReadMock.Return(true, nil)
+
+	messageRepoMock := mocks.NewMessageRepositoryMock(mc)
+	messageRepoMock.GetMock.Return(memberMessage(), nil)
+
+	service := chatService.NewService(chatRepoMock, messageRepoMock, newPresenceRepositoryMock(mc), mocks.NewLogRepositoryMock(mc), &txManagerMock{}, rpcMocks.NewUserClientMock(mc), storageMocks.NewBlobStoreMock(mc))
+	api := chat.NewImplementation(service)
+
+	ctx, cancel := context.WithCancel(memberCtx)
+	defer cancel()
+	stream := newConnectChatStreamMock(ctx)
+
+	go func() {
+		_ = api.ConnectChat(&desc.ConnectChatRequest{ChatId: membersChatID}, stream)
+	}()
+
+	want := &desc.ReadEvent{UserId: 1, Username: "owner", LastReadMessageId: editedMessageID}
+
+	// The subscription is registered asynchronously, so keep marking until it is delivered.
+	var got *desc.ReadEvent
+	require.Eventually(t, func() bool {
+		_, err := api.MarkRead(ownerCtx, &desc.MarkReadRequest{ChatId: membersChatID, MessageId: editedMessageID})
+		require.NoError(t, err)
+
+		select {
+		case event := <-stream.events:
+			got = event.GetRead()
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	require.True(t, proto.Equal(want, got), "got %v", got)
+}
+
+func TestImplementation_GetReadReceipts(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+
+	var (
+		mc     = minimock.NewController(t)
+		readAt = time.Now()
+	)
+
+	readChat := func() *model.Chat {
+		chatModel := membersChat()
+		chatModel.Members[0].LastReadMessageID = editedMessageID
+		chatModel.Members[0].LastReadAt = sql.NullTime{Time: readAt, Valid: true}
+		chatModel.Members[1].LastReadMessageID = editedMessageID - 1
+		// The author is left out even though their pointer is past the message.
+		chatModel.Members[2].LastReadMessageID = editedMessageID + 1
+		return chatModel
+	}
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		want               *desc.GetReadReceiptsResponse
+		code               codes.Code
+		err                error
+		chatRepositoryMock chatRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			ctx:  adminCtx,
+			want: &desc.GetReadReceiptsResponse{
+				Receipts: []*desc.ReadReceipt{
+					{UserId: 1, Username: "owner", ReadAt: timestamppb.New(readAt)},
+				},
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(adminCtx, membersChatID).Return(readChat(), nil)
+				return mock
+			},
+		},
+		{
+			name: "outsider",
+			ctx:  outsiderCtx,
+			code: codes.PermissionDenied,
+			err:  errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(readChat(), nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messageRepoMock := func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Expect(tt.ctx, editedMessageID).Return(memberMessage(), nil)
+				return mock
+			}
+
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, messageRepoMock, nil, nil))
+
+			res, err := api.GetReadReceipts(tt.ctx, &desc.GetReadReceiptsRequest{MessageId: editedMessageID})
+			requireStatus(t, tt.code, tt.err, err)
+			if tt.err == nil {
+				require.True(t, proto.Equal(tt.want, res), "got %v", res)
+			}
+		})
+	}
+}
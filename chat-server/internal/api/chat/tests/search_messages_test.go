@@ -0,0 +1,204 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	"chat-server/internal/model"
+	"chat-server/internal/pagination"
+	"chat-server/internal/repository"
+	"chat-server/internal/repository/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_SearchMessages(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+
+	var (
+		mc        = minimock.NewController(t)
+		timestamp = time.Now()
+		since     = timestamp.Add(-24 * time.Hour)
+
+		results = func() []*model.SearchResult {
+			return []*model.SearchResult{
+				{Message: &model.Message{ID: 7, ChatID: membersChatID, From: "admin", Text: "deploy is done", Timestamp: timestamp}, Snippet: "<b>deploy</b> is done", Rank: 0.5},
+				{Message: &model.Message{ID: 9, ChatID: membersChatID, From: "member", Text: "who runs the deploy?", Timestamp: timestamp}, Snippet: "who runs the <b>deploy</b>?", Rank: 0.25},
+				{Message: &model.Message{ID: 3, ChatID: membersChatID, From: "owner", Text: "deploy friday", Timestamp: timestamp}, Snippet: "<b>deploy</b> friday", Rank: 0.25},
+			}
+		}
+
+		nextCursor, _ = pagination.EncodeCursor(struct {
+			Rank    float32 `json:"rank"`
+			AfterID int64   `json:"after_id"`
+		}{Rank: 0.25, AfterID: 9})
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name                  string
+		req                   *desc.SearchMessagesRequest
+		want                  *desc.SearchMessagesResponse
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+	}{
+		{
+			name: "first page with more results",
+			req:  &desc.SearchMessagesRequest{Query: " deploy ", From: "admin", Since: timestamppb.New(since), Limit: 2},
+			want: &desc.SearchMessagesResponse{
+				Results: []*desc.SearchResult{
+					{
+						Message: &desc.Message{Id: 7, ChatId: membersChatID, From: "admin", Text: "deploy is done", Timestamp: timestamppb.New(timestamp)},
+						Snippet: "<b>deploy</b> is done",
+					},
+					{
+						Message: &desc.Message{Id: 9, ChatId: membersChatID, From: "member", Text: "who runs the deploy?", Timestamp: timestamppb.New(timestamp)},
+						Snippet: "who runs the <b>deploy</b>?",
+					},
+				},
+				NextCursor: nextCursor,
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.SearchMock.Expect(memberCtx, 3, &model.MessageSearch{Query: "deploy", From: "admin", Since: since.UTC()}, nil, 3).Return(results(), nil)
+				mock.ListReactionsMock.Expect(memberCtx, []int64{7, 9}, 3).Return(map[int64][]*model.Reaction{}, nil)
+				mock.ListAttachmentsMock.Expect(memberCtx, []int64{7, 9}).Return(map[int64][]*model.Attachment{}, nil)
+				return mock
+			},
+		},
+		{
+			name: "next page in a chat",
+			req:  &desc.SearchMessagesRequest{Query: "deploy", ChatId: membersChatID, Cursor: nextCursor},
+			want: &desc.SearchMessagesResponse{
+				Results: []*desc.SearchResult{
+					{
+						Message: &desc.Message{Id: 3, ChatId: membersChatID, From: "owner", Text: "deploy friday", Timestamp: timestamppb.New(timestamp)},
+						Snippet: "<b>deploy</b> friday",
+					},
+				},
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, membersChatID).Return(membersChat(), nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.SearchMock.Expect(memberCtx, 3,
+					&model.MessageSearch{Query: "deploy", ChatID: membersChatID},
+					&model.SearchResult{Message: &model.Message{ID: 9}, Rank: 0.25},
+					51,
+				).Return(results()[2:], nil)
+				mock.ListReactionsMock.Return(map[int64][]*model.Reaction{}, nil)
+				mock.ListAttachmentsMock.Return(map[int64][]*model.Attachment{}, nil)
+				return mock
+			},
+		},
+		{
+			name: "no results",
+			req:  &desc.SearchMessagesRequest{Query: "nothing"},
+			want: &desc.SearchMessagesResponse{},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.SearchMock.Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "empty query",
+			req:  &desc.SearchMessagesRequest{Query: "  "},
+			code: codes.InvalidArgument,
+			err:  errors.New("query is empty"),
+		},
+		{
+			name: "query too long",
+			req:  &desc.SearchMessagesRequest{Query: strings.Repeat("a", 257)},
+			code: codes.InvalidArgument,
+			err:  errors.New("query is too long"),
+		},
+		{
+			name: "until before since",
+			req: &desc.SearchMessagesRequest{
+				Query: "deploy",
+				Since: timestamppb.New(timestamp),
+				Until: timestamppb.New(since),
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("until must be after since"),
+		},
+		{
+			name: "invalid cursor",
+			req:  &desc.SearchMessagesRequest{Query: "deploy", Cursor: "not a cursor"},
+			code: codes.InvalidArgument,
+			err:  pagination.ErrInvalidCursor,
+		},
+		{
+			name: "chat not found",
+			req:  &desc.SearchMessagesRequest{Query: "deploy", ChatId: membersChatID},
+			code: codes.NotFound,
+			err:  errors.New("chat not found"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+		{
+			name: "not a member of the chat",
+			req:  &desc.SearchMessagesRequest{Query: "deploy", ChatId: membersChatID},
+			code: codes.PermissionDenied,
+			err:  errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				chatModel := membersChat()
+				chatModel.Members = chatModel.Members[:2]
+
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Return(chatModel, nil)
+				return mock
+			},
+		},
+		{
+			name: "repository error",
+			req:  &desc.SearchMessagesRequest{Query: "deploy"},
+			code: codes.Internal,
+			err:  repoErr,
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.SearchMock.Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, nil, nil))
+
+			res, err := api.SearchMessages(memberCtx, tt.req)
+			requireStatus(t, tt.code, tt.err, err)
+			if tt.err == nil {
+				require.True(t, proto.Equal(tt.want, res), "got %v", res)
+			}
+		})
+	}
+}
+
+func TestImplementation_SearchMessages_Unauthenticated(t *testing.T) {
+	mc := minimock.NewController(t)
+	api := chat.NewImplementation(newMembersService(mc, nil, nil, nil, nil))
+
+	_, err := api.SearchMessages(context.Background(), &desc.SearchMessagesRequest{Query: "deploy"})
+	requireStatus(t, codes.Unauthenticated, errors.New("user is not authenticated"), err)
+}
@@ -2,11 +2,16 @@ package chat_test
 
 import (
 	"chat-server/internal/api/chat"
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
 	"chat-server/internal/model"
+	"chat-server/internal/repository"
 	"chat-server/internal/repository/mocks"
 	chatService "chat-server/internal/service/chat"
+	storageMocks "chat-server/internal/storage/mocks"
 	desc "chat-server/pkg/chat_server_v1"
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 	"time"
@@ -14,11 +19,14 @@ import (
 	"github.com/gojuno/minimock/v3"
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestImplementation_SendMessage(t *testing.T) {
 	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
 	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
 
 	type args struct {
@@ -27,19 +35,40 @@ func TestImplementation_SendMessage(t *testing.T) {
 	}
 
 	var (
-		ctx       = context.Background()
+		ctx       = identity.WithUser(context.Background(), &model.User{ID: 1, Username: "user1"})
 		mc        = minimock.NewController(t)
+		chatID    = int64(123)
+		messageID = int64(456)
 		timestamp = time.Now()
 
+		strangerCtx = identity.WithUser(context.Background(), &model.User{ID: 3, Username: "stranger"})
+
+		// The client-supplied sender is ignored in favour of the authenticated user.
 		req = &desc.SendMessageRequest{
+			ChatId: chatID,
 			Message: &desc.Message{
-				From:      "user1",
-				Text:      "Hello, World!",
-				Timestamp: timestamppb.New(timestamp),
+				From: "spoofed",
+				Text: "Hello, World!",
+			},
+		}
+
+		chatModel = &model.Chat{
+			ID: chatID,
+			Members: []*model.ChatMember{
+				{ChatID: chatID, UserID: 1, Username: "user1", Role: model.ChatRoleMember},
+				{ChatID: chatID, UserID: 2, Username: "user2", Role: model.ChatRoleMember},
 			},
 		}
 
 		messageModel = &model.Message{
+			ChatID: chatID,
+			From:   "user1",
+			Text:   "Hello, World!",
+		}
+
+		createdMessage = &model.Message{
+			ID:        messageID,
+			ChatID:    chatID,
 			From:      "user1",
 			Text:      "Hello, World!",
 			Timestamp: timestamp,
@@ -47,18 +76,22 @@ func TestImplementation_SendMessage(t *testing.T) {
 
 		logEntry = &logModel.Log{
 			Action:   "message_sent",
-			EntityID: 0,
+			EntityID: messageID,
 		}
 
-		logErr = errors.New("log error")
+		repoErr = errors.New("repository error")
+		logErr  = errors.New("log error")
 	)
 
 	tests := []struct {
-		name               string
-		args               args
-		err                error
-		chatRepositoryMock chatRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
+		name                  string
+		args                  args
+		want                  *desc.SendMessageResponse
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
 	}{
 		{
 			name: "success case",
@@ -66,9 +99,21 @@ func TestImplementation_SendMessage(t *testing.T) {
 				ctx: ctx,
 				req: req,
 			},
+			want: &desc.SendMessageResponse{
+				Id:        messageID,
+				Timestamp: timestamppb.New(timestamp),
+			},
 			err: nil,
 			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
-				return mocks.NewChatRepositoryMock(mc)
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				mock.UpdateLastMessageMock.Expect(ctx, chatID, messageID).Return(nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Expect(ctx, messageModel).Return(createdMessage, nil)
+				return mock
 			},
 			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
 				mock := mocks.NewLogRepositoryMock(mc)
@@ -77,15 +122,149 @@ func TestImplementation_SendMessage(t *testing.T) {
 			},
 		},
 		{
-			name: "log error",
+			name: "empty text",
 			args: args{
 				ctx: ctx,
+				req: &desc.SendMessageRequest{
+					ChatId:  chatID,
+					Message: &desc.Message{From: "user1"},
+				},
+			},
+			code: codes.InvalidArgument,
+			err:  errors.New("message text is empty"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				return mocks.NewChatRepositoryMock(mc)
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+		},
+		{
+			name: "chat not found",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			code: codes.NotFound,
+			err:  errors.New("chat not found"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(nil, repository.ErrNotFound)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+		},
+		{
+			name: "unauthenticated",
+			args: args{
+				ctx: context.Background(),
 				req: req,
 			},
-			err: logErr,
+			code: codes.Unauthenticated,
+			err:  errors.New("user is not authenticated"),
 			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
 				return mocks.NewChatRepositoryMock(mc)
 			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+		},
+		{
+			name: "sender is not a member",
+			args: args{
+				ctx: strangerCtx,
+				req: req,
+			},
+			code: codes.PermissionDenied,
+			err:  errors.New("sender is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(strangerCtx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+		},
+		{
+			name: "member can't post in a channel",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			code: codes.PermissionDenied,
+			err:  errors.New("only the owner and admins can post in a channel"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(&model.Chat{
+					ID:      chatID,
+					Type:    model.ChatTypeChannel,
+					Members: chatModel.Members,
+				}, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				return mocks.NewMessageRepositoryMock(mc)
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+		},
+		{
+			name: "repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			code: codes.Internal,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Expect(ctx, messageModel).Return(nil, repoErr)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				return mocks.NewLogRepositoryMock(mc)
+			},
+		},
+		{
+			name: "log error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			code: codes.Internal,
+			err:  logErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ctx, chatID).Return(chatModel, nil)
+				mock.UpdateLastMessageMock.Expect(ctx, chatID, messageID).Return(nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Expect(ctx, messageModel).Return(createdMessage, nil)
+				return mock
+			},
 			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
 				mock := mocks.NewLogRepositoryMock(mc)
 				mock.LogMock.Expect(ctx, logEntry).Return(logErr)
@@ -97,14 +276,19 @@ func TestImplementation_SendMessage(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			chatRepoMock := tt.chatRepositoryMock(mc)
+			messageRepoMock := tt.messageRepositoryMock(mc)
 			logRepoMock := tt.logRepositoryMock(mc)
 
 			txManager := &txManagerMock{}
 
 			service := chatService.NewService(
 				chatRepoMock,
+				messageRepoMock,
+				newPresenceRepositoryMock(mc),
 				logRepoMock,
 				txManager,
+				rpcMocks.NewUserClientMock(mc),
+				storageMocks.NewBlobStoreMock(mc),
 			)
 
 			api := chat.NewImplementation(service)
@@ -113,14 +297,128 @@ func TestImplementation_SendMessage(t *testing.T) {
 
 			if tt.err != nil {
 				require.Error(t, err)
+				require.Equal(t, tt.code, status.Code(err))
 				require.Contains(t, err.Error(), tt.err.Error())
 				require.Nil(t, resp)
 			} else {
 				require.NoError(t, err)
-				require.NotNil(t, resp)
+				require.Equal(t, tt.want, resp)
 			}
+		})
+	}
+}
+
+func TestImplementation_SendMessage_Reply(t *testing.T) {
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+
+	var (
+		mc = minimock.NewController(t)
+
+		rootID  = int64(10)
+		replyID = int64(11)
+
+		root  = &model.Message{ID: rootID, ChatID: membersChatID, From: "owner", Text: "release?"}
+		reply = &model.Message{ID: replyID, ChatID: membersChatID, From: "admin", Text: "friday", ReplyToMessageID: rootID, ThreadRootID: rootID}
+	)
+
+	replyTo := func(id int64) *desc.SendMessageRequest {
+		return &desc.SendMessageRequest{
+			ChatId:  membersChatID,
+			Message: &desc.Message{Text: "ok", ReplyToMessageId: id, ThreadRootId: 999},
+		}
+	}
+
+	// The client-supplied thread root is ignored, the thread follows the replied message.
+	created := func(replyTo int64) messageRepositoryMockFunc {
+		return func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+			mock := mocks.NewMessageRepositoryMock(mc)
+			mock.GetMock.Expect(memberCtx, replyTo).Return(map[int64]*model.Message{rootID: root, replyID: reply}[replyTo], nil)
+			mock.CreateMock.Expect(memberCtx, &model.Message{
+				ChatID:           membersChatID,
+				From:             "member",
+				Text:             "ok",
+				ReplyToMessageID: replyTo,
+				ThreadRootID:     rootID,
+			}).Return(&model.Message{ID: 12, ChatID: membersChatID, ThreadRootID: rootID}, nil)
+			mock.AddReplyMock.Expect(memberCtx, rootID).Return(&model.Message{ID: rootID, ChatID: membersChatID, ReplyCount: 2}, nil)
+			return mock
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		req                   *desc.SendMessageRequest
+		code                  codes.Code
+		err                   error
+		messageRepositoryMock messageRepositoryMockFunc
+	}{
+		{
+			name:                  "reply to a root",
+			req:                   replyTo(rootID),
+			messageRepositoryMock: created(rootID),
+		},
+		{
+			name:                  "reply to a reply joins the same thread",
+			req:                   replyTo(replyID),
+			messageRepositoryMock: created(replyID),
+		},
+		{
+			name: "replied message in another chat",
+			req:  replyTo(rootID),
+			code: codes.InvalidArgument,
+			err:  errors.New("replied message is in another chat"),
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(&model.Message{ID: rootID, ChatID: 999}, nil)
+				return mock
+			},
+		},
+		{
+			name: "replied message deleted",
+			req:  replyTo(rootID),
+			code: codes.FailedPrecondition,
+			err:  errors.New("can't reply to a deleted message"),
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(&model.Message{ID: rootID, ChatID: membersChatID, DeletedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+				return mock
+			},
+		},
+		{
+			name: "replied message not found",
+			req:  replyTo(rootID),
+			code: codes.NotFound,
+			err:  errors.New("message not found"),
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.GetMock.Return(nil, repository.ErrNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepoMock := func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, membersChatID).Return(membersChat(), nil)
+				if tt.err == nil {
+					mock.UpdateLastMessageMock.Expect(memberCtx, membersChatID, 12).Return(nil)
+				}
+				return mock
+			}
+			logRepoMock := func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				if tt.err == nil {
+					mock.LogMock.Return(nil)
+				}
+				return mock
+			}
+
+			api := chat.NewImplementation(newMembersService(mc, chatRepoMock, tt.messageRepositoryMock, logRepoMock, nil))
 
-			_ = messageModel
+			_, err := api.SendMessage(memberCtx, tt.req)
+			requireStatus(t, tt.code, tt.err, err)
 		})
 	}
 }
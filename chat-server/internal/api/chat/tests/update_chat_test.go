@@ -0,0 +1,170 @@
+package chat_test
+
+import (
+	"chat-server/internal/api/chat"
+	"chat-server/internal/model"
+	"chat-server/internal/repository/mocks"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestImplementation_UpdateChat(t *testing.T) {
+	type chatRepositoryMockFunc func(mc *minimock.Controller) *mocks.ChatRepositoryMock
+	type messageRepositoryMockFunc func(mc *minimock.Controller) *mocks.MessageRepositoryMock
+	type logRepositoryMockFunc func(mc *minimock.Controller) *mocks.LogRepositoryMock
+
+	var (
+		mc = minimock.NewController(t)
+
+		title       = "release"
+		description = "Release planning"
+		avatarURL   = "https://example.com/avatar.png"
+
+		repoErr = errors.New("repository error")
+	)
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		info                  *desc.UpdateChatInfo
+		code                  codes.Code
+		err                   error
+		chatRepositoryMock    chatRepositoryMockFunc
+		messageRepositoryMock messageRepositoryMockFunc
+		logRepositoryMock     logRepositoryMockFunc
+	}{
+		{
+			name: "admin renames the chat",
+			ctx:  adminCtx,
+			info: &desc.UpdateChatInfo{
+				Title:       wrapperspb.String(title),
+				Description: wrapperspb.String(description),
+			},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(adminCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(adminCtx, membersChatID).Return(membersChat().Members, nil)
+				mock.UpdateMock.Expect(adminCtx, membersChatID, &model.UpdateChatData{Title: &title, Description: &description}).Return(nil)
+				mock.UpdateLastMessageMock.Expect(adminCtx, membersChatID, 1).Return(nil)
+				return mock
+			},
+			messageRepositoryMock: func(mc *minimock.Controller) *mocks.MessageRepositoryMock {
+				mock := mocks.NewMessageRepositoryMock(mc)
+				mock.CreateMock.Expect(adminCtx, systemMessage(`admin renamed the chat to "release"`)).Return(&model.Message{ID: 1}, nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(adminCtx, &logModel.Log{Action: "chat_updated", EntityID: membersChatID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "avatar change has no system message",
+			ctx:  ownerCtx,
+			info: &desc.UpdateChatInfo{AvatarUrl: wrapperspb.String(avatarURL)},
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(ownerCtx, membersChatID).Return(membersChat().Members, nil)
+				mock.UpdateMock.Expect(ownerCtx, membersChatID, &model.UpdateChatData{AvatarURL: &avatarURL}).Return(nil)
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) *mocks.LogRepositoryMock {
+				mock := mocks.NewLogRepositoryMock(mc)
+				mock.LogMock.Expect(ownerCtx, &logModel.Log{Action: "chat_updated", EntityID: membersChatID}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "member can't update",
+			ctx:  memberCtx,
+			info: &desc.UpdateChatInfo{Title: wrapperspb.String(title)},
+			code: codes.PermissionDenied,
+			err:  errors.New("only the owner and admins can update the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(memberCtx, membersChatID).Return(membersChat().Members, nil)
+				return mock
+			},
+		},
+		{
+			name: "outsider",
+			ctx:  outsiderCtx,
+			info: &desc.UpdateChatInfo{Title: wrapperspb.String(title)},
+			code: codes.PermissionDenied,
+			err:  errors.New("user is not a member of the chat"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(outsiderCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(outsiderCtx, membersChatID).Return(membersChat().Members, nil)
+				return mock
+			},
+		},
+		{
+			name: "direct chat",
+			ctx:  memberCtx,
+			info: &desc.UpdateChatInfo{Title: wrapperspb.String(title)},
+			code: codes.FailedPrecondition,
+			err:  errors.New("direct chats have no title, description or avatar"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(memberCtx, membersChatID).Return(directChat(), nil)
+				mock.LockMembersMock.Expect(memberCtx, membersChatID).Return(directChat().Members, nil)
+				return mock
+			},
+		},
+		{
+			name: "title too long",
+			ctx:  ownerCtx,
+			info: &desc.UpdateChatInfo{Title: wrapperspb.String(strings.Repeat("a", 101))},
+			code: codes.InvalidArgument,
+			err:  errors.New("title is longer than 100 characters"),
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(ownerCtx, membersChatID).Return(membersChat().Members, nil)
+				return mock
+			},
+		},
+		{
+			name: "nothing to update",
+			ctx:  ownerCtx,
+			info: &desc.UpdateChatInfo{},
+			code: codes.InvalidArgument,
+			err:  errors.New("nothing to update"),
+		},
+		{
+			name: "repository error",
+			ctx:  ownerCtx,
+			info: &desc.UpdateChatInfo{AvatarUrl: wrapperspb.String(avatarURL)},
+			code: codes.Internal,
+			err:  repoErr,
+			chatRepositoryMock: func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.GetMock.Expect(ownerCtx, membersChatID).Return(membersChat(), nil)
+				mock.LockMembersMock.Expect(ownerCtx, membersChatID).Return(membersChat().Members, nil)
+				mock.UpdateMock.Return(repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := chat.NewImplementation(newMembersService(mc, tt.chatRepositoryMock, tt.messageRepositoryMock, tt.logRepositoryMock, nil))
+
+			_, err := api.UpdateChat(tt.ctx, &desc.UpdateChatRequest{ChatId: membersChatID, Info: tt.info})
+			requireStatus(t, tt.code, tt.err, err)
+		})
+	}
+}
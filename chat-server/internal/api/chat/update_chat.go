@@ -0,0 +1,18 @@
+package chat
+
+import (
+	"chat-server/internal/converter"
+	desc "chat-server/pkg/chat_server_v1"
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) UpdateChat(ctx context.Context, req *desc.UpdateChatRequest) (*emptypb.Empty, error) {
+	err := i.chatService.UpdateChat(ctx, req.GetChatId(), converter.ToChatUpdateFromDesc(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
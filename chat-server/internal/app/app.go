@@ -11,6 +11,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -39,6 +40,30 @@ func (a *App) Run() error {
 	return a.runGRPCServer()
 }
 
+// ResolveLegacyMembers runs the one-off lookup of legacy chat members in auth
+// instead of serving. Auth only answers authenticated callers, so the lookup
+// is made on behalf of the owner of accessToken.
+func (a *App) ResolveLegacyMembers(ctx context.Context, accessToken string) error {
+	defer func() {
+		closer.CloseAll()
+		closer.Wait()
+	}()
+
+	// The user client forwards the token of the incoming call.
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken))
+
+	_, unresolved, err := a.serviceProvider.LegacyMembersService(ctx).Resolve(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range unresolved {
+		log.Printf("legacy chat member %q is unknown to auth or ambiguous, left unresolved", name)
+	}
+
+	return nil
+}
+
 func (a *App) initDeps(ctx context.Context, configPath string) error {
 	inits := []func(context.Context) error{
 		func(ctx context.Context) error {
@@ -73,7 +98,11 @@ func (a *App) initServiceProvider(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.UnaryInterceptor(a.serviceProvider.AuthInterceptor().Unary),
+		grpc.StreamInterceptor(a.serviceProvider.AuthInterceptor().Stream),
+	)
 
 	reflection.Register(a.grpcServer)
 
@@ -2,12 +2,23 @@ package app
 
 import (
 	"chat-server/internal/api/chat"
+	"chat-server/internal/client/rpc"
+	authClient "chat-server/internal/client/rpc/auth"
 	"chat-server/internal/config"
+	"chat-server/internal/interceptor"
 	"chat-server/internal/repository"
 	chatRepository "chat-server/internal/repository/chat"
+	messageRepository "chat-server/internal/repository/message"
+	presenceRepository "chat-server/internal/repository/presence"
 	"chat-server/internal/service"
 	chatService "chat-server/internal/service/chat"
+	legacyMembersService "chat-server/internal/service/legacymembers"
+	"chat-server/internal/storage"
+	localStorage "chat-server/internal/storage/local"
+	accessDesc "chat-server/pkg/access_v1"
+	userDesc "chat-server/pkg/user_v1"
 	"context"
+	"crypto/tls"
 	"log"
 
 	"github.com/makxtr/go-common/pkg/closer"
@@ -15,21 +26,37 @@ import (
 	"github.com/makxtr/go-common/pkg/db/pg"
 	"github.com/makxtr/go-common/pkg/db/transaction"
 	"github.com/makxtr/go-common/pkg/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type serviceProvider struct {
 	pgConfig   config.PGConfig
 	grpcConfig config.GRPCConfig
+	authConfig config.AuthConfig
+	blobConfig config.BlobConfig
 
 	dbClient  db.Client
 	txManager db.TxManager
 
-	chatRepository repository.ChatRepository
-	logRepository  repository.LogRepository
+	chatRepository     repository.ChatRepository
+	messageRepository  repository.MessageRepository
+	presenceRepository repository.PresenceRepository
+	logRepository      repository.LogRepository
+
+	authConn     *grpc.ClientConn
+	accessClient rpc.AccessClient
+	userClient   rpc.UserClient
 
-	chatService service.ChatService
+	blobStore storage.BlobStore
 
-	chatImpl *chat.Implementation
+	chatService          service.ChatService
+	legacyMembersService service.LegacyMembersService
+
+	chatImpl        *chat.Implementation
+	authInterceptor *interceptor.AuthInterceptor
 }
 
 func newServiceProvider() *serviceProvider {
@@ -62,6 +89,32 @@ func (s *serviceProvider) GRPCConfig() config.GRPCConfig {
 	return s.grpcConfig
 }
 
+func (s *serviceProvider) AuthConfig() config.AuthConfig {
+	if s.authConfig == nil {
+		cfg, err := config.NewAuthConfig()
+		if err != nil {
+			log.Fatalf("failed to get auth config: %s", err.Error())
+		}
+
+		s.authConfig = cfg
+	}
+
+	return s.authConfig
+}
+
+func (s *serviceProvider) BlobConfig() config.BlobConfig {
+	if s.blobConfig == nil {
+		cfg, err := config.NewBlobConfig()
+		if err != nil {
+			log.Fatalf("failed to get blob config: %s", err.Error())
+		}
+
+		s.blobConfig = cfg
+	}
+
+	return s.blobConfig
+}
+
 func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.dbClient == nil {
 		cl, err := pg.New(ctx, s.PGConfig().DSN())
@@ -98,6 +151,22 @@ func (s *serviceProvider) ChatRepository(ctx context.Context) repository.ChatRep
 	return s.chatRepository
 }
 
+func (s *serviceProvider) MessageRepository(ctx context.Context) repository.MessageRepository {
+	if s.messageRepository == nil {
+		s.messageRepository = messageRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.messageRepository
+}
+
+func (s *serviceProvider) PresenceRepository(ctx context.Context) repository.PresenceRepository {
+	if s.presenceRepository == nil {
+		s.presenceRepository = presenceRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.presenceRepository
+}
+
 func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepository {
 	if s.logRepository == nil {
 		s.logRepository = logger.NewRepository(s.DBClient(ctx), "chat_logs")
@@ -106,18 +175,83 @@ func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepos
 	return s.logRepository
 }
 
+func (s *serviceProvider) AuthConn() *grpc.ClientConn {
+	if s.authConn == nil {
+		creds := insecure.NewCredentials()
+		if s.AuthConfig().TLS() {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+
+		conn, err := grpc.NewClient(s.AuthConfig().Address(), grpc.WithTransportCredentials(creds))
+		if err != nil {
+			log.Fatalf("failed to connect to auth: %s", err.Error())
+		}
+
+		closer.Add(conn.Close)
+
+		s.authConn = conn
+	}
+
+	return s.authConn
+}
+
+func (s *serviceProvider) AccessClient() rpc.AccessClient {
+	if s.accessClient == nil {
+		s.accessClient = authClient.NewAccessClient(accessDesc.NewAccessV1Client(s.AuthConn()))
+	}
+
+	return s.accessClient
+}
+
+func (s *serviceProvider) UserClient() rpc.UserClient {
+	if s.userClient == nil {
+		s.userClient = authClient.NewUserClient(userDesc.NewUserV1Client(s.AuthConn()))
+	}
+
+	return s.userClient
+}
+
+func (s *serviceProvider) BlobStore() storage.BlobStore {
+	if s.blobStore == nil {
+		store, err := localStorage.NewBlobStore(s.BlobConfig().Dir())
+		if err != nil {
+			log.Fatalf("failed to create blob store: %s", err.Error())
+		}
+
+		s.blobStore = store
+	}
+
+	return s.blobStore
+}
+
 func (s *serviceProvider) ChatService(ctx context.Context) service.ChatService {
 	if s.chatService == nil {
 		s.chatService = chatService.NewService(
 			s.ChatRepository(ctx),
+			s.MessageRepository(ctx),
+			s.PresenceRepository(ctx),
 			s.LogRepository(ctx),
 			s.TxManager(ctx),
+			s.UserClient(),
+			s.BlobStore(),
 		)
 	}
 
 	return s.chatService
 }
 
+func (s *serviceProvider) LegacyMembersService(ctx context.Context) service.LegacyMembersService {
+	if s.legacyMembersService == nil {
+		s.legacyMembersService = legacyMembersService.NewService(
+			s.ChatRepository(ctx),
+			s.TxManager(ctx),
+			s.UserClient(),
+		)
+	}
+
+	return s.legacyMembersService
+}
+
 func (s *serviceProvider) ChatImpl(ctx context.Context) *chat.Implementation {
 	if s.chatImpl == nil {
 		s.chatImpl = chat.NewImplementation(s.ChatService(ctx))
@@ -125,3 +259,11 @@ func (s *serviceProvider) ChatImpl(ctx context.Context) *chat.Implementation {
 
 	return s.chatImpl
 }
+
+func (s *serviceProvider) AuthInterceptor() *interceptor.AuthInterceptor {
+	if s.authInterceptor == nil {
+		s.authInterceptor = interceptor.NewAuthInterceptor(s.AccessClient())
+	}
+
+	return s.authInterceptor
+}
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"chat-server/internal/client/rpc"
+	"chat-server/internal/model"
+	desc "chat-server/pkg/access_v1"
+	"context"
+)
+
+type accessClient struct {
+	client desc.AccessV1Client
+}
+
+func NewAccessClient(client desc.AccessV1Client) rpc.AccessClient {
+	return &accessClient{client: client}
+}
+
+// Check asks auth whether the caller may invoke the endpoint. The caller's
+// bearer token is taken from the incoming metadata and forwarded as is.
+func (c *accessClient) Check(ctx context.Context, endpoint string) (*model.User, error) {
+	res, err := c.client.Check(forwardAuthorization(ctx), &desc.CheckRequest{EndpointAddress: endpoint})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.User{
+		ID:       res.GetUserId(),
+		Username: res.GetUsername(),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const authorizationHeader = "authorization"
+
+// forwardAuthorization passes the caller's bearer token from the incoming
+// metadata on to auth, so that auth sees the original caller.
+func forwardAuthorization(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return metadata.NewOutgoingContext(ctx, metadata.MD{
+		authorizationHeader: md.Get(authorizationHeader),
+	})
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"chat-server/internal/client/rpc"
+	"chat-server/internal/model"
+	desc "chat-server/pkg/user_v1"
+	"context"
+)
+
+type userClient struct {
+	client desc.UserV1Client
+}
+
+func NewUserClient(client desc.UserV1Client) rpc.UserClient {
+	return &userClient{client: client}
+}
+
+// GetByNames resolves usernames in one call, names auth does not know are
+// left out of the result.
+func (c *userClient) GetByNames(ctx context.Context, names []string) ([]*model.User, error) {
+	res, err := c.client.GetUsersByNames(forwardAuthorization(ctx), &desc.GetUsersByNamesRequest{Names: names})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*model.User, 0, len(res.GetUsers()))
+	for _, user := range res.GetUsers() {
+		users = append(users, &model.User{
+			ID:       user.GetId(),
+			Username: user.GetName(),
+		})
+	}
+
+	return users, nil
+}
@@ -0,0 +1,4 @@
+package rpc
+
+//go:generate minimock -i AccessClient -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i UserClient -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat-server/internal/client/rpc.AccessClient -o access_client_minimock.go -n AccessClientMock -p mocks
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AccessClientMock implements mm_rpc.AccessClient
+type AccessClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheck          func(ctx context.Context, endpoint string) (up1 *model.User, err error)
+	funcCheckOrigin    string
+	inspectFuncCheck   func(ctx context.Context, endpoint string)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mAccessClientMockCheck
+}
+
+// NewAccessClientMock returns a mock for mm_rpc.AccessClient
+func NewAccessClientMock(t minimock.Tester) *AccessClientMock {
+	m := &AccessClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckMock = mAccessClientMockCheck{mock: m}
+	m.CheckMock.callArgs = []*AccessClientMockCheckParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessClientMockCheck struct {
+	optional           bool
+	mock               *AccessClientMock
+	defaultExpectation *AccessClientMockCheckExpectation
+	expectations       []*AccessClientMockCheckExpectation
+
+	callArgs []*AccessClientMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccessClientMockCheckExpectation specifies expectation struct of the AccessClient.Check
+type AccessClientMockCheckExpectation struct {
+	mock               *AccessClientMock
+	params             *AccessClientMockCheckParams
+	paramPtrs          *AccessClientMockCheckParamPtrs
+	expectationOrigins AccessClientMockCheckExpectationOrigins
+	results            *AccessClientMockCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccessClientMockCheckParams contains parameters of the AccessClient.Check
+type AccessClientMockCheckParams struct {
+	ctx      context.Context
+	endpoint string
+}
+
+// AccessClientMockCheckParamPtrs contains pointers to parameters of the AccessClient.Check
+type AccessClientMockCheckParamPtrs struct {
+	ctx      *context.Context
+	endpoint *string
+}
+
+// AccessClientMockCheckResults contains results of the AccessClient.Check
+type AccessClientMockCheckResults struct {
+	up1 *model.User
+	err error
+}
+
+// AccessClientMockCheckOrigins contains origins of expectations of the AccessClient.Check
+type AccessClientMockCheckExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originEndpoint string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mAccessClientMockCheck) Optional() *mAccessClientMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for AccessClient.Check
+func (mmCheck *mAccessClientMockCheck) Expect(ctx context.Context, endpoint string) *mAccessClientMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessClientMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessClientMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("AccessClientMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &AccessClientMockCheckParams{ctx, endpoint}
+	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessClient.Check
+func (mmCheck *mAccessClientMockCheck) ExpectCtxParam1(ctx context.Context) *mAccessClientMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessClientMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessClientMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessClientMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessClientMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// ExpectEndpointParam2 sets up expected param endpoint for AccessClient.Check
+func (mmCheck *mAccessClientMockCheck) ExpectEndpointParam2(endpoint string) *mAccessClientMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessClientMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessClientMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("AccessClientMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &AccessClientMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.endpoint = &endpoint
+	mmCheck.defaultExpectation.expectationOrigins.originEndpoint = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessClient.Check
+func (mmCheck *mAccessClientMockCheck) Inspect(f func(ctx context.Context, endpoint string)) *mAccessClientMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for AccessClientMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by AccessClient.Check
+func (mmCheck *mAccessClientMockCheck) Return(up1 *model.User, err error) *AccessClientMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessClientMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &AccessClientMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &AccessClientMockCheckResults{up1, err}
+	mmCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the AccessClient.Check method
+func (mmCheck *mAccessClientMockCheck) Set(f func(ctx context.Context, endpoint string) (up1 *model.User, err error)) *AccessClientMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the AccessClient.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the AccessClient.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	mmCheck.mock.funcCheckOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// When sets expectation for the AccessClient.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mAccessClientMockCheck) When(ctx context.Context, endpoint string) *AccessClientMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("AccessClientMock.Check mock is already set by Set")
+	}
+
+	expectation := &AccessClientMockCheckExpectation{
+		mock:               mmCheck.mock,
+		params:             &AccessClientMockCheckParams{ctx, endpoint},
+		expectationOrigins: AccessClientMockCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessClient.Check return parameters for the expectation previously defined by the When method
+func (e *AccessClientMockCheckExpectation) Then(up1 *model.User, err error) *AccessClientMock {
+	e.results = &AccessClientMockCheckResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times AccessClient.Check should be invoked
+func (mmCheck *mAccessClientMockCheck) Times(n uint64) *mAccessClientMockCheck {
+	if n == 0 {
+		mmCheck.mock.t.Fatalf("Times of AccessClientMock.Check mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheck.expectedInvocations, n)
+	mmCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheck
+}
+
+func (mmCheck *mAccessClientMockCheck) invocationsDone() bool {
+	if len(mmCheck.expectations) == 0 && mmCheck.defaultExpectation == nil && mmCheck.mock.funcCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheck.mock.afterCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Check implements mm_rpc.AccessClient
+func (mmCheck *AccessClientMock) Check(ctx context.Context, endpoint string) (up1 *model.User, err error) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	mmCheck.t.Helper()
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx, endpoint)
+	}
+
+	mm_params := AccessClientMockCheckParams{ctx, endpoint}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessClientMockCheckParams{ctx, endpoint}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("AccessClientMock.Check got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.endpoint != nil && !minimock.Equal(*mm_want_ptrs.endpoint, mm_got.endpoint) {
+				mmCheck.t.Errorf("AccessClientMock.Check got unexpected parameter endpoint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originEndpoint, *mm_want_ptrs.endpoint, mm_got.endpoint, minimock.Diff(*mm_want_ptrs.endpoint, mm_got.endpoint))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("AccessClientMock.Check got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheck.CheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the AccessClientMock.Check")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx, endpoint)
+	}
+	mmCheck.t.Fatalf("Unexpected call to AccessClientMock.Check. %v %v", ctx, endpoint)
+	return
+}
+
+// CheckAfterCounter returns a count of finished AccessClientMock.Check invocations
+func (mmCheck *AccessClientMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of AccessClientMock.Check invocations
+func (mmCheck *AccessClientMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessClientMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mAccessClientMockCheck) Calls() []*AccessClientMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*AccessClientMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *AccessClientMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *AccessClientMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessClientMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccessClientMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccessClientMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Errorf("Expected call to AccessClientMock.Check at\n%s", m.funcCheckOrigin)
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessClientMock.Check at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckDone()
+}
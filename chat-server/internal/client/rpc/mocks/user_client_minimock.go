@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat-server/internal/client/rpc.UserClient -o user_client_minimock.go -n UserClientMock -p mocks
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UserClientMock implements mm_rpc.UserClient
+type UserClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetByNames          func(ctx context.Context, names []string) (upa1 []*model.User, err error)
+	funcGetByNamesOrigin    string
+	inspectFuncGetByNames   func(ctx context.Context, names []string)
+	afterGetByNamesCounter  uint64
+	beforeGetByNamesCounter uint64
+	GetByNamesMock          mUserClientMockGetByNames
+}
+
+// NewUserClientMock returns a mock for mm_rpc.UserClient
+func NewUserClientMock(t minimock.Tester) *UserClientMock {
+	m := &UserClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetByNamesMock = mUserClientMockGetByNames{mock: m}
+	m.GetByNamesMock.callArgs = []*UserClientMockGetByNamesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserClientMockGetByNames struct {
+	optional           bool
+	mock               *UserClientMock
+	defaultExpectation *UserClientMockGetByNamesExpectation
+	expectations       []*UserClientMockGetByNamesExpectation
+
+	callArgs []*UserClientMockGetByNamesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserClientMockGetByNamesExpectation specifies expectation struct of the UserClient.GetByNames
+type UserClientMockGetByNamesExpectation struct {
+	mock               *UserClientMock
+	params             *UserClientMockGetByNamesParams
+	paramPtrs          *UserClientMockGetByNamesParamPtrs
+	expectationOrigins UserClientMockGetByNamesExpectationOrigins
+	results            *UserClientMockGetByNamesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserClientMockGetByNamesParams contains parameters of the UserClient.GetByNames
+type UserClientMockGetByNamesParams struct {
+	ctx   context.Context
+	names []string
+}
+
+// UserClientMockGetByNamesParamPtrs contains pointers to parameters of the UserClient.GetByNames
+type UserClientMockGetByNamesParamPtrs struct {
+	ctx   *context.Context
+	names *[]string
+}
+
+// UserClientMockGetByNamesResults contains results of the UserClient.GetByNames
+type UserClientMockGetByNamesResults struct {
+	upa1 []*model.User
+	err  error
+}
+
+// UserClientMockGetByNamesOrigins contains origins of expectations of the UserClient.GetByNames
+type UserClientMockGetByNamesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originNames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetByNames *mUserClientMockGetByNames) Optional() *mUserClientMockGetByNames {
+	mmGetByNames.optional = true
+	return mmGetByNames
+}
+
+// Expect sets up expected params for UserClient.GetByNames
+func (mmGetByNames *mUserClientMockGetByNames) Expect(ctx context.Context, names []string) *mUserClientMockGetByNames {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserClientMock.GetByNames mock is already set by Set")
+	}
+
+	if mmGetByNames.defaultExpectation == nil {
+		mmGetByNames.defaultExpectation = &UserClientMockGetByNamesExpectation{}
+	}
+
+	if mmGetByNames.defaultExpectation.paramPtrs != nil {
+		mmGetByNames.mock.t.Fatalf("UserClientMock.GetByNames mock is already set by ExpectParams functions")
+	}
+
+	mmGetByNames.defaultExpectation.params = &UserClientMockGetByNamesParams{ctx, names}
+	mmGetByNames.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetByNames.expectations {
+		if minimock.Equal(e.params, mmGetByNames.defaultExpectation.params) {
+			mmGetByNames.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByNames.defaultExpectation.params)
+		}
+	}
+
+	return mmGetByNames
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserClient.GetByNames
+func (mmGetByNames *mUserClientMockGetByNames) ExpectCtxParam1(ctx context.Context) *mUserClientMockGetByNames {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserClientMock.GetByNames mock is already set by Set")
+	}
+
+	if mmGetByNames.defaultExpectation == nil {
+		mmGetByNames.defaultExpectation = &UserClientMockGetByNamesExpectation{}
+	}
+
+	if mmGetByNames.defaultExpectation.params != nil {
+		mmGetByNames.mock.t.Fatalf("UserClientMock.GetByNames mock is already set by Expect")
+	}
+
+	if mmGetByNames.defaultExpectation.paramPtrs == nil {
+		mmGetByNames.defaultExpectation.paramPtrs = &UserClientMockGetByNamesParamPtrs{}
+	}
+	mmGetByNames.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetByNames.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetByNames
+}
+
+// ExpectNamesParam2 sets up expected param names for UserClient.GetByNames
+func (mmGetByNames *mUserClientMockGetByNames) ExpectNamesParam2(names []string) *mUserClientMockGetByNames {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserClientMock.GetByNames mock is already set by Set")
+	}
+
+	if mmGetByNames.defaultExpectation == nil {
+		mmGetByNames.defaultExpectation = &UserClientMockGetByNamesExpectation{}
+	}
+
+	if mmGetByNames.defaultExpectation.params != nil {
+		mmGetByNames.mock.t.Fatalf("UserClientMock.GetByNames mock is already set by Expect")
+	}
+
+	if mmGetByNames.defaultExpectation.paramPtrs == nil {
+		mmGetByNames.defaultExpectation.paramPtrs = &UserClientMockGetByNamesParamPtrs{}
+	}
+	mmGetByNames.defaultExpectation.paramPtrs.names = &names
+	mmGetByNames.defaultExpectation.expectationOrigins.originNames = minimock.CallerInfo(1)
+
+	return mmGetByNames
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserClient.GetByNames
+func (mmGetByNames *mUserClientMockGetByNames) Inspect(f func(ctx context.Context, names []string)) *mUserClientMockGetByNames {
+	if mmGetByNames.mock.inspectFuncGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("Inspect function is already set for UserClientMock.GetByNames")
+	}
+
+	mmGetByNames.mock.inspectFuncGetByNames = f
+
+	return mmGetByNames
+}
+
+// Return sets up results that will be returned by UserClient.GetByNames
+func (mmGetByNames *mUserClientMockGetByNames) Return(upa1 []*model.User, err error) *UserClientMock {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserClientMock.GetByNames mock is already set by Set")
+	}
+
+	if mmGetByNames.defaultExpectation == nil {
+		mmGetByNames.defaultExpectation = &UserClientMockGetByNamesExpectation{mock: mmGetByNames.mock}
+	}
+	mmGetByNames.defaultExpectation.results = &UserClientMockGetByNamesResults{upa1, err}
+	mmGetByNames.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetByNames.mock
+}
+
+// Set uses given function f to mock the UserClient.GetByNames method
+func (mmGetByNames *mUserClientMockGetByNames) Set(f func(ctx context.Context, names []string) (upa1 []*model.User, err error)) *UserClientMock {
+	if mmGetByNames.defaultExpectation != nil {
+		mmGetByNames.mock.t.Fatalf("Default expectation is already set for the UserClient.GetByNames method")
+	}
+
+	if len(mmGetByNames.expectations) > 0 {
+		mmGetByNames.mock.t.Fatalf("Some expectations are already set for the UserClient.GetByNames method")
+	}
+
+	mmGetByNames.mock.funcGetByNames = f
+	mmGetByNames.mock.funcGetByNamesOrigin = minimock.CallerInfo(1)
+	return mmGetByNames.mock
+}
+
+// When sets expectation for the UserClient.GetByNames which will trigger the result defined by the following
+// Then helper
+func (mmGetByNames *mUserClientMockGetByNames) When(ctx context.Context, names []string) *UserClientMockGetByNamesExpectation {
+	if mmGetByNames.mock.funcGetByNames != nil {
+		mmGetByNames.mock.t.Fatalf("UserClientMock.GetByNames mock is already set by Set")
+	}
+
+	expectation := &UserClientMockGetByNamesExpectation{
+		mock:               mmGetByNames.mock,
+		params:             &UserClientMockGetByNamesParams{ctx, names},
+		expectationOrigins: UserClientMockGetByNamesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetByNames.expectations = append(mmGetByNames.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserClient.GetByNames return parameters for the expectation previously defined by the When method
+func (e *UserClientMockGetByNamesExpectation) Then(upa1 []*model.User, err error) *UserClientMock {
+	e.results = &UserClientMockGetByNamesResults{upa1, err}
+	return e.mock
+}
+
+// Times sets number of times UserClient.GetByNames should be invoked
+func (mmGetByNames *mUserClientMockGetByNames) Times(n uint64) *mUserClientMockGetByNames {
+	if n == 0 {
+		mmGetByNames.mock.t.Fatalf("Times of UserClientMock.GetByNames mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByNames.expectedInvocations, n)
+	mmGetByNames.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetByNames
+}
+
+func (mmGetByNames *mUserClientMockGetByNames) invocationsDone() bool {
+	if len(mmGetByNames.expectations) == 0 && mmGetByNames.defaultExpectation == nil && mmGetByNames.mock.funcGetByNames == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByNames.mock.afterGetByNamesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByNames.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByNames implements mm_rpc.UserClient
+func (mmGetByNames *UserClientMock) GetByNames(ctx context.Context, names []string) (upa1 []*model.User, err error) {
+	mm_atomic.AddUint64(&mmGetByNames.beforeGetByNamesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByNames.afterGetByNamesCounter, 1)
+
+	mmGetByNames.t.Helper()
+
+	if mmGetByNames.inspectFuncGetByNames != nil {
+		mmGetByNames.inspectFuncGetByNames(ctx, names)
+	}
+
+	mm_params := UserClientMockGetByNamesParams{ctx, names}
+
+	// Record call args
+	mmGetByNames.GetByNamesMock.mutex.Lock()
+	mmGetByNames.GetByNamesMock.callArgs = append(mmGetByNames.GetByNamesMock.callArgs, &mm_params)
+	mmGetByNames.GetByNamesMock.mutex.Unlock()
+
+	for _, e := range mmGetByNames.GetByNamesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.upa1, e.results.err
+		}
+	}
+
+	if mmGetByNames.GetByNamesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByNames.GetByNamesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByNames.GetByNamesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByNames.GetByNamesMock.defaultExpectation.paramPtrs
+
+		mm_got := UserClientMockGetByNamesParams{ctx, names}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByNames.t.Errorf("UserClientMock.GetByNames got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByNames.GetByNamesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.names != nil && !minimock.Equal(*mm_want_ptrs.names, mm_got.names) {
+				mmGetByNames.t.Errorf("UserClientMock.GetByNames got unexpected parameter names, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByNames.GetByNamesMock.defaultExpectation.expectationOrigins.originNames, *mm_want_ptrs.names, mm_got.names, minimock.Diff(*mm_want_ptrs.names, mm_got.names))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByNames.t.Errorf("UserClientMock.GetByNames got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetByNames.GetByNamesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByNames.GetByNamesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByNames.t.Fatal("No results are set for the UserClientMock.GetByNames")
+		}
+		return (*mm_results).upa1, (*mm_results).err
+	}
+	if mmGetByNames.funcGetByNames != nil {
+		return mmGetByNames.funcGetByNames(ctx, names)
+	}
+	mmGetByNames.t.Fatalf("Unexpected call to UserClientMock.GetByNames. %v %v", ctx, names)
+	return
+}
+
+// GetByNamesAfterCounter returns a count of finished UserClientMock.GetByNames invocations
+func (mmGetByNames *UserClientMock) GetByNamesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByNames.afterGetByNamesCounter)
+}
+
+// GetByNamesBeforeCounter returns a count of UserClientMock.GetByNames invocations
+func (mmGetByNames *UserClientMock) GetByNamesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByNames.beforeGetByNamesCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserClientMock.GetByNames.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByNames *mUserClientMockGetByNames) Calls() []*UserClientMockGetByNamesParams {
+	mmGetByNames.mutex.RLock()
+
+	argCopy := make([]*UserClientMockGetByNamesParams, len(mmGetByNames.callArgs))
+	copy(argCopy, mmGetByNames.callArgs)
+
+	mmGetByNames.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByNamesDone returns true if the count of the GetByNames invocations corresponds
+// the number of defined expectations
+func (m *UserClientMock) MinimockGetByNamesDone() bool {
+	if m.GetByNamesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByNamesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByNamesMock.invocationsDone()
+}
+
+// MinimockGetByNamesInspect logs each unmet expectation
+func (m *UserClientMock) MinimockGetByNamesInspect() {
+	for _, e := range m.GetByNamesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserClientMock.GetByNames at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetByNamesCounter := mm_atomic.LoadUint64(&m.afterGetByNamesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByNamesMock.defaultExpectation != nil && afterGetByNamesCounter < 1 {
+		if m.GetByNamesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserClientMock.GetByNames at\n%s", m.GetByNamesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserClientMock.GetByNames at\n%s with params: %#v", m.GetByNamesMock.defaultExpectation.expectationOrigins.origin, *m.GetByNamesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByNames != nil && afterGetByNamesCounter < 1 {
+		m.t.Errorf("Expected call to UserClientMock.GetByNames at\n%s", m.funcGetByNamesOrigin)
+	}
+
+	if !m.GetByNamesMock.invocationsDone() && afterGetByNamesCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserClientMock.GetByNames at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByNamesMock.expectedInvocations), m.GetByNamesMock.expectedInvocationsOrigin, afterGetByNamesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetByNamesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetByNamesDone()
+}
@@ -0,0 +1,14 @@
+package rpc
+
+import (
+	"chat-server/internal/model"
+	"context"
+)
+
+type AccessClient interface {
+	Check(ctx context.Context, endpoint string) (*model.User, error)
+}
+
+type UserClient interface {
+	GetByNames(ctx context.Context, names []string) ([]*model.User, error)
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	authAddressEnvName = "AUTH_GRPC_ADDRESS"
+	authTLSEnvName     = "AUTH_GRPC_TLS"
+)
+
+type AuthConfig interface {
+	Address() string
+	TLS() bool
+}
+
+type authConfig struct {
+	address string
+	tls     bool
+}
+
+func NewAuthConfig() (AuthConfig, error) {
+	address := os.Getenv(authAddressEnvName)
+	if len(address) == 0 {
+		return nil, errors.New("auth grpc address not found")
+	}
+
+	var useTLS bool
+	if value := os.Getenv(authTLSEnvName); len(value) > 0 {
+		var err error
+		useTLS, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", authTLSEnvName)
+		}
+	}
+
+	return &authConfig{
+		address: address,
+		tls:     useTLS,
+	}, nil
+}
+
+func (cfg *authConfig) Address() string {
+	return cfg.address
+}
+
+// TLS reports whether the connection to auth must be encrypted, Cloud Run only accepts TLS.
+func (cfg *authConfig) TLS() bool {
+	return cfg.tls
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"errors"
+	"os"
+)
+
+const (
+	blobDirEnvName = "BLOB_DIR"
+)
+
+type BlobConfig interface {
+	Dir() string
+}
+
+type blobConfig struct {
+	dir string
+}
+
+func NewBlobConfig() (BlobConfig, error) {
+	dir := os.Getenv(blobDirEnvName)
+	if len(dir) == 0 {
+		return nil, errors.New("blob dir not found")
+	}
+
+	return &blobConfig{
+		dir: dir,
+	}, nil
+}
+
+// Dir is where the local blob store keeps the attachments.
+func (cfg *blobConfig) Dir() string {
+	return cfg.dir
+}
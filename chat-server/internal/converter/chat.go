@@ -7,24 +7,307 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ToChatFromDesc only fills member usernames, the service resolves them to users.
 func ToChatFromDesc(req *desc.CreateRequest) *model.Chat {
+	members := make([]*model.ChatMember, 0, len(req.GetUsernames()))
+	for _, username := range req.GetUsernames() {
+		members = append(members, &model.ChatMember{Username: username})
+	}
+
 	return &model.Chat{
-		Usernames: req.GetUsernames(),
+		Type:        model.ChatType(req.GetType()),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		AvatarURL:   req.GetAvatarUrl(),
+		Members:     members,
+	}
+}
+
+func ToChatUpdateFromDesc(req *desc.UpdateChatRequest) *model.UpdateChatData {
+	var title, description, avatarURL *string
+	if req.GetInfo().GetTitle() != nil {
+		val := req.GetInfo().GetTitle().GetValue()
+		title = &val
+	}
+	if req.GetInfo().GetDescription() != nil {
+		val := req.GetInfo().GetDescription().GetValue()
+		description = &val
+	}
+	if req.GetInfo().GetAvatarUrl() != nil {
+		val := req.GetInfo().GetAvatarUrl().GetValue()
+		avatarURL = &val
+	}
+
+	return &model.UpdateChatData{
+		Title:       title,
+		Description: description,
+		AvatarURL:   avatarURL,
 	}
 }
 
 func ToMessageFromDesc(req *desc.SendMessageRequest) *model.Message {
 	return &model.Message{
-		From:      req.GetMessage().GetFrom(),
-		Text:      req.GetMessage().GetText(),
-		Timestamp: req.GetMessage().GetTimestamp().AsTime(),
+		ChatID:           req.GetChatId(),
+		From:             req.GetMessage().GetFrom(),
+		Text:             req.GetMessage().GetText(),
+		ReplyToMessageID: req.GetMessage().GetReplyToMessageId(),
+		AttachmentIDs:    req.GetAttachmentIds(),
 	}
 }
 
 func ToDescFromMessage(message *model.Message) *desc.Message {
-	return &desc.Message{
+	res := &desc.Message{
+		Id:        message.ID,
+		ChatId:    message.ChatID,
 		From:      message.From,
 		Text:      message.Text,
 		Timestamp: timestamppb.New(message.Timestamp),
+		System:    message.System,
+
+		ReplyToMessageId: message.ReplyToMessageID,
+		ThreadRootId:     message.ThreadRootID,
+		ReplyCount:       message.ReplyCount,
+		Reactions:        ToDescFromReactions(message.Reactions),
+		Attachments:      ToDescFromAttachments(message.Attachments),
+	}
+	if message.EditedAt.Valid {
+		res.EditedAt = timestamppb.New(message.EditedAt.Time)
+	}
+	if message.DeletedAt.Valid {
+		res.DeletedAt = timestamppb.New(message.DeletedAt.Time)
+	}
+	if message.LastReplyAt.Valid {
+		res.LastReplyAt = timestamppb.New(message.LastReplyAt.Time)
+	}
+
+	return res
+}
+
+func ToDescFromMessages(messages []*model.Message) []*desc.Message {
+	res := make([]*desc.Message, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, ToDescFromMessage(message))
+	}
+
+	return res
+}
+
+func ToDescFromChatEvent(event *model.ChatEvent) *desc.ChatEvent {
+	switch event.Type {
+	case model.ChatEventMessageEdited:
+		return &desc.ChatEvent{Event: &desc.ChatEvent_MessageEdited{MessageEdited: ToDescFromMessage(event.Message)}}
+	case model.ChatEventMessageDeleted:
+		return &desc.ChatEvent{Event: &desc.ChatEvent_MessageDeleted{MessageDeleted: ToDescFromMessage(event.Message)}}
+	case model.ChatEventThreadUpdated:
+		return &desc.ChatEvent{Event: &desc.ChatEvent_ThreadUpdated{ThreadUpdated: ToDescFromMessage(event.Message)}}
+	case model.ChatEventReactionAdded:
+		return &desc.ChatEvent{Event: &desc.ChatEvent_ReactionAdded{ReactionAdded: toDescFromReactionChange(event)}}
+	case model.ChatEventReactionRemoved:
+		return &desc.ChatEvent{Event: &desc.ChatEvent_ReactionRemoved{ReactionRemoved: toDescFromReactionChange(event)}}
+	case model.ChatEventRead:
+		return &desc.ChatEvent{Event: &desc.ChatEvent_Read{Read: &desc.ReadEvent{
+			UserId:            event.Read.UserID,
+			Username:          event.Read.Username,
+			LastReadMessageId: event.Read.LastReadMessageID,
+		}}}
+	case model.ChatEventSignal:
+		return &desc.ChatEvent{Event: &desc.ChatEvent_Signal{Signal: &desc.SignalEvent{
+			UserId:   event.Signal.UserID,
+			Username: event.Signal.Username,
+			Type:     desc.SignalType(event.Signal.Type),
+			Active:   event.Signal.Active,
+		}}}
+	default:
+		return &desc.ChatEvent{Event: &desc.ChatEvent_Message{Message: ToDescFromMessage(event.Message)}}
 	}
 }
+
+func toDescFromReactionChange(event *model.ChatEvent) *desc.ReactionEvent {
+	return &desc.ReactionEvent{
+		MessageId:    event.Reaction.MessageID,
+		Emoji:        event.Reaction.Emoji,
+		UserId:       event.Reaction.UserID,
+		Username:     event.Reaction.Username,
+		Count:        event.Reaction.Count,
+		ThreadRootId: event.Message.ThreadRootID,
+	}
+}
+
+func ToDescFromReactions(reactions []*model.Reaction) []*desc.Reaction {
+	if len(reactions) == 0 {
+		return nil
+	}
+
+	res := make([]*desc.Reaction, 0, len(reactions))
+	for _, reaction := range reactions {
+		res = append(res, &desc.Reaction{
+			Emoji:   reaction.Emoji,
+			Count:   reaction.Count,
+			Reacted: reaction.Reacted,
+		})
+	}
+
+	return res
+}
+
+func ToDescFromChat(chat *model.Chat, lastMessage *model.Message) *desc.Chat {
+	members := make([]*desc.ChatMember, 0, len(chat.Members))
+	for _, member := range chat.Members {
+		members = append(members, &desc.ChatMember{
+			UserId:   member.UserID,
+			Username: member.Username,
+			Role:     desc.ChatRole(member.Role),
+			JoinedAt: timestamppb.New(member.JoinedAt),
+
+			LastReadMessageId: member.LastReadMessageID,
+		})
+	}
+
+	res := &desc.Chat{
+		Id:             chat.ID,
+		Type:           desc.ChatType(chat.Type),
+		Title:          chat.Title,
+		Description:    chat.Description,
+		AvatarUrl:      chat.AvatarURL,
+		CreatedAt:      timestamppb.New(chat.CreatedAt),
+		Members:        members,
+		LastActivityAt: timestamppb.New(chat.LastActivityAt),
+	}
+	if lastMessage != nil {
+		res.LastMessage = ToDescFromMessage(lastMessage)
+	}
+
+	return res
+}
+
+func ToDescFromChatSummaries(summaries []*model.ChatSummary) []*desc.ChatSummary {
+	res := make([]*desc.ChatSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		item := &desc.ChatSummary{
+			Id:             summary.Chat.ID,
+			Type:           desc.ChatType(summary.Chat.Type),
+			Title:          summary.Chat.Title,
+			AvatarUrl:      summary.Chat.AvatarURL,
+			LastActivityAt: timestamppb.New(summary.Chat.LastActivityAt),
+			UnreadCount:    summary.UnreadCount,
+
+			LastReadMessageId: summary.LastReadMessageID,
+		}
+		if summary.LastMessage != nil {
+			item.LastMessage = ToDescFromMessage(summary.LastMessage)
+		}
+
+		res = append(res, item)
+	}
+
+	return res
+}
+
+func ToDescFromReadReceipts(readers []*model.ChatMember) []*desc.ReadReceipt {
+	res := make([]*desc.ReadReceipt, 0, len(readers))
+	for _, reader := range readers {
+		receipt := &desc.ReadReceipt{
+			UserId:   reader.UserID,
+			Username: reader.Username,
+		}
+		if reader.LastReadAt.Valid {
+			receipt.ReadAt = timestamppb.New(reader.LastReadAt.Time)
+		}
+
+		res = append(res, receipt)
+	}
+
+	return res
+}
+
+func ToSignalFromDesc(signal *desc.Signal) *model.Signal {
+	return &model.Signal{
+		ChatID: signal.GetChatId(),
+		Type:   model.SignalType(signal.GetType()),
+		Stop:   signal.GetStop(),
+	}
+}
+
+func ToDescFromPresence(presence *model.Presence) *desc.Presence {
+	res := &desc.Presence{
+		UserId: presence.UserID,
+		Online: presence.Online,
+	}
+	if presence.LastSeenAt.Valid {
+		res.LastSeenAt = timestamppb.New(presence.LastSeenAt.Time)
+	}
+
+	return res
+}
+
+func ToDescFromPresenceList(presence []*model.Presence) []*desc.Presence {
+	res := make([]*desc.Presence, 0, len(presence))
+	for _, p := range presence {
+		res = append(res, ToDescFromPresence(p))
+	}
+
+	return res
+}
+
+func ToMessageSearchFromDesc(req *desc.SearchMessagesRequest) *model.MessageSearch {
+	search := &model.MessageSearch{
+		Query:  req.GetQuery(),
+		ChatID: req.GetChatId(),
+		From:   req.GetFrom(),
+	}
+	if req.GetSince() != nil {
+		search.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		search.Until = req.GetUntil().AsTime()
+	}
+
+	return search
+}
+
+func ToDescFromSearchResults(results []*model.SearchResult) []*desc.SearchResult {
+	res := make([]*desc.SearchResult, 0, len(results))
+	for _, result := range results {
+		res = append(res, &desc.SearchResult{
+			Message: ToDescFromMessage(result.Message),
+			Snippet: result.Snippet,
+		})
+	}
+
+	return res
+}
+
+func ToAttachmentFromDesc(info *desc.AttachmentInfo) *model.Attachment {
+	return &model.Attachment{
+		ChatID:   info.GetChatId(),
+		FileName: info.GetFileName(),
+		MimeType: info.GetMimeType(),
+	}
+}
+
+func ToDescFromAttachment(attachment *model.Attachment) *desc.Attachment {
+	return &desc.Attachment{
+		Id:         attachment.ID,
+		ChatId:     attachment.ChatID,
+		MessageId:  attachment.MessageID,
+		UploadedBy: attachment.UploadedBy,
+		FileName:   attachment.FileName,
+		MimeType:   attachment.MimeType,
+		Size:       attachment.Size,
+		Sha256:     attachment.SHA256,
+		CreatedAt:  timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+func ToDescFromAttachments(attachments []*model.Attachment) []*desc.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	res := make([]*desc.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		res = append(res, ToDescFromAttachment(attachment))
+	}
+
+	return res
+}
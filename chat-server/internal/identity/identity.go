@@ -0,0 +1,19 @@
+package identity
+
+import (
+	"chat-server/internal/model"
+	"context"
+)
+
+type ctxKey struct{}
+
+// WithUser stores the authenticated caller in the context.
+func WithUser(ctx context.Context, user *model.User) context.Context {
+	return context.WithValue(ctx, ctxKey{}, user)
+}
+
+// UserFromContext returns the authenticated caller put into the context by the auth interceptor.
+func UserFromContext(ctx context.Context) (*model.User, bool) {
+	user, ok := ctx.Value(ctxKey{}).(*model.User)
+	return user, ok
+}
@@ -0,0 +1,69 @@
+package interceptor
+
+import (
+	"chat-server/internal/client/rpc"
+	"chat-server/internal/identity"
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// protectedPrefix limits authentication to the chat API, so that
+// reflection and health checks keep working without a token.
+const protectedPrefix = "/chat_server_v1.ChatServerV1/"
+
+type AuthInterceptor struct {
+	accessClient rpc.AccessClient
+}
+
+func NewAuthInterceptor(accessClient rpc.AccessClient) *AuthInterceptor {
+	return &AuthInterceptor{
+		accessClient: accessClient,
+	}
+}
+
+func (i *AuthInterceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := i.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (i *AuthInterceptor) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+func (i *AuthInterceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if !strings.HasPrefix(method, protectedPrefix) {
+		return ctx, nil
+	}
+
+	user, err := i.accessClient.Check(ctx, method)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Unavailable, "failed to check access")
+	}
+
+	return identity.WithUser(ctx, user), nil
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
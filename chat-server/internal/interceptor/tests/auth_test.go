@@ -0,0 +1,140 @@
+package interceptor_test
+
+import (
+	"chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/identity"
+	"chat-server/internal/interceptor"
+	"chat-server/internal/model"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type accessClientMockFunc func(mc *minimock.Controller) *mocks.AccessClientMock
+
+const protectedMethod = "/chat_server_v1.ChatServerV1/SendMessage"
+
+func TestAuthInterceptor_Unary(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		user = &model.User{ID: 1, Username: "user1"}
+	)
+
+	tests := []struct {
+		name             string
+		method           string
+		want             *model.User
+		code             codes.Code
+		accessClientMock accessClientMockFunc
+	}{
+		{
+			name:   "success",
+			method: protectedMethod,
+			want:   user,
+			accessClientMock: func(mc *minimock.Controller) *mocks.AccessClientMock {
+				mock := mocks.NewAccessClientMock(mc)
+				mock.CheckMock.Expect(ctx, protectedMethod).Return(user, nil)
+				return mock
+			},
+		},
+		{
+			name:   "unprotected method",
+			method: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+			accessClientMock: func(mc *minimock.Controller) *mocks.AccessClientMock {
+				return mocks.NewAccessClientMock(mc)
+			},
+		},
+		{
+			name:   "access denied",
+			method: protectedMethod,
+			code:   codes.PermissionDenied,
+			accessClientMock: func(mc *minimock.Controller) *mocks.AccessClientMock {
+				mock := mocks.NewAccessClientMock(mc)
+				mock.CheckMock.Expect(ctx, protectedMethod).Return(nil, status.Error(codes.PermissionDenied, "access denied"))
+				return mock
+			},
+		},
+		{
+			name:   "auth service unavailable",
+			method: protectedMethod,
+			code:   codes.Unavailable,
+			accessClientMock: func(mc *minimock.Controller) *mocks.AccessClientMock {
+				mock := mocks.NewAccessClientMock(mc)
+				mock.CheckMock.Expect(ctx, protectedMethod).Return(nil, errors.New("connection refused"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			i := interceptor.NewAuthInterceptor(tt.accessClientMock(mc))
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				got, _ := identity.UserFromContext(ctx)
+				require.Equal(t, tt.want, got)
+				return req, nil
+			}
+
+			_, err := i.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if tt.code != codes.OK {
+				require.Equal(t, tt.code, status.Code(err))
+				require.False(t, called)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, called)
+		})
+	}
+}
+
+type serverStreamMock struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthInterceptor_Stream(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		mc     = minimock.NewController(t)
+		method = "/chat_server_v1.ChatServerV1/ConnectChat"
+
+		user = &model.User{ID: 1, Username: "user1"}
+	)
+
+	accessClientMock := mocks.NewAccessClientMock(mc)
+	accessClientMock.CheckMock.Expect(ctx, method).Return(user, nil)
+
+	i := interceptor.NewAuthInterceptor(accessClientMock)
+
+	var got *model.User
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		got, _ = identity.UserFromContext(stream.Context())
+		return nil
+	}
+
+	err := i.Stream(nil, &serverStreamMock{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method}, handler)
+	require.NoError(t, err)
+	require.Equal(t, user, got)
+}
@@ -1,14 +1,208 @@
 package model
 
-import "time"
+import (
+	"database/sql"
+	"time"
+)
 
 type Chat struct {
-	ID        int64
-	Usernames []string
+	ID             int64
+	Type           ChatType
+	Title          string
+	Description    string
+	AvatarURL      string
+	Members        []*ChatMember
+	CreatedAt      time.Time
+	LastMessageID  int64
+	LastActivityAt time.Time
+}
+
+// Member returns the chat member with the given user id, nil if the user is
+// not in the chat.
+func (c *Chat) Member(userID int64) *ChatMember {
+	for _, member := range c.Members {
+		if member.UserID == userID {
+			return member
+		}
+	}
+
+	return nil
+}
+
+// UpdateChatData holds the chat profile fields to change, nil fields are
+// left as they are.
+type UpdateChatData struct {
+	Title       *string
+	Description *string
+	AvatarURL   *string
 }
 
+type ChatType int32
+
+const (
+	ChatTypeUnspecified ChatType = iota
+	ChatTypeDirect
+	ChatTypeGroup
+	ChatTypeChannel
+)
+
+// ChatSummary is a chat as listed for one of its members. Chat.Members is
+// not loaded for summaries.
+type ChatSummary struct {
+	Chat              *Chat
+	LastMessage       *Message
+	UnreadCount       int64
+	LastReadMessageID int64
+}
+
+type ChatMember struct {
+	ChatID            int64
+	UserID            int64
+	Username          string
+	Role              ChatRole
+	JoinedAt          time.Time
+	LastReadMessageID int64
+	LastReadAt        sql.NullTime
+}
+
+type ChatRole int32
+
+const (
+	ChatRoleUnspecified ChatRole = iota
+	ChatRoleMember
+	ChatRoleAdmin
+	ChatRoleOwner
+)
+
 type Message struct {
+	ID        int64
+	ChatID    int64
 	From      string
 	Text      string
 	Timestamp time.Time
+	System    bool
+	EditedAt  sql.NullTime
+	DeletedAt sql.NullTime
+
+	ReplyToMessageID int64
+	// ThreadRootID is zero for top-level messages.
+	ThreadRootID int64
+	ReplyCount   int64
+	LastReplyAt  sql.NullTime
+
+	// Reactions are only loaded by history queries.
+	Reactions []*Reaction
+	// AttachmentIDs are the uploads to send with a new message.
+	AttachmentIDs []int64
+	Attachments   []*Attachment
+}
+
+// Attachment is a file uploaded to a chat, MessageID is zero until it is sent
+// with a message. The content lives in the blob store under BlobKey.
+type Attachment struct {
+	ID         int64
+	ChatID     int64
+	MessageID  int64
+	UploaderID int64
+	UploadedBy string
+	FileName   string
+	MimeType   string
+	Size       int64
+	SHA256     string
+	BlobKey    string
+	CreatedAt  time.Time
+}
+
+// UploadUsage is how many unsent attachments a user holds and their total
+// size.
+type UploadUsage struct {
+	Count int64
+	Size  int64
+}
+
+// MessageSearch narrows down SearchMessages, zero fields don't filter.
+type MessageSearch struct {
+	Query  string
+	ChatID int64
+	From   string
+	Since  time.Time
+	Until  time.Time
+}
+
+// SearchResult is a message matching a search, Rank orders the results.
+type SearchResult struct {
+	Message *Message
+	Snippet string
+	Rank    float32
+}
+
+// Reaction is the aggregate of one emoji on a message as seen by a user.
+type Reaction struct {
+	Emoji   string
+	Count   int64
+	Reacted bool
+}
+
+// ReactionChange is a reaction added or removed by a user.
+type ReactionChange struct {
+	MessageID int64
+	Emoji     string
+	UserID    int64
+	Username  string
+	Count     int64
+}
+
+type ChatEventType int32
+
+const (
+	ChatEventMessage ChatEventType = iota + 1
+	ChatEventMessageEdited
+	ChatEventMessageDeleted
+	ChatEventThreadUpdated
+	ChatEventReactionAdded
+	ChatEventReactionRemoved
+	ChatEventRead
+	ChatEventSignal
+)
+
+// ChatEvent is published to the live streams of a chat. Message is the
+// message the event is about, for reaction events only its id and thread are
+// meaningful. Events without a message concern the whole chat.
+type ChatEvent struct {
+	Type     ChatEventType
+	Message  *Message
+	Reaction *ReactionChange
+	Read     *ReadChange
+	Signal   *SignalChange
+}
+
+// ReadChange is a member's read pointer moving forward.
+type ReadChange struct {
+	UserID            int64
+	Username          string
+	LastReadMessageID int64
+}
+
+type SignalType int32
+
+const (
+	SignalTypeUnspecified SignalType = iota
+	SignalTypeTyping
+	SignalTypeOnline
+	SignalTypeAway
+)
+
+// Signal is an ephemeral state a client publishes into a chat, Stop clears it.
+type Signal struct {
+	ChatID int64
+	Type   SignalType
+	Stop   bool
+}
+
+// SignalChange is a member's signal starting, Active, or ending.
+type SignalChange struct {
+	UserID   int64
+	Username string
+	Type     SignalType
+	Active   bool
 }
@@ -0,0 +1,16 @@
+package model
+
+import "database/sql"
+
+type User struct {
+	ID       int64
+	Username string
+}
+
+// Presence tells whether the user holds a stream open to the server and when
+// their last stream closed.
+type Presence struct {
+	UserID     int64
+	Online     bool
+	LastSeenAt sql.NullTime
+}
@@ -5,9 +5,64 @@ import (
 	modelRepo "chat-server/internal/repository/chat/model"
 )
 
-func ToChatFromRepo(chat *modelRepo.Chat) *model.Chat {
+func ToChatFromRepo(chat *modelRepo.Chat, members []*modelRepo.Member) *model.Chat {
 	return &model.Chat{
-		ID:        chat.ID,
-		Usernames: chat.Usernames,
+		ID:             chat.ID,
+		Type:           model.ChatType(chat.Type),
+		Title:          chat.Title,
+		Description:    chat.Description,
+		AvatarURL:      chat.AvatarURL,
+		Members:        ToMembersFromRepo(members),
+		CreatedAt:      chat.CreatedAt,
+		LastMessageID:  chat.LastMessageID.Int64,
+		LastActivityAt: chat.LastActivityAt,
 	}
 }
+
+func ToChatSummariesFromRepo(summaries []*modelRepo.ChatSummary) []*model.ChatSummary {
+	res := make([]*model.ChatSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		chat := ToChatFromRepo(&summary.Chat, nil)
+		chat.Members = nil
+
+		var lastMessage *model.Message
+		if summary.LastMessageID.Valid {
+			lastMessage = &model.Message{
+				ID:        summary.LastMessageID.Int64,
+				ChatID:    summary.ID,
+				From:      summary.MessageFrom.String,
+				Text:      summary.MessageText.String,
+				Timestamp: summary.MessageCreatedAt.Time,
+				System:    summary.MessageSystem.Bool,
+				EditedAt:  summary.MessageEditedAt,
+				DeletedAt: summary.MessageDeletedAt,
+			}
+		}
+
+		res = append(res, &model.ChatSummary{
+			Chat:              chat,
+			LastMessage:       lastMessage,
+			UnreadCount:       summary.UnreadCount,
+			LastReadMessageID: summary.LastReadMessageID.Int64,
+		})
+	}
+
+	return res
+}
+
+func ToMembersFromRepo(members []*modelRepo.Member) []*model.ChatMember {
+	res := make([]*model.ChatMember, 0, len(members))
+	for _, member := range members {
+		res = append(res, &model.ChatMember{
+			ChatID:            member.ChatID,
+			UserID:            member.UserID,
+			Username:          member.Username,
+			Role:              model.ChatRole(member.Role),
+			JoinedAt:          member.JoinedAt,
+			LastReadMessageID: member.LastReadMessageID.Int64,
+			LastReadAt:        member.LastReadAt,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,131 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"log"
+
+	"github.com/makxtr/go-common/pkg/db"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// legacyMembersTableName holds the members of chats created before user ids
+// were stored, until their usernames are resolved in auth.
+const legacyMembersTableName = "legacy_chat_members"
+
+// ListLegacyUsernames returns the distinct usernames still waiting for their
+// user ids.
+func (r *repo) ListLegacyUsernames(ctx context.Context) ([]string, error) {
+	builder := sq.Select(usernameColumn).
+		Distinct().
+		PlaceholderFormat(sq.Dollar).
+		From(legacyMembersTableName).
+		OrderBy(usernameColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var usernames []string
+	err = r.db.DB().ScanAllContext(ctx, &usernames, db.Query{Name: "chat_repository.ListLegacyUsernames", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to list legacy usernames: %v", err)
+		return nil, err
+	}
+
+	return usernames, nil
+}
+
+// ResolveLegacyMember moves the legacy memberships of the user's name into
+// chat_members and returns how many chats the user was added to. A group or
+// channel left without an owner gets its longest-standing member as one.
+// Callers are expected to run it inside a transaction.
+func (r *repo) ResolveLegacyMember(ctx context.Context, user *model.User) (int64, error) {
+	members := sq.Select(chatIDColumn).
+		Column(sq.Expr("?::bigint", user.ID)).
+		Column(usernameColumn).
+		Column(sq.Expr("?::int", int32(model.ChatRoleMember))).
+		Column(joinedAtColumn).
+		From(legacyMembersTableName).
+		Where(sq.Eq{usernameColumn: user.Username})
+
+	builder := sq.Insert(membersTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, userIDColumn, usernameColumn, roleColumn, joinedAtColumn).
+		Select(members).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, err
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.ResolveLegacyMember", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to resolve legacy chat member: %v", err)
+		return 0, err
+	}
+
+	err = r.promoteLegacyOwners(ctx, user.Username)
+	if err != nil {
+		return 0, err
+	}
+
+	deleteBuilder := sq.Delete(legacyMembersTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{usernameColumn: user.Username})
+
+	query, args, err = deleteBuilder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, err
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.DeleteLegacyMember", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to delete legacy chat member: %v", err)
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// promoteLegacyOwners makes the longest-standing member the owner of every
+// group and channel the username was a legacy member of that has none, ties
+// go to the lowest user id.
+func (r *repo) promoteLegacyOwners(ctx context.Context, username string) error {
+	legacyChats := sq.Select(chatIDColumn).
+		From(legacyMembersTableName).
+		Where(sq.Eq{usernameColumn: username})
+
+	owners := sq.Select("DISTINCT ON (cm.chat_id) cm.chat_id", "cm.user_id").
+		From(membersTableName+" cm").
+		Join(tableName+" c ON c.id = cm.chat_id").
+		Where(sq.Expr("cm.chat_id IN (?)", legacyChats)).
+		Where(sq.NotEq{"c." + typeColumn: int32(model.ChatTypeDirect)}).
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM "+membersTableName+" o WHERE o.chat_id = cm.chat_id AND o.role = ?)", int32(model.ChatRoleOwner))).
+		OrderBy("cm.chat_id", "cm.joined_at", "cm.user_id")
+
+	builder := sq.Update(membersTableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(roleColumn, int32(model.ChatRoleOwner)).
+		Where(sq.Expr("("+chatIDColumn+", "+userIDColumn+") IN (?)", owners))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.PromoteLegacyOwners", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to promote legacy chat owners: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -1,6 +1,41 @@
 package model
 
+import (
+	"database/sql"
+	"time"
+)
+
 type Chat struct {
-	ID        int64    `db:"id"`
-	Usernames []string `db:"usernames"`
+	ID             int64         `db:"id"`
+	Type           int32         `db:"type"`
+	Title          string        `db:"title"`
+	Description    string        `db:"description"`
+	AvatarURL      string        `db:"avatar_url"`
+	CreatedAt      time.Time     `db:"created_at"`
+	LastMessageID  sql.NullInt64 `db:"last_message_id"`
+	LastActivityAt time.Time     `db:"last_activity_at"`
+}
+
+// ChatSummary is a chat row joined with its last message, the message columns
+// are null for chats without messages.
+type ChatSummary struct {
+	Chat              `db:""`
+	MessageFrom       sql.NullString `db:"message_from"`
+	MessageText       sql.NullString `db:"message_text"`
+	MessageCreatedAt  sql.NullTime   `db:"message_created_at"`
+	MessageSystem     sql.NullBool   `db:"message_system"`
+	MessageEditedAt   sql.NullTime   `db:"message_edited_at"`
+	MessageDeletedAt  sql.NullTime   `db:"message_deleted_at"`
+	UnreadCount       int64          `db:"unread_count"`
+	LastReadMessageID sql.NullInt64  `db:"last_read_message_id"`
+}
+
+type Member struct {
+	ChatID            int64         `db:"chat_id"`
+	UserID            int64         `db:"user_id"`
+	Username          string        `db:"username"`
+	Role              int32         `db:"role"`
+	JoinedAt          time.Time     `db:"joined_at"`
+	LastReadMessageID sql.NullInt64 `db:"last_read_message_id"`
+	LastReadAt        sql.NullTime  `db:"last_read_at"`
 }
@@ -3,20 +3,44 @@ package chat
 import (
 	"chat-server/internal/model"
 	"chat-server/internal/repository"
+	repoConverter "chat-server/internal/repository/chat/converter"
+	modelRepo "chat-server/internal/repository/chat/model"
 	"context"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/makxtr/go-common/pkg/db"
 
 	sq "github.com/Masterminds/squirrel"
-	"github.com/lib/pq"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 )
 
 const (
-	tableName = "chats"
+	tableName        = "chats"
+	membersTableName = "chat_members"
 
-	idColumn        = "id"
-	usernamesColumn = "usernames"
+	idColumn             = "id"
+	typeColumn           = "type"
+	titleColumn          = "title"
+	descriptionColumn    = "description"
+	avatarURLColumn      = "avatar_url"
+	directKeyColumn      = "direct_key"
+	createdAtColumn      = "created_at"
+	lastMessageIDColumn  = "last_message_id"
+	lastActivityAtColumn = "last_activity_at"
+
+	chatIDColumn            = "chat_id"
+	userIDColumn            = "user_id"
+	usernameColumn          = "username"
+	roleColumn              = "role"
+	joinedAtColumn          = "joined_at"
+	lastReadMessageIDColumn = "last_read_message_id"
+	lastReadAtColumn        = "last_read_at"
+
+	directKeyIndex      = "chats_direct_key_idx"
+	uniqueViolationCode = "23505"
 )
 
 type repo struct {
@@ -27,11 +51,23 @@ func NewRepository(db db.Client) repository.ChatRepository {
 	return &repo{db: db}
 }
 
+// Create inserts the chat together with its members, callers are expected to
+// run it inside a transaction. A direct chat that already exists for the pair
+// fails with ErrAlreadyExists.
 func (r *repo) Create(ctx context.Context, chat *model.Chat) (int64, error) {
+	var key *string
+	if chat.Type == model.ChatTypeDirect {
+		if len(chat.Members) != 2 {
+			return 0, fmt.Errorf("direct chat must have 2 members, got %d", len(chat.Members))
+		}
+		k := directKey(chat.Members[0].UserID, chat.Members[1].UserID)
+		key = &k
+	}
+
 	builder := sq.Insert(tableName).
 		PlaceholderFormat(sq.Dollar).
-		Columns(usernamesColumn).
-		Values(pq.Array(chat.Usernames)).
+		Columns(typeColumn, titleColumn, descriptionColumn, avatarURLColumn, directKeyColumn).
+		Values(int32(chat.Type), chat.Title, chat.Description, chat.AvatarURL, key).
 		Suffix("RETURNING id")
 
 	query, args, err := builder.ToSql()
@@ -43,13 +79,135 @@ func (r *repo) Create(ctx context.Context, chat *model.Chat) (int64, error) {
 	var id int64
 	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "chat_repository.Create", QueryRaw: query}, args...).Scan(&id)
 	if err != nil {
+		// Somebody else created the direct chat of the pair first.
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == directKeyIndex {
+			return 0, repository.ErrAlreadyExists
+		}
+
 		log.Printf("failed to create chat: %v", err)
 		return 0, err
 	}
 
+	err = r.AddMembers(ctx, id, chat.Members)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *repo) Get(ctx context.Context, id int64) (*model.Chat, error) {
+	builder := sq.Select(idColumn, typeColumn, titleColumn, descriptionColumn, avatarURLColumn,
+		createdAtColumn, lastMessageIDColumn, lastActivityAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var chat modelRepo.Chat
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "chat_repository.Get", QueryRaw: query}, args...).
+		Scan(&chat.ID, &chat.Type, &chat.Title, &chat.Description, &chat.AvatarURL,
+			&chat.CreatedAt, &chat.LastMessageID, &chat.LastActivityAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		log.Printf("failed to get chat: %v", err)
+		return nil, err
+	}
+
+	membersBuilder := sq.Select(chatIDColumn, userIDColumn, usernameColumn, roleColumn, joinedAtColumn, lastReadMessageIDColumn, lastReadAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(membersTableName).
+		Where(sq.Eq{chatIDColumn: id}).
+		OrderBy(joinedAtColumn, userIDColumn)
+
+	query, args, err = membersBuilder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var members []*modelRepo.Member
+	err = r.db.DB().ScanAllContext(ctx, &members, db.Query{Name: "chat_repository.GetMembers", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to get chat members: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToChatFromRepo(&chat, members), nil
+}
+
+// GetDirect returns the id of the direct chat between the two users.
+func (r *repo) GetDirect(ctx context.Context, userID, otherUserID int64) (int64, error) {
+	builder := sq.Select(idColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{directKeyColumn: directKey(userID, otherUserID)}).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, err
+	}
+
+	var id int64
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "chat_repository.GetDirect", QueryRaw: query}, args...).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrNotFound
+		}
+		log.Printf("failed to get direct chat: %v", err)
+		return 0, err
+	}
+
 	return id, nil
 }
 
+func (r *repo) Update(ctx context.Context, id int64, data *model.UpdateChatData) error {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{idColumn: id})
+
+	if data.Title != nil {
+		builder = builder.Set(titleColumn, *data.Title)
+	}
+
+	if data.Description != nil {
+		builder = builder.Set(descriptionColumn, *data.Description)
+	}
+
+	if data.AvatarURL != nil {
+		builder = builder.Set(avatarURLColumn, *data.AvatarURL)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.Update", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to update chat: %v", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *repo) Delete(ctx context.Context, id int64) error {
 	builder := sq.Delete(tableName).
 		PlaceholderFormat(sq.Dollar).
@@ -73,3 +231,211 @@ func (r *repo) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+func (r *repo) AddMembers(ctx context.Context, chatID int64, members []*model.ChatMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	builder := sq.Insert(membersTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, userIDColumn, usernameColumn, roleColumn)
+
+	for _, member := range members {
+		builder = builder.Values(chatID, member.UserID, member.Username, int32(member.Role))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.AddMembers", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to add chat members: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// LockMembers returns the members of the chat ordered by join time and keeps
+// them locked until the transaction ends, so the roles checked against them
+// can't change before the caller acts on them. Callers are expected to run
+// it inside a transaction.
+func (r *repo) LockMembers(ctx context.Context, chatID int64) ([]*model.ChatMember, error) {
+	builder := sq.Select(chatIDColumn, userIDColumn, usernameColumn, roleColumn, joinedAtColumn, lastReadMessageIDColumn, lastReadAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(membersTableName).
+		Where(sq.Eq{chatIDColumn: chatID}).
+		OrderBy(joinedAtColumn, userIDColumn).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var members []*modelRepo.Member
+	err = r.db.DB().ScanAllContext(ctx, &members, db.Query{Name: "chat_repository.LockMembers", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to lock chat members: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToMembersFromRepo(members), nil
+}
+
+func (r *repo) RemoveMember(ctx context.Context, chatID, userID int64) error {
+	builder := sq.Delete(membersTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID, userIDColumn: userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.RemoveMember", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to remove chat member: %v", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) UpdateMemberRole(ctx context.Context, chatID, userID int64, role model.ChatRole) error {
+	builder := sq.Update(membersTableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(roleColumn, int32(role)).
+		Where(sq.Eq{chatIDColumn: chatID, userIDColumn: userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.UpdateMemberRole", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to update chat member role: %v", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// MarkRead moves the member's read pointer forward to messageID, advanced is
+// false if the pointer was already at or past it.
+func (r *repo) MarkRead(ctx context.Context, chatID, userID, messageID int64) (bool, error) {
+	builder := sq.Update(membersTableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastReadMessageIDColumn, messageID).
+		Set(lastReadAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{chatIDColumn: chatID, userIDColumn: userID}).
+		Where(sq.Or{sq.Eq{lastReadMessageIDColumn: nil}, sq.Lt{lastReadMessageIDColumn: messageID}})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return false, err
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.MarkRead", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to mark chat read: %v", err)
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// ListByUser returns the chats the user is a member of, most recently active
+// first. before is the last chat of the previous page, nil for the first page.
+// Thread replies don't count as unread, they are read in their thread.
+func (r *repo) ListByUser(ctx context.Context, userID int64, before *model.Chat, limit uint64) ([]*model.ChatSummary, error) {
+	builder := sq.Select(
+		"c.id", "c.type", "c.title", "c.description", "c.avatar_url",
+		"c.created_at", "c.last_message_id", "c.last_activity_at",
+		"m.from_username AS message_from", "m.text AS message_text",
+		"m.created_at AS message_created_at", "m.system AS message_system",
+		"m.edited_at AS message_edited_at", "m.deleted_at AS message_deleted_at",
+		"(SELECT COUNT(*) FROM messages u WHERE u.chat_id = c.id"+
+			" AND u.id > COALESCE(cm.last_read_message_id, 0)"+
+			" AND u.from_username <> cm.username AND u.deleted_at IS NULL"+
+			" AND u.thread_root_id IS NULL) AS unread_count",
+		"cm.last_read_message_id",
+	).
+		PlaceholderFormat(sq.Dollar).
+		From(membersTableName+" cm").
+		Join(tableName+" c ON c.id = cm.chat_id").
+		LeftJoin("messages m ON m.id = c.last_message_id").
+		Where(sq.Eq{"cm.user_id": userID}).
+		OrderBy("c.last_activity_at DESC", "c.id DESC").
+		Limit(limit)
+
+	if before != nil {
+		builder = builder.Where(sq.Expr("(c.last_activity_at, c.id) < (?, ?)", before.LastActivityAt, before.ID))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var summaries []*modelRepo.ChatSummary
+	err = r.db.DB().ScanAllContext(ctx, &summaries, db.Query{Name: "chat_repository.ListByUser", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to list chats: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToChatSummariesFromRepo(summaries), nil
+}
+
+// UpdateLastMessage records a new message as the chat's latest activity. The
+// pointer never moves backwards, so concurrent senders can't undo each other.
+func (r *repo) UpdateLastMessage(ctx context.Context, chatID, messageID int64) error {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(lastMessageIDColumn, sq.Expr("GREATEST(COALESCE("+lastMessageIDColumn+", 0), ?)", messageID)).
+		Set(lastActivityAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: chatID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "chat_repository.UpdateLastMessage", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to update chat activity: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// directKey identifies the direct chat of a pair of users regardless of who
+// started it.
+func directKey(userID, otherUserID int64) string {
+	if userID > otherUserID {
+		userID, otherUserID = otherUserID, userID
+	}
+
+	return fmt.Sprintf("%d:%d", userID, otherUserID)
+}
@@ -1,4 +1,6 @@
 package repository
 
 //go:generate minimock -i ChatRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i MessageRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PresenceRepository -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i LogRepository -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,240 @@
+package message
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	repoConverter "chat-server/internal/repository/message/converter"
+	modelRepo "chat-server/internal/repository/message/model"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/makxtr/go-common/pkg/db"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	attachmentsTableName = "attachments"
+
+	uploaderIDColumn = "uploader_id"
+	uploadedByColumn = "uploaded_by"
+	fileNameColumn   = "file_name"
+	mimeTypeColumn   = "mime_type"
+	sizeColumn       = "size"
+	sha256Column     = "sha256"
+	blobKeyColumn    = "blob_key"
+)
+
+var attachmentColumns = []string{
+	idColumn, chatIDColumn, messageIDColumn, uploaderIDColumn, uploadedByColumn,
+	fileNameColumn, mimeTypeColumn, sizeColumn, sha256Column, blobKeyColumn, createdAtColumn,
+}
+
+func (r *repo) CreateAttachment(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error) {
+	builder := sq.Insert(attachmentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, uploaderIDColumn, uploadedByColumn, fileNameColumn, mimeTypeColumn, sizeColumn, sha256Column, blobKeyColumn).
+		Values(attachment.ChatID, attachment.UploaderID, attachment.UploadedBy, attachment.FileName,
+			attachment.MimeType, attachment.Size, attachment.SHA256, attachment.BlobKey).
+		Suffix("RETURNING " + strings.Join(attachmentColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var created modelRepo.Attachment
+	err = r.db.DB().ScanOneContext(ctx, &created, db.Query{Name: "message_repository.CreateAttachment", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to create attachment: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToAttachmentFromRepo(&created), nil
+}
+
+func (r *repo) GetAttachment(ctx context.Context, id int64) (*model.Attachment, error) {
+	builder := sq.Select(attachmentColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From(attachmentsTableName).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var attachment modelRepo.Attachment
+	err = r.db.DB().ScanOneContext(ctx, &attachment, db.Query{Name: "message_repository.GetAttachment", QueryRaw: query}, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		log.Printf("failed to get attachment: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToAttachmentFromRepo(&attachment), nil
+}
+
+// AttachToMessage links the attachments to the message. Only attachments the
+// uploader put into the message's chat within maxAge and hasn't sent yet are
+// linked, the linked ones are returned.
+func (r *repo) AttachToMessage(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration) ([]*model.Attachment, error) {
+	builder := sq.Update(attachmentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(messageIDColumn, message.ID).
+		Where(sq.Expr(idColumn+" = ANY(?)", ids)).
+		Where(sq.Eq{chatIDColumn: message.ChatID, uploaderIDColumn: uploaderID, messageIDColumn: nil}).
+		Where(sq.Expr(createdAtColumn+" >= now() - make_interval(secs => ?)", maxAge.Seconds())).
+		Suffix("RETURNING " + strings.Join(attachmentColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var attachments []*modelRepo.Attachment
+	err = r.db.DB().ScanAllContext(ctx, &attachments, db.Query{Name: "message_repository.AttachToMessage", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to attach to message: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToAttachmentsFromRepo(attachments), nil
+}
+
+// ListAttachments returns the attachments of the messages by message id, in
+// upload order.
+func (r *repo) ListAttachments(ctx context.Context, messageIDs []int64) (map[int64][]*model.Attachment, error) {
+	if len(messageIDs) == 0 {
+		return map[int64][]*model.Attachment{}, nil
+	}
+
+	builder := sq.Select(attachmentColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From(attachmentsTableName).
+		Where(sq.Expr(messageIDColumn+" = ANY(?)", messageIDs)).
+		OrderBy(idColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var attachments []*modelRepo.Attachment
+	err = r.db.DB().ScanAllContext(ctx, &attachments, db.Query{Name: "message_repository.ListAttachments", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to list attachments: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToMessageAttachmentsFromRepo(attachments), nil
+}
+
+// LockUploads holds off the other uploads of the user until the transaction
+// ends, so that their quota is checked one upload at a time. Callers are
+// expected to run it inside a transaction.
+func (r *repo) LockUploads(ctx context.Context, uploaderID int64) error {
+	builder := sq.Select().
+		Column(sq.Expr("pg_advisory_xact_lock(hashtextextended(?, 0))", fmt.Sprintf("attachments:%d", uploaderID))).
+		PlaceholderFormat(sq.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "message_repository.LockUploads", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to lock uploads: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetUploadUsage counts the attachments the user uploaded within maxAge and
+// hasn't sent yet.
+func (r *repo) GetUploadUsage(ctx context.Context, uploaderID int64, maxAge time.Duration) (*model.UploadUsage, error) {
+	builder := sq.Select("COUNT(*)", "COALESCE(SUM("+sizeColumn+"), 0)").
+		PlaceholderFormat(sq.Dollar).
+		From(attachmentsTableName).
+		Where(sq.Eq{uploaderIDColumn: uploaderID, messageIDColumn: nil}).
+		Where(sq.Expr(createdAtColumn+" >= now() - make_interval(secs => ?)", maxAge.Seconds()))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var usage model.UploadUsage
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "message_repository.GetUploadUsage", QueryRaw: query}, args...).
+		Scan(&usage.Count, &usage.Size)
+	if err != nil {
+		log.Printf("failed to get upload usage: %v", err)
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// DeleteUnsentAttachments deletes the attachments that weren't sent within
+// maxAge and returns the keys of their blobs.
+func (r *repo) DeleteUnsentAttachments(ctx context.Context, maxAge time.Duration) ([]string, error) {
+	builder := sq.Delete(attachmentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: nil}).
+		Where(sq.Expr(createdAtColumn+" < now() - make_interval(secs => ?)", maxAge.Seconds()))
+
+	return r.deleteAttachments(ctx, "message_repository.DeleteUnsentAttachments", builder)
+}
+
+// DeleteChatAttachments deletes the attachments of the chat and returns the
+// keys of their blobs.
+func (r *repo) DeleteChatAttachments(ctx context.Context, chatID int64) ([]string, error) {
+	builder := sq.Delete(attachmentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{chatIDColumn: chatID})
+
+	return r.deleteAttachments(ctx, "message_repository.DeleteChatAttachments", builder)
+}
+
+// DeleteMessageAttachments deletes the attachments sent with the message and
+// returns the keys of their blobs.
+func (r *repo) DeleteMessageAttachments(ctx context.Context, messageID int64) ([]string, error) {
+	builder := sq.Delete(attachmentsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID})
+
+	return r.deleteAttachments(ctx, "message_repository.DeleteMessageAttachments", builder)
+}
+
+func (r *repo) deleteAttachments(ctx context.Context, name string, builder sq.DeleteBuilder) ([]string, error) {
+	query, args, err := builder.Suffix("RETURNING " + blobKeyColumn).ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var blobKeys []string
+	err = r.db.DB().ScanAllContext(ctx, &blobKeys, db.Query{Name: name, QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to delete attachments: %v", err)
+		return nil, err
+	}
+
+	return blobKeys, nil
+}
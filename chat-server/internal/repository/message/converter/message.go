@@ -0,0 +1,96 @@
+package converter
+
+import (
+	"chat-server/internal/model"
+	modelRepo "chat-server/internal/repository/message/model"
+)
+
+func ToMessageFromRepo(message *modelRepo.Message) *model.Message {
+	return &model.Message{
+		ID:        message.ID,
+		ChatID:    message.ChatID,
+		From:      message.From,
+		Text:      message.Text,
+		Timestamp: message.CreatedAt,
+		System:    message.System,
+		EditedAt:  message.EditedAt,
+		DeletedAt: message.DeletedAt,
+
+		ReplyToMessageID: message.ReplyToMessageID.Int64,
+		ThreadRootID:     message.ThreadRootID.Int64,
+		ReplyCount:       message.ReplyCount,
+		LastReplyAt:      message.LastReplyAt,
+	}
+}
+
+func ToMessagesFromRepo(messages []*modelRepo.Message) []*model.Message {
+	res := make([]*model.Message, 0, len(messages))
+	for _, message := range messages {
+		res = append(res, ToMessageFromRepo(message))
+	}
+
+	return res
+}
+
+func ToSearchResultsFromRepo(results []*modelRepo.SearchResult) []*model.SearchResult {
+	res := make([]*model.SearchResult, 0, len(results))
+	for _, result := range results {
+		res = append(res, &model.SearchResult{
+			Message: ToMessageFromRepo(&result.Message),
+			Snippet: result.Snippet,
+			Rank:    result.Rank,
+		})
+	}
+
+	return res
+}
+
+// ToReactionsFromRepo groups the reactions by message id, keeping their order.
+func ToReactionsFromRepo(reactions []*modelRepo.Reaction) map[int64][]*model.Reaction {
+	res := make(map[int64][]*model.Reaction)
+	for _, reaction := range reactions {
+		res[reaction.MessageID] = append(res[reaction.MessageID], &model.Reaction{
+			Emoji:   reaction.Emoji,
+			Count:   reaction.Count,
+			Reacted: reaction.Reacted,
+		})
+	}
+
+	return res
+}
+
+func ToAttachmentFromRepo(attachment *modelRepo.Attachment) *model.Attachment {
+	return &model.Attachment{
+		ID:         attachment.ID,
+		ChatID:     attachment.ChatID,
+		MessageID:  attachment.MessageID.Int64,
+		UploaderID: attachment.UploaderID,
+		UploadedBy: attachment.UploadedBy,
+		FileName:   attachment.FileName,
+		MimeType:   attachment.MimeType,
+		Size:       attachment.Size,
+		SHA256:     attachment.SHA256,
+		BlobKey:    attachment.BlobKey,
+		CreatedAt:  attachment.CreatedAt,
+	}
+}
+
+func ToAttachmentsFromRepo(attachments []*modelRepo.Attachment) []*model.Attachment {
+	res := make([]*model.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		res = append(res, ToAttachmentFromRepo(attachment))
+	}
+
+	return res
+}
+
+// ToMessageAttachmentsFromRepo groups the attachments by message id, keeping
+// their order.
+func ToMessageAttachmentsFromRepo(attachments []*modelRepo.Attachment) map[int64][]*model.Attachment {
+	res := make(map[int64][]*model.Attachment)
+	for _, attachment := range attachments {
+		res[attachment.MessageID.Int64] = append(res[attachment.MessageID.Int64], ToAttachmentFromRepo(attachment))
+	}
+
+	return res
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Message struct {
+	ID        int64        `db:"id"`
+	ChatID    int64        `db:"chat_id"`
+	From      string       `db:"from_username"`
+	Text      string       `db:"text"`
+	CreatedAt time.Time    `db:"created_at"`
+	System    bool         `db:"system"`
+	EditedAt  sql.NullTime `db:"edited_at"`
+	DeletedAt sql.NullTime `db:"deleted_at"`
+
+	ReplyToMessageID sql.NullInt64 `db:"reply_to_message_id"`
+	ThreadRootID     sql.NullInt64 `db:"thread_root_id"`
+	ReplyCount       int64         `db:"reply_count"`
+	LastReplyAt      sql.NullTime  `db:"last_reply_at"`
+}
+
+type SearchResult struct {
+	Message
+	Snippet string  `db:"snippet"`
+	Rank    float32 `db:"rank"`
+}
+
+type Reaction struct {
+	MessageID int64  `db:"message_id"`
+	Emoji     string `db:"emoji"`
+	Count     int64  `db:"count"`
+	Reacted   bool   `db:"reacted"`
+}
+
+type Attachment struct {
+	ID         int64         `db:"id"`
+	ChatID     int64         `db:"chat_id"`
+	MessageID  sql.NullInt64 `db:"message_id"`
+	UploaderID int64         `db:"uploader_id"`
+	UploadedBy string        `db:"uploaded_by"`
+	FileName   string        `db:"file_name"`
+	MimeType   string        `db:"mime_type"`
+	Size       int64         `db:"size"`
+	SHA256     string        `db:"sha256"`
+	BlobKey    string        `db:"blob_key"`
+	CreatedAt  time.Time     `db:"created_at"`
+}
@@ -0,0 +1,430 @@
+package message
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	repoConverter "chat-server/internal/repository/message/converter"
+	modelRepo "chat-server/internal/repository/message/model"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/makxtr/go-common/pkg/db"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	tableName          = "messages"
+	editsTableName     = "message_edits"
+	reactionsTableName = "message_reactions"
+
+	idColumn        = "id"
+	chatIDColumn    = "chat_id"
+	fromColumn      = "from_username"
+	textColumn      = "text"
+	createdAtColumn = "created_at"
+	systemColumn    = "system"
+	editedAtColumn  = "edited_at"
+	deletedAtColumn = "deleted_at"
+
+	replyToMessageIDColumn = "reply_to_message_id"
+	threadRootIDColumn     = "thread_root_id"
+	replyCountColumn       = "reply_count"
+	lastReplyAtColumn      = "last_reply_at"
+
+	messageIDColumn = "message_id"
+	editedByColumn  = "edited_by"
+	userIDColumn    = "user_id"
+	emojiColumn     = "emoji"
+
+	searchVectorColumn = "search_vector"
+	rankColumn         = "rank"
+
+	membersTableName = "chat_members"
+
+	// searchConfig must match the configuration of the search_vector column.
+	searchConfig    = "simple"
+	headlineOptions = "MaxFragments=2, MaxWords=20, MinWords=5"
+)
+
+var messageColumns = []string{
+	idColumn, chatIDColumn, fromColumn, textColumn, createdAtColumn, systemColumn, editedAtColumn, deletedAtColumn,
+	replyToMessageIDColumn, threadRootIDColumn, replyCountColumn, lastReplyAtColumn,
+}
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.MessageRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) Create(ctx context.Context, message *model.Message) (*model.Message, error) {
+	builder := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(chatIDColumn, fromColumn, textColumn, systemColumn, replyToMessageIDColumn, threadRootIDColumn).
+		Values(message.ChatID, message.From, message.Text, message.System,
+			nullID(message.ReplyToMessageID), nullID(message.ThreadRootID)).
+		Suffix("RETURNING " + strings.Join(messageColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var created modelRepo.Message
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "message_repository.Create", QueryRaw: query}, args...).
+		Scan(&created.ID, &created.ChatID, &created.From, &created.Text, &created.CreatedAt, &created.System,
+			&created.EditedAt, &created.DeletedAt,
+			&created.ReplyToMessageID, &created.ThreadRootID, &created.ReplyCount, &created.LastReplyAt)
+	if err != nil {
+		log.Printf("failed to create message: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToMessageFromRepo(&created), nil
+}
+
+func (r *repo) Get(ctx context.Context, id int64) (*model.Message, error) {
+	builder := sq.Select(messageColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{idColumn: id}).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var message modelRepo.Message
+	err = r.db.DB().ScanOneContext(ctx, &message, db.Query{Name: "message_repository.Get", QueryRaw: query}, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		log.Printf("failed to get message: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToMessageFromRepo(&message), nil
+}
+
+// List returns the top-level messages of the chat, newest first.
+func (r *repo) List(ctx context.Context, chatID, beforeID int64, limit uint64) ([]*model.Message, error) {
+	builder := sq.Select(messageColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{chatIDColumn: chatID, threadRootIDColumn: nil}).
+		OrderBy(idColumn + " DESC").
+		Limit(limit)
+
+	if beforeID > 0 {
+		builder = builder.Where(sq.Lt{idColumn: beforeID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var messages []*modelRepo.Message
+	err = r.db.DB().ScanAllContext(ctx, &messages, db.Query{Name: "message_repository.List", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to list messages: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToMessagesFromRepo(messages), nil
+}
+
+// ListReplies returns the replies of a thread, oldest first.
+func (r *repo) ListReplies(ctx context.Context, rootID, afterID int64, limit uint64) ([]*model.Message, error) {
+	builder := sq.Select(messageColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Eq{threadRootIDColumn: rootID}).
+		Where(sq.Gt{idColumn: afterID}).
+		OrderBy(idColumn).
+		Limit(limit)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var messages []*modelRepo.Message
+	err = r.db.DB().ScanAllContext(ctx, &messages, db.Query{Name: "message_repository.ListReplies", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to list replies: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToMessagesFromRepo(messages), nil
+}
+
+// AddReply bumps the reply counter of a thread root and returns the updated root.
+func (r *repo) AddReply(ctx context.Context, rootID int64) (*model.Message, error) {
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(replyCountColumn, sq.Expr(replyCountColumn+" + 1")).
+		Set(lastReplyAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: rootID}).
+		Suffix("RETURNING " + strings.Join(messageColumns, ", "))
+
+	return r.update(ctx, "message_repository.AddReply", builder)
+}
+
+// Edit replaces the text of a message that is not deleted and records the
+// previous text in the edit history. Callers are expected to run it inside a
+// transaction.
+func (r *repo) Edit(ctx context.Context, id int64, text, editedBy string) (*model.Message, error) {
+	err := r.saveVersion(ctx, id, editedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(textColumn, text).
+		Set(editedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id, deletedAtColumn: nil}).
+		Suffix("RETURNING " + strings.Join(messageColumns, ", "))
+
+	return r.update(ctx, "message_repository.Edit", builder)
+}
+
+// Delete turns the message into a tombstone, its text is moved to the edit
+// history. Callers are expected to run it inside a transaction.
+func (r *repo) Delete(ctx context.Context, id int64, deletedBy string) (*model.Message, error) {
+	err := r.saveVersion(ctx, id, deletedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := sq.Update(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Set(textColumn, "").
+		Set(deletedAtColumn, sq.Expr("now()")).
+		Where(sq.Eq{idColumn: id, deletedAtColumn: nil}).
+		Suffix("RETURNING " + strings.Join(messageColumns, ", "))
+
+	return r.update(ctx, "message_repository.Delete", builder)
+}
+
+func (r *repo) saveVersion(ctx context.Context, id int64, editedBy string) error {
+	builder := sq.Insert(editsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, textColumn, editedByColumn).
+		Select(sq.Select(idColumn, textColumn).
+			Column(sq.Expr("?::text", editedBy)).
+			From(tableName).
+			Where(sq.Eq{idColumn: id, deletedAtColumn: nil}).
+			Suffix("FOR UPDATE"))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "message_repository.SaveVersion", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to save message version: %v", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repo) update(ctx context.Context, name string, builder sq.UpdateBuilder) (*model.Message, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var message modelRepo.Message
+	err = r.db.DB().ScanOneContext(ctx, &message, db.Query{Name: name, QueryRaw: query}, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		log.Printf("failed to update message: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToMessageFromRepo(&message), nil
+}
+
+// AddReaction records the user's reaction, reacting twice with the same
+// emoji is a no-op reported by added being false.
+func (r *repo) AddReaction(ctx context.Context, messageID, userID int64, emoji string) (bool, error) {
+	builder := sq.Insert(reactionsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(messageIDColumn, userIDColumn, emojiColumn).
+		Values(messageID, userID, emoji).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return false, err
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "message_repository.AddReaction", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to add reaction: %v", err)
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// RemoveReaction deletes the user's reaction, removed is false if there was none.
+func (r *repo) RemoveReaction(ctx context.Context, messageID, userID int64, emoji string) (bool, error) {
+	builder := sq.Delete(reactionsTableName).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{messageIDColumn: messageID, userIDColumn: userID, emojiColumn: emoji})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return false, err
+	}
+
+	res, err := r.db.DB().ExecContext(ctx, db.Query{Name: "message_repository.RemoveReaction", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to remove reaction: %v", err)
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+func (r *repo) CountReactions(ctx context.Context, messageID int64, emoji string) (int64, error) {
+	builder := sq.Select("COUNT(*)").
+		PlaceholderFormat(sq.Dollar).
+		From(reactionsTableName).
+		Where(sq.Eq{messageIDColumn: messageID, emojiColumn: emoji})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return 0, err
+	}
+
+	var count int64
+	err = r.db.DB().QueryRowContext(ctx, db.Query{Name: "message_repository.CountReactions", QueryRaw: query}, args...).Scan(&count)
+	if err != nil {
+		log.Printf("failed to count reactions: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ListReactions aggregates the reactions of the messages per emoji, flagging
+// the ones the user took part in. Emoji are ordered by first use.
+func (r *repo) ListReactions(ctx context.Context, messageIDs []int64, userID int64) (map[int64][]*model.Reaction, error) {
+	if len(messageIDs) == 0 {
+		return map[int64][]*model.Reaction{}, nil
+	}
+
+	builder := sq.Select(messageIDColumn, emojiColumn, "COUNT(*) AS count").
+		Column(sq.Expr("BOOL_OR("+userIDColumn+" = ?) AS reacted", userID)).
+		PlaceholderFormat(sq.Dollar).
+		From(reactionsTableName).
+		Where(sq.Expr(messageIDColumn+" = ANY(?)", messageIDs)).
+		GroupBy(messageIDColumn, emojiColumn).
+		OrderBy(messageIDColumn, "MIN(created_at)", emojiColumn)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var reactions []*modelRepo.Reaction
+	err = r.db.DB().ScanAllContext(ctx, &reactions, db.Query{Name: "message_repository.ListReactions", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to list reactions: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToReactionsFromRepo(reactions), nil
+}
+
+// Search finds the messages matching the search in the chats the user is a
+// member of, best matches first. after is the last result of the previous
+// page, nil for the first page.
+func (r *repo) Search(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64) ([]*model.SearchResult, error) {
+	tsQuery := "websearch_to_tsquery('" + searchConfig + "', ?)"
+
+	matches := sq.Select(messageColumns...).
+		Column(sq.Expr("ts_rank("+searchVectorColumn+", "+tsQuery+") AS "+rankColumn, search.Query)).
+		From(tableName).
+		Where(sq.Expr(searchVectorColumn+" @@ "+tsQuery, search.Query)).
+		Where(sq.Expr(chatIDColumn+" IN (SELECT chat_id FROM "+membersTableName+" WHERE user_id = ?)", userID)).
+		Where(sq.Eq{systemColumn: false, deletedAtColumn: nil})
+
+	if search.ChatID != 0 {
+		matches = matches.Where(sq.Eq{chatIDColumn: search.ChatID})
+	}
+	if search.From != "" {
+		matches = matches.Where(sq.Eq{fromColumn: search.From})
+	}
+	if !search.Since.IsZero() {
+		matches = matches.Where(sq.GtOrEq{createdAtColumn: search.Since})
+	}
+	if !search.Until.IsZero() {
+		matches = matches.Where(sq.Lt{createdAtColumn: search.Until})
+	}
+
+	// The snippets are only built for the page, not for every match.
+	builder := sq.Select("*").
+		Column(sq.Expr("ts_headline('"+searchConfig+"', "+textColumn+", "+tsQuery+", ?) AS snippet", search.Query, headlineOptions)).
+		PlaceholderFormat(sq.Dollar).
+		FromSelect(matches, "m").
+		OrderBy(rankColumn+" DESC", idColumn+" DESC").
+		Limit(limit)
+
+	if after != nil {
+		builder = builder.Where(sq.Or{
+			sq.Lt{rankColumn: after.Rank},
+			sq.And{sq.Eq{rankColumn: after.Rank}, sq.Lt{idColumn: after.Message.ID}},
+		})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var results []*modelRepo.SearchResult
+	err = r.db.DB().ScanAllContext(ctx, &results, db.Query{Name: "message_repository.Search", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to search messages: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToSearchResultsFromRepo(results), nil
+}
+
+func nullID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
@@ -19,6 +19,13 @@ type ChatRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddMembers          func(ctx context.Context, chatID int64, members []*model.ChatMember) (err error)
+	funcAddMembersOrigin    string
+	inspectFuncAddMembers   func(ctx context.Context, chatID int64, members []*model.ChatMember)
+	afterAddMembersCounter  uint64
+	beforeAddMembersCounter uint64
+	AddMembersMock          mChatRepositoryMockAddMembers
+
 	funcCreate          func(ctx context.Context, chat *model.Chat) (i1 int64, err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, chat *model.Chat)
@@ -32,6 +39,83 @@ type ChatRepositoryMock struct {
 	afterDeleteCounter  uint64
 	beforeDeleteCounter uint64
 	DeleteMock          mChatRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, id int64) (cp1 *model.Chat, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mChatRepositoryMockGet
+
+	funcGetDirect          func(ctx context.Context, userID int64, otherUserID int64) (i1 int64, err error)
+	funcGetDirectOrigin    string
+	inspectFuncGetDirect   func(ctx context.Context, userID int64, otherUserID int64)
+	afterGetDirectCounter  uint64
+	beforeGetDirectCounter uint64
+	GetDirectMock          mChatRepositoryMockGetDirect
+
+	funcListByUser          func(ctx context.Context, userID int64, before *model.Chat, limit uint64) (cpa1 []*model.ChatSummary, err error)
+	funcListByUserOrigin    string
+	inspectFuncListByUser   func(ctx context.Context, userID int64, before *model.Chat, limit uint64)
+	afterListByUserCounter  uint64
+	beforeListByUserCounter uint64
+	ListByUserMock          mChatRepositoryMockListByUser
+
+	funcListLegacyUsernames          func(ctx context.Context) (sa1 []string, err error)
+	funcListLegacyUsernamesOrigin    string
+	inspectFuncListLegacyUsernames   func(ctx context.Context)
+	afterListLegacyUsernamesCounter  uint64
+	beforeListLegacyUsernamesCounter uint64
+	ListLegacyUsernamesMock          mChatRepositoryMockListLegacyUsernames
+
+	funcLockMembers          func(ctx context.Context, chatID int64) (cpa1 []*model.ChatMember, err error)
+	funcLockMembersOrigin    string
+	inspectFuncLockMembers   func(ctx context.Context, chatID int64)
+	afterLockMembersCounter  uint64
+	beforeLockMembersCounter uint64
+	LockMembersMock          mChatRepositoryMockLockMembers
+
+	funcMarkRead          func(ctx context.Context, chatID int64, userID int64, messageID int64) (b1 bool, err error)
+	funcMarkReadOrigin    string
+	inspectFuncMarkRead   func(ctx context.Context, chatID int64, userID int64, messageID int64)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mChatRepositoryMockMarkRead
+
+	funcRemoveMember          func(ctx context.Context, chatID int64, userID int64) (err error)
+	funcRemoveMemberOrigin    string
+	inspectFuncRemoveMember   func(ctx context.Context, chatID int64, userID int64)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mChatRepositoryMockRemoveMember
+
+	funcResolveLegacyMember          func(ctx context.Context, user *model.User) (i1 int64, err error)
+	funcResolveLegacyMemberOrigin    string
+	inspectFuncResolveLegacyMember   func(ctx context.Context, user *model.User)
+	afterResolveLegacyMemberCounter  uint64
+	beforeResolveLegacyMemberCounter uint64
+	ResolveLegacyMemberMock          mChatRepositoryMockResolveLegacyMember
+
+	funcUpdate          func(ctx context.Context, id int64, data *model.UpdateChatData) (err error)
+	funcUpdateOrigin    string
+	inspectFuncUpdate   func(ctx context.Context, id int64, data *model.UpdateChatData)
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mChatRepositoryMockUpdate
+
+	funcUpdateLastMessage          func(ctx context.Context, chatID int64, messageID int64) (err error)
+	funcUpdateLastMessageOrigin    string
+	inspectFuncUpdateLastMessage   func(ctx context.Context, chatID int64, messageID int64)
+	afterUpdateLastMessageCounter  uint64
+	beforeUpdateLastMessageCounter uint64
+	UpdateLastMessageMock          mChatRepositoryMockUpdateLastMessage
+
+	funcUpdateMemberRole          func(ctx context.Context, chatID int64, userID int64, role model.ChatRole) (err error)
+	funcUpdateMemberRoleOrigin    string
+	inspectFuncUpdateMemberRole   func(ctx context.Context, chatID int64, userID int64, role model.ChatRole)
+	afterUpdateMemberRoleCounter  uint64
+	beforeUpdateMemberRoleCounter uint64
+	UpdateMemberRoleMock          mChatRepositoryMockUpdateMemberRole
 }
 
 // NewChatRepositoryMock returns a mock for mm_repository.ChatRepository
@@ -42,17 +126,426 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddMembersMock = mChatRepositoryMockAddMembers{mock: m}
+	m.AddMembersMock.callArgs = []*ChatRepositoryMockAddMembersParams{}
+
 	m.CreateMock = mChatRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*ChatRepositoryMockCreateParams{}
 
 	m.DeleteMock = mChatRepositoryMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*ChatRepositoryMockDeleteParams{}
 
+	m.GetMock = mChatRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*ChatRepositoryMockGetParams{}
+
+	m.GetDirectMock = mChatRepositoryMockGetDirect{mock: m}
+	m.GetDirectMock.callArgs = []*ChatRepositoryMockGetDirectParams{}
+
+	m.ListByUserMock = mChatRepositoryMockListByUser{mock: m}
+	m.ListByUserMock.callArgs = []*ChatRepositoryMockListByUserParams{}
+
+	m.ListLegacyUsernamesMock = mChatRepositoryMockListLegacyUsernames{mock: m}
+	m.ListLegacyUsernamesMock.callArgs = []*ChatRepositoryMockListLegacyUsernamesParams{}
+
+	m.LockMembersMock = mChatRepositoryMockLockMembers{mock: m}
+	m.LockMembersMock.callArgs = []*ChatRepositoryMockLockMembersParams{}
+
+	m.MarkReadMock = mChatRepositoryMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*ChatRepositoryMockMarkReadParams{}
+
+	m.RemoveMemberMock = mChatRepositoryMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*ChatRepositoryMockRemoveMemberParams{}
+
+	m.ResolveLegacyMemberMock = mChatRepositoryMockResolveLegacyMember{mock: m}
+	m.ResolveLegacyMemberMock.callArgs = []*ChatRepositoryMockResolveLegacyMemberParams{}
+
+	m.UpdateMock = mChatRepositoryMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*ChatRepositoryMockUpdateParams{}
+
+	m.UpdateLastMessageMock = mChatRepositoryMockUpdateLastMessage{mock: m}
+	m.UpdateLastMessageMock.callArgs = []*ChatRepositoryMockUpdateLastMessageParams{}
+
+	m.UpdateMemberRoleMock = mChatRepositoryMockUpdateMemberRole{mock: m}
+	m.UpdateMemberRoleMock.callArgs = []*ChatRepositoryMockUpdateMemberRoleParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mChatRepositoryMockAddMembers struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockAddMembersExpectation
+	expectations       []*ChatRepositoryMockAddMembersExpectation
+
+	callArgs []*ChatRepositoryMockAddMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockAddMembersExpectation specifies expectation struct of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockAddMembersParams
+	paramPtrs          *ChatRepositoryMockAddMembersParamPtrs
+	expectationOrigins ChatRepositoryMockAddMembersExpectationOrigins
+	results            *ChatRepositoryMockAddMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockAddMembersParams contains parameters of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersParams struct {
+	ctx     context.Context
+	chatID  int64
+	members []*model.ChatMember
+}
+
+// ChatRepositoryMockAddMembersParamPtrs contains pointers to parameters of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	members *[]*model.ChatMember
+}
+
+// ChatRepositoryMockAddMembersResults contains results of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersResults struct {
+	err error
+}
+
+// ChatRepositoryMockAddMembersOrigins contains origins of expectations of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originChatID  string
+	originMembers string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddMembers *mChatRepositoryMockAddMembers) Optional() *mChatRepositoryMockAddMembers {
+	mmAddMembers.optional = true
+	return mmAddMembers
+}
+
+// Expect sets up expected params for ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) Expect(ctx context.Context, chatID int64, members []*model.ChatMember) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by ExpectParams functions")
+	}
+
+	mmAddMembers.defaultExpectation.params = &ChatRepositoryMockAddMembersParams{ctx, chatID, members}
+	mmAddMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddMembers.expectations {
+		if minimock.Equal(e.params, mmAddMembers.defaultExpectation.params) {
+			mmAddMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// ExpectMembersParam3 sets up expected param members for ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) ExpectMembersParam3(members []*model.ChatMember) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.members = &members
+	mmAddMembers.defaultExpectation.expectationOrigins.originMembers = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) Inspect(f func(ctx context.Context, chatID int64, members []*model.ChatMember)) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.inspectFuncAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.AddMembers")
+	}
+
+	mmAddMembers.mock.inspectFuncAddMembers = f
+
+	return mmAddMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) Return(err error) *ChatRepositoryMock {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{mock: mmAddMembers.mock}
+	}
+	mmAddMembers.defaultExpectation.results = &ChatRepositoryMockAddMembersResults{err}
+	mmAddMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddMembers.mock
+}
+
+// Set uses given function f to mock the ChatRepository.AddMembers method
+func (mmAddMembers *mChatRepositoryMockAddMembers) Set(f func(ctx context.Context, chatID int64, members []*model.ChatMember) (err error)) *ChatRepositoryMock {
+	if mmAddMembers.defaultExpectation != nil {
+		mmAddMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.AddMembers method")
+	}
+
+	if len(mmAddMembers.expectations) > 0 {
+		mmAddMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.AddMembers method")
+	}
+
+	mmAddMembers.mock.funcAddMembers = f
+	mmAddMembers.mock.funcAddMembersOrigin = minimock.CallerInfo(1)
+	return mmAddMembers.mock
+}
+
+// When sets expectation for the ChatRepository.AddMembers which will trigger the result defined by the following
+// Then helper
+func (mmAddMembers *mChatRepositoryMockAddMembers) When(ctx context.Context, chatID int64, members []*model.ChatMember) *ChatRepositoryMockAddMembersExpectation {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockAddMembersExpectation{
+		mock:               mmAddMembers.mock,
+		params:             &ChatRepositoryMockAddMembersParams{ctx, chatID, members},
+		expectationOrigins: ChatRepositoryMockAddMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddMembers.expectations = append(mmAddMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.AddMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockAddMembersExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockAddMembersResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.AddMembers should be invoked
+func (mmAddMembers *mChatRepositoryMockAddMembers) Times(n uint64) *mChatRepositoryMockAddMembers {
+	if n == 0 {
+		mmAddMembers.mock.t.Fatalf("Times of ChatRepositoryMock.AddMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddMembers.expectedInvocations, n)
+	mmAddMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddMembers
+}
+
+func (mmAddMembers *mChatRepositoryMockAddMembers) invocationsDone() bool {
+	if len(mmAddMembers.expectations) == 0 && mmAddMembers.defaultExpectation == nil && mmAddMembers.mock.funcAddMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddMembers.mock.afterAddMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddMembers implements mm_repository.ChatRepository
+func (mmAddMembers *ChatRepositoryMock) AddMembers(ctx context.Context, chatID int64, members []*model.ChatMember) (err error) {
+	mm_atomic.AddUint64(&mmAddMembers.beforeAddMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMembers.afterAddMembersCounter, 1)
+
+	mmAddMembers.t.Helper()
+
+	if mmAddMembers.inspectFuncAddMembers != nil {
+		mmAddMembers.inspectFuncAddMembers(ctx, chatID, members)
+	}
+
+	mm_params := ChatRepositoryMockAddMembersParams{ctx, chatID, members}
+
+	// Record call args
+	mmAddMembers.AddMembersMock.mutex.Lock()
+	mmAddMembers.AddMembersMock.callArgs = append(mmAddMembers.AddMembersMock.callArgs, &mm_params)
+	mmAddMembers.AddMembersMock.mutex.Unlock()
+
+	for _, e := range mmAddMembers.AddMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMembers.AddMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMembers.AddMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMembers.AddMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMembers.AddMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockAddMembersParams{ctx, chatID, members}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMembers.t.Errorf("ChatRepositoryMock.AddMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAddMembers.t.Errorf("ChatRepositoryMock.AddMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.members != nil && !minimock.Equal(*mm_want_ptrs.members, mm_got.members) {
+				mmAddMembers.t.Errorf("ChatRepositoryMock.AddMembers got unexpected parameter members, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originMembers, *mm_want_ptrs.members, mm_got.members, minimock.Diff(*mm_want_ptrs.members, mm_got.members))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMembers.t.Errorf("ChatRepositoryMock.AddMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMembers.AddMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMembers.t.Fatal("No results are set for the ChatRepositoryMock.AddMembers")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMembers.funcAddMembers != nil {
+		return mmAddMembers.funcAddMembers(ctx, chatID, members)
+	}
+	mmAddMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.AddMembers. %v %v %v", ctx, chatID, members)
+	return
+}
+
+// AddMembersAfterCounter returns a count of finished ChatRepositoryMock.AddMembers invocations
+func (mmAddMembers *ChatRepositoryMock) AddMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.afterAddMembersCounter)
+}
+
+// AddMembersBeforeCounter returns a count of ChatRepositoryMock.AddMembers invocations
+func (mmAddMembers *ChatRepositoryMock) AddMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.beforeAddMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.AddMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMembers *mChatRepositoryMockAddMembers) Calls() []*ChatRepositoryMockAddMembersParams {
+	mmAddMembers.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockAddMembersParams, len(mmAddMembers.callArgs))
+	copy(argCopy, mmAddMembers.callArgs)
+
+	mmAddMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMembersDone returns true if the count of the AddMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockAddMembersDone() bool {
+	if m.AddMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMembersMock.invocationsDone()
+}
+
+// MinimockAddMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockAddMembersInspect() {
+	for _, e := range m.AddMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddMembersCounter := mm_atomic.LoadUint64(&m.afterAddMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMembersMock.defaultExpectation != nil && afterAddMembersCounter < 1 {
+		if m.AddMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddMembers at\n%s", m.AddMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddMembers at\n%s with params: %#v", m.AddMembersMock.defaultExpectation.expectationOrigins.origin, *m.AddMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMembers != nil && afterAddMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.AddMembers at\n%s", m.funcAddMembersOrigin)
+	}
+
+	if !m.AddMembersMock.invocationsDone() && afterAddMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.AddMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMembersMock.expectedInvocations), m.AddMembersMock.expectedInvocationsOrigin, afterAddMembersCounter)
+	}
+}
+
 type mChatRepositoryMockCreate struct {
 	optional           bool
 	mock               *ChatRepositoryMock
@@ -738,13 +1231,4085 @@ func (m *ChatRepositoryMock) MinimockDeleteInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ChatRepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateInspect()
+type mChatRepositoryMockGet struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetExpectation
+	expectations       []*ChatRepositoryMockGetExpectation
 
-			m.MinimockDeleteInspect()
+	callArgs []*ChatRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetExpectation specifies expectation struct of the ChatRepository.Get
+type ChatRepositoryMockGetExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetParams
+	paramPtrs          *ChatRepositoryMockGetParamPtrs
+	expectationOrigins ChatRepositoryMockGetExpectationOrigins
+	results            *ChatRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetParams contains parameters of the ChatRepository.Get
+type ChatRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ChatRepositoryMockGetParamPtrs contains pointers to parameters of the ChatRepository.Get
+type ChatRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// ChatRepositoryMockGetResults contains results of the ChatRepository.Get
+type ChatRepositoryMockGetResults struct {
+	cp1 *model.Chat
+	err error
+}
+
+// ChatRepositoryMockGetOrigins contains origins of expectations of the ChatRepository.Get
+type ChatRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mChatRepositoryMockGet) Optional() *mChatRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) Expect(ctx context.Context, id int64) *mChatRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &ChatRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ChatRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) ExpectIdParam2(id int64) *mChatRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ChatRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mChatRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by ChatRepository.Get
+func (mmGet *mChatRepositoryMockGet) Return(cp1 *model.Chat, err error) *ChatRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ChatRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &ChatRepositoryMockGetResults{cp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the ChatRepository.Get method
+func (mmGet *mChatRepositoryMockGet) Set(f func(ctx context.Context, id int64) (cp1 *model.Chat, err error)) *ChatRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the ChatRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mChatRepositoryMockGet) When(ctx context.Context, id int64) *ChatRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ChatRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &ChatRepositoryMockGetParams{ctx, id},
+		expectationOrigins: ChatRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.Get return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetExpectation) Then(cp1 *model.Chat, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.Get should be invoked
+func (mmGet *mChatRepositoryMockGet) Times(n uint64) *mChatRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of ChatRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mChatRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.ChatRepository
+func (mmGet *ChatRepositoryMock) Get(ctx context.Context, id int64) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := ChatRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("ChatRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the ChatRepositoryMock.Get")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to ChatRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished ChatRepositoryMock.Get invocations
+func (mmGet *ChatRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of ChatRepositoryMock.Get invocations
+func (mmGet *ChatRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mChatRepositoryMockGet) Calls() []*ChatRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mChatRepositoryMockGetDirect struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetDirectExpectation
+	expectations       []*ChatRepositoryMockGetDirectExpectation
+
+	callArgs []*ChatRepositoryMockGetDirectParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetDirectExpectation specifies expectation struct of the ChatRepository.GetDirect
+type ChatRepositoryMockGetDirectExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetDirectParams
+	paramPtrs          *ChatRepositoryMockGetDirectParamPtrs
+	expectationOrigins ChatRepositoryMockGetDirectExpectationOrigins
+	results            *ChatRepositoryMockGetDirectResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetDirectParams contains parameters of the ChatRepository.GetDirect
+type ChatRepositoryMockGetDirectParams struct {
+	ctx         context.Context
+	userID      int64
+	otherUserID int64
+}
+
+// ChatRepositoryMockGetDirectParamPtrs contains pointers to parameters of the ChatRepository.GetDirect
+type ChatRepositoryMockGetDirectParamPtrs struct {
+	ctx         *context.Context
+	userID      *int64
+	otherUserID *int64
+}
+
+// ChatRepositoryMockGetDirectResults contains results of the ChatRepository.GetDirect
+type ChatRepositoryMockGetDirectResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockGetDirectOrigins contains origins of expectations of the ChatRepository.GetDirect
+type ChatRepositoryMockGetDirectExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originOtherUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDirect *mChatRepositoryMockGetDirect) Optional() *mChatRepositoryMockGetDirect {
+	mmGetDirect.optional = true
+	return mmGetDirect
+}
+
+// Expect sets up expected params for ChatRepository.GetDirect
+func (mmGetDirect *mChatRepositoryMockGetDirect) Expect(ctx context.Context, userID int64, otherUserID int64) *mChatRepositoryMockGetDirect {
+	if mmGetDirect.mock.funcGetDirect != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Set")
+	}
+
+	if mmGetDirect.defaultExpectation == nil {
+		mmGetDirect.defaultExpectation = &ChatRepositoryMockGetDirectExpectation{}
+	}
+
+	if mmGetDirect.defaultExpectation.paramPtrs != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by ExpectParams functions")
+	}
+
+	mmGetDirect.defaultExpectation.params = &ChatRepositoryMockGetDirectParams{ctx, userID, otherUserID}
+	mmGetDirect.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDirect.expectations {
+		if minimock.Equal(e.params, mmGetDirect.defaultExpectation.params) {
+			mmGetDirect.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDirect.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDirect
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetDirect
+func (mmGetDirect *mChatRepositoryMockGetDirect) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetDirect {
+	if mmGetDirect.mock.funcGetDirect != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Set")
+	}
+
+	if mmGetDirect.defaultExpectation == nil {
+		mmGetDirect.defaultExpectation = &ChatRepositoryMockGetDirectExpectation{}
+	}
+
+	if mmGetDirect.defaultExpectation.params != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Expect")
+	}
+
+	if mmGetDirect.defaultExpectation.paramPtrs == nil {
+		mmGetDirect.defaultExpectation.paramPtrs = &ChatRepositoryMockGetDirectParamPtrs{}
+	}
+	mmGetDirect.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDirect.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDirect
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ChatRepository.GetDirect
+func (mmGetDirect *mChatRepositoryMockGetDirect) ExpectUserIDParam2(userID int64) *mChatRepositoryMockGetDirect {
+	if mmGetDirect.mock.funcGetDirect != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Set")
+	}
+
+	if mmGetDirect.defaultExpectation == nil {
+		mmGetDirect.defaultExpectation = &ChatRepositoryMockGetDirectExpectation{}
+	}
+
+	if mmGetDirect.defaultExpectation.params != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Expect")
+	}
+
+	if mmGetDirect.defaultExpectation.paramPtrs == nil {
+		mmGetDirect.defaultExpectation.paramPtrs = &ChatRepositoryMockGetDirectParamPtrs{}
+	}
+	mmGetDirect.defaultExpectation.paramPtrs.userID = &userID
+	mmGetDirect.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetDirect
+}
+
+// ExpectOtherUserIDParam3 sets up expected param otherUserID for ChatRepository.GetDirect
+func (mmGetDirect *mChatRepositoryMockGetDirect) ExpectOtherUserIDParam3(otherUserID int64) *mChatRepositoryMockGetDirect {
+	if mmGetDirect.mock.funcGetDirect != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Set")
+	}
+
+	if mmGetDirect.defaultExpectation == nil {
+		mmGetDirect.defaultExpectation = &ChatRepositoryMockGetDirectExpectation{}
+	}
+
+	if mmGetDirect.defaultExpectation.params != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Expect")
+	}
+
+	if mmGetDirect.defaultExpectation.paramPtrs == nil {
+		mmGetDirect.defaultExpectation.paramPtrs = &ChatRepositoryMockGetDirectParamPtrs{}
+	}
+	mmGetDirect.defaultExpectation.paramPtrs.otherUserID = &otherUserID
+	mmGetDirect.defaultExpectation.expectationOrigins.originOtherUserID = minimock.CallerInfo(1)
+
+	return mmGetDirect
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetDirect
+func (mmGetDirect *mChatRepositoryMockGetDirect) Inspect(f func(ctx context.Context, userID int64, otherUserID int64)) *mChatRepositoryMockGetDirect {
+	if mmGetDirect.mock.inspectFuncGetDirect != nil {
+		mmGetDirect.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetDirect")
+	}
+
+	mmGetDirect.mock.inspectFuncGetDirect = f
+
+	return mmGetDirect
+}
+
+// Return sets up results that will be returned by ChatRepository.GetDirect
+func (mmGetDirect *mChatRepositoryMockGetDirect) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmGetDirect.mock.funcGetDirect != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Set")
+	}
+
+	if mmGetDirect.defaultExpectation == nil {
+		mmGetDirect.defaultExpectation = &ChatRepositoryMockGetDirectExpectation{mock: mmGetDirect.mock}
+	}
+	mmGetDirect.defaultExpectation.results = &ChatRepositoryMockGetDirectResults{i1, err}
+	mmGetDirect.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDirect.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetDirect method
+func (mmGetDirect *mChatRepositoryMockGetDirect) Set(f func(ctx context.Context, userID int64, otherUserID int64) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmGetDirect.defaultExpectation != nil {
+		mmGetDirect.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetDirect method")
+	}
+
+	if len(mmGetDirect.expectations) > 0 {
+		mmGetDirect.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetDirect method")
+	}
+
+	mmGetDirect.mock.funcGetDirect = f
+	mmGetDirect.mock.funcGetDirectOrigin = minimock.CallerInfo(1)
+	return mmGetDirect.mock
+}
+
+// When sets expectation for the ChatRepository.GetDirect which will trigger the result defined by the following
+// Then helper
+func (mmGetDirect *mChatRepositoryMockGetDirect) When(ctx context.Context, userID int64, otherUserID int64) *ChatRepositoryMockGetDirectExpectation {
+	if mmGetDirect.mock.funcGetDirect != nil {
+		mmGetDirect.mock.t.Fatalf("ChatRepositoryMock.GetDirect mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetDirectExpectation{
+		mock:               mmGetDirect.mock,
+		params:             &ChatRepositoryMockGetDirectParams{ctx, userID, otherUserID},
+		expectationOrigins: ChatRepositoryMockGetDirectExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDirect.expectations = append(mmGetDirect.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetDirect return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetDirectExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetDirectResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetDirect should be invoked
+func (mmGetDirect *mChatRepositoryMockGetDirect) Times(n uint64) *mChatRepositoryMockGetDirect {
+	if n == 0 {
+		mmGetDirect.mock.t.Fatalf("Times of ChatRepositoryMock.GetDirect mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDirect.expectedInvocations, n)
+	mmGetDirect.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDirect
+}
+
+func (mmGetDirect *mChatRepositoryMockGetDirect) invocationsDone() bool {
+	if len(mmGetDirect.expectations) == 0 && mmGetDirect.defaultExpectation == nil && mmGetDirect.mock.funcGetDirect == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDirect.mock.afterGetDirectCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDirect.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDirect implements mm_repository.ChatRepository
+func (mmGetDirect *ChatRepositoryMock) GetDirect(ctx context.Context, userID int64, otherUserID int64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetDirect.beforeGetDirectCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDirect.afterGetDirectCounter, 1)
+
+	mmGetDirect.t.Helper()
+
+	if mmGetDirect.inspectFuncGetDirect != nil {
+		mmGetDirect.inspectFuncGetDirect(ctx, userID, otherUserID)
+	}
+
+	mm_params := ChatRepositoryMockGetDirectParams{ctx, userID, otherUserID}
+
+	// Record call args
+	mmGetDirect.GetDirectMock.mutex.Lock()
+	mmGetDirect.GetDirectMock.callArgs = append(mmGetDirect.GetDirectMock.callArgs, &mm_params)
+	mmGetDirect.GetDirectMock.mutex.Unlock()
+
+	for _, e := range mmGetDirect.GetDirectMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetDirect.GetDirectMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDirect.GetDirectMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDirect.GetDirectMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDirect.GetDirectMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetDirectParams{ctx, userID, otherUserID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDirect.t.Errorf("ChatRepositoryMock.GetDirect got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDirect.GetDirectMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetDirect.t.Errorf("ChatRepositoryMock.GetDirect got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDirect.GetDirectMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.otherUserID != nil && !minimock.Equal(*mm_want_ptrs.otherUserID, mm_got.otherUserID) {
+				mmGetDirect.t.Errorf("ChatRepositoryMock.GetDirect got unexpected parameter otherUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDirect.GetDirectMock.defaultExpectation.expectationOrigins.originOtherUserID, *mm_want_ptrs.otherUserID, mm_got.otherUserID, minimock.Diff(*mm_want_ptrs.otherUserID, mm_got.otherUserID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDirect.t.Errorf("ChatRepositoryMock.GetDirect got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDirect.GetDirectMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDirect.GetDirectMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDirect.t.Fatal("No results are set for the ChatRepositoryMock.GetDirect")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetDirect.funcGetDirect != nil {
+		return mmGetDirect.funcGetDirect(ctx, userID, otherUserID)
+	}
+	mmGetDirect.t.Fatalf("Unexpected call to ChatRepositoryMock.GetDirect. %v %v %v", ctx, userID, otherUserID)
+	return
+}
+
+// GetDirectAfterCounter returns a count of finished ChatRepositoryMock.GetDirect invocations
+func (mmGetDirect *ChatRepositoryMock) GetDirectAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDirect.afterGetDirectCounter)
+}
+
+// GetDirectBeforeCounter returns a count of ChatRepositoryMock.GetDirect invocations
+func (mmGetDirect *ChatRepositoryMock) GetDirectBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDirect.beforeGetDirectCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetDirect.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDirect *mChatRepositoryMockGetDirect) Calls() []*ChatRepositoryMockGetDirectParams {
+	mmGetDirect.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetDirectParams, len(mmGetDirect.callArgs))
+	copy(argCopy, mmGetDirect.callArgs)
+
+	mmGetDirect.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDirectDone returns true if the count of the GetDirect invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetDirectDone() bool {
+	if m.GetDirectMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDirectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDirectMock.invocationsDone()
+}
+
+// MinimockGetDirectInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetDirectInspect() {
+	for _, e := range m.GetDirectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetDirect at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDirectCounter := mm_atomic.LoadUint64(&m.afterGetDirectCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDirectMock.defaultExpectation != nil && afterGetDirectCounter < 1 {
+		if m.GetDirectMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetDirect at\n%s", m.GetDirectMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetDirect at\n%s with params: %#v", m.GetDirectMock.defaultExpectation.expectationOrigins.origin, *m.GetDirectMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDirect != nil && afterGetDirectCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetDirect at\n%s", m.funcGetDirectOrigin)
+	}
+
+	if !m.GetDirectMock.invocationsDone() && afterGetDirectCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetDirect at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDirectMock.expectedInvocations), m.GetDirectMock.expectedInvocationsOrigin, afterGetDirectCounter)
+	}
+}
+
+type mChatRepositoryMockListByUser struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListByUserExpectation
+	expectations       []*ChatRepositoryMockListByUserExpectation
+
+	callArgs []*ChatRepositoryMockListByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListByUserExpectation specifies expectation struct of the ChatRepository.ListByUser
+type ChatRepositoryMockListByUserExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListByUserParams
+	paramPtrs          *ChatRepositoryMockListByUserParamPtrs
+	expectationOrigins ChatRepositoryMockListByUserExpectationOrigins
+	results            *ChatRepositoryMockListByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListByUserParams contains parameters of the ChatRepository.ListByUser
+type ChatRepositoryMockListByUserParams struct {
+	ctx    context.Context
+	userID int64
+	before *model.Chat
+	limit  uint64
+}
+
+// ChatRepositoryMockListByUserParamPtrs contains pointers to parameters of the ChatRepository.ListByUser
+type ChatRepositoryMockListByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	before **model.Chat
+	limit  *uint64
+}
+
+// ChatRepositoryMockListByUserResults contains results of the ChatRepository.ListByUser
+type ChatRepositoryMockListByUserResults struct {
+	cpa1 []*model.ChatSummary
+	err  error
+}
+
+// ChatRepositoryMockListByUserOrigins contains origins of expectations of the ChatRepository.ListByUser
+type ChatRepositoryMockListByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originBefore string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListByUser *mChatRepositoryMockListByUser) Optional() *mChatRepositoryMockListByUser {
+	mmListByUser.optional = true
+	return mmListByUser
+}
+
+// Expect sets up expected params for ChatRepository.ListByUser
+func (mmListByUser *mChatRepositoryMockListByUser) Expect(ctx context.Context, userID int64, before *model.Chat, limit uint64) *mChatRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &ChatRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by ExpectParams functions")
+	}
+
+	mmListByUser.defaultExpectation.params = &ChatRepositoryMockListByUserParams{ctx, userID, before, limit}
+	mmListByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListByUser.expectations {
+		if minimock.Equal(e.params, mmListByUser.defaultExpectation.params) {
+			mmListByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmListByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListByUser
+func (mmListByUser *mChatRepositoryMockListByUser) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &ChatRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &ChatRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ChatRepository.ListByUser
+func (mmListByUser *mChatRepositoryMockListByUser) ExpectUserIDParam2(userID int64) *mChatRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &ChatRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &ChatRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmListByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// ExpectBeforeParam3 sets up expected param before for ChatRepository.ListByUser
+func (mmListByUser *mChatRepositoryMockListByUser) ExpectBeforeParam3(before *model.Chat) *mChatRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &ChatRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &ChatRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.before = &before
+	mmListByUser.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// ExpectLimitParam4 sets up expected param limit for ChatRepository.ListByUser
+func (mmListByUser *mChatRepositoryMockListByUser) ExpectLimitParam4(limit uint64) *mChatRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &ChatRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &ChatRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.limit = &limit
+	mmListByUser.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListByUser
+func (mmListByUser *mChatRepositoryMockListByUser) Inspect(f func(ctx context.Context, userID int64, before *model.Chat, limit uint64)) *mChatRepositoryMockListByUser {
+	if mmListByUser.mock.inspectFuncListByUser != nil {
+		mmListByUser.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListByUser")
+	}
+
+	mmListByUser.mock.inspectFuncListByUser = f
+
+	return mmListByUser
+}
+
+// Return sets up results that will be returned by ChatRepository.ListByUser
+func (mmListByUser *mChatRepositoryMockListByUser) Return(cpa1 []*model.ChatSummary, err error) *ChatRepositoryMock {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &ChatRepositoryMockListByUserExpectation{mock: mmListByUser.mock}
+	}
+	mmListByUser.defaultExpectation.results = &ChatRepositoryMockListByUserResults{cpa1, err}
+	mmListByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListByUser.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListByUser method
+func (mmListByUser *mChatRepositoryMockListByUser) Set(f func(ctx context.Context, userID int64, before *model.Chat, limit uint64) (cpa1 []*model.ChatSummary, err error)) *ChatRepositoryMock {
+	if mmListByUser.defaultExpectation != nil {
+		mmListByUser.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListByUser method")
+	}
+
+	if len(mmListByUser.expectations) > 0 {
+		mmListByUser.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListByUser method")
+	}
+
+	mmListByUser.mock.funcListByUser = f
+	mmListByUser.mock.funcListByUserOrigin = minimock.CallerInfo(1)
+	return mmListByUser.mock
+}
+
+// When sets expectation for the ChatRepository.ListByUser which will trigger the result defined by the following
+// Then helper
+func (mmListByUser *mChatRepositoryMockListByUser) When(ctx context.Context, userID int64, before *model.Chat, limit uint64) *ChatRepositoryMockListByUserExpectation {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("ChatRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListByUserExpectation{
+		mock:               mmListByUser.mock,
+		params:             &ChatRepositoryMockListByUserParams{ctx, userID, before, limit},
+		expectationOrigins: ChatRepositoryMockListByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListByUser.expectations = append(mmListByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListByUser return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListByUserExpectation) Then(cpa1 []*model.ChatSummary, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListByUserResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListByUser should be invoked
+func (mmListByUser *mChatRepositoryMockListByUser) Times(n uint64) *mChatRepositoryMockListByUser {
+	if n == 0 {
+		mmListByUser.mock.t.Fatalf("Times of ChatRepositoryMock.ListByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListByUser.expectedInvocations, n)
+	mmListByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListByUser
+}
+
+func (mmListByUser *mChatRepositoryMockListByUser) invocationsDone() bool {
+	if len(mmListByUser.expectations) == 0 && mmListByUser.defaultExpectation == nil && mmListByUser.mock.funcListByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListByUser.mock.afterListByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListByUser implements mm_repository.ChatRepository
+func (mmListByUser *ChatRepositoryMock) ListByUser(ctx context.Context, userID int64, before *model.Chat, limit uint64) (cpa1 []*model.ChatSummary, err error) {
+	mm_atomic.AddUint64(&mmListByUser.beforeListByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmListByUser.afterListByUserCounter, 1)
+
+	mmListByUser.t.Helper()
+
+	if mmListByUser.inspectFuncListByUser != nil {
+		mmListByUser.inspectFuncListByUser(ctx, userID, before, limit)
+	}
+
+	mm_params := ChatRepositoryMockListByUserParams{ctx, userID, before, limit}
+
+	// Record call args
+	mmListByUser.ListByUserMock.mutex.Lock()
+	mmListByUser.ListByUserMock.callArgs = append(mmListByUser.ListByUserMock.callArgs, &mm_params)
+	mmListByUser.ListByUserMock.mutex.Unlock()
+
+	for _, e := range mmListByUser.ListByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmListByUser.ListByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListByUser.ListByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmListByUser.ListByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmListByUser.ListByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListByUserParams{ctx, userID, before, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListByUser.t.Errorf("ChatRepositoryMock.ListByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListByUser.t.Errorf("ChatRepositoryMock.ListByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmListByUser.t.Errorf("ChatRepositoryMock.ListByUser got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListByUser.t.Errorf("ChatRepositoryMock.ListByUser got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListByUser.t.Errorf("ChatRepositoryMock.ListByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListByUser.ListByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListByUser.t.Fatal("No results are set for the ChatRepositoryMock.ListByUser")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmListByUser.funcListByUser != nil {
+		return mmListByUser.funcListByUser(ctx, userID, before, limit)
+	}
+	mmListByUser.t.Fatalf("Unexpected call to ChatRepositoryMock.ListByUser. %v %v %v %v", ctx, userID, before, limit)
+	return
+}
+
+// ListByUserAfterCounter returns a count of finished ChatRepositoryMock.ListByUser invocations
+func (mmListByUser *ChatRepositoryMock) ListByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.afterListByUserCounter)
+}
+
+// ListByUserBeforeCounter returns a count of ChatRepositoryMock.ListByUser invocations
+func (mmListByUser *ChatRepositoryMock) ListByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.beforeListByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListByUser *mChatRepositoryMockListByUser) Calls() []*ChatRepositoryMockListByUserParams {
+	mmListByUser.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListByUserParams, len(mmListByUser.callArgs))
+	copy(argCopy, mmListByUser.callArgs)
+
+	mmListByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListByUserDone returns true if the count of the ListByUser invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListByUserDone() bool {
+	if m.ListByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListByUserMock.invocationsDone()
+}
+
+// MinimockListByUserInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListByUserInspect() {
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListByUserCounter := mm_atomic.LoadUint64(&m.afterListByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByUserMock.defaultExpectation != nil && afterListByUserCounter < 1 {
+		if m.ListByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListByUser at\n%s", m.ListByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListByUser at\n%s with params: %#v", m.ListByUserMock.defaultExpectation.expectationOrigins.origin, *m.ListByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByUser != nil && afterListByUserCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListByUser at\n%s", m.funcListByUserOrigin)
+	}
+
+	if !m.ListByUserMock.invocationsDone() && afterListByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListByUserMock.expectedInvocations), m.ListByUserMock.expectedInvocationsOrigin, afterListByUserCounter)
+	}
+}
+
+type mChatRepositoryMockListLegacyUsernames struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListLegacyUsernamesExpectation
+	expectations       []*ChatRepositoryMockListLegacyUsernamesExpectation
+
+	callArgs []*ChatRepositoryMockListLegacyUsernamesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListLegacyUsernamesExpectation specifies expectation struct of the ChatRepository.ListLegacyUsernames
+type ChatRepositoryMockListLegacyUsernamesExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListLegacyUsernamesParams
+	paramPtrs          *ChatRepositoryMockListLegacyUsernamesParamPtrs
+	expectationOrigins ChatRepositoryMockListLegacyUsernamesExpectationOrigins
+	results            *ChatRepositoryMockListLegacyUsernamesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListLegacyUsernamesParams contains parameters of the ChatRepository.ListLegacyUsernames
+type ChatRepositoryMockListLegacyUsernamesParams struct {
+	ctx context.Context
+}
+
+// ChatRepositoryMockListLegacyUsernamesParamPtrs contains pointers to parameters of the ChatRepository.ListLegacyUsernames
+type ChatRepositoryMockListLegacyUsernamesParamPtrs struct {
+	ctx *context.Context
+}
+
+// ChatRepositoryMockListLegacyUsernamesResults contains results of the ChatRepository.ListLegacyUsernames
+type ChatRepositoryMockListLegacyUsernamesResults struct {
+	sa1 []string
+	err error
+}
+
+// ChatRepositoryMockListLegacyUsernamesOrigins contains origins of expectations of the ChatRepository.ListLegacyUsernames
+type ChatRepositoryMockListLegacyUsernamesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) Optional() *mChatRepositoryMockListLegacyUsernames {
+	mmListLegacyUsernames.optional = true
+	return mmListLegacyUsernames
+}
+
+// Expect sets up expected params for ChatRepository.ListLegacyUsernames
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) Expect(ctx context.Context) *mChatRepositoryMockListLegacyUsernames {
+	if mmListLegacyUsernames.mock.funcListLegacyUsernames != nil {
+		mmListLegacyUsernames.mock.t.Fatalf("ChatRepositoryMock.ListLegacyUsernames mock is already set by Set")
+	}
+
+	if mmListLegacyUsernames.defaultExpectation == nil {
+		mmListLegacyUsernames.defaultExpectation = &ChatRepositoryMockListLegacyUsernamesExpectation{}
+	}
+
+	if mmListLegacyUsernames.defaultExpectation.paramPtrs != nil {
+		mmListLegacyUsernames.mock.t.Fatalf("ChatRepositoryMock.ListLegacyUsernames mock is already set by ExpectParams functions")
+	}
+
+	mmListLegacyUsernames.defaultExpectation.params = &ChatRepositoryMockListLegacyUsernamesParams{ctx}
+	mmListLegacyUsernames.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListLegacyUsernames.expectations {
+		if minimock.Equal(e.params, mmListLegacyUsernames.defaultExpectation.params) {
+			mmListLegacyUsernames.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListLegacyUsernames.defaultExpectation.params)
+		}
+	}
+
+	return mmListLegacyUsernames
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListLegacyUsernames
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListLegacyUsernames {
+	if mmListLegacyUsernames.mock.funcListLegacyUsernames != nil {
+		mmListLegacyUsernames.mock.t.Fatalf("ChatRepositoryMock.ListLegacyUsernames mock is already set by Set")
+	}
+
+	if mmListLegacyUsernames.defaultExpectation == nil {
+		mmListLegacyUsernames.defaultExpectation = &ChatRepositoryMockListLegacyUsernamesExpectation{}
+	}
+
+	if mmListLegacyUsernames.defaultExpectation.params != nil {
+		mmListLegacyUsernames.mock.t.Fatalf("ChatRepositoryMock.ListLegacyUsernames mock is already set by Expect")
+	}
+
+	if mmListLegacyUsernames.defaultExpectation.paramPtrs == nil {
+		mmListLegacyUsernames.defaultExpectation.paramPtrs = &ChatRepositoryMockListLegacyUsernamesParamPtrs{}
+	}
+	mmListLegacyUsernames.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListLegacyUsernames.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListLegacyUsernames
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListLegacyUsernames
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) Inspect(f func(ctx context.Context)) *mChatRepositoryMockListLegacyUsernames {
+	if mmListLegacyUsernames.mock.inspectFuncListLegacyUsernames != nil {
+		mmListLegacyUsernames.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListLegacyUsernames")
+	}
+
+	mmListLegacyUsernames.mock.inspectFuncListLegacyUsernames = f
+
+	return mmListLegacyUsernames
+}
+
+// Return sets up results that will be returned by ChatRepository.ListLegacyUsernames
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) Return(sa1 []string, err error) *ChatRepositoryMock {
+	if mmListLegacyUsernames.mock.funcListLegacyUsernames != nil {
+		mmListLegacyUsernames.mock.t.Fatalf("ChatRepositoryMock.ListLegacyUsernames mock is already set by Set")
+	}
+
+	if mmListLegacyUsernames.defaultExpectation == nil {
+		mmListLegacyUsernames.defaultExpectation = &ChatRepositoryMockListLegacyUsernamesExpectation{mock: mmListLegacyUsernames.mock}
+	}
+	mmListLegacyUsernames.defaultExpectation.results = &ChatRepositoryMockListLegacyUsernamesResults{sa1, err}
+	mmListLegacyUsernames.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListLegacyUsernames.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListLegacyUsernames method
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) Set(f func(ctx context.Context) (sa1 []string, err error)) *ChatRepositoryMock {
+	if mmListLegacyUsernames.defaultExpectation != nil {
+		mmListLegacyUsernames.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListLegacyUsernames method")
+	}
+
+	if len(mmListLegacyUsernames.expectations) > 0 {
+		mmListLegacyUsernames.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListLegacyUsernames method")
+	}
+
+	mmListLegacyUsernames.mock.funcListLegacyUsernames = f
+	mmListLegacyUsernames.mock.funcListLegacyUsernamesOrigin = minimock.CallerInfo(1)
+	return mmListLegacyUsernames.mock
+}
+
+// When sets expectation for the ChatRepository.ListLegacyUsernames which will trigger the result defined by the following
+// Then helper
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) When(ctx context.Context) *ChatRepositoryMockListLegacyUsernamesExpectation {
+	if mmListLegacyUsernames.mock.funcListLegacyUsernames != nil {
+		mmListLegacyUsernames.mock.t.Fatalf("ChatRepositoryMock.ListLegacyUsernames mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListLegacyUsernamesExpectation{
+		mock:               mmListLegacyUsernames.mock,
+		params:             &ChatRepositoryMockListLegacyUsernamesParams{ctx},
+		expectationOrigins: ChatRepositoryMockListLegacyUsernamesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListLegacyUsernames.expectations = append(mmListLegacyUsernames.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListLegacyUsernames return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListLegacyUsernamesExpectation) Then(sa1 []string, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListLegacyUsernamesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListLegacyUsernames should be invoked
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) Times(n uint64) *mChatRepositoryMockListLegacyUsernames {
+	if n == 0 {
+		mmListLegacyUsernames.mock.t.Fatalf("Times of ChatRepositoryMock.ListLegacyUsernames mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListLegacyUsernames.expectedInvocations, n)
+	mmListLegacyUsernames.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListLegacyUsernames
+}
+
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) invocationsDone() bool {
+	if len(mmListLegacyUsernames.expectations) == 0 && mmListLegacyUsernames.defaultExpectation == nil && mmListLegacyUsernames.mock.funcListLegacyUsernames == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListLegacyUsernames.mock.afterListLegacyUsernamesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListLegacyUsernames.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListLegacyUsernames implements mm_repository.ChatRepository
+func (mmListLegacyUsernames *ChatRepositoryMock) ListLegacyUsernames(ctx context.Context) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListLegacyUsernames.beforeListLegacyUsernamesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListLegacyUsernames.afterListLegacyUsernamesCounter, 1)
+
+	mmListLegacyUsernames.t.Helper()
+
+	if mmListLegacyUsernames.inspectFuncListLegacyUsernames != nil {
+		mmListLegacyUsernames.inspectFuncListLegacyUsernames(ctx)
+	}
+
+	mm_params := ChatRepositoryMockListLegacyUsernamesParams{ctx}
+
+	// Record call args
+	mmListLegacyUsernames.ListLegacyUsernamesMock.mutex.Lock()
+	mmListLegacyUsernames.ListLegacyUsernamesMock.callArgs = append(mmListLegacyUsernames.ListLegacyUsernamesMock.callArgs, &mm_params)
+	mmListLegacyUsernames.ListLegacyUsernamesMock.mutex.Unlock()
+
+	for _, e := range mmListLegacyUsernames.ListLegacyUsernamesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListLegacyUsernames.ListLegacyUsernamesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListLegacyUsernames.ListLegacyUsernamesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListLegacyUsernames.ListLegacyUsernamesMock.defaultExpectation.params
+		mm_want_ptrs := mmListLegacyUsernames.ListLegacyUsernamesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListLegacyUsernamesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListLegacyUsernames.t.Errorf("ChatRepositoryMock.ListLegacyUsernames got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLegacyUsernames.ListLegacyUsernamesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListLegacyUsernames.t.Errorf("ChatRepositoryMock.ListLegacyUsernames got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListLegacyUsernames.ListLegacyUsernamesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListLegacyUsernames.ListLegacyUsernamesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListLegacyUsernames.t.Fatal("No results are set for the ChatRepositoryMock.ListLegacyUsernames")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListLegacyUsernames.funcListLegacyUsernames != nil {
+		return mmListLegacyUsernames.funcListLegacyUsernames(ctx)
+	}
+	mmListLegacyUsernames.t.Fatalf("Unexpected call to ChatRepositoryMock.ListLegacyUsernames. %v", ctx)
+	return
+}
+
+// ListLegacyUsernamesAfterCounter returns a count of finished ChatRepositoryMock.ListLegacyUsernames invocations
+func (mmListLegacyUsernames *ChatRepositoryMock) ListLegacyUsernamesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLegacyUsernames.afterListLegacyUsernamesCounter)
+}
+
+// ListLegacyUsernamesBeforeCounter returns a count of ChatRepositoryMock.ListLegacyUsernames invocations
+func (mmListLegacyUsernames *ChatRepositoryMock) ListLegacyUsernamesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLegacyUsernames.beforeListLegacyUsernamesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListLegacyUsernames.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListLegacyUsernames *mChatRepositoryMockListLegacyUsernames) Calls() []*ChatRepositoryMockListLegacyUsernamesParams {
+	mmListLegacyUsernames.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListLegacyUsernamesParams, len(mmListLegacyUsernames.callArgs))
+	copy(argCopy, mmListLegacyUsernames.callArgs)
+
+	mmListLegacyUsernames.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListLegacyUsernamesDone returns true if the count of the ListLegacyUsernames invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListLegacyUsernamesDone() bool {
+	if m.ListLegacyUsernamesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListLegacyUsernamesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListLegacyUsernamesMock.invocationsDone()
+}
+
+// MinimockListLegacyUsernamesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListLegacyUsernamesInspect() {
+	for _, e := range m.ListLegacyUsernamesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListLegacyUsernames at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListLegacyUsernamesCounter := mm_atomic.LoadUint64(&m.afterListLegacyUsernamesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListLegacyUsernamesMock.defaultExpectation != nil && afterListLegacyUsernamesCounter < 1 {
+		if m.ListLegacyUsernamesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListLegacyUsernames at\n%s", m.ListLegacyUsernamesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListLegacyUsernames at\n%s with params: %#v", m.ListLegacyUsernamesMock.defaultExpectation.expectationOrigins.origin, *m.ListLegacyUsernamesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListLegacyUsernames != nil && afterListLegacyUsernamesCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListLegacyUsernames at\n%s", m.funcListLegacyUsernamesOrigin)
+	}
+
+	if !m.ListLegacyUsernamesMock.invocationsDone() && afterListLegacyUsernamesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListLegacyUsernames at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListLegacyUsernamesMock.expectedInvocations), m.ListLegacyUsernamesMock.expectedInvocationsOrigin, afterListLegacyUsernamesCounter)
+	}
+}
+
+type mChatRepositoryMockLockMembers struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockLockMembersExpectation
+	expectations       []*ChatRepositoryMockLockMembersExpectation
+
+	callArgs []*ChatRepositoryMockLockMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockLockMembersExpectation specifies expectation struct of the ChatRepository.LockMembers
+type ChatRepositoryMockLockMembersExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockLockMembersParams
+	paramPtrs          *ChatRepositoryMockLockMembersParamPtrs
+	expectationOrigins ChatRepositoryMockLockMembersExpectationOrigins
+	results            *ChatRepositoryMockLockMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockLockMembersParams contains parameters of the ChatRepository.LockMembers
+type ChatRepositoryMockLockMembersParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockLockMembersParamPtrs contains pointers to parameters of the ChatRepository.LockMembers
+type ChatRepositoryMockLockMembersParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockLockMembersResults contains results of the ChatRepository.LockMembers
+type ChatRepositoryMockLockMembersResults struct {
+	cpa1 []*model.ChatMember
+	err  error
+}
+
+// ChatRepositoryMockLockMembersOrigins contains origins of expectations of the ChatRepository.LockMembers
+type ChatRepositoryMockLockMembersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockMembers *mChatRepositoryMockLockMembers) Optional() *mChatRepositoryMockLockMembers {
+	mmLockMembers.optional = true
+	return mmLockMembers
+}
+
+// Expect sets up expected params for ChatRepository.LockMembers
+func (mmLockMembers *mChatRepositoryMockLockMembers) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockLockMembers {
+	if mmLockMembers.mock.funcLockMembers != nil {
+		mmLockMembers.mock.t.Fatalf("ChatRepositoryMock.LockMembers mock is already set by Set")
+	}
+
+	if mmLockMembers.defaultExpectation == nil {
+		mmLockMembers.defaultExpectation = &ChatRepositoryMockLockMembersExpectation{}
+	}
+
+	if mmLockMembers.defaultExpectation.paramPtrs != nil {
+		mmLockMembers.mock.t.Fatalf("ChatRepositoryMock.LockMembers mock is already set by ExpectParams functions")
+	}
+
+	mmLockMembers.defaultExpectation.params = &ChatRepositoryMockLockMembersParams{ctx, chatID}
+	mmLockMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockMembers.expectations {
+		if minimock.Equal(e.params, mmLockMembers.defaultExpectation.params) {
+			mmLockMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmLockMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.LockMembers
+func (mmLockMembers *mChatRepositoryMockLockMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockLockMembers {
+	if mmLockMembers.mock.funcLockMembers != nil {
+		mmLockMembers.mock.t.Fatalf("ChatRepositoryMock.LockMembers mock is already set by Set")
+	}
+
+	if mmLockMembers.defaultExpectation == nil {
+		mmLockMembers.defaultExpectation = &ChatRepositoryMockLockMembersExpectation{}
+	}
+
+	if mmLockMembers.defaultExpectation.params != nil {
+		mmLockMembers.mock.t.Fatalf("ChatRepositoryMock.LockMembers mock is already set by Expect")
+	}
+
+	if mmLockMembers.defaultExpectation.paramPtrs == nil {
+		mmLockMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockLockMembersParamPtrs{}
+	}
+	mmLockMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.LockMembers
+func (mmLockMembers *mChatRepositoryMockLockMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockLockMembers {
+	if mmLockMembers.mock.funcLockMembers != nil {
+		mmLockMembers.mock.t.Fatalf("ChatRepositoryMock.LockMembers mock is already set by Set")
+	}
+
+	if mmLockMembers.defaultExpectation == nil {
+		mmLockMembers.defaultExpectation = &ChatRepositoryMockLockMembersExpectation{}
+	}
+
+	if mmLockMembers.defaultExpectation.params != nil {
+		mmLockMembers.mock.t.Fatalf("ChatRepositoryMock.LockMembers mock is already set by Expect")
+	}
+
+	if mmLockMembers.defaultExpectation.paramPtrs == nil {
+		mmLockMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockLockMembersParamPtrs{}
+	}
+	mmLockMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmLockMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmLockMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.LockMembers
+func (mmLockMembers *mChatRepositoryMockLockMembers) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockLockMembers {
+	if mmLockMembers.mock.inspectFuncLockMembers != nil {
+		mmLockMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.LockMembers")
+	}
+
+	mmLockMembers.mock.inspectFuncLockMembers = f
+
+	return mmLockMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.LockMembers
+func (mmLockMembers *mChatRepositoryMockLockMembers) Return(cpa1 []*model.ChatMember, err error) *ChatRepositoryMock {
+	if mmLockMembers.mock.funcLockMembers != nil {
+		mmLockMembers.mock.t.Fatalf("ChatRepositoryMock.LockMembers mock is already set by Set")
+	}
+
+	if mmLockMembers.defaultExpectation == nil {
+		mmLockMembers.defaultExpectation = &ChatRepositoryMockLockMembersExpectation{mock: mmLockMembers.mock}
+	}
+	mmLockMembers.defaultExpectation.results = &ChatRepositoryMockLockMembersResults{cpa1, err}
+	mmLockMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockMembers.mock
+}
+
+// Set uses given function f to mock the ChatRepository.LockMembers method
+func (mmLockMembers *mChatRepositoryMockLockMembers) Set(f func(ctx context.Context, chatID int64) (cpa1 []*model.ChatMember, err error)) *ChatRepositoryMock {
+	if mmLockMembers.defaultExpectation != nil {
+		mmLockMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.LockMembers method")
+	}
+
+	if len(mmLockMembers.expectations) > 0 {
+		mmLockMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.LockMembers method")
+	}
+
+	mmLockMembers.mock.funcLockMembers = f
+	mmLockMembers.mock.funcLockMembersOrigin = minimock.CallerInfo(1)
+	return mmLockMembers.mock
+}
+
+// When sets expectation for the ChatRepository.LockMembers which will trigger the result defined by the following
+// Then helper
+func (mmLockMembers *mChatRepositoryMockLockMembers) When(ctx context.Context, chatID int64) *ChatRepositoryMockLockMembersExpectation {
+	if mmLockMembers.mock.funcLockMembers != nil {
+		mmLockMembers.mock.t.Fatalf("ChatRepositoryMock.LockMembers mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockLockMembersExpectation{
+		mock:               mmLockMembers.mock,
+		params:             &ChatRepositoryMockLockMembersParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockLockMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockMembers.expectations = append(mmLockMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.LockMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockLockMembersExpectation) Then(cpa1 []*model.ChatMember, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockLockMembersResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.LockMembers should be invoked
+func (mmLockMembers *mChatRepositoryMockLockMembers) Times(n uint64) *mChatRepositoryMockLockMembers {
+	if n == 0 {
+		mmLockMembers.mock.t.Fatalf("Times of ChatRepositoryMock.LockMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockMembers.expectedInvocations, n)
+	mmLockMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockMembers
+}
+
+func (mmLockMembers *mChatRepositoryMockLockMembers) invocationsDone() bool {
+	if len(mmLockMembers.expectations) == 0 && mmLockMembers.defaultExpectation == nil && mmLockMembers.mock.funcLockMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockMembers.mock.afterLockMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockMembers implements mm_repository.ChatRepository
+func (mmLockMembers *ChatRepositoryMock) LockMembers(ctx context.Context, chatID int64) (cpa1 []*model.ChatMember, err error) {
+	mm_atomic.AddUint64(&mmLockMembers.beforeLockMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockMembers.afterLockMembersCounter, 1)
+
+	mmLockMembers.t.Helper()
+
+	if mmLockMembers.inspectFuncLockMembers != nil {
+		mmLockMembers.inspectFuncLockMembers(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockLockMembersParams{ctx, chatID}
+
+	// Record call args
+	mmLockMembers.LockMembersMock.mutex.Lock()
+	mmLockMembers.LockMembersMock.callArgs = append(mmLockMembers.LockMembersMock.callArgs, &mm_params)
+	mmLockMembers.LockMembersMock.mutex.Unlock()
+
+	for _, e := range mmLockMembers.LockMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmLockMembers.LockMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockMembers.LockMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockMembers.LockMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmLockMembers.LockMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockLockMembersParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockMembers.t.Errorf("ChatRepositoryMock.LockMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockMembers.LockMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmLockMembers.t.Errorf("ChatRepositoryMock.LockMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockMembers.LockMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockMembers.t.Errorf("ChatRepositoryMock.LockMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockMembers.LockMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockMembers.LockMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockMembers.t.Fatal("No results are set for the ChatRepositoryMock.LockMembers")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmLockMembers.funcLockMembers != nil {
+		return mmLockMembers.funcLockMembers(ctx, chatID)
+	}
+	mmLockMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.LockMembers. %v %v", ctx, chatID)
+	return
+}
+
+// LockMembersAfterCounter returns a count of finished ChatRepositoryMock.LockMembers invocations
+func (mmLockMembers *ChatRepositoryMock) LockMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockMembers.afterLockMembersCounter)
+}
+
+// LockMembersBeforeCounter returns a count of ChatRepositoryMock.LockMembers invocations
+func (mmLockMembers *ChatRepositoryMock) LockMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockMembers.beforeLockMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.LockMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockMembers *mChatRepositoryMockLockMembers) Calls() []*ChatRepositoryMockLockMembersParams {
+	mmLockMembers.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockLockMembersParams, len(mmLockMembers.callArgs))
+	copy(argCopy, mmLockMembers.callArgs)
+
+	mmLockMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockMembersDone returns true if the count of the LockMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockLockMembersDone() bool {
+	if m.LockMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockMembersMock.invocationsDone()
+}
+
+// MinimockLockMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockLockMembersInspect() {
+	for _, e := range m.LockMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockMembersCounter := mm_atomic.LoadUint64(&m.afterLockMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockMembersMock.defaultExpectation != nil && afterLockMembersCounter < 1 {
+		if m.LockMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockMembers at\n%s", m.LockMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockMembers at\n%s with params: %#v", m.LockMembersMock.defaultExpectation.expectationOrigins.origin, *m.LockMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockMembers != nil && afterLockMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.LockMembers at\n%s", m.funcLockMembersOrigin)
+	}
+
+	if !m.LockMembersMock.invocationsDone() && afterLockMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.LockMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockMembersMock.expectedInvocations), m.LockMembersMock.expectedInvocationsOrigin, afterLockMembersCounter)
+	}
+}
+
+type mChatRepositoryMockMarkRead struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockMarkReadExpectation
+	expectations       []*ChatRepositoryMockMarkReadExpectation
+
+	callArgs []*ChatRepositoryMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockMarkReadExpectation specifies expectation struct of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockMarkReadParams
+	paramPtrs          *ChatRepositoryMockMarkReadParamPtrs
+	expectationOrigins ChatRepositoryMockMarkReadExpectationOrigins
+	results            *ChatRepositoryMockMarkReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockMarkReadParams contains parameters of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadParams struct {
+	ctx       context.Context
+	chatID    int64
+	userID    int64
+	messageID int64
+}
+
+// ChatRepositoryMockMarkReadParamPtrs contains pointers to parameters of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	userID    *int64
+	messageID *int64
+}
+
+// ChatRepositoryMockMarkReadResults contains results of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockMarkReadOrigins contains origins of expectations of the ChatRepository.MarkRead
+type ChatRepositoryMockMarkReadExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originUserID    string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mChatRepositoryMockMarkRead) Optional() *mChatRepositoryMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Expect(ctx context.Context, chatID int64, userID int64, messageID int64) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &ChatRepositoryMockMarkReadParams{ctx, chatID, userID, messageID}
+	mmMarkRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.chatID = &chatID
+	mmMarkRead.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectUserIDParam3(userID int64) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.userID = &userID
+	mmMarkRead.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectMessageIDParam4 sets up expected param messageID for ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) ExpectMessageIDParam4(messageID int64) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatRepositoryMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.messageID = &messageID
+	mmMarkRead.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Inspect(f func(ctx context.Context, chatID int64, userID int64, messageID int64)) *mChatRepositoryMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by ChatRepository.MarkRead
+func (mmMarkRead *mChatRepositoryMockMarkRead) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatRepositoryMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &ChatRepositoryMockMarkReadResults{b1, err}
+	mmMarkRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the ChatRepository.MarkRead method
+func (mmMarkRead *mChatRepositoryMockMarkRead) Set(f func(ctx context.Context, chatID int64, userID int64, messageID int64) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the ChatRepository.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the ChatRepository.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	mmMarkRead.mock.funcMarkReadOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the ChatRepository.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mChatRepositoryMockMarkRead) When(ctx context.Context, chatID int64, userID int64, messageID int64) *ChatRepositoryMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatRepositoryMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockMarkReadExpectation{
+		mock:               mmMarkRead.mock,
+		params:             &ChatRepositoryMockMarkReadParams{ctx, chatID, userID, messageID},
+		expectationOrigins: ChatRepositoryMockMarkReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.MarkRead return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockMarkReadExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockMarkReadResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.MarkRead should be invoked
+func (mmMarkRead *mChatRepositoryMockMarkRead) Times(n uint64) *mChatRepositoryMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of ChatRepositoryMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	mmMarkRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mChatRepositoryMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements mm_repository.ChatRepository
+func (mmMarkRead *ChatRepositoryMock) MarkRead(ctx context.Context, chatID int64, userID int64, messageID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	mmMarkRead.t.Helper()
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, chatID, userID, messageID)
+	}
+
+	mm_params := ChatRepositoryMockMarkReadParams{ctx, chatID, userID, messageID}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockMarkReadParams{ctx, chatID, userID, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("ChatRepositoryMock.MarkRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the ChatRepositoryMock.MarkRead")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, chatID, userID, messageID)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to ChatRepositoryMock.MarkRead. %v %v %v %v", ctx, chatID, userID, messageID)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished ChatRepositoryMock.MarkRead invocations
+func (mmMarkRead *ChatRepositoryMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of ChatRepositoryMock.MarkRead invocations
+func (mmMarkRead *ChatRepositoryMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mChatRepositoryMockMarkRead) Calls() []*ChatRepositoryMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s", m.MarkReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s with params: %#v", m.MarkReadMock.defaultExpectation.expectationOrigins.origin, *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.MarkRead at\n%s", m.funcMarkReadOrigin)
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.MarkRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), m.MarkReadMock.expectedInvocationsOrigin, afterMarkReadCounter)
+	}
+}
+
+type mChatRepositoryMockRemoveMember struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockRemoveMemberExpectation
+	expectations       []*ChatRepositoryMockRemoveMemberExpectation
+
+	callArgs []*ChatRepositoryMockRemoveMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockRemoveMemberExpectation specifies expectation struct of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockRemoveMemberParams
+	paramPtrs          *ChatRepositoryMockRemoveMemberParamPtrs
+	expectationOrigins ChatRepositoryMockRemoveMemberExpectationOrigins
+	results            *ChatRepositoryMockRemoveMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockRemoveMemberParams contains parameters of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberParams struct {
+	ctx    context.Context
+	chatID int64
+	userID int64
+}
+
+// ChatRepositoryMockRemoveMemberParamPtrs contains pointers to parameters of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *int64
+}
+
+// ChatRepositoryMockRemoveMemberResults contains results of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberResults struct {
+	err error
+}
+
+// ChatRepositoryMockRemoveMemberOrigins contains origins of expectations of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Optional() *mChatRepositoryMockRemoveMember {
+	mmRemoveMember.optional = true
+	return mmRemoveMember
+}
+
+// Expect sets up expected params for ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Expect(ctx context.Context, chatID int64, userID int64) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &ChatRepositoryMockRemoveMemberParams{ctx, chatID, userID}
+	mmRemoveMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRemoveMember.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) ExpectUserIDParam3(userID int64) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveMember.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Inspect(f func(ctx context.Context, chatID int64, userID int64)) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Return(err error) *ChatRepositoryMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &ChatRepositoryMockRemoveMemberResults{err}
+	mmRemoveMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the ChatRepository.RemoveMember method
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Set(f func(ctx context.Context, chatID int64, userID int64) (err error)) *ChatRepositoryMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the ChatRepository.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the ChatRepository.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	mmRemoveMember.mock.funcRemoveMemberOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the ChatRepository.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) When(ctx context.Context, chatID int64, userID int64) *ChatRepositoryMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockRemoveMemberExpectation{
+		mock:               mmRemoveMember.mock,
+		params:             &ChatRepositoryMockRemoveMemberParams{ctx, chatID, userID},
+		expectationOrigins: ChatRepositoryMockRemoveMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockRemoveMemberExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockRemoveMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.RemoveMember should be invoked
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Times(n uint64) *mChatRepositoryMockRemoveMember {
+	if n == 0 {
+		mmRemoveMember.mock.t.Fatalf("Times of ChatRepositoryMock.RemoveMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveMember.expectedInvocations, n)
+	mmRemoveMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember
+}
+
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) invocationsDone() bool {
+	if len(mmRemoveMember.expectations) == 0 && mmRemoveMember.defaultExpectation == nil && mmRemoveMember.mock.funcRemoveMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveMember.mock.afterRemoveMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveMember implements mm_repository.ChatRepository
+func (mmRemoveMember *ChatRepositoryMock) RemoveMember(ctx context.Context, chatID int64, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	mmRemoveMember.t.Helper()
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, chatID, userID)
+	}
+
+	mm_params := ChatRepositoryMockRemoveMemberParams{ctx, chatID, userID}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockRemoveMemberParams{ctx, chatID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("ChatRepositoryMock.RemoveMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveMember.t.Errorf("ChatRepositoryMock.RemoveMember got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveMember.t.Errorf("ChatRepositoryMock.RemoveMember got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("ChatRepositoryMock.RemoveMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the ChatRepositoryMock.RemoveMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, chatID, userID)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to ChatRepositoryMock.RemoveMember. %v %v %v", ctx, chatID, userID)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished ChatRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *ChatRepositoryMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
+}
+
+// RemoveMemberBeforeCounter returns a count of ChatRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *ChatRepositoryMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Calls() []*ChatRepositoryMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockRemoveMemberDone() bool {
+	if m.RemoveMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveMemberMock.invocationsDone()
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveMemberCounter := mm_atomic.LoadUint64(&m.afterRemoveMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && afterRemoveMemberCounter < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveMember at\n%s", m.RemoveMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveMember at\n%s with params: %#v", m.RemoveMemberMock.defaultExpectation.expectationOrigins.origin, *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && afterRemoveMemberCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.RemoveMember at\n%s", m.funcRemoveMemberOrigin)
+	}
+
+	if !m.RemoveMemberMock.invocationsDone() && afterRemoveMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.RemoveMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveMemberMock.expectedInvocations), m.RemoveMemberMock.expectedInvocationsOrigin, afterRemoveMemberCounter)
+	}
+}
+
+type mChatRepositoryMockResolveLegacyMember struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockResolveLegacyMemberExpectation
+	expectations       []*ChatRepositoryMockResolveLegacyMemberExpectation
+
+	callArgs []*ChatRepositoryMockResolveLegacyMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockResolveLegacyMemberExpectation specifies expectation struct of the ChatRepository.ResolveLegacyMember
+type ChatRepositoryMockResolveLegacyMemberExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockResolveLegacyMemberParams
+	paramPtrs          *ChatRepositoryMockResolveLegacyMemberParamPtrs
+	expectationOrigins ChatRepositoryMockResolveLegacyMemberExpectationOrigins
+	results            *ChatRepositoryMockResolveLegacyMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockResolveLegacyMemberParams contains parameters of the ChatRepository.ResolveLegacyMember
+type ChatRepositoryMockResolveLegacyMemberParams struct {
+	ctx  context.Context
+	user *model.User
+}
+
+// ChatRepositoryMockResolveLegacyMemberParamPtrs contains pointers to parameters of the ChatRepository.ResolveLegacyMember
+type ChatRepositoryMockResolveLegacyMemberParamPtrs struct {
+	ctx  *context.Context
+	user **model.User
+}
+
+// ChatRepositoryMockResolveLegacyMemberResults contains results of the ChatRepository.ResolveLegacyMember
+type ChatRepositoryMockResolveLegacyMemberResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockResolveLegacyMemberOrigins contains origins of expectations of the ChatRepository.ResolveLegacyMember
+type ChatRepositoryMockResolveLegacyMemberExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) Optional() *mChatRepositoryMockResolveLegacyMember {
+	mmResolveLegacyMember.optional = true
+	return mmResolveLegacyMember
+}
+
+// Expect sets up expected params for ChatRepository.ResolveLegacyMember
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) Expect(ctx context.Context, user *model.User) *mChatRepositoryMockResolveLegacyMember {
+	if mmResolveLegacyMember.mock.funcResolveLegacyMember != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("ChatRepositoryMock.ResolveLegacyMember mock is already set by Set")
+	}
+
+	if mmResolveLegacyMember.defaultExpectation == nil {
+		mmResolveLegacyMember.defaultExpectation = &ChatRepositoryMockResolveLegacyMemberExpectation{}
+	}
+
+	if mmResolveLegacyMember.defaultExpectation.paramPtrs != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("ChatRepositoryMock.ResolveLegacyMember mock is already set by ExpectParams functions")
+	}
+
+	mmResolveLegacyMember.defaultExpectation.params = &ChatRepositoryMockResolveLegacyMemberParams{ctx, user}
+	mmResolveLegacyMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResolveLegacyMember.expectations {
+		if minimock.Equal(e.params, mmResolveLegacyMember.defaultExpectation.params) {
+			mmResolveLegacyMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResolveLegacyMember.defaultExpectation.params)
+		}
+	}
+
+	return mmResolveLegacyMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ResolveLegacyMember
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockResolveLegacyMember {
+	if mmResolveLegacyMember.mock.funcResolveLegacyMember != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("ChatRepositoryMock.ResolveLegacyMember mock is already set by Set")
+	}
+
+	if mmResolveLegacyMember.defaultExpectation == nil {
+		mmResolveLegacyMember.defaultExpectation = &ChatRepositoryMockResolveLegacyMemberExpectation{}
+	}
+
+	if mmResolveLegacyMember.defaultExpectation.params != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("ChatRepositoryMock.ResolveLegacyMember mock is already set by Expect")
+	}
+
+	if mmResolveLegacyMember.defaultExpectation.paramPtrs == nil {
+		mmResolveLegacyMember.defaultExpectation.paramPtrs = &ChatRepositoryMockResolveLegacyMemberParamPtrs{}
+	}
+	mmResolveLegacyMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResolveLegacyMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmResolveLegacyMember
+}
+
+// ExpectUserParam2 sets up expected param user for ChatRepository.ResolveLegacyMember
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) ExpectUserParam2(user *model.User) *mChatRepositoryMockResolveLegacyMember {
+	if mmResolveLegacyMember.mock.funcResolveLegacyMember != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("ChatRepositoryMock.ResolveLegacyMember mock is already set by Set")
+	}
+
+	if mmResolveLegacyMember.defaultExpectation == nil {
+		mmResolveLegacyMember.defaultExpectation = &ChatRepositoryMockResolveLegacyMemberExpectation{}
+	}
+
+	if mmResolveLegacyMember.defaultExpectation.params != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("ChatRepositoryMock.ResolveLegacyMember mock is already set by Expect")
+	}
+
+	if mmResolveLegacyMember.defaultExpectation.paramPtrs == nil {
+		mmResolveLegacyMember.defaultExpectation.paramPtrs = &ChatRepositoryMockResolveLegacyMemberParamPtrs{}
+	}
+	mmResolveLegacyMember.defaultExpectation.paramPtrs.user = &user
+	mmResolveLegacyMember.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmResolveLegacyMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ResolveLegacyMember
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) Inspect(f func(ctx context.Context, user *model.User)) *mChatRepositoryMockResolveLegacyMember {
+	if mmResolveLegacyMember.mock.inspectFuncResolveLegacyMember != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ResolveLegacyMember")
+	}
+
+	mmResolveLegacyMember.mock.inspectFuncResolveLegacyMember = f
+
+	return mmResolveLegacyMember
+}
+
+// Return sets up results that will be returned by ChatRepository.ResolveLegacyMember
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmResolveLegacyMember.mock.funcResolveLegacyMember != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("ChatRepositoryMock.ResolveLegacyMember mock is already set by Set")
+	}
+
+	if mmResolveLegacyMember.defaultExpectation == nil {
+		mmResolveLegacyMember.defaultExpectation = &ChatRepositoryMockResolveLegacyMemberExpectation{mock: mmResolveLegacyMember.mock}
+	}
+	mmResolveLegacyMember.defaultExpectation.results = &ChatRepositoryMockResolveLegacyMemberResults{i1, err}
+	mmResolveLegacyMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResolveLegacyMember.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ResolveLegacyMember method
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) Set(f func(ctx context.Context, user *model.User) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmResolveLegacyMember.defaultExpectation != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ResolveLegacyMember method")
+	}
+
+	if len(mmResolveLegacyMember.expectations) > 0 {
+		mmResolveLegacyMember.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ResolveLegacyMember method")
+	}
+
+	mmResolveLegacyMember.mock.funcResolveLegacyMember = f
+	mmResolveLegacyMember.mock.funcResolveLegacyMemberOrigin = minimock.CallerInfo(1)
+	return mmResolveLegacyMember.mock
+}
+
+// When sets expectation for the ChatRepository.ResolveLegacyMember which will trigger the result defined by the following
+// Then helper
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) When(ctx context.Context, user *model.User) *ChatRepositoryMockResolveLegacyMemberExpectation {
+	if mmResolveLegacyMember.mock.funcResolveLegacyMember != nil {
+		mmResolveLegacyMember.mock.t.Fatalf("ChatRepositoryMock.ResolveLegacyMember mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockResolveLegacyMemberExpectation{
+		mock:               mmResolveLegacyMember.mock,
+		params:             &ChatRepositoryMockResolveLegacyMemberParams{ctx, user},
+		expectationOrigins: ChatRepositoryMockResolveLegacyMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmResolveLegacyMember.expectations = append(mmResolveLegacyMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ResolveLegacyMember return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockResolveLegacyMemberExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockResolveLegacyMemberResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ResolveLegacyMember should be invoked
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) Times(n uint64) *mChatRepositoryMockResolveLegacyMember {
+	if n == 0 {
+		mmResolveLegacyMember.mock.t.Fatalf("Times of ChatRepositoryMock.ResolveLegacyMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResolveLegacyMember.expectedInvocations, n)
+	mmResolveLegacyMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResolveLegacyMember
+}
+
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) invocationsDone() bool {
+	if len(mmResolveLegacyMember.expectations) == 0 && mmResolveLegacyMember.defaultExpectation == nil && mmResolveLegacyMember.mock.funcResolveLegacyMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResolveLegacyMember.mock.afterResolveLegacyMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResolveLegacyMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ResolveLegacyMember implements mm_repository.ChatRepository
+func (mmResolveLegacyMember *ChatRepositoryMock) ResolveLegacyMember(ctx context.Context, user *model.User) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmResolveLegacyMember.beforeResolveLegacyMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmResolveLegacyMember.afterResolveLegacyMemberCounter, 1)
+
+	mmResolveLegacyMember.t.Helper()
+
+	if mmResolveLegacyMember.inspectFuncResolveLegacyMember != nil {
+		mmResolveLegacyMember.inspectFuncResolveLegacyMember(ctx, user)
+	}
+
+	mm_params := ChatRepositoryMockResolveLegacyMemberParams{ctx, user}
+
+	// Record call args
+	mmResolveLegacyMember.ResolveLegacyMemberMock.mutex.Lock()
+	mmResolveLegacyMember.ResolveLegacyMemberMock.callArgs = append(mmResolveLegacyMember.ResolveLegacyMemberMock.callArgs, &mm_params)
+	mmResolveLegacyMember.ResolveLegacyMemberMock.mutex.Unlock()
+
+	for _, e := range mmResolveLegacyMember.ResolveLegacyMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmResolveLegacyMember.ResolveLegacyMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResolveLegacyMember.ResolveLegacyMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmResolveLegacyMember.ResolveLegacyMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmResolveLegacyMember.ResolveLegacyMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockResolveLegacyMemberParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResolveLegacyMember.t.Errorf("ChatRepositoryMock.ResolveLegacyMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResolveLegacyMember.ResolveLegacyMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmResolveLegacyMember.t.Errorf("ChatRepositoryMock.ResolveLegacyMember got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResolveLegacyMember.ResolveLegacyMemberMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResolveLegacyMember.t.Errorf("ChatRepositoryMock.ResolveLegacyMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResolveLegacyMember.ResolveLegacyMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResolveLegacyMember.ResolveLegacyMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResolveLegacyMember.t.Fatal("No results are set for the ChatRepositoryMock.ResolveLegacyMember")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmResolveLegacyMember.funcResolveLegacyMember != nil {
+		return mmResolveLegacyMember.funcResolveLegacyMember(ctx, user)
+	}
+	mmResolveLegacyMember.t.Fatalf("Unexpected call to ChatRepositoryMock.ResolveLegacyMember. %v %v", ctx, user)
+	return
+}
+
+// ResolveLegacyMemberAfterCounter returns a count of finished ChatRepositoryMock.ResolveLegacyMember invocations
+func (mmResolveLegacyMember *ChatRepositoryMock) ResolveLegacyMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolveLegacyMember.afterResolveLegacyMemberCounter)
+}
+
+// ResolveLegacyMemberBeforeCounter returns a count of ChatRepositoryMock.ResolveLegacyMember invocations
+func (mmResolveLegacyMember *ChatRepositoryMock) ResolveLegacyMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolveLegacyMember.beforeResolveLegacyMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ResolveLegacyMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResolveLegacyMember *mChatRepositoryMockResolveLegacyMember) Calls() []*ChatRepositoryMockResolveLegacyMemberParams {
+	mmResolveLegacyMember.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockResolveLegacyMemberParams, len(mmResolveLegacyMember.callArgs))
+	copy(argCopy, mmResolveLegacyMember.callArgs)
+
+	mmResolveLegacyMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResolveLegacyMemberDone returns true if the count of the ResolveLegacyMember invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockResolveLegacyMemberDone() bool {
+	if m.ResolveLegacyMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResolveLegacyMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResolveLegacyMemberMock.invocationsDone()
+}
+
+// MinimockResolveLegacyMemberInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockResolveLegacyMemberInspect() {
+	for _, e := range m.ResolveLegacyMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ResolveLegacyMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResolveLegacyMemberCounter := mm_atomic.LoadUint64(&m.afterResolveLegacyMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResolveLegacyMemberMock.defaultExpectation != nil && afterResolveLegacyMemberCounter < 1 {
+		if m.ResolveLegacyMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ResolveLegacyMember at\n%s", m.ResolveLegacyMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ResolveLegacyMember at\n%s with params: %#v", m.ResolveLegacyMemberMock.defaultExpectation.expectationOrigins.origin, *m.ResolveLegacyMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResolveLegacyMember != nil && afterResolveLegacyMemberCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ResolveLegacyMember at\n%s", m.funcResolveLegacyMemberOrigin)
+	}
+
+	if !m.ResolveLegacyMemberMock.invocationsDone() && afterResolveLegacyMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ResolveLegacyMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResolveLegacyMemberMock.expectedInvocations), m.ResolveLegacyMemberMock.expectedInvocationsOrigin, afterResolveLegacyMemberCounter)
+	}
+}
+
+type mChatRepositoryMockUpdate struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockUpdateExpectation
+	expectations       []*ChatRepositoryMockUpdateExpectation
+
+	callArgs []*ChatRepositoryMockUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockUpdateExpectation specifies expectation struct of the ChatRepository.Update
+type ChatRepositoryMockUpdateExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockUpdateParams
+	paramPtrs          *ChatRepositoryMockUpdateParamPtrs
+	expectationOrigins ChatRepositoryMockUpdateExpectationOrigins
+	results            *ChatRepositoryMockUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockUpdateParams contains parameters of the ChatRepository.Update
+type ChatRepositoryMockUpdateParams struct {
+	ctx  context.Context
+	id   int64
+	data *model.UpdateChatData
+}
+
+// ChatRepositoryMockUpdateParamPtrs contains pointers to parameters of the ChatRepository.Update
+type ChatRepositoryMockUpdateParamPtrs struct {
+	ctx  *context.Context
+	id   *int64
+	data **model.UpdateChatData
+}
+
+// ChatRepositoryMockUpdateResults contains results of the ChatRepository.Update
+type ChatRepositoryMockUpdateResults struct {
+	err error
+}
+
+// ChatRepositoryMockUpdateOrigins contains origins of expectations of the ChatRepository.Update
+type ChatRepositoryMockUpdateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originId   string
+	originData string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdate *mChatRepositoryMockUpdate) Optional() *mChatRepositoryMockUpdate {
+	mmUpdate.optional = true
+	return mmUpdate
+}
+
+// Expect sets up expected params for ChatRepository.Update
+func (mmUpdate *mChatRepositoryMockUpdate) Expect(ctx context.Context, id int64, data *model.UpdateChatData) *mChatRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ChatRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by ExpectParams functions")
+	}
+
+	mmUpdate.defaultExpectation.params = &ChatRepositoryMockUpdateParams{ctx, id, data}
+	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.Update
+func (mmUpdate *mChatRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ChatRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectIdParam2 sets up expected param id for ChatRepository.Update
+func (mmUpdate *mChatRepositoryMockUpdate) ExpectIdParam2(id int64) *mChatRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ChatRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.id = &id
+	mmUpdate.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// ExpectDataParam3 sets up expected param data for ChatRepository.Update
+func (mmUpdate *mChatRepositoryMockUpdate) ExpectDataParam3(data *model.UpdateChatData) *mChatRepositoryMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ChatRepositoryMockUpdateExpectation{}
+	}
+
+	if mmUpdate.defaultExpectation.params != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Expect")
+	}
+
+	if mmUpdate.defaultExpectation.paramPtrs == nil {
+		mmUpdate.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateParamPtrs{}
+	}
+	mmUpdate.defaultExpectation.paramPtrs.data = &data
+	mmUpdate.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.Update
+func (mmUpdate *mChatRepositoryMockUpdate) Inspect(f func(ctx context.Context, id int64, data *model.UpdateChatData)) *mChatRepositoryMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by ChatRepository.Update
+func (mmUpdate *mChatRepositoryMockUpdate) Return(err error) *ChatRepositoryMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ChatRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &ChatRepositoryMockUpdateResults{err}
+	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// Set uses given function f to mock the ChatRepository.Update method
+func (mmUpdate *mChatRepositoryMockUpdate) Set(f func(ctx context.Context, id int64, data *model.UpdateChatData) (err error)) *ChatRepositoryMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the ChatRepository.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the ChatRepository.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
+	return mmUpdate.mock
+}
+
+// When sets expectation for the ChatRepository.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mChatRepositoryMockUpdate) When(ctx context.Context, id int64, data *model.UpdateChatData) *ChatRepositoryMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ChatRepositoryMock.Update mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockUpdateExpectation{
+		mock:               mmUpdate.mock,
+		params:             &ChatRepositoryMockUpdateParams{ctx, id, data},
+		expectationOrigins: ChatRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.Update return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockUpdateExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.Update should be invoked
+func (mmUpdate *mChatRepositoryMockUpdate) Times(n uint64) *mChatRepositoryMockUpdate {
+	if n == 0 {
+		mmUpdate.mock.t.Fatalf("Times of ChatRepositoryMock.Update mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
+	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdate
+}
+
+func (mmUpdate *mChatRepositoryMockUpdate) invocationsDone() bool {
+	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Update implements mm_repository.ChatRepository
+func (mmUpdate *ChatRepositoryMock) Update(ctx context.Context, id int64, data *model.UpdateChatData) (err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	mmUpdate.t.Helper()
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, id, data)
+	}
+
+	mm_params := ChatRepositoryMockUpdateParams{ctx, id, data}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockUpdateParams{ctx, id, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdate.t.Errorf("ChatRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdate.t.Errorf("ChatRepositoryMock.Update got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmUpdate.t.Errorf("ChatRepositoryMock.Update got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("ChatRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the ChatRepositoryMock.Update")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, id, data)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to ChatRepositoryMock.Update. %v %v %v", ctx, id, data)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished ChatRepositoryMock.Update invocations
+func (mmUpdate *ChatRepositoryMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of ChatRepositoryMock.Update invocations
+func (mmUpdate *ChatRepositoryMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mChatRepositoryMockUpdate) Calls() []*ChatRepositoryMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockUpdateDone() bool {
+	if m.UpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMock.invocationsDone()
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && afterUpdateCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	}
+
+	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.Update at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	}
+}
+
+type mChatRepositoryMockUpdateLastMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockUpdateLastMessageExpectation
+	expectations       []*ChatRepositoryMockUpdateLastMessageExpectation
+
+	callArgs []*ChatRepositoryMockUpdateLastMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockUpdateLastMessageExpectation specifies expectation struct of the ChatRepository.UpdateLastMessage
+type ChatRepositoryMockUpdateLastMessageExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockUpdateLastMessageParams
+	paramPtrs          *ChatRepositoryMockUpdateLastMessageParamPtrs
+	expectationOrigins ChatRepositoryMockUpdateLastMessageExpectationOrigins
+	results            *ChatRepositoryMockUpdateLastMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockUpdateLastMessageParams contains parameters of the ChatRepository.UpdateLastMessage
+type ChatRepositoryMockUpdateLastMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+}
+
+// ChatRepositoryMockUpdateLastMessageParamPtrs contains pointers to parameters of the ChatRepository.UpdateLastMessage
+type ChatRepositoryMockUpdateLastMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+}
+
+// ChatRepositoryMockUpdateLastMessageResults contains results of the ChatRepository.UpdateLastMessage
+type ChatRepositoryMockUpdateLastMessageResults struct {
+	err error
+}
+
+// ChatRepositoryMockUpdateLastMessageOrigins contains origins of expectations of the ChatRepository.UpdateLastMessage
+type ChatRepositoryMockUpdateLastMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) Optional() *mChatRepositoryMockUpdateLastMessage {
+	mmUpdateLastMessage.optional = true
+	return mmUpdateLastMessage
+}
+
+// Expect sets up expected params for ChatRepository.UpdateLastMessage
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) Expect(ctx context.Context, chatID int64, messageID int64) *mChatRepositoryMockUpdateLastMessage {
+	if mmUpdateLastMessage.mock.funcUpdateLastMessage != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Set")
+	}
+
+	if mmUpdateLastMessage.defaultExpectation == nil {
+		mmUpdateLastMessage.defaultExpectation = &ChatRepositoryMockUpdateLastMessageExpectation{}
+	}
+
+	if mmUpdateLastMessage.defaultExpectation.paramPtrs != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateLastMessage.defaultExpectation.params = &ChatRepositoryMockUpdateLastMessageParams{ctx, chatID, messageID}
+	mmUpdateLastMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateLastMessage.expectations {
+		if minimock.Equal(e.params, mmUpdateLastMessage.defaultExpectation.params) {
+			mmUpdateLastMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateLastMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateLastMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.UpdateLastMessage
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockUpdateLastMessage {
+	if mmUpdateLastMessage.mock.funcUpdateLastMessage != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Set")
+	}
+
+	if mmUpdateLastMessage.defaultExpectation == nil {
+		mmUpdateLastMessage.defaultExpectation = &ChatRepositoryMockUpdateLastMessageExpectation{}
+	}
+
+	if mmUpdateLastMessage.defaultExpectation.params != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Expect")
+	}
+
+	if mmUpdateLastMessage.defaultExpectation.paramPtrs == nil {
+		mmUpdateLastMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateLastMessageParamPtrs{}
+	}
+	mmUpdateLastMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateLastMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateLastMessage
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.UpdateLastMessage
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockUpdateLastMessage {
+	if mmUpdateLastMessage.mock.funcUpdateLastMessage != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Set")
+	}
+
+	if mmUpdateLastMessage.defaultExpectation == nil {
+		mmUpdateLastMessage.defaultExpectation = &ChatRepositoryMockUpdateLastMessageExpectation{}
+	}
+
+	if mmUpdateLastMessage.defaultExpectation.params != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Expect")
+	}
+
+	if mmUpdateLastMessage.defaultExpectation.paramPtrs == nil {
+		mmUpdateLastMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateLastMessageParamPtrs{}
+	}
+	mmUpdateLastMessage.defaultExpectation.paramPtrs.chatID = &chatID
+	mmUpdateLastMessage.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmUpdateLastMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatRepository.UpdateLastMessage
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) ExpectMessageIDParam3(messageID int64) *mChatRepositoryMockUpdateLastMessage {
+	if mmUpdateLastMessage.mock.funcUpdateLastMessage != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Set")
+	}
+
+	if mmUpdateLastMessage.defaultExpectation == nil {
+		mmUpdateLastMessage.defaultExpectation = &ChatRepositoryMockUpdateLastMessageExpectation{}
+	}
+
+	if mmUpdateLastMessage.defaultExpectation.params != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Expect")
+	}
+
+	if mmUpdateLastMessage.defaultExpectation.paramPtrs == nil {
+		mmUpdateLastMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateLastMessageParamPtrs{}
+	}
+	mmUpdateLastMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmUpdateLastMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmUpdateLastMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.UpdateLastMessage
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) Inspect(f func(ctx context.Context, chatID int64, messageID int64)) *mChatRepositoryMockUpdateLastMessage {
+	if mmUpdateLastMessage.mock.inspectFuncUpdateLastMessage != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.UpdateLastMessage")
+	}
+
+	mmUpdateLastMessage.mock.inspectFuncUpdateLastMessage = f
+
+	return mmUpdateLastMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.UpdateLastMessage
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) Return(err error) *ChatRepositoryMock {
+	if mmUpdateLastMessage.mock.funcUpdateLastMessage != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Set")
+	}
+
+	if mmUpdateLastMessage.defaultExpectation == nil {
+		mmUpdateLastMessage.defaultExpectation = &ChatRepositoryMockUpdateLastMessageExpectation{mock: mmUpdateLastMessage.mock}
+	}
+	mmUpdateLastMessage.defaultExpectation.results = &ChatRepositoryMockUpdateLastMessageResults{err}
+	mmUpdateLastMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateLastMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.UpdateLastMessage method
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) Set(f func(ctx context.Context, chatID int64, messageID int64) (err error)) *ChatRepositoryMock {
+	if mmUpdateLastMessage.defaultExpectation != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.UpdateLastMessage method")
+	}
+
+	if len(mmUpdateLastMessage.expectations) > 0 {
+		mmUpdateLastMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.UpdateLastMessage method")
+	}
+
+	mmUpdateLastMessage.mock.funcUpdateLastMessage = f
+	mmUpdateLastMessage.mock.funcUpdateLastMessageOrigin = minimock.CallerInfo(1)
+	return mmUpdateLastMessage.mock
+}
+
+// When sets expectation for the ChatRepository.UpdateLastMessage which will trigger the result defined by the following
+// Then helper
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) When(ctx context.Context, chatID int64, messageID int64) *ChatRepositoryMockUpdateLastMessageExpectation {
+	if mmUpdateLastMessage.mock.funcUpdateLastMessage != nil {
+		mmUpdateLastMessage.mock.t.Fatalf("ChatRepositoryMock.UpdateLastMessage mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockUpdateLastMessageExpectation{
+		mock:               mmUpdateLastMessage.mock,
+		params:             &ChatRepositoryMockUpdateLastMessageParams{ctx, chatID, messageID},
+		expectationOrigins: ChatRepositoryMockUpdateLastMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateLastMessage.expectations = append(mmUpdateLastMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.UpdateLastMessage return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockUpdateLastMessageExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockUpdateLastMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.UpdateLastMessage should be invoked
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) Times(n uint64) *mChatRepositoryMockUpdateLastMessage {
+	if n == 0 {
+		mmUpdateLastMessage.mock.t.Fatalf("Times of ChatRepositoryMock.UpdateLastMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateLastMessage.expectedInvocations, n)
+	mmUpdateLastMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateLastMessage
+}
+
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) invocationsDone() bool {
+	if len(mmUpdateLastMessage.expectations) == 0 && mmUpdateLastMessage.defaultExpectation == nil && mmUpdateLastMessage.mock.funcUpdateLastMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateLastMessage.mock.afterUpdateLastMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateLastMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateLastMessage implements mm_repository.ChatRepository
+func (mmUpdateLastMessage *ChatRepositoryMock) UpdateLastMessage(ctx context.Context, chatID int64, messageID int64) (err error) {
+	mm_atomic.AddUint64(&mmUpdateLastMessage.beforeUpdateLastMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateLastMessage.afterUpdateLastMessageCounter, 1)
+
+	mmUpdateLastMessage.t.Helper()
+
+	if mmUpdateLastMessage.inspectFuncUpdateLastMessage != nil {
+		mmUpdateLastMessage.inspectFuncUpdateLastMessage(ctx, chatID, messageID)
+	}
+
+	mm_params := ChatRepositoryMockUpdateLastMessageParams{ctx, chatID, messageID}
+
+	// Record call args
+	mmUpdateLastMessage.UpdateLastMessageMock.mutex.Lock()
+	mmUpdateLastMessage.UpdateLastMessageMock.callArgs = append(mmUpdateLastMessage.UpdateLastMessageMock.callArgs, &mm_params)
+	mmUpdateLastMessage.UpdateLastMessageMock.mutex.Unlock()
+
+	for _, e := range mmUpdateLastMessage.UpdateLastMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockUpdateLastMessageParams{ctx, chatID, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateLastMessage.t.Errorf("ChatRepositoryMock.UpdateLastMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmUpdateLastMessage.t.Errorf("ChatRepositoryMock.UpdateLastMessage got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmUpdateLastMessage.t.Errorf("ChatRepositoryMock.UpdateLastMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateLastMessage.t.Errorf("ChatRepositoryMock.UpdateLastMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateLastMessage.UpdateLastMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateLastMessage.t.Fatal("No results are set for the ChatRepositoryMock.UpdateLastMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateLastMessage.funcUpdateLastMessage != nil {
+		return mmUpdateLastMessage.funcUpdateLastMessage(ctx, chatID, messageID)
+	}
+	mmUpdateLastMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.UpdateLastMessage. %v %v %v", ctx, chatID, messageID)
+	return
+}
+
+// UpdateLastMessageAfterCounter returns a count of finished ChatRepositoryMock.UpdateLastMessage invocations
+func (mmUpdateLastMessage *ChatRepositoryMock) UpdateLastMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateLastMessage.afterUpdateLastMessageCounter)
+}
+
+// UpdateLastMessageBeforeCounter returns a count of ChatRepositoryMock.UpdateLastMessage invocations
+func (mmUpdateLastMessage *ChatRepositoryMock) UpdateLastMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateLastMessage.beforeUpdateLastMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.UpdateLastMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateLastMessage *mChatRepositoryMockUpdateLastMessage) Calls() []*ChatRepositoryMockUpdateLastMessageParams {
+	mmUpdateLastMessage.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockUpdateLastMessageParams, len(mmUpdateLastMessage.callArgs))
+	copy(argCopy, mmUpdateLastMessage.callArgs)
+
+	mmUpdateLastMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateLastMessageDone returns true if the count of the UpdateLastMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockUpdateLastMessageDone() bool {
+	if m.UpdateLastMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateLastMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateLastMessageMock.invocationsDone()
+}
+
+// MinimockUpdateLastMessageInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockUpdateLastMessageInspect() {
+	for _, e := range m.UpdateLastMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateLastMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateLastMessageCounter := mm_atomic.LoadUint64(&m.afterUpdateLastMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateLastMessageMock.defaultExpectation != nil && afterUpdateLastMessageCounter < 1 {
+		if m.UpdateLastMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateLastMessage at\n%s", m.UpdateLastMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateLastMessage at\n%s with params: %#v", m.UpdateLastMessageMock.defaultExpectation.expectationOrigins.origin, *m.UpdateLastMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateLastMessage != nil && afterUpdateLastMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.UpdateLastMessage at\n%s", m.funcUpdateLastMessageOrigin)
+	}
+
+	if !m.UpdateLastMessageMock.invocationsDone() && afterUpdateLastMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.UpdateLastMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateLastMessageMock.expectedInvocations), m.UpdateLastMessageMock.expectedInvocationsOrigin, afterUpdateLastMessageCounter)
+	}
+}
+
+type mChatRepositoryMockUpdateMemberRole struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockUpdateMemberRoleExpectation
+	expectations       []*ChatRepositoryMockUpdateMemberRoleExpectation
+
+	callArgs []*ChatRepositoryMockUpdateMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockUpdateMemberRoleExpectation specifies expectation struct of the ChatRepository.UpdateMemberRole
+type ChatRepositoryMockUpdateMemberRoleExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockUpdateMemberRoleParams
+	paramPtrs          *ChatRepositoryMockUpdateMemberRoleParamPtrs
+	expectationOrigins ChatRepositoryMockUpdateMemberRoleExpectationOrigins
+	results            *ChatRepositoryMockUpdateMemberRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockUpdateMemberRoleParams contains parameters of the ChatRepository.UpdateMemberRole
+type ChatRepositoryMockUpdateMemberRoleParams struct {
+	ctx    context.Context
+	chatID int64
+	userID int64
+	role   model.ChatRole
+}
+
+// ChatRepositoryMockUpdateMemberRoleParamPtrs contains pointers to parameters of the ChatRepository.UpdateMemberRole
+type ChatRepositoryMockUpdateMemberRoleParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	userID *int64
+	role   *model.ChatRole
+}
+
+// ChatRepositoryMockUpdateMemberRoleResults contains results of the ChatRepository.UpdateMemberRole
+type ChatRepositoryMockUpdateMemberRoleResults struct {
+	err error
+}
+
+// ChatRepositoryMockUpdateMemberRoleOrigins contains origins of expectations of the ChatRepository.UpdateMemberRole
+type ChatRepositoryMockUpdateMemberRoleExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+	originUserID string
+	originRole   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) Optional() *mChatRepositoryMockUpdateMemberRole {
+	mmUpdateMemberRole.optional = true
+	return mmUpdateMemberRole
+}
+
+// Expect sets up expected params for ChatRepository.UpdateMemberRole
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) Expect(ctx context.Context, chatID int64, userID int64, role model.ChatRole) *mChatRepositoryMockUpdateMemberRole {
+	if mmUpdateMemberRole.mock.funcUpdateMemberRole != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Set")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation == nil {
+		mmUpdateMemberRole.defaultExpectation = &ChatRepositoryMockUpdateMemberRoleExpectation{}
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.paramPtrs != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateMemberRole.defaultExpectation.params = &ChatRepositoryMockUpdateMemberRoleParams{ctx, chatID, userID, role}
+	mmUpdateMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateMemberRole.expectations {
+		if minimock.Equal(e.params, mmUpdateMemberRole.defaultExpectation.params) {
+			mmUpdateMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateMemberRole.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.UpdateMemberRole
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockUpdateMemberRole {
+	if mmUpdateMemberRole.mock.funcUpdateMemberRole != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Set")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation == nil {
+		mmUpdateMemberRole.defaultExpectation = &ChatRepositoryMockUpdateMemberRoleExpectation{}
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.params != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Expect")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateMemberRoleParamPtrs{}
+	}
+	mmUpdateMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateMemberRole
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.UpdateMemberRole
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockUpdateMemberRole {
+	if mmUpdateMemberRole.mock.funcUpdateMemberRole != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Set")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation == nil {
+		mmUpdateMemberRole.defaultExpectation = &ChatRepositoryMockUpdateMemberRoleExpectation{}
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.params != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Expect")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateMemberRoleParamPtrs{}
+	}
+	mmUpdateMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+	mmUpdateMemberRole.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmUpdateMemberRole
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ChatRepository.UpdateMemberRole
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) ExpectUserIDParam3(userID int64) *mChatRepositoryMockUpdateMemberRole {
+	if mmUpdateMemberRole.mock.funcUpdateMemberRole != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Set")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation == nil {
+		mmUpdateMemberRole.defaultExpectation = &ChatRepositoryMockUpdateMemberRoleExpectation{}
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.params != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Expect")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateMemberRoleParamPtrs{}
+	}
+	mmUpdateMemberRole.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateMemberRole.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdateMemberRole
+}
+
+// ExpectRoleParam4 sets up expected param role for ChatRepository.UpdateMemberRole
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) ExpectRoleParam4(role model.ChatRole) *mChatRepositoryMockUpdateMemberRole {
+	if mmUpdateMemberRole.mock.funcUpdateMemberRole != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Set")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation == nil {
+		mmUpdateMemberRole.defaultExpectation = &ChatRepositoryMockUpdateMemberRoleExpectation{}
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.params != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Expect")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateMemberRoleParamPtrs{}
+	}
+	mmUpdateMemberRole.defaultExpectation.paramPtrs.role = &role
+	mmUpdateMemberRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmUpdateMemberRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.UpdateMemberRole
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) Inspect(f func(ctx context.Context, chatID int64, userID int64, role model.ChatRole)) *mChatRepositoryMockUpdateMemberRole {
+	if mmUpdateMemberRole.mock.inspectFuncUpdateMemberRole != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.UpdateMemberRole")
+	}
+
+	mmUpdateMemberRole.mock.inspectFuncUpdateMemberRole = f
+
+	return mmUpdateMemberRole
+}
+
+// Return sets up results that will be returned by ChatRepository.UpdateMemberRole
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) Return(err error) *ChatRepositoryMock {
+	if mmUpdateMemberRole.mock.funcUpdateMemberRole != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Set")
+	}
+
+	if mmUpdateMemberRole.defaultExpectation == nil {
+		mmUpdateMemberRole.defaultExpectation = &ChatRepositoryMockUpdateMemberRoleExpectation{mock: mmUpdateMemberRole.mock}
+	}
+	mmUpdateMemberRole.defaultExpectation.results = &ChatRepositoryMockUpdateMemberRoleResults{err}
+	mmUpdateMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateMemberRole.mock
+}
+
+// Set uses given function f to mock the ChatRepository.UpdateMemberRole method
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) Set(f func(ctx context.Context, chatID int64, userID int64, role model.ChatRole) (err error)) *ChatRepositoryMock {
+	if mmUpdateMemberRole.defaultExpectation != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatRepository.UpdateMemberRole method")
+	}
+
+	if len(mmUpdateMemberRole.expectations) > 0 {
+		mmUpdateMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatRepository.UpdateMemberRole method")
+	}
+
+	mmUpdateMemberRole.mock.funcUpdateMemberRole = f
+	mmUpdateMemberRole.mock.funcUpdateMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmUpdateMemberRole.mock
+}
+
+// When sets expectation for the ChatRepository.UpdateMemberRole which will trigger the result defined by the following
+// Then helper
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) When(ctx context.Context, chatID int64, userID int64, role model.ChatRole) *ChatRepositoryMockUpdateMemberRoleExpectation {
+	if mmUpdateMemberRole.mock.funcUpdateMemberRole != nil {
+		mmUpdateMemberRole.mock.t.Fatalf("ChatRepositoryMock.UpdateMemberRole mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockUpdateMemberRoleExpectation{
+		mock:               mmUpdateMemberRole.mock,
+		params:             &ChatRepositoryMockUpdateMemberRoleParams{ctx, chatID, userID, role},
+		expectationOrigins: ChatRepositoryMockUpdateMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateMemberRole.expectations = append(mmUpdateMemberRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.UpdateMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockUpdateMemberRoleExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockUpdateMemberRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.UpdateMemberRole should be invoked
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) Times(n uint64) *mChatRepositoryMockUpdateMemberRole {
+	if n == 0 {
+		mmUpdateMemberRole.mock.t.Fatalf("Times of ChatRepositoryMock.UpdateMemberRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateMemberRole.expectedInvocations, n)
+	mmUpdateMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateMemberRole
+}
+
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) invocationsDone() bool {
+	if len(mmUpdateMemberRole.expectations) == 0 && mmUpdateMemberRole.defaultExpectation == nil && mmUpdateMemberRole.mock.funcUpdateMemberRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateMemberRole.mock.afterUpdateMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateMemberRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateMemberRole implements mm_repository.ChatRepository
+func (mmUpdateMemberRole *ChatRepositoryMock) UpdateMemberRole(ctx context.Context, chatID int64, userID int64, role model.ChatRole) (err error) {
+	mm_atomic.AddUint64(&mmUpdateMemberRole.beforeUpdateMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateMemberRole.afterUpdateMemberRoleCounter, 1)
+
+	mmUpdateMemberRole.t.Helper()
+
+	if mmUpdateMemberRole.inspectFuncUpdateMemberRole != nil {
+		mmUpdateMemberRole.inspectFuncUpdateMemberRole(ctx, chatID, userID, role)
+	}
+
+	mm_params := ChatRepositoryMockUpdateMemberRoleParams{ctx, chatID, userID, role}
+
+	// Record call args
+	mmUpdateMemberRole.UpdateMemberRoleMock.mutex.Lock()
+	mmUpdateMemberRole.UpdateMemberRoleMock.callArgs = append(mmUpdateMemberRole.UpdateMemberRoleMock.callArgs, &mm_params)
+	mmUpdateMemberRole.UpdateMemberRoleMock.mutex.Unlock()
+
+	for _, e := range mmUpdateMemberRole.UpdateMemberRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockUpdateMemberRoleParams{ctx, chatID, userID, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateMemberRole.t.Errorf("ChatRepositoryMock.UpdateMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmUpdateMemberRole.t.Errorf("ChatRepositoryMock.UpdateMemberRole got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdateMemberRole.t.Errorf("ChatRepositoryMock.UpdateMemberRole got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmUpdateMemberRole.t.Errorf("ChatRepositoryMock.UpdateMemberRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateMemberRole.t.Errorf("ChatRepositoryMock.UpdateMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateMemberRole.UpdateMemberRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateMemberRole.t.Fatal("No results are set for the ChatRepositoryMock.UpdateMemberRole")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateMemberRole.funcUpdateMemberRole != nil {
+		return mmUpdateMemberRole.funcUpdateMemberRole(ctx, chatID, userID, role)
+	}
+	mmUpdateMemberRole.t.Fatalf("Unexpected call to ChatRepositoryMock.UpdateMemberRole. %v %v %v %v", ctx, chatID, userID, role)
+	return
+}
+
+// UpdateMemberRoleAfterCounter returns a count of finished ChatRepositoryMock.UpdateMemberRole invocations
+func (mmUpdateMemberRole *ChatRepositoryMock) UpdateMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateMemberRole.afterUpdateMemberRoleCounter)
+}
+
+// UpdateMemberRoleBeforeCounter returns a count of ChatRepositoryMock.UpdateMemberRole invocations
+func (mmUpdateMemberRole *ChatRepositoryMock) UpdateMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateMemberRole.beforeUpdateMemberRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.UpdateMemberRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateMemberRole *mChatRepositoryMockUpdateMemberRole) Calls() []*ChatRepositoryMockUpdateMemberRoleParams {
+	mmUpdateMemberRole.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockUpdateMemberRoleParams, len(mmUpdateMemberRole.callArgs))
+	copy(argCopy, mmUpdateMemberRole.callArgs)
+
+	mmUpdateMemberRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateMemberRoleDone returns true if the count of the UpdateMemberRole invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockUpdateMemberRoleDone() bool {
+	if m.UpdateMemberRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateMemberRoleMock.invocationsDone()
+}
+
+// MinimockUpdateMemberRoleInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockUpdateMemberRoleInspect() {
+	for _, e := range m.UpdateMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateMemberRoleCounter := mm_atomic.LoadUint64(&m.afterUpdateMemberRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMemberRoleMock.defaultExpectation != nil && afterUpdateMemberRoleCounter < 1 {
+		if m.UpdateMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateMemberRole at\n%s", m.UpdateMemberRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateMemberRole at\n%s with params: %#v", m.UpdateMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMemberRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateMemberRole != nil && afterUpdateMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.UpdateMemberRole at\n%s", m.funcUpdateMemberRoleOrigin)
+	}
+
+	if !m.UpdateMemberRoleMock.invocationsDone() && afterUpdateMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.UpdateMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateMemberRoleMock.expectedInvocations), m.UpdateMemberRoleMock.expectedInvocationsOrigin, afterUpdateMemberRoleCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ChatRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddMembersInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetDirectInspect()
+
+			m.MinimockListByUserInspect()
+
+			m.MinimockListLegacyUsernamesInspect()
+
+			m.MinimockLockMembersInspect()
+
+			m.MinimockMarkReadInspect()
+
+			m.MinimockRemoveMemberInspect()
+
+			m.MinimockResolveLegacyMemberInspect()
+
+			m.MinimockUpdateInspect()
+
+			m.MinimockUpdateLastMessageInspect()
+
+			m.MinimockUpdateMemberRoleInspect()
 		}
 	})
 }
@@ -768,6 +5333,18 @@ func (m *ChatRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddMembersDone() &&
 		m.MinimockCreateDone() &&
-		m.MinimockDeleteDone()
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetDirectDone() &&
+		m.MinimockListByUserDone() &&
+		m.MinimockListLegacyUsernamesDone() &&
+		m.MinimockLockMembersDone() &&
+		m.MinimockMarkReadDone() &&
+		m.MinimockRemoveMemberDone() &&
+		m.MinimockResolveLegacyMemberDone() &&
+		m.MinimockUpdateDone() &&
+		m.MinimockUpdateLastMessageDone() &&
+		m.MinimockUpdateMemberRoleDone()
 }
@@ -0,0 +1,8158 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat-server/internal/repository.MessageRepository -o message_repository_minimock.go -n MessageRepositoryMock -p mocks
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// MessageRepositoryMock implements mm_repository.MessageRepository
+type MessageRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddReaction          func(ctx context.Context, messageID int64, userID int64, emoji string) (b1 bool, err error)
+	funcAddReactionOrigin    string
+	inspectFuncAddReaction   func(ctx context.Context, messageID int64, userID int64, emoji string)
+	afterAddReactionCounter  uint64
+	beforeAddReactionCounter uint64
+	AddReactionMock          mMessageRepositoryMockAddReaction
+
+	funcAddReply          func(ctx context.Context, rootID int64) (mp1 *model.Message, err error)
+	funcAddReplyOrigin    string
+	inspectFuncAddReply   func(ctx context.Context, rootID int64)
+	afterAddReplyCounter  uint64
+	beforeAddReplyCounter uint64
+	AddReplyMock          mMessageRepositoryMockAddReply
+
+	funcAttachToMessage          func(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration) (apa1 []*model.Attachment, err error)
+	funcAttachToMessageOrigin    string
+	inspectFuncAttachToMessage   func(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration)
+	afterAttachToMessageCounter  uint64
+	beforeAttachToMessageCounter uint64
+	AttachToMessageMock          mMessageRepositoryMockAttachToMessage
+
+	funcCountReactions          func(ctx context.Context, messageID int64, emoji string) (i1 int64, err error)
+	funcCountReactionsOrigin    string
+	inspectFuncCountReactions   func(ctx context.Context, messageID int64, emoji string)
+	afterCountReactionsCounter  uint64
+	beforeCountReactionsCounter uint64
+	CountReactionsMock          mMessageRepositoryMockCountReactions
+
+	funcCreate          func(ctx context.Context, message *model.Message) (mp1 *model.Message, err error)
+	funcCreateOrigin    string
+	inspectFuncCreate   func(ctx context.Context, message *model.Message)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mMessageRepositoryMockCreate
+
+	funcCreateAttachment          func(ctx context.Context, attachment *model.Attachment) (ap1 *model.Attachment, err error)
+	funcCreateAttachmentOrigin    string
+	inspectFuncCreateAttachment   func(ctx context.Context, attachment *model.Attachment)
+	afterCreateAttachmentCounter  uint64
+	beforeCreateAttachmentCounter uint64
+	CreateAttachmentMock          mMessageRepositoryMockCreateAttachment
+
+	funcDelete          func(ctx context.Context, id int64, deletedBy string) (mp1 *model.Message, err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id int64, deletedBy string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mMessageRepositoryMockDelete
+
+	funcDeleteChatAttachments          func(ctx context.Context, chatID int64) (sa1 []string, err error)
+	funcDeleteChatAttachmentsOrigin    string
+	inspectFuncDeleteChatAttachments   func(ctx context.Context, chatID int64)
+	afterDeleteChatAttachmentsCounter  uint64
+	beforeDeleteChatAttachmentsCounter uint64
+	DeleteChatAttachmentsMock          mMessageRepositoryMockDeleteChatAttachments
+
+	funcDeleteMessageAttachments          func(ctx context.Context, messageID int64) (sa1 []string, err error)
+	funcDeleteMessageAttachmentsOrigin    string
+	inspectFuncDeleteMessageAttachments   func(ctx context.Context, messageID int64)
+	afterDeleteMessageAttachmentsCounter  uint64
+	beforeDeleteMessageAttachmentsCounter uint64
+	DeleteMessageAttachmentsMock          mMessageRepositoryMockDeleteMessageAttachments
+
+	funcDeleteUnsentAttachments          func(ctx context.Context, maxAge time.Duration) (sa1 []string, err error)
+	funcDeleteUnsentAttachmentsOrigin    string
+	inspectFuncDeleteUnsentAttachments   func(ctx context.Context, maxAge time.Duration)
+	afterDeleteUnsentAttachmentsCounter  uint64
+	beforeDeleteUnsentAttachmentsCounter uint64
+	DeleteUnsentAttachmentsMock          mMessageRepositoryMockDeleteUnsentAttachments
+
+	funcEdit          func(ctx context.Context, id int64, text string, editedBy string) (mp1 *model.Message, err error)
+	funcEditOrigin    string
+	inspectFuncEdit   func(ctx context.Context, id int64, text string, editedBy string)
+	afterEditCounter  uint64
+	beforeEditCounter uint64
+	EditMock          mMessageRepositoryMockEdit
+
+	funcGet          func(ctx context.Context, id int64) (mp1 *model.Message, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id int64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mMessageRepositoryMockGet
+
+	funcGetAttachment          func(ctx context.Context, id int64) (ap1 *model.Attachment, err error)
+	funcGetAttachmentOrigin    string
+	inspectFuncGetAttachment   func(ctx context.Context, id int64)
+	afterGetAttachmentCounter  uint64
+	beforeGetAttachmentCounter uint64
+	GetAttachmentMock          mMessageRepositoryMockGetAttachment
+
+	funcGetUploadUsage          func(ctx context.Context, uploaderID int64, maxAge time.Duration) (up1 *model.UploadUsage, err error)
+	funcGetUploadUsageOrigin    string
+	inspectFuncGetUploadUsage   func(ctx context.Context, uploaderID int64, maxAge time.Duration)
+	afterGetUploadUsageCounter  uint64
+	beforeGetUploadUsageCounter uint64
+	GetUploadUsageMock          mMessageRepositoryMockGetUploadUsage
+
+	funcList          func(ctx context.Context, chatID int64, beforeID int64, limit uint64) (mpa1 []*model.Message, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, chatID int64, beforeID int64, limit uint64)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mMessageRepositoryMockList
+
+	funcListAttachments          func(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.Attachment, err error)
+	funcListAttachmentsOrigin    string
+	inspectFuncListAttachments   func(ctx context.Context, messageIDs []int64)
+	afterListAttachmentsCounter  uint64
+	beforeListAttachmentsCounter uint64
+	ListAttachmentsMock          mMessageRepositoryMockListAttachments
+
+	funcListReactions          func(ctx context.Context, messageIDs []int64, userID int64) (m1 map[int64][]*model.Reaction, err error)
+	funcListReactionsOrigin    string
+	inspectFuncListReactions   func(ctx context.Context, messageIDs []int64, userID int64)
+	afterListReactionsCounter  uint64
+	beforeListReactionsCounter uint64
+	ListReactionsMock          mMessageRepositoryMockListReactions
+
+	funcListReplies          func(ctx context.Context, rootID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error)
+	funcListRepliesOrigin    string
+	inspectFuncListReplies   func(ctx context.Context, rootID int64, afterID int64, limit uint64)
+	afterListRepliesCounter  uint64
+	beforeListRepliesCounter uint64
+	ListRepliesMock          mMessageRepositoryMockListReplies
+
+	funcLockUploads          func(ctx context.Context, uploaderID int64) (err error)
+	funcLockUploadsOrigin    string
+	inspectFuncLockUploads   func(ctx context.Context, uploaderID int64)
+	afterLockUploadsCounter  uint64
+	beforeLockUploadsCounter uint64
+	LockUploadsMock          mMessageRepositoryMockLockUploads
+
+	funcRemoveReaction          func(ctx context.Context, messageID int64, userID int64, emoji string) (b1 bool, err error)
+	funcRemoveReactionOrigin    string
+	inspectFuncRemoveReaction   func(ctx context.Context, messageID int64, userID int64, emoji string)
+	afterRemoveReactionCounter  uint64
+	beforeRemoveReactionCounter uint64
+	RemoveReactionMock          mMessageRepositoryMockRemoveReaction
+
+	funcSearch          func(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64) (spa1 []*model.SearchResult, err error)
+	funcSearchOrigin    string
+	inspectFuncSearch   func(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64)
+	afterSearchCounter  uint64
+	beforeSearchCounter uint64
+	SearchMock          mMessageRepositoryMockSearch
+}
+
+// NewMessageRepositoryMock returns a mock for mm_repository.MessageRepository
+func NewMessageRepositoryMock(t minimock.Tester) *MessageRepositoryMock {
+	m := &MessageRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddReactionMock = mMessageRepositoryMockAddReaction{mock: m}
+	m.AddReactionMock.callArgs = []*MessageRepositoryMockAddReactionParams{}
+
+	m.AddReplyMock = mMessageRepositoryMockAddReply{mock: m}
+	m.AddReplyMock.callArgs = []*MessageRepositoryMockAddReplyParams{}
+
+	m.AttachToMessageMock = mMessageRepositoryMockAttachToMessage{mock: m}
+	m.AttachToMessageMock.callArgs = []*MessageRepositoryMockAttachToMessageParams{}
+
+	m.CountReactionsMock = mMessageRepositoryMockCountReactions{mock: m}
+	m.CountReactionsMock.callArgs = []*MessageRepositoryMockCountReactionsParams{}
+
+	m.CreateMock = mMessageRepositoryMockCreate{mock: m}
+	m.CreateMock.callArgs = []*MessageRepositoryMockCreateParams{}
+
+	m.CreateAttachmentMock = mMessageRepositoryMockCreateAttachment{mock: m}
+	m.CreateAttachmentMock.callArgs = []*MessageRepositoryMockCreateAttachmentParams{}
+
+	m.DeleteMock = mMessageRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*MessageRepositoryMockDeleteParams{}
+
+	m.DeleteChatAttachmentsMock = mMessageRepositoryMockDeleteChatAttachments{mock: m}
+	m.DeleteChatAttachmentsMock.callArgs = []*MessageRepositoryMockDeleteChatAttachmentsParams{}
+
+	m.DeleteMessageAttachmentsMock = mMessageRepositoryMockDeleteMessageAttachments{mock: m}
+	m.DeleteMessageAttachmentsMock.callArgs = []*MessageRepositoryMockDeleteMessageAttachmentsParams{}
+
+	m.DeleteUnsentAttachmentsMock = mMessageRepositoryMockDeleteUnsentAttachments{mock: m}
+	m.DeleteUnsentAttachmentsMock.callArgs = []*MessageRepositoryMockDeleteUnsentAttachmentsParams{}
+
+	m.EditMock = mMessageRepositoryMockEdit{mock: m}
+	m.EditMock.callArgs = []*MessageRepositoryMockEditParams{}
+
+	m.GetMock = mMessageRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*MessageRepositoryMockGetParams{}
+
+	m.GetAttachmentMock = mMessageRepositoryMockGetAttachment{mock: m}
+	m.GetAttachmentMock.callArgs = []*MessageRepositoryMockGetAttachmentParams{}
+
+	m.GetUploadUsageMock = mMessageRepositoryMockGetUploadUsage{mock: m}
+	m.GetUploadUsageMock.callArgs = []*MessageRepositoryMockGetUploadUsageParams{}
+
+	m.ListMock = mMessageRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*MessageRepositoryMockListParams{}
+
+	m.ListAttachmentsMock = mMessageRepositoryMockListAttachments{mock: m}
+	m.ListAttachmentsMock.callArgs = []*MessageRepositoryMockListAttachmentsParams{}
+
+	m.ListReactionsMock = mMessageRepositoryMockListReactions{mock: m}
+	m.ListReactionsMock.callArgs = []*MessageRepositoryMockListReactionsParams{}
+
+	m.ListRepliesMock = mMessageRepositoryMockListReplies{mock: m}
+	m.ListRepliesMock.callArgs = []*MessageRepositoryMockListRepliesParams{}
+
+	m.LockUploadsMock = mMessageRepositoryMockLockUploads{mock: m}
+	m.LockUploadsMock.callArgs = []*MessageRepositoryMockLockUploadsParams{}
+
+	m.RemoveReactionMock = mMessageRepositoryMockRemoveReaction{mock: m}
+	m.RemoveReactionMock.callArgs = []*MessageRepositoryMockRemoveReactionParams{}
+
+	m.SearchMock = mMessageRepositoryMockSearch{mock: m}
+	m.SearchMock.callArgs = []*MessageRepositoryMockSearchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMessageRepositoryMockAddReaction struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockAddReactionExpectation
+	expectations       []*MessageRepositoryMockAddReactionExpectation
+
+	callArgs []*MessageRepositoryMockAddReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockAddReactionExpectation specifies expectation struct of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockAddReactionParams
+	paramPtrs          *MessageRepositoryMockAddReactionParamPtrs
+	expectationOrigins MessageRepositoryMockAddReactionExpectationOrigins
+	results            *MessageRepositoryMockAddReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockAddReactionParams contains parameters of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionParams struct {
+	ctx       context.Context
+	messageID int64
+	userID    int64
+	emoji     string
+}
+
+// MessageRepositoryMockAddReactionParamPtrs contains pointers to parameters of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	userID    *int64
+	emoji     *string
+}
+
+// MessageRepositoryMockAddReactionResults contains results of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionResults struct {
+	b1  bool
+	err error
+}
+
+// MessageRepositoryMockAddReactionOrigins contains origins of expectations of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originUserID    string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Optional() *mMessageRepositoryMockAddReaction {
+	mmAddReaction.optional = true
+	return mmAddReaction
+}
+
+// Expect sets up expected params for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Expect(ctx context.Context, messageID int64, userID int64, emoji string) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by ExpectParams functions")
+	}
+
+	mmAddReaction.defaultExpectation.params = &MessageRepositoryMockAddReactionParams{ctx, messageID, userID, emoji}
+	mmAddReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReaction.expectations {
+		if minimock.Equal(e.params, mmAddReaction.defaultExpectation.params) {
+			mmAddReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmAddReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectUserIDParam3 sets up expected param userID for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) ExpectUserIDParam3(userID int64) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.userID = &userID
+	mmAddReaction.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) ExpectEmojiParam4(emoji string) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmAddReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Inspect(f func(ctx context.Context, messageID int64, userID int64, emoji string)) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.inspectFuncAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.AddReaction")
+	}
+
+	mmAddReaction.mock.inspectFuncAddReaction = f
+
+	return mmAddReaction
+}
+
+// Return sets up results that will be returned by MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Return(b1 bool, err error) *MessageRepositoryMock {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{mock: mmAddReaction.mock}
+	}
+	mmAddReaction.defaultExpectation.results = &MessageRepositoryMockAddReactionResults{b1, err}
+	mmAddReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// Set uses given function f to mock the MessageRepository.AddReaction method
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Set(f func(ctx context.Context, messageID int64, userID int64, emoji string) (b1 bool, err error)) *MessageRepositoryMock {
+	if mmAddReaction.defaultExpectation != nil {
+		mmAddReaction.mock.t.Fatalf("Default expectation is already set for the MessageRepository.AddReaction method")
+	}
+
+	if len(mmAddReaction.expectations) > 0 {
+		mmAddReaction.mock.t.Fatalf("Some expectations are already set for the MessageRepository.AddReaction method")
+	}
+
+	mmAddReaction.mock.funcAddReaction = f
+	mmAddReaction.mock.funcAddReactionOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// When sets expectation for the MessageRepository.AddReaction which will trigger the result defined by the following
+// Then helper
+func (mmAddReaction *mMessageRepositoryMockAddReaction) When(ctx context.Context, messageID int64, userID int64, emoji string) *MessageRepositoryMockAddReactionExpectation {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockAddReactionExpectation{
+		mock:               mmAddReaction.mock,
+		params:             &MessageRepositoryMockAddReactionParams{ctx, messageID, userID, emoji},
+		expectationOrigins: MessageRepositoryMockAddReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReaction.expectations = append(mmAddReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.AddReaction return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockAddReactionExpectation) Then(b1 bool, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockAddReactionResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.AddReaction should be invoked
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Times(n uint64) *mMessageRepositoryMockAddReaction {
+	if n == 0 {
+		mmAddReaction.mock.t.Fatalf("Times of MessageRepositoryMock.AddReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReaction.expectedInvocations, n)
+	mmAddReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReaction
+}
+
+func (mmAddReaction *mMessageRepositoryMockAddReaction) invocationsDone() bool {
+	if len(mmAddReaction.expectations) == 0 && mmAddReaction.defaultExpectation == nil && mmAddReaction.mock.funcAddReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReaction.mock.afterAddReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReaction implements mm_repository.MessageRepository
+func (mmAddReaction *MessageRepositoryMock) AddReaction(ctx context.Context, messageID int64, userID int64, emoji string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAddReaction.beforeAddReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReaction.afterAddReactionCounter, 1)
+
+	mmAddReaction.t.Helper()
+
+	if mmAddReaction.inspectFuncAddReaction != nil {
+		mmAddReaction.inspectFuncAddReaction(ctx, messageID, userID, emoji)
+	}
+
+	mm_params := MessageRepositoryMockAddReactionParams{ctx, messageID, userID, emoji}
+
+	// Record call args
+	mmAddReaction.AddReactionMock.mutex.Lock()
+	mmAddReaction.AddReactionMock.callArgs = append(mmAddReaction.AddReactionMock.callArgs, &mm_params)
+	mmAddReaction.AddReactionMock.mutex.Unlock()
+
+	for _, e := range mmAddReaction.AddReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmAddReaction.AddReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReaction.AddReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReaction.AddReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReaction.AddReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockAddReactionParams{ctx, messageID, userID, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReaction.AddReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReaction.t.Fatal("No results are set for the MessageRepositoryMock.AddReaction")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmAddReaction.funcAddReaction != nil {
+		return mmAddReaction.funcAddReaction(ctx, messageID, userID, emoji)
+	}
+	mmAddReaction.t.Fatalf("Unexpected call to MessageRepositoryMock.AddReaction. %v %v %v %v", ctx, messageID, userID, emoji)
+	return
+}
+
+// AddReactionAfterCounter returns a count of finished MessageRepositoryMock.AddReaction invocations
+func (mmAddReaction *MessageRepositoryMock) AddReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.afterAddReactionCounter)
+}
+
+// AddReactionBeforeCounter returns a count of MessageRepositoryMock.AddReaction invocations
+func (mmAddReaction *MessageRepositoryMock) AddReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.beforeAddReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.AddReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Calls() []*MessageRepositoryMockAddReactionParams {
+	mmAddReaction.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockAddReactionParams, len(mmAddReaction.callArgs))
+	copy(argCopy, mmAddReaction.callArgs)
+
+	mmAddReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReactionDone returns true if the count of the AddReaction invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockAddReactionDone() bool {
+	if m.AddReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReactionMock.invocationsDone()
+}
+
+// MinimockAddReactionInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockAddReactionInspect() {
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReactionCounter := mm_atomic.LoadUint64(&m.afterAddReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReactionMock.defaultExpectation != nil && afterAddReactionCounter < 1 {
+		if m.AddReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReaction at\n%s", m.AddReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReaction at\n%s with params: %#v", m.AddReactionMock.defaultExpectation.expectationOrigins.origin, *m.AddReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReaction != nil && afterAddReactionCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.AddReaction at\n%s", m.funcAddReactionOrigin)
+	}
+
+	if !m.AddReactionMock.invocationsDone() && afterAddReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.AddReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReactionMock.expectedInvocations), m.AddReactionMock.expectedInvocationsOrigin, afterAddReactionCounter)
+	}
+}
+
+type mMessageRepositoryMockAddReply struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockAddReplyExpectation
+	expectations       []*MessageRepositoryMockAddReplyExpectation
+
+	callArgs []*MessageRepositoryMockAddReplyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockAddReplyExpectation specifies expectation struct of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockAddReplyParams
+	paramPtrs          *MessageRepositoryMockAddReplyParamPtrs
+	expectationOrigins MessageRepositoryMockAddReplyExpectationOrigins
+	results            *MessageRepositoryMockAddReplyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockAddReplyParams contains parameters of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyParams struct {
+	ctx    context.Context
+	rootID int64
+}
+
+// MessageRepositoryMockAddReplyParamPtrs contains pointers to parameters of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyParamPtrs struct {
+	ctx    *context.Context
+	rootID *int64
+}
+
+// MessageRepositoryMockAddReplyResults contains results of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockAddReplyOrigins contains origins of expectations of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originRootID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReply *mMessageRepositoryMockAddReply) Optional() *mMessageRepositoryMockAddReply {
+	mmAddReply.optional = true
+	return mmAddReply
+}
+
+// Expect sets up expected params for MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) Expect(ctx context.Context, rootID int64) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{}
+	}
+
+	if mmAddReply.defaultExpectation.paramPtrs != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by ExpectParams functions")
+	}
+
+	mmAddReply.defaultExpectation.params = &MessageRepositoryMockAddReplyParams{ctx, rootID}
+	mmAddReply.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReply.expectations {
+		if minimock.Equal(e.params, mmAddReply.defaultExpectation.params) {
+			mmAddReply.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReply.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReply
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{}
+	}
+
+	if mmAddReply.defaultExpectation.params != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Expect")
+	}
+
+	if mmAddReply.defaultExpectation.paramPtrs == nil {
+		mmAddReply.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReplyParamPtrs{}
+	}
+	mmAddReply.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReply.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReply
+}
+
+// ExpectRootIDParam2 sets up expected param rootID for MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) ExpectRootIDParam2(rootID int64) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{}
+	}
+
+	if mmAddReply.defaultExpectation.params != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Expect")
+	}
+
+	if mmAddReply.defaultExpectation.paramPtrs == nil {
+		mmAddReply.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReplyParamPtrs{}
+	}
+	mmAddReply.defaultExpectation.paramPtrs.rootID = &rootID
+	mmAddReply.defaultExpectation.expectationOrigins.originRootID = minimock.CallerInfo(1)
+
+	return mmAddReply
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) Inspect(f func(ctx context.Context, rootID int64)) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.inspectFuncAddReply != nil {
+		mmAddReply.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.AddReply")
+	}
+
+	mmAddReply.mock.inspectFuncAddReply = f
+
+	return mmAddReply
+}
+
+// Return sets up results that will be returned by MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{mock: mmAddReply.mock}
+	}
+	mmAddReply.defaultExpectation.results = &MessageRepositoryMockAddReplyResults{mp1, err}
+	mmAddReply.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReply.mock
+}
+
+// Set uses given function f to mock the MessageRepository.AddReply method
+func (mmAddReply *mMessageRepositoryMockAddReply) Set(f func(ctx context.Context, rootID int64) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmAddReply.defaultExpectation != nil {
+		mmAddReply.mock.t.Fatalf("Default expectation is already set for the MessageRepository.AddReply method")
+	}
+
+	if len(mmAddReply.expectations) > 0 {
+		mmAddReply.mock.t.Fatalf("Some expectations are already set for the MessageRepository.AddReply method")
+	}
+
+	mmAddReply.mock.funcAddReply = f
+	mmAddReply.mock.funcAddReplyOrigin = minimock.CallerInfo(1)
+	return mmAddReply.mock
+}
+
+// When sets expectation for the MessageRepository.AddReply which will trigger the result defined by the following
+// Then helper
+func (mmAddReply *mMessageRepositoryMockAddReply) When(ctx context.Context, rootID int64) *MessageRepositoryMockAddReplyExpectation {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockAddReplyExpectation{
+		mock:               mmAddReply.mock,
+		params:             &MessageRepositoryMockAddReplyParams{ctx, rootID},
+		expectationOrigins: MessageRepositoryMockAddReplyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReply.expectations = append(mmAddReply.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.AddReply return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockAddReplyExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockAddReplyResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.AddReply should be invoked
+func (mmAddReply *mMessageRepositoryMockAddReply) Times(n uint64) *mMessageRepositoryMockAddReply {
+	if n == 0 {
+		mmAddReply.mock.t.Fatalf("Times of MessageRepositoryMock.AddReply mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReply.expectedInvocations, n)
+	mmAddReply.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReply
+}
+
+func (mmAddReply *mMessageRepositoryMockAddReply) invocationsDone() bool {
+	if len(mmAddReply.expectations) == 0 && mmAddReply.defaultExpectation == nil && mmAddReply.mock.funcAddReply == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReply.mock.afterAddReplyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReply.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReply implements mm_repository.MessageRepository
+func (mmAddReply *MessageRepositoryMock) AddReply(ctx context.Context, rootID int64) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmAddReply.beforeAddReplyCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReply.afterAddReplyCounter, 1)
+
+	mmAddReply.t.Helper()
+
+	if mmAddReply.inspectFuncAddReply != nil {
+		mmAddReply.inspectFuncAddReply(ctx, rootID)
+	}
+
+	mm_params := MessageRepositoryMockAddReplyParams{ctx, rootID}
+
+	// Record call args
+	mmAddReply.AddReplyMock.mutex.Lock()
+	mmAddReply.AddReplyMock.callArgs = append(mmAddReply.AddReplyMock.callArgs, &mm_params)
+	mmAddReply.AddReplyMock.mutex.Unlock()
+
+	for _, e := range mmAddReply.AddReplyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmAddReply.AddReplyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReply.AddReplyMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReply.AddReplyMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReply.AddReplyMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockAddReplyParams{ctx, rootID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReply.t.Errorf("MessageRepositoryMock.AddReply got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReply.AddReplyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rootID != nil && !minimock.Equal(*mm_want_ptrs.rootID, mm_got.rootID) {
+				mmAddReply.t.Errorf("MessageRepositoryMock.AddReply got unexpected parameter rootID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReply.AddReplyMock.defaultExpectation.expectationOrigins.originRootID, *mm_want_ptrs.rootID, mm_got.rootID, minimock.Diff(*mm_want_ptrs.rootID, mm_got.rootID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReply.t.Errorf("MessageRepositoryMock.AddReply got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReply.AddReplyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReply.AddReplyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReply.t.Fatal("No results are set for the MessageRepositoryMock.AddReply")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmAddReply.funcAddReply != nil {
+		return mmAddReply.funcAddReply(ctx, rootID)
+	}
+	mmAddReply.t.Fatalf("Unexpected call to MessageRepositoryMock.AddReply. %v %v", ctx, rootID)
+	return
+}
+
+// AddReplyAfterCounter returns a count of finished MessageRepositoryMock.AddReply invocations
+func (mmAddReply *MessageRepositoryMock) AddReplyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReply.afterAddReplyCounter)
+}
+
+// AddReplyBeforeCounter returns a count of MessageRepositoryMock.AddReply invocations
+func (mmAddReply *MessageRepositoryMock) AddReplyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReply.beforeAddReplyCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.AddReply.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReply *mMessageRepositoryMockAddReply) Calls() []*MessageRepositoryMockAddReplyParams {
+	mmAddReply.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockAddReplyParams, len(mmAddReply.callArgs))
+	copy(argCopy, mmAddReply.callArgs)
+
+	mmAddReply.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReplyDone returns true if the count of the AddReply invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockAddReplyDone() bool {
+	if m.AddReplyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReplyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReplyMock.invocationsDone()
+}
+
+// MinimockAddReplyInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockAddReplyInspect() {
+	for _, e := range m.AddReplyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReply at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReplyCounter := mm_atomic.LoadUint64(&m.afterAddReplyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReplyMock.defaultExpectation != nil && afterAddReplyCounter < 1 {
+		if m.AddReplyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReply at\n%s", m.AddReplyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReply at\n%s with params: %#v", m.AddReplyMock.defaultExpectation.expectationOrigins.origin, *m.AddReplyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReply != nil && afterAddReplyCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.AddReply at\n%s", m.funcAddReplyOrigin)
+	}
+
+	if !m.AddReplyMock.invocationsDone() && afterAddReplyCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.AddReply at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReplyMock.expectedInvocations), m.AddReplyMock.expectedInvocationsOrigin, afterAddReplyCounter)
+	}
+}
+
+type mMessageRepositoryMockAttachToMessage struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockAttachToMessageExpectation
+	expectations       []*MessageRepositoryMockAttachToMessageExpectation
+
+	callArgs []*MessageRepositoryMockAttachToMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockAttachToMessageExpectation specifies expectation struct of the MessageRepository.AttachToMessage
+type MessageRepositoryMockAttachToMessageExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockAttachToMessageParams
+	paramPtrs          *MessageRepositoryMockAttachToMessageParamPtrs
+	expectationOrigins MessageRepositoryMockAttachToMessageExpectationOrigins
+	results            *MessageRepositoryMockAttachToMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockAttachToMessageParams contains parameters of the MessageRepository.AttachToMessage
+type MessageRepositoryMockAttachToMessageParams struct {
+	ctx        context.Context
+	message    *model.Message
+	uploaderID int64
+	ids        []int64
+	maxAge     time.Duration
+}
+
+// MessageRepositoryMockAttachToMessageParamPtrs contains pointers to parameters of the MessageRepository.AttachToMessage
+type MessageRepositoryMockAttachToMessageParamPtrs struct {
+	ctx        *context.Context
+	message    **model.Message
+	uploaderID *int64
+	ids        *[]int64
+	maxAge     *time.Duration
+}
+
+// MessageRepositoryMockAttachToMessageResults contains results of the MessageRepository.AttachToMessage
+type MessageRepositoryMockAttachToMessageResults struct {
+	apa1 []*model.Attachment
+	err  error
+}
+
+// MessageRepositoryMockAttachToMessageOrigins contains origins of expectations of the MessageRepository.AttachToMessage
+type MessageRepositoryMockAttachToMessageExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMessage    string
+	originUploaderID string
+	originIds        string
+	originMaxAge     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) Optional() *mMessageRepositoryMockAttachToMessage {
+	mmAttachToMessage.optional = true
+	return mmAttachToMessage
+}
+
+// Expect sets up expected params for MessageRepository.AttachToMessage
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) Expect(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration) *mMessageRepositoryMockAttachToMessage {
+	if mmAttachToMessage.mock.funcAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Set")
+	}
+
+	if mmAttachToMessage.defaultExpectation == nil {
+		mmAttachToMessage.defaultExpectation = &MessageRepositoryMockAttachToMessageExpectation{}
+	}
+
+	if mmAttachToMessage.defaultExpectation.paramPtrs != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by ExpectParams functions")
+	}
+
+	mmAttachToMessage.defaultExpectation.params = &MessageRepositoryMockAttachToMessageParams{ctx, message, uploaderID, ids, maxAge}
+	mmAttachToMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAttachToMessage.expectations {
+		if minimock.Equal(e.params, mmAttachToMessage.defaultExpectation.params) {
+			mmAttachToMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAttachToMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmAttachToMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.AttachToMessage
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockAttachToMessage {
+	if mmAttachToMessage.mock.funcAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Set")
+	}
+
+	if mmAttachToMessage.defaultExpectation == nil {
+		mmAttachToMessage.defaultExpectation = &MessageRepositoryMockAttachToMessageExpectation{}
+	}
+
+	if mmAttachToMessage.defaultExpectation.params != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Expect")
+	}
+
+	if mmAttachToMessage.defaultExpectation.paramPtrs == nil {
+		mmAttachToMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockAttachToMessageParamPtrs{}
+	}
+	mmAttachToMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAttachToMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAttachToMessage
+}
+
+// ExpectMessageParam2 sets up expected param message for MessageRepository.AttachToMessage
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) ExpectMessageParam2(message *model.Message) *mMessageRepositoryMockAttachToMessage {
+	if mmAttachToMessage.mock.funcAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Set")
+	}
+
+	if mmAttachToMessage.defaultExpectation == nil {
+		mmAttachToMessage.defaultExpectation = &MessageRepositoryMockAttachToMessageExpectation{}
+	}
+
+	if mmAttachToMessage.defaultExpectation.params != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Expect")
+	}
+
+	if mmAttachToMessage.defaultExpectation.paramPtrs == nil {
+		mmAttachToMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockAttachToMessageParamPtrs{}
+	}
+	mmAttachToMessage.defaultExpectation.paramPtrs.message = &message
+	mmAttachToMessage.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmAttachToMessage
+}
+
+// ExpectUploaderIDParam3 sets up expected param uploaderID for MessageRepository.AttachToMessage
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) ExpectUploaderIDParam3(uploaderID int64) *mMessageRepositoryMockAttachToMessage {
+	if mmAttachToMessage.mock.funcAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Set")
+	}
+
+	if mmAttachToMessage.defaultExpectation == nil {
+		mmAttachToMessage.defaultExpectation = &MessageRepositoryMockAttachToMessageExpectation{}
+	}
+
+	if mmAttachToMessage.defaultExpectation.params != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Expect")
+	}
+
+	if mmAttachToMessage.defaultExpectation.paramPtrs == nil {
+		mmAttachToMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockAttachToMessageParamPtrs{}
+	}
+	mmAttachToMessage.defaultExpectation.paramPtrs.uploaderID = &uploaderID
+	mmAttachToMessage.defaultExpectation.expectationOrigins.originUploaderID = minimock.CallerInfo(1)
+
+	return mmAttachToMessage
+}
+
+// ExpectIdsParam4 sets up expected param ids for MessageRepository.AttachToMessage
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) ExpectIdsParam4(ids []int64) *mMessageRepositoryMockAttachToMessage {
+	if mmAttachToMessage.mock.funcAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Set")
+	}
+
+	if mmAttachToMessage.defaultExpectation == nil {
+		mmAttachToMessage.defaultExpectation = &MessageRepositoryMockAttachToMessageExpectation{}
+	}
+
+	if mmAttachToMessage.defaultExpectation.params != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Expect")
+	}
+
+	if mmAttachToMessage.defaultExpectation.paramPtrs == nil {
+		mmAttachToMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockAttachToMessageParamPtrs{}
+	}
+	mmAttachToMessage.defaultExpectation.paramPtrs.ids = &ids
+	mmAttachToMessage.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmAttachToMessage
+}
+
+// ExpectMaxAgeParam5 sets up expected param maxAge for MessageRepository.AttachToMessage
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) ExpectMaxAgeParam5(maxAge time.Duration) *mMessageRepositoryMockAttachToMessage {
+	if mmAttachToMessage.mock.funcAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Set")
+	}
+
+	if mmAttachToMessage.defaultExpectation == nil {
+		mmAttachToMessage.defaultExpectation = &MessageRepositoryMockAttachToMessageExpectation{}
+	}
+
+	if mmAttachToMessage.defaultExpectation.params != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Expect")
+	}
+
+	if mmAttachToMessage.defaultExpectation.paramPtrs == nil {
+		mmAttachToMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockAttachToMessageParamPtrs{}
+	}
+	mmAttachToMessage.defaultExpectation.paramPtrs.maxAge = &maxAge
+	mmAttachToMessage.defaultExpectation.expectationOrigins.originMaxAge = minimock.CallerInfo(1)
+
+	return mmAttachToMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.AttachToMessage
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) Inspect(f func(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration)) *mMessageRepositoryMockAttachToMessage {
+	if mmAttachToMessage.mock.inspectFuncAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.AttachToMessage")
+	}
+
+	mmAttachToMessage.mock.inspectFuncAttachToMessage = f
+
+	return mmAttachToMessage
+}
+
+// Return sets up results that will be returned by MessageRepository.AttachToMessage
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) Return(apa1 []*model.Attachment, err error) *MessageRepositoryMock {
+	if mmAttachToMessage.mock.funcAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Set")
+	}
+
+	if mmAttachToMessage.defaultExpectation == nil {
+		mmAttachToMessage.defaultExpectation = &MessageRepositoryMockAttachToMessageExpectation{mock: mmAttachToMessage.mock}
+	}
+	mmAttachToMessage.defaultExpectation.results = &MessageRepositoryMockAttachToMessageResults{apa1, err}
+	mmAttachToMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAttachToMessage.mock
+}
+
+// Set uses given function f to mock the MessageRepository.AttachToMessage method
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) Set(f func(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration) (apa1 []*model.Attachment, err error)) *MessageRepositoryMock {
+	if mmAttachToMessage.defaultExpectation != nil {
+		mmAttachToMessage.mock.t.Fatalf("Default expectation is already set for the MessageRepository.AttachToMessage method")
+	}
+
+	if len(mmAttachToMessage.expectations) > 0 {
+		mmAttachToMessage.mock.t.Fatalf("Some expectations are already set for the MessageRepository.AttachToMessage method")
+	}
+
+	mmAttachToMessage.mock.funcAttachToMessage = f
+	mmAttachToMessage.mock.funcAttachToMessageOrigin = minimock.CallerInfo(1)
+	return mmAttachToMessage.mock
+}
+
+// When sets expectation for the MessageRepository.AttachToMessage which will trigger the result defined by the following
+// Then helper
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) When(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration) *MessageRepositoryMockAttachToMessageExpectation {
+	if mmAttachToMessage.mock.funcAttachToMessage != nil {
+		mmAttachToMessage.mock.t.Fatalf("MessageRepositoryMock.AttachToMessage mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockAttachToMessageExpectation{
+		mock:               mmAttachToMessage.mock,
+		params:             &MessageRepositoryMockAttachToMessageParams{ctx, message, uploaderID, ids, maxAge},
+		expectationOrigins: MessageRepositoryMockAttachToMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAttachToMessage.expectations = append(mmAttachToMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.AttachToMessage return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockAttachToMessageExpectation) Then(apa1 []*model.Attachment, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockAttachToMessageResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.AttachToMessage should be invoked
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) Times(n uint64) *mMessageRepositoryMockAttachToMessage {
+	if n == 0 {
+		mmAttachToMessage.mock.t.Fatalf("Times of MessageRepositoryMock.AttachToMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAttachToMessage.expectedInvocations, n)
+	mmAttachToMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAttachToMessage
+}
+
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) invocationsDone() bool {
+	if len(mmAttachToMessage.expectations) == 0 && mmAttachToMessage.defaultExpectation == nil && mmAttachToMessage.mock.funcAttachToMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAttachToMessage.mock.afterAttachToMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAttachToMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AttachToMessage implements mm_repository.MessageRepository
+func (mmAttachToMessage *MessageRepositoryMock) AttachToMessage(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration) (apa1 []*model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmAttachToMessage.beforeAttachToMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmAttachToMessage.afterAttachToMessageCounter, 1)
+
+	mmAttachToMessage.t.Helper()
+
+	if mmAttachToMessage.inspectFuncAttachToMessage != nil {
+		mmAttachToMessage.inspectFuncAttachToMessage(ctx, message, uploaderID, ids, maxAge)
+	}
+
+	mm_params := MessageRepositoryMockAttachToMessageParams{ctx, message, uploaderID, ids, maxAge}
+
+	// Record call args
+	mmAttachToMessage.AttachToMessageMock.mutex.Lock()
+	mmAttachToMessage.AttachToMessageMock.callArgs = append(mmAttachToMessage.AttachToMessageMock.callArgs, &mm_params)
+	mmAttachToMessage.AttachToMessageMock.mutex.Unlock()
+
+	for _, e := range mmAttachToMessage.AttachToMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmAttachToMessage.AttachToMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAttachToMessage.AttachToMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmAttachToMessage.AttachToMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmAttachToMessage.AttachToMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockAttachToMessageParams{ctx, message, uploaderID, ids, maxAge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAttachToMessage.t.Errorf("MessageRepositoryMock.AttachToMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttachToMessage.AttachToMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmAttachToMessage.t.Errorf("MessageRepositoryMock.AttachToMessage got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttachToMessage.AttachToMessageMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+			if mm_want_ptrs.uploaderID != nil && !minimock.Equal(*mm_want_ptrs.uploaderID, mm_got.uploaderID) {
+				mmAttachToMessage.t.Errorf("MessageRepositoryMock.AttachToMessage got unexpected parameter uploaderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttachToMessage.AttachToMessageMock.defaultExpectation.expectationOrigins.originUploaderID, *mm_want_ptrs.uploaderID, mm_got.uploaderID, minimock.Diff(*mm_want_ptrs.uploaderID, mm_got.uploaderID))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmAttachToMessage.t.Errorf("MessageRepositoryMock.AttachToMessage got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttachToMessage.AttachToMessageMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+			if mm_want_ptrs.maxAge != nil && !minimock.Equal(*mm_want_ptrs.maxAge, mm_got.maxAge) {
+				mmAttachToMessage.t.Errorf("MessageRepositoryMock.AttachToMessage got unexpected parameter maxAge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAttachToMessage.AttachToMessageMock.defaultExpectation.expectationOrigins.originMaxAge, *mm_want_ptrs.maxAge, mm_got.maxAge, minimock.Diff(*mm_want_ptrs.maxAge, mm_got.maxAge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAttachToMessage.t.Errorf("MessageRepositoryMock.AttachToMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAttachToMessage.AttachToMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAttachToMessage.AttachToMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAttachToMessage.t.Fatal("No results are set for the MessageRepositoryMock.AttachToMessage")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmAttachToMessage.funcAttachToMessage != nil {
+		return mmAttachToMessage.funcAttachToMessage(ctx, message, uploaderID, ids, maxAge)
+	}
+	mmAttachToMessage.t.Fatalf("Unexpected call to MessageRepositoryMock.AttachToMessage. %v %v %v %v %v", ctx, message, uploaderID, ids, maxAge)
+	return
+}
+
+// AttachToMessageAfterCounter returns a count of finished MessageRepositoryMock.AttachToMessage invocations
+func (mmAttachToMessage *MessageRepositoryMock) AttachToMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAttachToMessage.afterAttachToMessageCounter)
+}
+
+// AttachToMessageBeforeCounter returns a count of MessageRepositoryMock.AttachToMessage invocations
+func (mmAttachToMessage *MessageRepositoryMock) AttachToMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAttachToMessage.beforeAttachToMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.AttachToMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAttachToMessage *mMessageRepositoryMockAttachToMessage) Calls() []*MessageRepositoryMockAttachToMessageParams {
+	mmAttachToMessage.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockAttachToMessageParams, len(mmAttachToMessage.callArgs))
+	copy(argCopy, mmAttachToMessage.callArgs)
+
+	mmAttachToMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAttachToMessageDone returns true if the count of the AttachToMessage invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockAttachToMessageDone() bool {
+	if m.AttachToMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AttachToMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AttachToMessageMock.invocationsDone()
+}
+
+// MinimockAttachToMessageInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockAttachToMessageInspect() {
+	for _, e := range m.AttachToMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AttachToMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAttachToMessageCounter := mm_atomic.LoadUint64(&m.afterAttachToMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AttachToMessageMock.defaultExpectation != nil && afterAttachToMessageCounter < 1 {
+		if m.AttachToMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AttachToMessage at\n%s", m.AttachToMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AttachToMessage at\n%s with params: %#v", m.AttachToMessageMock.defaultExpectation.expectationOrigins.origin, *m.AttachToMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAttachToMessage != nil && afterAttachToMessageCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.AttachToMessage at\n%s", m.funcAttachToMessageOrigin)
+	}
+
+	if !m.AttachToMessageMock.invocationsDone() && afterAttachToMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.AttachToMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AttachToMessageMock.expectedInvocations), m.AttachToMessageMock.expectedInvocationsOrigin, afterAttachToMessageCounter)
+	}
+}
+
+type mMessageRepositoryMockCountReactions struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockCountReactionsExpectation
+	expectations       []*MessageRepositoryMockCountReactionsExpectation
+
+	callArgs []*MessageRepositoryMockCountReactionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockCountReactionsExpectation specifies expectation struct of the MessageRepository.CountReactions
+type MessageRepositoryMockCountReactionsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockCountReactionsParams
+	paramPtrs          *MessageRepositoryMockCountReactionsParamPtrs
+	expectationOrigins MessageRepositoryMockCountReactionsExpectationOrigins
+	results            *MessageRepositoryMockCountReactionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockCountReactionsParams contains parameters of the MessageRepository.CountReactions
+type MessageRepositoryMockCountReactionsParams struct {
+	ctx       context.Context
+	messageID int64
+	emoji     string
+}
+
+// MessageRepositoryMockCountReactionsParamPtrs contains pointers to parameters of the MessageRepository.CountReactions
+type MessageRepositoryMockCountReactionsParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	emoji     *string
+}
+
+// MessageRepositoryMockCountReactionsResults contains results of the MessageRepository.CountReactions
+type MessageRepositoryMockCountReactionsResults struct {
+	i1  int64
+	err error
+}
+
+// MessageRepositoryMockCountReactionsOrigins contains origins of expectations of the MessageRepository.CountReactions
+type MessageRepositoryMockCountReactionsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountReactions *mMessageRepositoryMockCountReactions) Optional() *mMessageRepositoryMockCountReactions {
+	mmCountReactions.optional = true
+	return mmCountReactions
+}
+
+// Expect sets up expected params for MessageRepository.CountReactions
+func (mmCountReactions *mMessageRepositoryMockCountReactions) Expect(ctx context.Context, messageID int64, emoji string) *mMessageRepositoryMockCountReactions {
+	if mmCountReactions.mock.funcCountReactions != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Set")
+	}
+
+	if mmCountReactions.defaultExpectation == nil {
+		mmCountReactions.defaultExpectation = &MessageRepositoryMockCountReactionsExpectation{}
+	}
+
+	if mmCountReactions.defaultExpectation.paramPtrs != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by ExpectParams functions")
+	}
+
+	mmCountReactions.defaultExpectation.params = &MessageRepositoryMockCountReactionsParams{ctx, messageID, emoji}
+	mmCountReactions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountReactions.expectations {
+		if minimock.Equal(e.params, mmCountReactions.defaultExpectation.params) {
+			mmCountReactions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountReactions.defaultExpectation.params)
+		}
+	}
+
+	return mmCountReactions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.CountReactions
+func (mmCountReactions *mMessageRepositoryMockCountReactions) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockCountReactions {
+	if mmCountReactions.mock.funcCountReactions != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Set")
+	}
+
+	if mmCountReactions.defaultExpectation == nil {
+		mmCountReactions.defaultExpectation = &MessageRepositoryMockCountReactionsExpectation{}
+	}
+
+	if mmCountReactions.defaultExpectation.params != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Expect")
+	}
+
+	if mmCountReactions.defaultExpectation.paramPtrs == nil {
+		mmCountReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockCountReactionsParamPtrs{}
+	}
+	mmCountReactions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountReactions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountReactions
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.CountReactions
+func (mmCountReactions *mMessageRepositoryMockCountReactions) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockCountReactions {
+	if mmCountReactions.mock.funcCountReactions != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Set")
+	}
+
+	if mmCountReactions.defaultExpectation == nil {
+		mmCountReactions.defaultExpectation = &MessageRepositoryMockCountReactionsExpectation{}
+	}
+
+	if mmCountReactions.defaultExpectation.params != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Expect")
+	}
+
+	if mmCountReactions.defaultExpectation.paramPtrs == nil {
+		mmCountReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockCountReactionsParamPtrs{}
+	}
+	mmCountReactions.defaultExpectation.paramPtrs.messageID = &messageID
+	mmCountReactions.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmCountReactions
+}
+
+// ExpectEmojiParam3 sets up expected param emoji for MessageRepository.CountReactions
+func (mmCountReactions *mMessageRepositoryMockCountReactions) ExpectEmojiParam3(emoji string) *mMessageRepositoryMockCountReactions {
+	if mmCountReactions.mock.funcCountReactions != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Set")
+	}
+
+	if mmCountReactions.defaultExpectation == nil {
+		mmCountReactions.defaultExpectation = &MessageRepositoryMockCountReactionsExpectation{}
+	}
+
+	if mmCountReactions.defaultExpectation.params != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Expect")
+	}
+
+	if mmCountReactions.defaultExpectation.paramPtrs == nil {
+		mmCountReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockCountReactionsParamPtrs{}
+	}
+	mmCountReactions.defaultExpectation.paramPtrs.emoji = &emoji
+	mmCountReactions.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmCountReactions
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.CountReactions
+func (mmCountReactions *mMessageRepositoryMockCountReactions) Inspect(f func(ctx context.Context, messageID int64, emoji string)) *mMessageRepositoryMockCountReactions {
+	if mmCountReactions.mock.inspectFuncCountReactions != nil {
+		mmCountReactions.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.CountReactions")
+	}
+
+	mmCountReactions.mock.inspectFuncCountReactions = f
+
+	return mmCountReactions
+}
+
+// Return sets up results that will be returned by MessageRepository.CountReactions
+func (mmCountReactions *mMessageRepositoryMockCountReactions) Return(i1 int64, err error) *MessageRepositoryMock {
+	if mmCountReactions.mock.funcCountReactions != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Set")
+	}
+
+	if mmCountReactions.defaultExpectation == nil {
+		mmCountReactions.defaultExpectation = &MessageRepositoryMockCountReactionsExpectation{mock: mmCountReactions.mock}
+	}
+	mmCountReactions.defaultExpectation.results = &MessageRepositoryMockCountReactionsResults{i1, err}
+	mmCountReactions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountReactions.mock
+}
+
+// Set uses given function f to mock the MessageRepository.CountReactions method
+func (mmCountReactions *mMessageRepositoryMockCountReactions) Set(f func(ctx context.Context, messageID int64, emoji string) (i1 int64, err error)) *MessageRepositoryMock {
+	if mmCountReactions.defaultExpectation != nil {
+		mmCountReactions.mock.t.Fatalf("Default expectation is already set for the MessageRepository.CountReactions method")
+	}
+
+	if len(mmCountReactions.expectations) > 0 {
+		mmCountReactions.mock.t.Fatalf("Some expectations are already set for the MessageRepository.CountReactions method")
+	}
+
+	mmCountReactions.mock.funcCountReactions = f
+	mmCountReactions.mock.funcCountReactionsOrigin = minimock.CallerInfo(1)
+	return mmCountReactions.mock
+}
+
+// When sets expectation for the MessageRepository.CountReactions which will trigger the result defined by the following
+// Then helper
+func (mmCountReactions *mMessageRepositoryMockCountReactions) When(ctx context.Context, messageID int64, emoji string) *MessageRepositoryMockCountReactionsExpectation {
+	if mmCountReactions.mock.funcCountReactions != nil {
+		mmCountReactions.mock.t.Fatalf("MessageRepositoryMock.CountReactions mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockCountReactionsExpectation{
+		mock:               mmCountReactions.mock,
+		params:             &MessageRepositoryMockCountReactionsParams{ctx, messageID, emoji},
+		expectationOrigins: MessageRepositoryMockCountReactionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountReactions.expectations = append(mmCountReactions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.CountReactions return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockCountReactionsExpectation) Then(i1 int64, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockCountReactionsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.CountReactions should be invoked
+func (mmCountReactions *mMessageRepositoryMockCountReactions) Times(n uint64) *mMessageRepositoryMockCountReactions {
+	if n == 0 {
+		mmCountReactions.mock.t.Fatalf("Times of MessageRepositoryMock.CountReactions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountReactions.expectedInvocations, n)
+	mmCountReactions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountReactions
+}
+
+func (mmCountReactions *mMessageRepositoryMockCountReactions) invocationsDone() bool {
+	if len(mmCountReactions.expectations) == 0 && mmCountReactions.defaultExpectation == nil && mmCountReactions.mock.funcCountReactions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountReactions.mock.afterCountReactionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountReactions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountReactions implements mm_repository.MessageRepository
+func (mmCountReactions *MessageRepositoryMock) CountReactions(ctx context.Context, messageID int64, emoji string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountReactions.beforeCountReactionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountReactions.afterCountReactionsCounter, 1)
+
+	mmCountReactions.t.Helper()
+
+	if mmCountReactions.inspectFuncCountReactions != nil {
+		mmCountReactions.inspectFuncCountReactions(ctx, messageID, emoji)
+	}
+
+	mm_params := MessageRepositoryMockCountReactionsParams{ctx, messageID, emoji}
+
+	// Record call args
+	mmCountReactions.CountReactionsMock.mutex.Lock()
+	mmCountReactions.CountReactionsMock.callArgs = append(mmCountReactions.CountReactionsMock.callArgs, &mm_params)
+	mmCountReactions.CountReactionsMock.mutex.Unlock()
+
+	for _, e := range mmCountReactions.CountReactionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountReactions.CountReactionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountReactions.CountReactionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountReactions.CountReactionsMock.defaultExpectation.params
+		mm_want_ptrs := mmCountReactions.CountReactionsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockCountReactionsParams{ctx, messageID, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountReactions.t.Errorf("MessageRepositoryMock.CountReactions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountReactions.CountReactionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmCountReactions.t.Errorf("MessageRepositoryMock.CountReactions got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountReactions.CountReactionsMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmCountReactions.t.Errorf("MessageRepositoryMock.CountReactions got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountReactions.CountReactionsMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountReactions.t.Errorf("MessageRepositoryMock.CountReactions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountReactions.CountReactionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountReactions.CountReactionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountReactions.t.Fatal("No results are set for the MessageRepositoryMock.CountReactions")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountReactions.funcCountReactions != nil {
+		return mmCountReactions.funcCountReactions(ctx, messageID, emoji)
+	}
+	mmCountReactions.t.Fatalf("Unexpected call to MessageRepositoryMock.CountReactions. %v %v %v", ctx, messageID, emoji)
+	return
+}
+
+// CountReactionsAfterCounter returns a count of finished MessageRepositoryMock.CountReactions invocations
+func (mmCountReactions *MessageRepositoryMock) CountReactionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountReactions.afterCountReactionsCounter)
+}
+
+// CountReactionsBeforeCounter returns a count of MessageRepositoryMock.CountReactions invocations
+func (mmCountReactions *MessageRepositoryMock) CountReactionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountReactions.beforeCountReactionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.CountReactions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountReactions *mMessageRepositoryMockCountReactions) Calls() []*MessageRepositoryMockCountReactionsParams {
+	mmCountReactions.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockCountReactionsParams, len(mmCountReactions.callArgs))
+	copy(argCopy, mmCountReactions.callArgs)
+
+	mmCountReactions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountReactionsDone returns true if the count of the CountReactions invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockCountReactionsDone() bool {
+	if m.CountReactionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountReactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountReactionsMock.invocationsDone()
+}
+
+// MinimockCountReactionsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockCountReactionsInspect() {
+	for _, e := range m.CountReactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountReactions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountReactionsCounter := mm_atomic.LoadUint64(&m.afterCountReactionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountReactionsMock.defaultExpectation != nil && afterCountReactionsCounter < 1 {
+		if m.CountReactionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountReactions at\n%s", m.CountReactionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountReactions at\n%s with params: %#v", m.CountReactionsMock.defaultExpectation.expectationOrigins.origin, *m.CountReactionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountReactions != nil && afterCountReactionsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.CountReactions at\n%s", m.funcCountReactionsOrigin)
+	}
+
+	if !m.CountReactionsMock.invocationsDone() && afterCountReactionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.CountReactions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountReactionsMock.expectedInvocations), m.CountReactionsMock.expectedInvocationsOrigin, afterCountReactionsCounter)
+	}
+}
+
+type mMessageRepositoryMockCreate struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockCreateExpectation
+	expectations       []*MessageRepositoryMockCreateExpectation
+
+	callArgs []*MessageRepositoryMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockCreateExpectation specifies expectation struct of the MessageRepository.Create
+type MessageRepositoryMockCreateExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockCreateParams
+	paramPtrs          *MessageRepositoryMockCreateParamPtrs
+	expectationOrigins MessageRepositoryMockCreateExpectationOrigins
+	results            *MessageRepositoryMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockCreateParams contains parameters of the MessageRepository.Create
+type MessageRepositoryMockCreateParams struct {
+	ctx     context.Context
+	message *model.Message
+}
+
+// MessageRepositoryMockCreateParamPtrs contains pointers to parameters of the MessageRepository.Create
+type MessageRepositoryMockCreateParamPtrs struct {
+	ctx     *context.Context
+	message **model.Message
+}
+
+// MessageRepositoryMockCreateResults contains results of the MessageRepository.Create
+type MessageRepositoryMockCreateResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockCreateOrigins contains origins of expectations of the MessageRepository.Create
+type MessageRepositoryMockCreateExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originMessage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mMessageRepositoryMockCreate) Optional() *mMessageRepositoryMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for MessageRepository.Create
+func (mmCreate *mMessageRepositoryMockCreate) Expect(ctx context.Context, message *model.Message) *mMessageRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &MessageRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("MessageRepositoryMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &MessageRepositoryMockCreateParams{ctx, message}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Create
+func (mmCreate *mMessageRepositoryMockCreate) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &MessageRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("MessageRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &MessageRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectMessageParam2 sets up expected param message for MessageRepository.Create
+func (mmCreate *mMessageRepositoryMockCreate) ExpectMessageParam2(message *model.Message) *mMessageRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &MessageRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("MessageRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &MessageRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.message = &message
+	mmCreate.defaultExpectation.expectationOrigins.originMessage = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Create
+func (mmCreate *mMessageRepositoryMockCreate) Inspect(f func(ctx context.Context, message *model.Message)) *mMessageRepositoryMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by MessageRepository.Create
+func (mmCreate *mMessageRepositoryMockCreate) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &MessageRepositoryMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &MessageRepositoryMockCreateResults{mp1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Create method
+func (mmCreate *mMessageRepositoryMockCreate) Set(f func(ctx context.Context, message *model.Message) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the MessageRepository.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mMessageRepositoryMockCreate) When(ctx context.Context, message *model.Message) *MessageRepositoryMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("MessageRepositoryMock.Create mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &MessageRepositoryMockCreateParams{ctx, message},
+		expectationOrigins: MessageRepositoryMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Create return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockCreateExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockCreateResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Create should be invoked
+func (mmCreate *mMessageRepositoryMockCreate) Times(n uint64) *mMessageRepositoryMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of MessageRepositoryMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mMessageRepositoryMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_repository.MessageRepository
+func (mmCreate *MessageRepositoryMock) Create(ctx context.Context, message *model.Message) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, message)
+	}
+
+	mm_params := MessageRepositoryMockCreateParams{ctx, message}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockCreateParams{ctx, message}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("MessageRepositoryMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.message != nil && !minimock.Equal(*mm_want_ptrs.message, mm_got.message) {
+				mmCreate.t.Errorf("MessageRepositoryMock.Create got unexpected parameter message, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originMessage, *mm_want_ptrs.message, mm_got.message, minimock.Diff(*mm_want_ptrs.message, mm_got.message))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("MessageRepositoryMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the MessageRepositoryMock.Create")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, message)
+	}
+	mmCreate.t.Fatalf("Unexpected call to MessageRepositoryMock.Create. %v %v", ctx, message)
+	return
+}
+
+// CreateAfterCounter returns a count of finished MessageRepositoryMock.Create invocations
+func (mmCreate *MessageRepositoryMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of MessageRepositoryMock.Create invocations
+func (mmCreate *MessageRepositoryMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mMessageRepositoryMockCreate) Calls() []*MessageRepositoryMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mMessageRepositoryMockCreateAttachment struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockCreateAttachmentExpectation
+	expectations       []*MessageRepositoryMockCreateAttachmentExpectation
+
+	callArgs []*MessageRepositoryMockCreateAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockCreateAttachmentExpectation specifies expectation struct of the MessageRepository.CreateAttachment
+type MessageRepositoryMockCreateAttachmentExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockCreateAttachmentParams
+	paramPtrs          *MessageRepositoryMockCreateAttachmentParamPtrs
+	expectationOrigins MessageRepositoryMockCreateAttachmentExpectationOrigins
+	results            *MessageRepositoryMockCreateAttachmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockCreateAttachmentParams contains parameters of the MessageRepository.CreateAttachment
+type MessageRepositoryMockCreateAttachmentParams struct {
+	ctx        context.Context
+	attachment *model.Attachment
+}
+
+// MessageRepositoryMockCreateAttachmentParamPtrs contains pointers to parameters of the MessageRepository.CreateAttachment
+type MessageRepositoryMockCreateAttachmentParamPtrs struct {
+	ctx        *context.Context
+	attachment **model.Attachment
+}
+
+// MessageRepositoryMockCreateAttachmentResults contains results of the MessageRepository.CreateAttachment
+type MessageRepositoryMockCreateAttachmentResults struct {
+	ap1 *model.Attachment
+	err error
+}
+
+// MessageRepositoryMockCreateAttachmentOrigins contains origins of expectations of the MessageRepository.CreateAttachment
+type MessageRepositoryMockCreateAttachmentExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originAttachment string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) Optional() *mMessageRepositoryMockCreateAttachment {
+	mmCreateAttachment.optional = true
+	return mmCreateAttachment
+}
+
+// Expect sets up expected params for MessageRepository.CreateAttachment
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) Expect(ctx context.Context, attachment *model.Attachment) *mMessageRepositoryMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("MessageRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &MessageRepositoryMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs != nil {
+		mmCreateAttachment.mock.t.Fatalf("MessageRepositoryMock.CreateAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAttachment.defaultExpectation.params = &MessageRepositoryMockCreateAttachmentParams{ctx, attachment}
+	mmCreateAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAttachment.expectations {
+		if minimock.Equal(e.params, mmCreateAttachment.defaultExpectation.params) {
+			mmCreateAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.CreateAttachment
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("MessageRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &MessageRepositoryMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.params != nil {
+		mmCreateAttachment.mock.t.Fatalf("MessageRepositoryMock.CreateAttachment mock is already set by Expect")
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs == nil {
+		mmCreateAttachment.defaultExpectation.paramPtrs = &MessageRepositoryMockCreateAttachmentParamPtrs{}
+	}
+	mmCreateAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAttachment
+}
+
+// ExpectAttachmentParam2 sets up expected param attachment for MessageRepository.CreateAttachment
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) ExpectAttachmentParam2(attachment *model.Attachment) *mMessageRepositoryMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("MessageRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &MessageRepositoryMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.params != nil {
+		mmCreateAttachment.mock.t.Fatalf("MessageRepositoryMock.CreateAttachment mock is already set by Expect")
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs == nil {
+		mmCreateAttachment.defaultExpectation.paramPtrs = &MessageRepositoryMockCreateAttachmentParamPtrs{}
+	}
+	mmCreateAttachment.defaultExpectation.paramPtrs.attachment = &attachment
+	mmCreateAttachment.defaultExpectation.expectationOrigins.originAttachment = minimock.CallerInfo(1)
+
+	return mmCreateAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.CreateAttachment
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) Inspect(f func(ctx context.Context, attachment *model.Attachment)) *mMessageRepositoryMockCreateAttachment {
+	if mmCreateAttachment.mock.inspectFuncCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.CreateAttachment")
+	}
+
+	mmCreateAttachment.mock.inspectFuncCreateAttachment = f
+
+	return mmCreateAttachment
+}
+
+// Return sets up results that will be returned by MessageRepository.CreateAttachment
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) Return(ap1 *model.Attachment, err error) *MessageRepositoryMock {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("MessageRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &MessageRepositoryMockCreateAttachmentExpectation{mock: mmCreateAttachment.mock}
+	}
+	mmCreateAttachment.defaultExpectation.results = &MessageRepositoryMockCreateAttachmentResults{ap1, err}
+	mmCreateAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment.mock
+}
+
+// Set uses given function f to mock the MessageRepository.CreateAttachment method
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) Set(f func(ctx context.Context, attachment *model.Attachment) (ap1 *model.Attachment, err error)) *MessageRepositoryMock {
+	if mmCreateAttachment.defaultExpectation != nil {
+		mmCreateAttachment.mock.t.Fatalf("Default expectation is already set for the MessageRepository.CreateAttachment method")
+	}
+
+	if len(mmCreateAttachment.expectations) > 0 {
+		mmCreateAttachment.mock.t.Fatalf("Some expectations are already set for the MessageRepository.CreateAttachment method")
+	}
+
+	mmCreateAttachment.mock.funcCreateAttachment = f
+	mmCreateAttachment.mock.funcCreateAttachmentOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment.mock
+}
+
+// When sets expectation for the MessageRepository.CreateAttachment which will trigger the result defined by the following
+// Then helper
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) When(ctx context.Context, attachment *model.Attachment) *MessageRepositoryMockCreateAttachmentExpectation {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("MessageRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockCreateAttachmentExpectation{
+		mock:               mmCreateAttachment.mock,
+		params:             &MessageRepositoryMockCreateAttachmentParams{ctx, attachment},
+		expectationOrigins: MessageRepositoryMockCreateAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAttachment.expectations = append(mmCreateAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.CreateAttachment return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockCreateAttachmentExpectation) Then(ap1 *model.Attachment, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockCreateAttachmentResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.CreateAttachment should be invoked
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) Times(n uint64) *mMessageRepositoryMockCreateAttachment {
+	if n == 0 {
+		mmCreateAttachment.mock.t.Fatalf("Times of MessageRepositoryMock.CreateAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAttachment.expectedInvocations, n)
+	mmCreateAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment
+}
+
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) invocationsDone() bool {
+	if len(mmCreateAttachment.expectations) == 0 && mmCreateAttachment.defaultExpectation == nil && mmCreateAttachment.mock.funcCreateAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAttachment.mock.afterCreateAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAttachment implements mm_repository.MessageRepository
+func (mmCreateAttachment *MessageRepositoryMock) CreateAttachment(ctx context.Context, attachment *model.Attachment) (ap1 *model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmCreateAttachment.beforeCreateAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAttachment.afterCreateAttachmentCounter, 1)
+
+	mmCreateAttachment.t.Helper()
+
+	if mmCreateAttachment.inspectFuncCreateAttachment != nil {
+		mmCreateAttachment.inspectFuncCreateAttachment(ctx, attachment)
+	}
+
+	mm_params := MessageRepositoryMockCreateAttachmentParams{ctx, attachment}
+
+	// Record call args
+	mmCreateAttachment.CreateAttachmentMock.mutex.Lock()
+	mmCreateAttachment.CreateAttachmentMock.callArgs = append(mmCreateAttachment.CreateAttachmentMock.callArgs, &mm_params)
+	mmCreateAttachment.CreateAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmCreateAttachment.CreateAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmCreateAttachment.CreateAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAttachment.CreateAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockCreateAttachmentParams{ctx, attachment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAttachment.t.Errorf("MessageRepositoryMock.CreateAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.attachment != nil && !minimock.Equal(*mm_want_ptrs.attachment, mm_got.attachment) {
+				mmCreateAttachment.t.Errorf("MessageRepositoryMock.CreateAttachment got unexpected parameter attachment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.originAttachment, *mm_want_ptrs.attachment, mm_got.attachment, minimock.Diff(*mm_want_ptrs.attachment, mm_got.attachment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAttachment.t.Errorf("MessageRepositoryMock.CreateAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAttachment.t.Fatal("No results are set for the MessageRepositoryMock.CreateAttachment")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmCreateAttachment.funcCreateAttachment != nil {
+		return mmCreateAttachment.funcCreateAttachment(ctx, attachment)
+	}
+	mmCreateAttachment.t.Fatalf("Unexpected call to MessageRepositoryMock.CreateAttachment. %v %v", ctx, attachment)
+	return
+}
+
+// CreateAttachmentAfterCounter returns a count of finished MessageRepositoryMock.CreateAttachment invocations
+func (mmCreateAttachment *MessageRepositoryMock) CreateAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAttachment.afterCreateAttachmentCounter)
+}
+
+// CreateAttachmentBeforeCounter returns a count of MessageRepositoryMock.CreateAttachment invocations
+func (mmCreateAttachment *MessageRepositoryMock) CreateAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAttachment.beforeCreateAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.CreateAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAttachment *mMessageRepositoryMockCreateAttachment) Calls() []*MessageRepositoryMockCreateAttachmentParams {
+	mmCreateAttachment.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockCreateAttachmentParams, len(mmCreateAttachment.callArgs))
+	copy(argCopy, mmCreateAttachment.callArgs)
+
+	mmCreateAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAttachmentDone returns true if the count of the CreateAttachment invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockCreateAttachmentDone() bool {
+	if m.CreateAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAttachmentMock.invocationsDone()
+}
+
+// MinimockCreateAttachmentInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockCreateAttachmentInspect() {
+	for _, e := range m.CreateAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CreateAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAttachmentCounter := mm_atomic.LoadUint64(&m.afterCreateAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAttachmentMock.defaultExpectation != nil && afterCreateAttachmentCounter < 1 {
+		if m.CreateAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CreateAttachment at\n%s", m.CreateAttachmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CreateAttachment at\n%s with params: %#v", m.CreateAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.CreateAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAttachment != nil && afterCreateAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.CreateAttachment at\n%s", m.funcCreateAttachmentOrigin)
+	}
+
+	if !m.CreateAttachmentMock.invocationsDone() && afterCreateAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.CreateAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAttachmentMock.expectedInvocations), m.CreateAttachmentMock.expectedInvocationsOrigin, afterCreateAttachmentCounter)
+	}
+}
+
+type mMessageRepositoryMockDelete struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockDeleteExpectation
+	expectations       []*MessageRepositoryMockDeleteExpectation
+
+	callArgs []*MessageRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockDeleteExpectation specifies expectation struct of the MessageRepository.Delete
+type MessageRepositoryMockDeleteExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockDeleteParams
+	paramPtrs          *MessageRepositoryMockDeleteParamPtrs
+	expectationOrigins MessageRepositoryMockDeleteExpectationOrigins
+	results            *MessageRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockDeleteParams contains parameters of the MessageRepository.Delete
+type MessageRepositoryMockDeleteParams struct {
+	ctx       context.Context
+	id        int64
+	deletedBy string
+}
+
+// MessageRepositoryMockDeleteParamPtrs contains pointers to parameters of the MessageRepository.Delete
+type MessageRepositoryMockDeleteParamPtrs struct {
+	ctx       *context.Context
+	id        *int64
+	deletedBy *string
+}
+
+// MessageRepositoryMockDeleteResults contains results of the MessageRepository.Delete
+type MessageRepositoryMockDeleteResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockDeleteOrigins contains origins of expectations of the MessageRepository.Delete
+type MessageRepositoryMockDeleteExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originId        string
+	originDeletedBy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mMessageRepositoryMockDelete) Optional() *mMessageRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) Expect(ctx context.Context, id int64, deletedBy string) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &MessageRepositoryMockDeleteParams{ctx, id, deletedBy}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) ExpectIdParam2(id int64) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectDeletedByParam3 sets up expected param deletedBy for MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) ExpectDeletedByParam3(deletedBy string) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.deletedBy = &deletedBy
+	mmDelete.defaultExpectation.expectationOrigins.originDeletedBy = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) Inspect(f func(ctx context.Context, id int64, deletedBy string)) *mMessageRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by MessageRepository.Delete
+func (mmDelete *mMessageRepositoryMockDelete) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &MessageRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &MessageRepositoryMockDeleteResults{mp1, err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Delete method
+func (mmDelete *mMessageRepositoryMockDelete) Set(f func(ctx context.Context, id int64, deletedBy string) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the MessageRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mMessageRepositoryMockDelete) When(ctx context.Context, id int64, deletedBy string) *MessageRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("MessageRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &MessageRepositoryMockDeleteParams{ctx, id, deletedBy},
+		expectationOrigins: MessageRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockDeleteExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockDeleteResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Delete should be invoked
+func (mmDelete *mMessageRepositoryMockDelete) Times(n uint64) *mMessageRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of MessageRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mMessageRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.MessageRepository
+func (mmDelete *MessageRepositoryMock) Delete(ctx context.Context, id int64, deletedBy string) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id, deletedBy)
+	}
+
+	mm_params := MessageRepositoryMockDeleteParams{ctx, id, deletedBy}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockDeleteParams{ctx, id, deletedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("MessageRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("MessageRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.deletedBy != nil && !minimock.Equal(*mm_want_ptrs.deletedBy, mm_got.deletedBy) {
+				mmDelete.t.Errorf("MessageRepositoryMock.Delete got unexpected parameter deletedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originDeletedBy, *mm_want_ptrs.deletedBy, mm_got.deletedBy, minimock.Diff(*mm_want_ptrs.deletedBy, mm_got.deletedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("MessageRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the MessageRepositoryMock.Delete")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id, deletedBy)
+	}
+	mmDelete.t.Fatalf("Unexpected call to MessageRepositoryMock.Delete. %v %v %v", ctx, id, deletedBy)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished MessageRepositoryMock.Delete invocations
+func (mmDelete *MessageRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of MessageRepositoryMock.Delete invocations
+func (mmDelete *MessageRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mMessageRepositoryMockDelete) Calls() []*MessageRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mMessageRepositoryMockDeleteChatAttachments struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockDeleteChatAttachmentsExpectation
+	expectations       []*MessageRepositoryMockDeleteChatAttachmentsExpectation
+
+	callArgs []*MessageRepositoryMockDeleteChatAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockDeleteChatAttachmentsExpectation specifies expectation struct of the MessageRepository.DeleteChatAttachments
+type MessageRepositoryMockDeleteChatAttachmentsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockDeleteChatAttachmentsParams
+	paramPtrs          *MessageRepositoryMockDeleteChatAttachmentsParamPtrs
+	expectationOrigins MessageRepositoryMockDeleteChatAttachmentsExpectationOrigins
+	results            *MessageRepositoryMockDeleteChatAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockDeleteChatAttachmentsParams contains parameters of the MessageRepository.DeleteChatAttachments
+type MessageRepositoryMockDeleteChatAttachmentsParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// MessageRepositoryMockDeleteChatAttachmentsParamPtrs contains pointers to parameters of the MessageRepository.DeleteChatAttachments
+type MessageRepositoryMockDeleteChatAttachmentsParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// MessageRepositoryMockDeleteChatAttachmentsResults contains results of the MessageRepository.DeleteChatAttachments
+type MessageRepositoryMockDeleteChatAttachmentsResults struct {
+	sa1 []string
+	err error
+}
+
+// MessageRepositoryMockDeleteChatAttachmentsOrigins contains origins of expectations of the MessageRepository.DeleteChatAttachments
+type MessageRepositoryMockDeleteChatAttachmentsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) Optional() *mMessageRepositoryMockDeleteChatAttachments {
+	mmDeleteChatAttachments.optional = true
+	return mmDeleteChatAttachments
+}
+
+// Expect sets up expected params for MessageRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) Expect(ctx context.Context, chatID int64) *mMessageRepositoryMockDeleteChatAttachments {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation == nil {
+		mmDeleteChatAttachments.defaultExpectation = &MessageRepositoryMockDeleteChatAttachmentsExpectation{}
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.paramPtrs != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteChatAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteChatAttachments.defaultExpectation.params = &MessageRepositoryMockDeleteChatAttachmentsParams{ctx, chatID}
+	mmDeleteChatAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteChatAttachments.expectations {
+		if minimock.Equal(e.params, mmDeleteChatAttachments.defaultExpectation.params) {
+			mmDeleteChatAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChatAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteChatAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockDeleteChatAttachments {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation == nil {
+		mmDeleteChatAttachments.defaultExpectation = &MessageRepositoryMockDeleteChatAttachmentsExpectation{}
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.params != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteChatAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteChatAttachments.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteChatAttachmentsParamPtrs{}
+	}
+	mmDeleteChatAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteChatAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteChatAttachments
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockDeleteChatAttachments {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation == nil {
+		mmDeleteChatAttachments.defaultExpectation = &MessageRepositoryMockDeleteChatAttachmentsExpectation{}
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.params != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteChatAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteChatAttachments.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteChatAttachmentsParamPtrs{}
+	}
+	mmDeleteChatAttachments.defaultExpectation.paramPtrs.chatID = &chatID
+	mmDeleteChatAttachments.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmDeleteChatAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) Inspect(f func(ctx context.Context, chatID int64)) *mMessageRepositoryMockDeleteChatAttachments {
+	if mmDeleteChatAttachments.mock.inspectFuncDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.DeleteChatAttachments")
+	}
+
+	mmDeleteChatAttachments.mock.inspectFuncDeleteChatAttachments = f
+
+	return mmDeleteChatAttachments
+}
+
+// Return sets up results that will be returned by MessageRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) Return(sa1 []string, err error) *MessageRepositoryMock {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation == nil {
+		mmDeleteChatAttachments.defaultExpectation = &MessageRepositoryMockDeleteChatAttachmentsExpectation{mock: mmDeleteChatAttachments.mock}
+	}
+	mmDeleteChatAttachments.defaultExpectation.results = &MessageRepositoryMockDeleteChatAttachmentsResults{sa1, err}
+	mmDeleteChatAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteChatAttachments.mock
+}
+
+// Set uses given function f to mock the MessageRepository.DeleteChatAttachments method
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) Set(f func(ctx context.Context, chatID int64) (sa1 []string, err error)) *MessageRepositoryMock {
+	if mmDeleteChatAttachments.defaultExpectation != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("Default expectation is already set for the MessageRepository.DeleteChatAttachments method")
+	}
+
+	if len(mmDeleteChatAttachments.expectations) > 0 {
+		mmDeleteChatAttachments.mock.t.Fatalf("Some expectations are already set for the MessageRepository.DeleteChatAttachments method")
+	}
+
+	mmDeleteChatAttachments.mock.funcDeleteChatAttachments = f
+	mmDeleteChatAttachments.mock.funcDeleteChatAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmDeleteChatAttachments.mock
+}
+
+// When sets expectation for the MessageRepository.DeleteChatAttachments which will trigger the result defined by the following
+// Then helper
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) When(ctx context.Context, chatID int64) *MessageRepositoryMockDeleteChatAttachmentsExpectation {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockDeleteChatAttachmentsExpectation{
+		mock:               mmDeleteChatAttachments.mock,
+		params:             &MessageRepositoryMockDeleteChatAttachmentsParams{ctx, chatID},
+		expectationOrigins: MessageRepositoryMockDeleteChatAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteChatAttachments.expectations = append(mmDeleteChatAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.DeleteChatAttachments return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockDeleteChatAttachmentsExpectation) Then(sa1 []string, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockDeleteChatAttachmentsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.DeleteChatAttachments should be invoked
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) Times(n uint64) *mMessageRepositoryMockDeleteChatAttachments {
+	if n == 0 {
+		mmDeleteChatAttachments.mock.t.Fatalf("Times of MessageRepositoryMock.DeleteChatAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteChatAttachments.expectedInvocations, n)
+	mmDeleteChatAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteChatAttachments
+}
+
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) invocationsDone() bool {
+	if len(mmDeleteChatAttachments.expectations) == 0 && mmDeleteChatAttachments.defaultExpectation == nil && mmDeleteChatAttachments.mock.funcDeleteChatAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChatAttachments.mock.afterDeleteChatAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChatAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteChatAttachments implements mm_repository.MessageRepository
+func (mmDeleteChatAttachments *MessageRepositoryMock) DeleteChatAttachments(ctx context.Context, chatID int64) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmDeleteChatAttachments.beforeDeleteChatAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteChatAttachments.afterDeleteChatAttachmentsCounter, 1)
+
+	mmDeleteChatAttachments.t.Helper()
+
+	if mmDeleteChatAttachments.inspectFuncDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.inspectFuncDeleteChatAttachments(ctx, chatID)
+	}
+
+	mm_params := MessageRepositoryMockDeleteChatAttachmentsParams{ctx, chatID}
+
+	// Record call args
+	mmDeleteChatAttachments.DeleteChatAttachmentsMock.mutex.Lock()
+	mmDeleteChatAttachments.DeleteChatAttachmentsMock.callArgs = append(mmDeleteChatAttachments.DeleteChatAttachmentsMock.callArgs, &mm_params)
+	mmDeleteChatAttachments.DeleteChatAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteChatAttachments.DeleteChatAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockDeleteChatAttachmentsParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteChatAttachments.t.Errorf("MessageRepositoryMock.DeleteChatAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmDeleteChatAttachments.t.Errorf("MessageRepositoryMock.DeleteChatAttachments got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteChatAttachments.t.Errorf("MessageRepositoryMock.DeleteChatAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteChatAttachments.t.Fatal("No results are set for the MessageRepositoryMock.DeleteChatAttachments")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmDeleteChatAttachments.funcDeleteChatAttachments != nil {
+		return mmDeleteChatAttachments.funcDeleteChatAttachments(ctx, chatID)
+	}
+	mmDeleteChatAttachments.t.Fatalf("Unexpected call to MessageRepositoryMock.DeleteChatAttachments. %v %v", ctx, chatID)
+	return
+}
+
+// DeleteChatAttachmentsAfterCounter returns a count of finished MessageRepositoryMock.DeleteChatAttachments invocations
+func (mmDeleteChatAttachments *MessageRepositoryMock) DeleteChatAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChatAttachments.afterDeleteChatAttachmentsCounter)
+}
+
+// DeleteChatAttachmentsBeforeCounter returns a count of MessageRepositoryMock.DeleteChatAttachments invocations
+func (mmDeleteChatAttachments *MessageRepositoryMock) DeleteChatAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChatAttachments.beforeDeleteChatAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.DeleteChatAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteChatAttachments *mMessageRepositoryMockDeleteChatAttachments) Calls() []*MessageRepositoryMockDeleteChatAttachmentsParams {
+	mmDeleteChatAttachments.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockDeleteChatAttachmentsParams, len(mmDeleteChatAttachments.callArgs))
+	copy(argCopy, mmDeleteChatAttachments.callArgs)
+
+	mmDeleteChatAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteChatAttachmentsDone returns true if the count of the DeleteChatAttachments invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockDeleteChatAttachmentsDone() bool {
+	if m.DeleteChatAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteChatAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteChatAttachmentsMock.invocationsDone()
+}
+
+// MinimockDeleteChatAttachmentsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockDeleteChatAttachmentsInspect() {
+	for _, e := range m.DeleteChatAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteChatAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteChatAttachmentsCounter := mm_atomic.LoadUint64(&m.afterDeleteChatAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteChatAttachmentsMock.defaultExpectation != nil && afterDeleteChatAttachmentsCounter < 1 {
+		if m.DeleteChatAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteChatAttachments at\n%s", m.DeleteChatAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteChatAttachments at\n%s with params: %#v", m.DeleteChatAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteChatAttachments != nil && afterDeleteChatAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.DeleteChatAttachments at\n%s", m.funcDeleteChatAttachmentsOrigin)
+	}
+
+	if !m.DeleteChatAttachmentsMock.invocationsDone() && afterDeleteChatAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.DeleteChatAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteChatAttachmentsMock.expectedInvocations), m.DeleteChatAttachmentsMock.expectedInvocationsOrigin, afterDeleteChatAttachmentsCounter)
+	}
+}
+
+type mMessageRepositoryMockDeleteMessageAttachments struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockDeleteMessageAttachmentsExpectation
+	expectations       []*MessageRepositoryMockDeleteMessageAttachmentsExpectation
+
+	callArgs []*MessageRepositoryMockDeleteMessageAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockDeleteMessageAttachmentsExpectation specifies expectation struct of the MessageRepository.DeleteMessageAttachments
+type MessageRepositoryMockDeleteMessageAttachmentsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockDeleteMessageAttachmentsParams
+	paramPtrs          *MessageRepositoryMockDeleteMessageAttachmentsParamPtrs
+	expectationOrigins MessageRepositoryMockDeleteMessageAttachmentsExpectationOrigins
+	results            *MessageRepositoryMockDeleteMessageAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockDeleteMessageAttachmentsParams contains parameters of the MessageRepository.DeleteMessageAttachments
+type MessageRepositoryMockDeleteMessageAttachmentsParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// MessageRepositoryMockDeleteMessageAttachmentsParamPtrs contains pointers to parameters of the MessageRepository.DeleteMessageAttachments
+type MessageRepositoryMockDeleteMessageAttachmentsParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// MessageRepositoryMockDeleteMessageAttachmentsResults contains results of the MessageRepository.DeleteMessageAttachments
+type MessageRepositoryMockDeleteMessageAttachmentsResults struct {
+	sa1 []string
+	err error
+}
+
+// MessageRepositoryMockDeleteMessageAttachmentsOrigins contains origins of expectations of the MessageRepository.DeleteMessageAttachments
+type MessageRepositoryMockDeleteMessageAttachmentsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) Optional() *mMessageRepositoryMockDeleteMessageAttachments {
+	mmDeleteMessageAttachments.optional = true
+	return mmDeleteMessageAttachments
+}
+
+// Expect sets up expected params for MessageRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) Expect(ctx context.Context, messageID int64) *mMessageRepositoryMockDeleteMessageAttachments {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation == nil {
+		mmDeleteMessageAttachments.defaultExpectation = &MessageRepositoryMockDeleteMessageAttachmentsExpectation{}
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteMessageAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessageAttachments.defaultExpectation.params = &MessageRepositoryMockDeleteMessageAttachmentsParams{ctx, messageID}
+	mmDeleteMessageAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessageAttachments.expectations {
+		if minimock.Equal(e.params, mmDeleteMessageAttachments.defaultExpectation.params) {
+			mmDeleteMessageAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessageAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessageAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockDeleteMessageAttachments {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation == nil {
+		mmDeleteMessageAttachments.defaultExpectation = &MessageRepositoryMockDeleteMessageAttachmentsExpectation{}
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.params != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteMessageAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessageAttachments.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteMessageAttachmentsParamPtrs{}
+	}
+	mmDeleteMessageAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessageAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessageAttachments
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockDeleteMessageAttachments {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation == nil {
+		mmDeleteMessageAttachments.defaultExpectation = &MessageRepositoryMockDeleteMessageAttachmentsExpectation{}
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.params != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteMessageAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessageAttachments.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteMessageAttachmentsParamPtrs{}
+	}
+	mmDeleteMessageAttachments.defaultExpectation.paramPtrs.messageID = &messageID
+	mmDeleteMessageAttachments.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmDeleteMessageAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) Inspect(f func(ctx context.Context, messageID int64)) *mMessageRepositoryMockDeleteMessageAttachments {
+	if mmDeleteMessageAttachments.mock.inspectFuncDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.DeleteMessageAttachments")
+	}
+
+	mmDeleteMessageAttachments.mock.inspectFuncDeleteMessageAttachments = f
+
+	return mmDeleteMessageAttachments
+}
+
+// Return sets up results that will be returned by MessageRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) Return(sa1 []string, err error) *MessageRepositoryMock {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation == nil {
+		mmDeleteMessageAttachments.defaultExpectation = &MessageRepositoryMockDeleteMessageAttachmentsExpectation{mock: mmDeleteMessageAttachments.mock}
+	}
+	mmDeleteMessageAttachments.defaultExpectation.results = &MessageRepositoryMockDeleteMessageAttachmentsResults{sa1, err}
+	mmDeleteMessageAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessageAttachments.mock
+}
+
+// Set uses given function f to mock the MessageRepository.DeleteMessageAttachments method
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) Set(f func(ctx context.Context, messageID int64) (sa1 []string, err error)) *MessageRepositoryMock {
+	if mmDeleteMessageAttachments.defaultExpectation != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("Default expectation is already set for the MessageRepository.DeleteMessageAttachments method")
+	}
+
+	if len(mmDeleteMessageAttachments.expectations) > 0 {
+		mmDeleteMessageAttachments.mock.t.Fatalf("Some expectations are already set for the MessageRepository.DeleteMessageAttachments method")
+	}
+
+	mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments = f
+	mmDeleteMessageAttachments.mock.funcDeleteMessageAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessageAttachments.mock
+}
+
+// When sets expectation for the MessageRepository.DeleteMessageAttachments which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) When(ctx context.Context, messageID int64) *MessageRepositoryMockDeleteMessageAttachmentsExpectation {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockDeleteMessageAttachmentsExpectation{
+		mock:               mmDeleteMessageAttachments.mock,
+		params:             &MessageRepositoryMockDeleteMessageAttachmentsParams{ctx, messageID},
+		expectationOrigins: MessageRepositoryMockDeleteMessageAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessageAttachments.expectations = append(mmDeleteMessageAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.DeleteMessageAttachments return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockDeleteMessageAttachmentsExpectation) Then(sa1 []string, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockDeleteMessageAttachmentsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.DeleteMessageAttachments should be invoked
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) Times(n uint64) *mMessageRepositoryMockDeleteMessageAttachments {
+	if n == 0 {
+		mmDeleteMessageAttachments.mock.t.Fatalf("Times of MessageRepositoryMock.DeleteMessageAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessageAttachments.expectedInvocations, n)
+	mmDeleteMessageAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessageAttachments
+}
+
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) invocationsDone() bool {
+	if len(mmDeleteMessageAttachments.expectations) == 0 && mmDeleteMessageAttachments.defaultExpectation == nil && mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessageAttachments.mock.afterDeleteMessageAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessageAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessageAttachments implements mm_repository.MessageRepository
+func (mmDeleteMessageAttachments *MessageRepositoryMock) DeleteMessageAttachments(ctx context.Context, messageID int64) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmDeleteMessageAttachments.beforeDeleteMessageAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessageAttachments.afterDeleteMessageAttachmentsCounter, 1)
+
+	mmDeleteMessageAttachments.t.Helper()
+
+	if mmDeleteMessageAttachments.inspectFuncDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.inspectFuncDeleteMessageAttachments(ctx, messageID)
+	}
+
+	mm_params := MessageRepositoryMockDeleteMessageAttachmentsParams{ctx, messageID}
+
+	// Record call args
+	mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.mutex.Lock()
+	mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.callArgs = append(mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.callArgs, &mm_params)
+	mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockDeleteMessageAttachmentsParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessageAttachments.t.Errorf("MessageRepositoryMock.DeleteMessageAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmDeleteMessageAttachments.t.Errorf("MessageRepositoryMock.DeleteMessageAttachments got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessageAttachments.t.Errorf("MessageRepositoryMock.DeleteMessageAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessageAttachments.t.Fatal("No results are set for the MessageRepositoryMock.DeleteMessageAttachments")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmDeleteMessageAttachments.funcDeleteMessageAttachments != nil {
+		return mmDeleteMessageAttachments.funcDeleteMessageAttachments(ctx, messageID)
+	}
+	mmDeleteMessageAttachments.t.Fatalf("Unexpected call to MessageRepositoryMock.DeleteMessageAttachments. %v %v", ctx, messageID)
+	return
+}
+
+// DeleteMessageAttachmentsAfterCounter returns a count of finished MessageRepositoryMock.DeleteMessageAttachments invocations
+func (mmDeleteMessageAttachments *MessageRepositoryMock) DeleteMessageAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessageAttachments.afterDeleteMessageAttachmentsCounter)
+}
+
+// DeleteMessageAttachmentsBeforeCounter returns a count of MessageRepositoryMock.DeleteMessageAttachments invocations
+func (mmDeleteMessageAttachments *MessageRepositoryMock) DeleteMessageAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessageAttachments.beforeDeleteMessageAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.DeleteMessageAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessageAttachments *mMessageRepositoryMockDeleteMessageAttachments) Calls() []*MessageRepositoryMockDeleteMessageAttachmentsParams {
+	mmDeleteMessageAttachments.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockDeleteMessageAttachmentsParams, len(mmDeleteMessageAttachments.callArgs))
+	copy(argCopy, mmDeleteMessageAttachments.callArgs)
+
+	mmDeleteMessageAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageAttachmentsDone returns true if the count of the DeleteMessageAttachments invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockDeleteMessageAttachmentsDone() bool {
+	if m.DeleteMessageAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageAttachmentsMock.invocationsDone()
+}
+
+// MinimockDeleteMessageAttachmentsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockDeleteMessageAttachmentsInspect() {
+	for _, e := range m.DeleteMessageAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteMessageAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageAttachmentsCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageAttachmentsMock.defaultExpectation != nil && afterDeleteMessageAttachmentsCounter < 1 {
+		if m.DeleteMessageAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteMessageAttachments at\n%s", m.DeleteMessageAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteMessageAttachments at\n%s with params: %#v", m.DeleteMessageAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessageAttachments != nil && afterDeleteMessageAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.DeleteMessageAttachments at\n%s", m.funcDeleteMessageAttachmentsOrigin)
+	}
+
+	if !m.DeleteMessageAttachmentsMock.invocationsDone() && afterDeleteMessageAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.DeleteMessageAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageAttachmentsMock.expectedInvocations), m.DeleteMessageAttachmentsMock.expectedInvocationsOrigin, afterDeleteMessageAttachmentsCounter)
+	}
+}
+
+type mMessageRepositoryMockDeleteUnsentAttachments struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockDeleteUnsentAttachmentsExpectation
+	expectations       []*MessageRepositoryMockDeleteUnsentAttachmentsExpectation
+
+	callArgs []*MessageRepositoryMockDeleteUnsentAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockDeleteUnsentAttachmentsExpectation specifies expectation struct of the MessageRepository.DeleteUnsentAttachments
+type MessageRepositoryMockDeleteUnsentAttachmentsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockDeleteUnsentAttachmentsParams
+	paramPtrs          *MessageRepositoryMockDeleteUnsentAttachmentsParamPtrs
+	expectationOrigins MessageRepositoryMockDeleteUnsentAttachmentsExpectationOrigins
+	results            *MessageRepositoryMockDeleteUnsentAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockDeleteUnsentAttachmentsParams contains parameters of the MessageRepository.DeleteUnsentAttachments
+type MessageRepositoryMockDeleteUnsentAttachmentsParams struct {
+	ctx    context.Context
+	maxAge time.Duration
+}
+
+// MessageRepositoryMockDeleteUnsentAttachmentsParamPtrs contains pointers to parameters of the MessageRepository.DeleteUnsentAttachments
+type MessageRepositoryMockDeleteUnsentAttachmentsParamPtrs struct {
+	ctx    *context.Context
+	maxAge *time.Duration
+}
+
+// MessageRepositoryMockDeleteUnsentAttachmentsResults contains results of the MessageRepository.DeleteUnsentAttachments
+type MessageRepositoryMockDeleteUnsentAttachmentsResults struct {
+	sa1 []string
+	err error
+}
+
+// MessageRepositoryMockDeleteUnsentAttachmentsOrigins contains origins of expectations of the MessageRepository.DeleteUnsentAttachments
+type MessageRepositoryMockDeleteUnsentAttachmentsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originMaxAge string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) Optional() *mMessageRepositoryMockDeleteUnsentAttachments {
+	mmDeleteUnsentAttachments.optional = true
+	return mmDeleteUnsentAttachments
+}
+
+// Expect sets up expected params for MessageRepository.DeleteUnsentAttachments
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) Expect(ctx context.Context, maxAge time.Duration) *mMessageRepositoryMockDeleteUnsentAttachments {
+	if mmDeleteUnsentAttachments.mock.funcDeleteUnsentAttachments != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteUnsentAttachments mock is already set by Set")
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation == nil {
+		mmDeleteUnsentAttachments.defaultExpectation = &MessageRepositoryMockDeleteUnsentAttachmentsExpectation{}
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation.paramPtrs != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteUnsentAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteUnsentAttachments.defaultExpectation.params = &MessageRepositoryMockDeleteUnsentAttachmentsParams{ctx, maxAge}
+	mmDeleteUnsentAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteUnsentAttachments.expectations {
+		if minimock.Equal(e.params, mmDeleteUnsentAttachments.defaultExpectation.params) {
+			mmDeleteUnsentAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteUnsentAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteUnsentAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.DeleteUnsentAttachments
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockDeleteUnsentAttachments {
+	if mmDeleteUnsentAttachments.mock.funcDeleteUnsentAttachments != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteUnsentAttachments mock is already set by Set")
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation == nil {
+		mmDeleteUnsentAttachments.defaultExpectation = &MessageRepositoryMockDeleteUnsentAttachmentsExpectation{}
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation.params != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteUnsentAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteUnsentAttachments.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteUnsentAttachmentsParamPtrs{}
+	}
+	mmDeleteUnsentAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteUnsentAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteUnsentAttachments
+}
+
+// ExpectMaxAgeParam2 sets up expected param maxAge for MessageRepository.DeleteUnsentAttachments
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) ExpectMaxAgeParam2(maxAge time.Duration) *mMessageRepositoryMockDeleteUnsentAttachments {
+	if mmDeleteUnsentAttachments.mock.funcDeleteUnsentAttachments != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteUnsentAttachments mock is already set by Set")
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation == nil {
+		mmDeleteUnsentAttachments.defaultExpectation = &MessageRepositoryMockDeleteUnsentAttachmentsExpectation{}
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation.params != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteUnsentAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteUnsentAttachments.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteUnsentAttachmentsParamPtrs{}
+	}
+	mmDeleteUnsentAttachments.defaultExpectation.paramPtrs.maxAge = &maxAge
+	mmDeleteUnsentAttachments.defaultExpectation.expectationOrigins.originMaxAge = minimock.CallerInfo(1)
+
+	return mmDeleteUnsentAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.DeleteUnsentAttachments
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) Inspect(f func(ctx context.Context, maxAge time.Duration)) *mMessageRepositoryMockDeleteUnsentAttachments {
+	if mmDeleteUnsentAttachments.mock.inspectFuncDeleteUnsentAttachments != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.DeleteUnsentAttachments")
+	}
+
+	mmDeleteUnsentAttachments.mock.inspectFuncDeleteUnsentAttachments = f
+
+	return mmDeleteUnsentAttachments
+}
+
+// Return sets up results that will be returned by MessageRepository.DeleteUnsentAttachments
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) Return(sa1 []string, err error) *MessageRepositoryMock {
+	if mmDeleteUnsentAttachments.mock.funcDeleteUnsentAttachments != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteUnsentAttachments mock is already set by Set")
+	}
+
+	if mmDeleteUnsentAttachments.defaultExpectation == nil {
+		mmDeleteUnsentAttachments.defaultExpectation = &MessageRepositoryMockDeleteUnsentAttachmentsExpectation{mock: mmDeleteUnsentAttachments.mock}
+	}
+	mmDeleteUnsentAttachments.defaultExpectation.results = &MessageRepositoryMockDeleteUnsentAttachmentsResults{sa1, err}
+	mmDeleteUnsentAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteUnsentAttachments.mock
+}
+
+// Set uses given function f to mock the MessageRepository.DeleteUnsentAttachments method
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) Set(f func(ctx context.Context, maxAge time.Duration) (sa1 []string, err error)) *MessageRepositoryMock {
+	if mmDeleteUnsentAttachments.defaultExpectation != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("Default expectation is already set for the MessageRepository.DeleteUnsentAttachments method")
+	}
+
+	if len(mmDeleteUnsentAttachments.expectations) > 0 {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("Some expectations are already set for the MessageRepository.DeleteUnsentAttachments method")
+	}
+
+	mmDeleteUnsentAttachments.mock.funcDeleteUnsentAttachments = f
+	mmDeleteUnsentAttachments.mock.funcDeleteUnsentAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUnsentAttachments.mock
+}
+
+// When sets expectation for the MessageRepository.DeleteUnsentAttachments which will trigger the result defined by the following
+// Then helper
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) When(ctx context.Context, maxAge time.Duration) *MessageRepositoryMockDeleteUnsentAttachmentsExpectation {
+	if mmDeleteUnsentAttachments.mock.funcDeleteUnsentAttachments != nil {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("MessageRepositoryMock.DeleteUnsentAttachments mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockDeleteUnsentAttachmentsExpectation{
+		mock:               mmDeleteUnsentAttachments.mock,
+		params:             &MessageRepositoryMockDeleteUnsentAttachmentsParams{ctx, maxAge},
+		expectationOrigins: MessageRepositoryMockDeleteUnsentAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteUnsentAttachments.expectations = append(mmDeleteUnsentAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.DeleteUnsentAttachments return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockDeleteUnsentAttachmentsExpectation) Then(sa1 []string, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockDeleteUnsentAttachmentsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.DeleteUnsentAttachments should be invoked
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) Times(n uint64) *mMessageRepositoryMockDeleteUnsentAttachments {
+	if n == 0 {
+		mmDeleteUnsentAttachments.mock.t.Fatalf("Times of MessageRepositoryMock.DeleteUnsentAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteUnsentAttachments.expectedInvocations, n)
+	mmDeleteUnsentAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteUnsentAttachments
+}
+
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) invocationsDone() bool {
+	if len(mmDeleteUnsentAttachments.expectations) == 0 && mmDeleteUnsentAttachments.defaultExpectation == nil && mmDeleteUnsentAttachments.mock.funcDeleteUnsentAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteUnsentAttachments.mock.afterDeleteUnsentAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteUnsentAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteUnsentAttachments implements mm_repository.MessageRepository
+func (mmDeleteUnsentAttachments *MessageRepositoryMock) DeleteUnsentAttachments(ctx context.Context, maxAge time.Duration) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmDeleteUnsentAttachments.beforeDeleteUnsentAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteUnsentAttachments.afterDeleteUnsentAttachmentsCounter, 1)
+
+	mmDeleteUnsentAttachments.t.Helper()
+
+	if mmDeleteUnsentAttachments.inspectFuncDeleteUnsentAttachments != nil {
+		mmDeleteUnsentAttachments.inspectFuncDeleteUnsentAttachments(ctx, maxAge)
+	}
+
+	mm_params := MessageRepositoryMockDeleteUnsentAttachmentsParams{ctx, maxAge}
+
+	// Record call args
+	mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.mutex.Lock()
+	mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.callArgs = append(mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.callArgs, &mm_params)
+	mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockDeleteUnsentAttachmentsParams{ctx, maxAge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteUnsentAttachments.t.Errorf("MessageRepositoryMock.DeleteUnsentAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.maxAge != nil && !minimock.Equal(*mm_want_ptrs.maxAge, mm_got.maxAge) {
+				mmDeleteUnsentAttachments.t.Errorf("MessageRepositoryMock.DeleteUnsentAttachments got unexpected parameter maxAge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.defaultExpectation.expectationOrigins.originMaxAge, *mm_want_ptrs.maxAge, mm_got.maxAge, minimock.Diff(*mm_want_ptrs.maxAge, mm_got.maxAge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteUnsentAttachments.t.Errorf("MessageRepositoryMock.DeleteUnsentAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteUnsentAttachments.DeleteUnsentAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteUnsentAttachments.t.Fatal("No results are set for the MessageRepositoryMock.DeleteUnsentAttachments")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmDeleteUnsentAttachments.funcDeleteUnsentAttachments != nil {
+		return mmDeleteUnsentAttachments.funcDeleteUnsentAttachments(ctx, maxAge)
+	}
+	mmDeleteUnsentAttachments.t.Fatalf("Unexpected call to MessageRepositoryMock.DeleteUnsentAttachments. %v %v", ctx, maxAge)
+	return
+}
+
+// DeleteUnsentAttachmentsAfterCounter returns a count of finished MessageRepositoryMock.DeleteUnsentAttachments invocations
+func (mmDeleteUnsentAttachments *MessageRepositoryMock) DeleteUnsentAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUnsentAttachments.afterDeleteUnsentAttachmentsCounter)
+}
+
+// DeleteUnsentAttachmentsBeforeCounter returns a count of MessageRepositoryMock.DeleteUnsentAttachments invocations
+func (mmDeleteUnsentAttachments *MessageRepositoryMock) DeleteUnsentAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteUnsentAttachments.beforeDeleteUnsentAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.DeleteUnsentAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteUnsentAttachments *mMessageRepositoryMockDeleteUnsentAttachments) Calls() []*MessageRepositoryMockDeleteUnsentAttachmentsParams {
+	mmDeleteUnsentAttachments.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockDeleteUnsentAttachmentsParams, len(mmDeleteUnsentAttachments.callArgs))
+	copy(argCopy, mmDeleteUnsentAttachments.callArgs)
+
+	mmDeleteUnsentAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteUnsentAttachmentsDone returns true if the count of the DeleteUnsentAttachments invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockDeleteUnsentAttachmentsDone() bool {
+	if m.DeleteUnsentAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteUnsentAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteUnsentAttachmentsMock.invocationsDone()
+}
+
+// MinimockDeleteUnsentAttachmentsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockDeleteUnsentAttachmentsInspect() {
+	for _, e := range m.DeleteUnsentAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteUnsentAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteUnsentAttachmentsCounter := mm_atomic.LoadUint64(&m.afterDeleteUnsentAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteUnsentAttachmentsMock.defaultExpectation != nil && afterDeleteUnsentAttachmentsCounter < 1 {
+		if m.DeleteUnsentAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteUnsentAttachments at\n%s", m.DeleteUnsentAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteUnsentAttachments at\n%s with params: %#v", m.DeleteUnsentAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteUnsentAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteUnsentAttachments != nil && afterDeleteUnsentAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.DeleteUnsentAttachments at\n%s", m.funcDeleteUnsentAttachmentsOrigin)
+	}
+
+	if !m.DeleteUnsentAttachmentsMock.invocationsDone() && afterDeleteUnsentAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.DeleteUnsentAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteUnsentAttachmentsMock.expectedInvocations), m.DeleteUnsentAttachmentsMock.expectedInvocationsOrigin, afterDeleteUnsentAttachmentsCounter)
+	}
+}
+
+type mMessageRepositoryMockEdit struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockEditExpectation
+	expectations       []*MessageRepositoryMockEditExpectation
+
+	callArgs []*MessageRepositoryMockEditParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockEditExpectation specifies expectation struct of the MessageRepository.Edit
+type MessageRepositoryMockEditExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockEditParams
+	paramPtrs          *MessageRepositoryMockEditParamPtrs
+	expectationOrigins MessageRepositoryMockEditExpectationOrigins
+	results            *MessageRepositoryMockEditResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockEditParams contains parameters of the MessageRepository.Edit
+type MessageRepositoryMockEditParams struct {
+	ctx      context.Context
+	id       int64
+	text     string
+	editedBy string
+}
+
+// MessageRepositoryMockEditParamPtrs contains pointers to parameters of the MessageRepository.Edit
+type MessageRepositoryMockEditParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	text     *string
+	editedBy *string
+}
+
+// MessageRepositoryMockEditResults contains results of the MessageRepository.Edit
+type MessageRepositoryMockEditResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockEditOrigins contains origins of expectations of the MessageRepository.Edit
+type MessageRepositoryMockEditExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originText     string
+	originEditedBy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEdit *mMessageRepositoryMockEdit) Optional() *mMessageRepositoryMockEdit {
+	mmEdit.optional = true
+	return mmEdit
+}
+
+// Expect sets up expected params for MessageRepository.Edit
+func (mmEdit *mMessageRepositoryMockEdit) Expect(ctx context.Context, id int64, text string, editedBy string) *mMessageRepositoryMockEdit {
+	if mmEdit.mock.funcEdit != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Set")
+	}
+
+	if mmEdit.defaultExpectation == nil {
+		mmEdit.defaultExpectation = &MessageRepositoryMockEditExpectation{}
+	}
+
+	if mmEdit.defaultExpectation.paramPtrs != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by ExpectParams functions")
+	}
+
+	mmEdit.defaultExpectation.params = &MessageRepositoryMockEditParams{ctx, id, text, editedBy}
+	mmEdit.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEdit.expectations {
+		if minimock.Equal(e.params, mmEdit.defaultExpectation.params) {
+			mmEdit.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEdit.defaultExpectation.params)
+		}
+	}
+
+	return mmEdit
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Edit
+func (mmEdit *mMessageRepositoryMockEdit) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockEdit {
+	if mmEdit.mock.funcEdit != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Set")
+	}
+
+	if mmEdit.defaultExpectation == nil {
+		mmEdit.defaultExpectation = &MessageRepositoryMockEditExpectation{}
+	}
+
+	if mmEdit.defaultExpectation.params != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Expect")
+	}
+
+	if mmEdit.defaultExpectation.paramPtrs == nil {
+		mmEdit.defaultExpectation.paramPtrs = &MessageRepositoryMockEditParamPtrs{}
+	}
+	mmEdit.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEdit.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEdit
+}
+
+// ExpectIdParam2 sets up expected param id for MessageRepository.Edit
+func (mmEdit *mMessageRepositoryMockEdit) ExpectIdParam2(id int64) *mMessageRepositoryMockEdit {
+	if mmEdit.mock.funcEdit != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Set")
+	}
+
+	if mmEdit.defaultExpectation == nil {
+		mmEdit.defaultExpectation = &MessageRepositoryMockEditExpectation{}
+	}
+
+	if mmEdit.defaultExpectation.params != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Expect")
+	}
+
+	if mmEdit.defaultExpectation.paramPtrs == nil {
+		mmEdit.defaultExpectation.paramPtrs = &MessageRepositoryMockEditParamPtrs{}
+	}
+	mmEdit.defaultExpectation.paramPtrs.id = &id
+	mmEdit.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmEdit
+}
+
+// ExpectTextParam3 sets up expected param text for MessageRepository.Edit
+func (mmEdit *mMessageRepositoryMockEdit) ExpectTextParam3(text string) *mMessageRepositoryMockEdit {
+	if mmEdit.mock.funcEdit != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Set")
+	}
+
+	if mmEdit.defaultExpectation == nil {
+		mmEdit.defaultExpectation = &MessageRepositoryMockEditExpectation{}
+	}
+
+	if mmEdit.defaultExpectation.params != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Expect")
+	}
+
+	if mmEdit.defaultExpectation.paramPtrs == nil {
+		mmEdit.defaultExpectation.paramPtrs = &MessageRepositoryMockEditParamPtrs{}
+	}
+	mmEdit.defaultExpectation.paramPtrs.text = &text
+	mmEdit.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmEdit
+}
+
+// ExpectEditedByParam4 sets up expected param editedBy for MessageRepository.Edit
+func (mmEdit *mMessageRepositoryMockEdit) ExpectEditedByParam4(editedBy string) *mMessageRepositoryMockEdit {
+	if mmEdit.mock.funcEdit != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Set")
+	}
+
+	if mmEdit.defaultExpectation == nil {
+		mmEdit.defaultExpectation = &MessageRepositoryMockEditExpectation{}
+	}
+
+	if mmEdit.defaultExpectation.params != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Expect")
+	}
+
+	if mmEdit.defaultExpectation.paramPtrs == nil {
+		mmEdit.defaultExpectation.paramPtrs = &MessageRepositoryMockEditParamPtrs{}
+	}
+	mmEdit.defaultExpectation.paramPtrs.editedBy = &editedBy
+	mmEdit.defaultExpectation.expectationOrigins.originEditedBy = minimock.CallerInfo(1)
+
+	return mmEdit
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Edit
+func (mmEdit *mMessageRepositoryMockEdit) Inspect(f func(ctx context.Context, id int64, text string, editedBy string)) *mMessageRepositoryMockEdit {
+	if mmEdit.mock.inspectFuncEdit != nil {
+		mmEdit.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Edit")
+	}
+
+	mmEdit.mock.inspectFuncEdit = f
+
+	return mmEdit
+}
+
+// Return sets up results that will be returned by MessageRepository.Edit
+func (mmEdit *mMessageRepositoryMockEdit) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmEdit.mock.funcEdit != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Set")
+	}
+
+	if mmEdit.defaultExpectation == nil {
+		mmEdit.defaultExpectation = &MessageRepositoryMockEditExpectation{mock: mmEdit.mock}
+	}
+	mmEdit.defaultExpectation.results = &MessageRepositoryMockEditResults{mp1, err}
+	mmEdit.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEdit.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Edit method
+func (mmEdit *mMessageRepositoryMockEdit) Set(f func(ctx context.Context, id int64, text string, editedBy string) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmEdit.defaultExpectation != nil {
+		mmEdit.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Edit method")
+	}
+
+	if len(mmEdit.expectations) > 0 {
+		mmEdit.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Edit method")
+	}
+
+	mmEdit.mock.funcEdit = f
+	mmEdit.mock.funcEditOrigin = minimock.CallerInfo(1)
+	return mmEdit.mock
+}
+
+// When sets expectation for the MessageRepository.Edit which will trigger the result defined by the following
+// Then helper
+func (mmEdit *mMessageRepositoryMockEdit) When(ctx context.Context, id int64, text string, editedBy string) *MessageRepositoryMockEditExpectation {
+	if mmEdit.mock.funcEdit != nil {
+		mmEdit.mock.t.Fatalf("MessageRepositoryMock.Edit mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockEditExpectation{
+		mock:               mmEdit.mock,
+		params:             &MessageRepositoryMockEditParams{ctx, id, text, editedBy},
+		expectationOrigins: MessageRepositoryMockEditExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEdit.expectations = append(mmEdit.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Edit return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockEditExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockEditResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Edit should be invoked
+func (mmEdit *mMessageRepositoryMockEdit) Times(n uint64) *mMessageRepositoryMockEdit {
+	if n == 0 {
+		mmEdit.mock.t.Fatalf("Times of MessageRepositoryMock.Edit mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEdit.expectedInvocations, n)
+	mmEdit.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEdit
+}
+
+func (mmEdit *mMessageRepositoryMockEdit) invocationsDone() bool {
+	if len(mmEdit.expectations) == 0 && mmEdit.defaultExpectation == nil && mmEdit.mock.funcEdit == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEdit.mock.afterEditCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEdit.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Edit implements mm_repository.MessageRepository
+func (mmEdit *MessageRepositoryMock) Edit(ctx context.Context, id int64, text string, editedBy string) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmEdit.beforeEditCounter, 1)
+	defer mm_atomic.AddUint64(&mmEdit.afterEditCounter, 1)
+
+	mmEdit.t.Helper()
+
+	if mmEdit.inspectFuncEdit != nil {
+		mmEdit.inspectFuncEdit(ctx, id, text, editedBy)
+	}
+
+	mm_params := MessageRepositoryMockEditParams{ctx, id, text, editedBy}
+
+	// Record call args
+	mmEdit.EditMock.mutex.Lock()
+	mmEdit.EditMock.callArgs = append(mmEdit.EditMock.callArgs, &mm_params)
+	mmEdit.EditMock.mutex.Unlock()
+
+	for _, e := range mmEdit.EditMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmEdit.EditMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEdit.EditMock.defaultExpectation.Counter, 1)
+		mm_want := mmEdit.EditMock.defaultExpectation.params
+		mm_want_ptrs := mmEdit.EditMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockEditParams{ctx, id, text, editedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEdit.t.Errorf("MessageRepositoryMock.Edit got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEdit.EditMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmEdit.t.Errorf("MessageRepositoryMock.Edit got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEdit.EditMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmEdit.t.Errorf("MessageRepositoryMock.Edit got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEdit.EditMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+			if mm_want_ptrs.editedBy != nil && !minimock.Equal(*mm_want_ptrs.editedBy, mm_got.editedBy) {
+				mmEdit.t.Errorf("MessageRepositoryMock.Edit got unexpected parameter editedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEdit.EditMock.defaultExpectation.expectationOrigins.originEditedBy, *mm_want_ptrs.editedBy, mm_got.editedBy, minimock.Diff(*mm_want_ptrs.editedBy, mm_got.editedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEdit.t.Errorf("MessageRepositoryMock.Edit got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEdit.EditMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEdit.EditMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEdit.t.Fatal("No results are set for the MessageRepositoryMock.Edit")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmEdit.funcEdit != nil {
+		return mmEdit.funcEdit(ctx, id, text, editedBy)
+	}
+	mmEdit.t.Fatalf("Unexpected call to MessageRepositoryMock.Edit. %v %v %v %v", ctx, id, text, editedBy)
+	return
+}
+
+// EditAfterCounter returns a count of finished MessageRepositoryMock.Edit invocations
+func (mmEdit *MessageRepositoryMock) EditAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEdit.afterEditCounter)
+}
+
+// EditBeforeCounter returns a count of MessageRepositoryMock.Edit invocations
+func (mmEdit *MessageRepositoryMock) EditBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEdit.beforeEditCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Edit.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEdit *mMessageRepositoryMockEdit) Calls() []*MessageRepositoryMockEditParams {
+	mmEdit.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockEditParams, len(mmEdit.callArgs))
+	copy(argCopy, mmEdit.callArgs)
+
+	mmEdit.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditDone returns true if the count of the Edit invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockEditDone() bool {
+	if m.EditMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMock.invocationsDone()
+}
+
+// MinimockEditInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockEditInspect() {
+	for _, e := range m.EditMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Edit at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEditCounter := mm_atomic.LoadUint64(&m.afterEditCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMock.defaultExpectation != nil && afterEditCounter < 1 {
+		if m.EditMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Edit at\n%s", m.EditMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Edit at\n%s with params: %#v", m.EditMock.defaultExpectation.expectationOrigins.origin, *m.EditMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEdit != nil && afterEditCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Edit at\n%s", m.funcEditOrigin)
+	}
+
+	if !m.EditMock.invocationsDone() && afterEditCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Edit at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMock.expectedInvocations), m.EditMock.expectedInvocationsOrigin, afterEditCounter)
+	}
+}
+
+type mMessageRepositoryMockGet struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockGetExpectation
+	expectations       []*MessageRepositoryMockGetExpectation
+
+	callArgs []*MessageRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockGetExpectation specifies expectation struct of the MessageRepository.Get
+type MessageRepositoryMockGetExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockGetParams
+	paramPtrs          *MessageRepositoryMockGetParamPtrs
+	expectationOrigins MessageRepositoryMockGetExpectationOrigins
+	results            *MessageRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockGetParams contains parameters of the MessageRepository.Get
+type MessageRepositoryMockGetParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// MessageRepositoryMockGetParamPtrs contains pointers to parameters of the MessageRepository.Get
+type MessageRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// MessageRepositoryMockGetResults contains results of the MessageRepository.Get
+type MessageRepositoryMockGetResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockGetOrigins contains origins of expectations of the MessageRepository.Get
+type MessageRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mMessageRepositoryMockGet) Optional() *mMessageRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) Expect(ctx context.Context, id int64) *mMessageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &MessageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &MessageRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &MessageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &MessageRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) ExpectIdParam2(id int64) *mMessageRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &MessageRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &MessageRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) Inspect(f func(ctx context.Context, id int64)) *mMessageRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by MessageRepository.Get
+func (mmGet *mMessageRepositoryMockGet) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &MessageRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &MessageRepositoryMockGetResults{mp1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Get method
+func (mmGet *mMessageRepositoryMockGet) Set(f func(ctx context.Context, id int64) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the MessageRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mMessageRepositoryMockGet) When(ctx context.Context, id int64) *MessageRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("MessageRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &MessageRepositoryMockGetParams{ctx, id},
+		expectationOrigins: MessageRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Get return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockGetExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockGetResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Get should be invoked
+func (mmGet *mMessageRepositoryMockGet) Times(n uint64) *mMessageRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of MessageRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mMessageRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.MessageRepository
+func (mmGet *MessageRepositoryMock) Get(ctx context.Context, id int64) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := MessageRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("MessageRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("MessageRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("MessageRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the MessageRepositoryMock.Get")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to MessageRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished MessageRepositoryMock.Get invocations
+func (mmGet *MessageRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of MessageRepositoryMock.Get invocations
+func (mmGet *MessageRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mMessageRepositoryMockGet) Calls() []*MessageRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mMessageRepositoryMockGetAttachment struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockGetAttachmentExpectation
+	expectations       []*MessageRepositoryMockGetAttachmentExpectation
+
+	callArgs []*MessageRepositoryMockGetAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockGetAttachmentExpectation specifies expectation struct of the MessageRepository.GetAttachment
+type MessageRepositoryMockGetAttachmentExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockGetAttachmentParams
+	paramPtrs          *MessageRepositoryMockGetAttachmentParamPtrs
+	expectationOrigins MessageRepositoryMockGetAttachmentExpectationOrigins
+	results            *MessageRepositoryMockGetAttachmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockGetAttachmentParams contains parameters of the MessageRepository.GetAttachment
+type MessageRepositoryMockGetAttachmentParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// MessageRepositoryMockGetAttachmentParamPtrs contains pointers to parameters of the MessageRepository.GetAttachment
+type MessageRepositoryMockGetAttachmentParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// MessageRepositoryMockGetAttachmentResults contains results of the MessageRepository.GetAttachment
+type MessageRepositoryMockGetAttachmentResults struct {
+	ap1 *model.Attachment
+	err error
+}
+
+// MessageRepositoryMockGetAttachmentOrigins contains origins of expectations of the MessageRepository.GetAttachment
+type MessageRepositoryMockGetAttachmentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) Optional() *mMessageRepositoryMockGetAttachment {
+	mmGetAttachment.optional = true
+	return mmGetAttachment
+}
+
+// Expect sets up expected params for MessageRepository.GetAttachment
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) Expect(ctx context.Context, id int64) *mMessageRepositoryMockGetAttachment {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("MessageRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	if mmGetAttachment.defaultExpectation == nil {
+		mmGetAttachment.defaultExpectation = &MessageRepositoryMockGetAttachmentExpectation{}
+	}
+
+	if mmGetAttachment.defaultExpectation.paramPtrs != nil {
+		mmGetAttachment.mock.t.Fatalf("MessageRepositoryMock.GetAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmGetAttachment.defaultExpectation.params = &MessageRepositoryMockGetAttachmentParams{ctx, id}
+	mmGetAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAttachment.expectations {
+		if minimock.Equal(e.params, mmGetAttachment.defaultExpectation.params) {
+			mmGetAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.GetAttachment
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockGetAttachment {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("MessageRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	if mmGetAttachment.defaultExpectation == nil {
+		mmGetAttachment.defaultExpectation = &MessageRepositoryMockGetAttachmentExpectation{}
+	}
+
+	if mmGetAttachment.defaultExpectation.params != nil {
+		mmGetAttachment.mock.t.Fatalf("MessageRepositoryMock.GetAttachment mock is already set by Expect")
+	}
+
+	if mmGetAttachment.defaultExpectation.paramPtrs == nil {
+		mmGetAttachment.defaultExpectation.paramPtrs = &MessageRepositoryMockGetAttachmentParamPtrs{}
+	}
+	mmGetAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAttachment
+}
+
+// ExpectIdParam2 sets up expected param id for MessageRepository.GetAttachment
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) ExpectIdParam2(id int64) *mMessageRepositoryMockGetAttachment {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("MessageRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	if mmGetAttachment.defaultExpectation == nil {
+		mmGetAttachment.defaultExpectation = &MessageRepositoryMockGetAttachmentExpectation{}
+	}
+
+	if mmGetAttachment.defaultExpectation.params != nil {
+		mmGetAttachment.mock.t.Fatalf("MessageRepositoryMock.GetAttachment mock is already set by Expect")
+	}
+
+	if mmGetAttachment.defaultExpectation.paramPtrs == nil {
+		mmGetAttachment.defaultExpectation.paramPtrs = &MessageRepositoryMockGetAttachmentParamPtrs{}
+	}
+	mmGetAttachment.defaultExpectation.paramPtrs.id = &id
+	mmGetAttachment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.GetAttachment
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) Inspect(f func(ctx context.Context, id int64)) *mMessageRepositoryMockGetAttachment {
+	if mmGetAttachment.mock.inspectFuncGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.GetAttachment")
+	}
+
+	mmGetAttachment.mock.inspectFuncGetAttachment = f
+
+	return mmGetAttachment
+}
+
+// Return sets up results that will be returned by MessageRepository.GetAttachment
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) Return(ap1 *model.Attachment, err error) *MessageRepositoryMock {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("MessageRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	if mmGetAttachment.defaultExpectation == nil {
+		mmGetAttachment.defaultExpectation = &MessageRepositoryMockGetAttachmentExpectation{mock: mmGetAttachment.mock}
+	}
+	mmGetAttachment.defaultExpectation.results = &MessageRepositoryMockGetAttachmentResults{ap1, err}
+	mmGetAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAttachment.mock
+}
+
+// Set uses given function f to mock the MessageRepository.GetAttachment method
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) Set(f func(ctx context.Context, id int64) (ap1 *model.Attachment, err error)) *MessageRepositoryMock {
+	if mmGetAttachment.defaultExpectation != nil {
+		mmGetAttachment.mock.t.Fatalf("Default expectation is already set for the MessageRepository.GetAttachment method")
+	}
+
+	if len(mmGetAttachment.expectations) > 0 {
+		mmGetAttachment.mock.t.Fatalf("Some expectations are already set for the MessageRepository.GetAttachment method")
+	}
+
+	mmGetAttachment.mock.funcGetAttachment = f
+	mmGetAttachment.mock.funcGetAttachmentOrigin = minimock.CallerInfo(1)
+	return mmGetAttachment.mock
+}
+
+// When sets expectation for the MessageRepository.GetAttachment which will trigger the result defined by the following
+// Then helper
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) When(ctx context.Context, id int64) *MessageRepositoryMockGetAttachmentExpectation {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("MessageRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockGetAttachmentExpectation{
+		mock:               mmGetAttachment.mock,
+		params:             &MessageRepositoryMockGetAttachmentParams{ctx, id},
+		expectationOrigins: MessageRepositoryMockGetAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAttachment.expectations = append(mmGetAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.GetAttachment return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockGetAttachmentExpectation) Then(ap1 *model.Attachment, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockGetAttachmentResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.GetAttachment should be invoked
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) Times(n uint64) *mMessageRepositoryMockGetAttachment {
+	if n == 0 {
+		mmGetAttachment.mock.t.Fatalf("Times of MessageRepositoryMock.GetAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAttachment.expectedInvocations, n)
+	mmGetAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAttachment
+}
+
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) invocationsDone() bool {
+	if len(mmGetAttachment.expectations) == 0 && mmGetAttachment.defaultExpectation == nil && mmGetAttachment.mock.funcGetAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAttachment.mock.afterGetAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAttachment implements mm_repository.MessageRepository
+func (mmGetAttachment *MessageRepositoryMock) GetAttachment(ctx context.Context, id int64) (ap1 *model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmGetAttachment.beforeGetAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAttachment.afterGetAttachmentCounter, 1)
+
+	mmGetAttachment.t.Helper()
+
+	if mmGetAttachment.inspectFuncGetAttachment != nil {
+		mmGetAttachment.inspectFuncGetAttachment(ctx, id)
+	}
+
+	mm_params := MessageRepositoryMockGetAttachmentParams{ctx, id}
+
+	// Record call args
+	mmGetAttachment.GetAttachmentMock.mutex.Lock()
+	mmGetAttachment.GetAttachmentMock.callArgs = append(mmGetAttachment.GetAttachmentMock.callArgs, &mm_params)
+	mmGetAttachment.GetAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmGetAttachment.GetAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetAttachment.GetAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAttachment.GetAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAttachment.GetAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAttachment.GetAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockGetAttachmentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAttachment.t.Errorf("MessageRepositoryMock.GetAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttachment.GetAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetAttachment.t.Errorf("MessageRepositoryMock.GetAttachment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttachment.GetAttachmentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAttachment.t.Errorf("MessageRepositoryMock.GetAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAttachment.GetAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAttachment.GetAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAttachment.t.Fatal("No results are set for the MessageRepositoryMock.GetAttachment")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetAttachment.funcGetAttachment != nil {
+		return mmGetAttachment.funcGetAttachment(ctx, id)
+	}
+	mmGetAttachment.t.Fatalf("Unexpected call to MessageRepositoryMock.GetAttachment. %v %v", ctx, id)
+	return
+}
+
+// GetAttachmentAfterCounter returns a count of finished MessageRepositoryMock.GetAttachment invocations
+func (mmGetAttachment *MessageRepositoryMock) GetAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAttachment.afterGetAttachmentCounter)
+}
+
+// GetAttachmentBeforeCounter returns a count of MessageRepositoryMock.GetAttachment invocations
+func (mmGetAttachment *MessageRepositoryMock) GetAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAttachment.beforeGetAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.GetAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAttachment *mMessageRepositoryMockGetAttachment) Calls() []*MessageRepositoryMockGetAttachmentParams {
+	mmGetAttachment.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockGetAttachmentParams, len(mmGetAttachment.callArgs))
+	copy(argCopy, mmGetAttachment.callArgs)
+
+	mmGetAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAttachmentDone returns true if the count of the GetAttachment invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockGetAttachmentDone() bool {
+	if m.GetAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAttachmentMock.invocationsDone()
+}
+
+// MinimockGetAttachmentInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockGetAttachmentInspect() {
+	for _, e := range m.GetAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAttachmentCounter := mm_atomic.LoadUint64(&m.afterGetAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAttachmentMock.defaultExpectation != nil && afterGetAttachmentCounter < 1 {
+		if m.GetAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetAttachment at\n%s", m.GetAttachmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetAttachment at\n%s with params: %#v", m.GetAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.GetAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAttachment != nil && afterGetAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.GetAttachment at\n%s", m.funcGetAttachmentOrigin)
+	}
+
+	if !m.GetAttachmentMock.invocationsDone() && afterGetAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.GetAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAttachmentMock.expectedInvocations), m.GetAttachmentMock.expectedInvocationsOrigin, afterGetAttachmentCounter)
+	}
+}
+
+type mMessageRepositoryMockGetUploadUsage struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockGetUploadUsageExpectation
+	expectations       []*MessageRepositoryMockGetUploadUsageExpectation
+
+	callArgs []*MessageRepositoryMockGetUploadUsageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockGetUploadUsageExpectation specifies expectation struct of the MessageRepository.GetUploadUsage
+type MessageRepositoryMockGetUploadUsageExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockGetUploadUsageParams
+	paramPtrs          *MessageRepositoryMockGetUploadUsageParamPtrs
+	expectationOrigins MessageRepositoryMockGetUploadUsageExpectationOrigins
+	results            *MessageRepositoryMockGetUploadUsageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockGetUploadUsageParams contains parameters of the MessageRepository.GetUploadUsage
+type MessageRepositoryMockGetUploadUsageParams struct {
+	ctx        context.Context
+	uploaderID int64
+	maxAge     time.Duration
+}
+
+// MessageRepositoryMockGetUploadUsageParamPtrs contains pointers to parameters of the MessageRepository.GetUploadUsage
+type MessageRepositoryMockGetUploadUsageParamPtrs struct {
+	ctx        *context.Context
+	uploaderID *int64
+	maxAge     *time.Duration
+}
+
+// MessageRepositoryMockGetUploadUsageResults contains results of the MessageRepository.GetUploadUsage
+type MessageRepositoryMockGetUploadUsageResults struct {
+	up1 *model.UploadUsage
+	err error
+}
+
+// MessageRepositoryMockGetUploadUsageOrigins contains origins of expectations of the MessageRepository.GetUploadUsage
+type MessageRepositoryMockGetUploadUsageExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUploaderID string
+	originMaxAge     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) Optional() *mMessageRepositoryMockGetUploadUsage {
+	mmGetUploadUsage.optional = true
+	return mmGetUploadUsage
+}
+
+// Expect sets up expected params for MessageRepository.GetUploadUsage
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) Expect(ctx context.Context, uploaderID int64, maxAge time.Duration) *mMessageRepositoryMockGetUploadUsage {
+	if mmGetUploadUsage.mock.funcGetUploadUsage != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Set")
+	}
+
+	if mmGetUploadUsage.defaultExpectation == nil {
+		mmGetUploadUsage.defaultExpectation = &MessageRepositoryMockGetUploadUsageExpectation{}
+	}
+
+	if mmGetUploadUsage.defaultExpectation.paramPtrs != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by ExpectParams functions")
+	}
+
+	mmGetUploadUsage.defaultExpectation.params = &MessageRepositoryMockGetUploadUsageParams{ctx, uploaderID, maxAge}
+	mmGetUploadUsage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUploadUsage.expectations {
+		if minimock.Equal(e.params, mmGetUploadUsage.defaultExpectation.params) {
+			mmGetUploadUsage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUploadUsage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUploadUsage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.GetUploadUsage
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockGetUploadUsage {
+	if mmGetUploadUsage.mock.funcGetUploadUsage != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Set")
+	}
+
+	if mmGetUploadUsage.defaultExpectation == nil {
+		mmGetUploadUsage.defaultExpectation = &MessageRepositoryMockGetUploadUsageExpectation{}
+	}
+
+	if mmGetUploadUsage.defaultExpectation.params != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Expect")
+	}
+
+	if mmGetUploadUsage.defaultExpectation.paramPtrs == nil {
+		mmGetUploadUsage.defaultExpectation.paramPtrs = &MessageRepositoryMockGetUploadUsageParamPtrs{}
+	}
+	mmGetUploadUsage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUploadUsage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUploadUsage
+}
+
+// ExpectUploaderIDParam2 sets up expected param uploaderID for MessageRepository.GetUploadUsage
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) ExpectUploaderIDParam2(uploaderID int64) *mMessageRepositoryMockGetUploadUsage {
+	if mmGetUploadUsage.mock.funcGetUploadUsage != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Set")
+	}
+
+	if mmGetUploadUsage.defaultExpectation == nil {
+		mmGetUploadUsage.defaultExpectation = &MessageRepositoryMockGetUploadUsageExpectation{}
+	}
+
+	if mmGetUploadUsage.defaultExpectation.params != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Expect")
+	}
+
+	if mmGetUploadUsage.defaultExpectation.paramPtrs == nil {
+		mmGetUploadUsage.defaultExpectation.paramPtrs = &MessageRepositoryMockGetUploadUsageParamPtrs{}
+	}
+	mmGetUploadUsage.defaultExpectation.paramPtrs.uploaderID = &uploaderID
+	mmGetUploadUsage.defaultExpectation.expectationOrigins.originUploaderID = minimock.CallerInfo(1)
+
+	return mmGetUploadUsage
+}
+
+// ExpectMaxAgeParam3 sets up expected param maxAge for MessageRepository.GetUploadUsage
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) ExpectMaxAgeParam3(maxAge time.Duration) *mMessageRepositoryMockGetUploadUsage {
+	if mmGetUploadUsage.mock.funcGetUploadUsage != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Set")
+	}
+
+	if mmGetUploadUsage.defaultExpectation == nil {
+		mmGetUploadUsage.defaultExpectation = &MessageRepositoryMockGetUploadUsageExpectation{}
+	}
+
+	if mmGetUploadUsage.defaultExpectation.params != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Expect")
+	}
+
+	if mmGetUploadUsage.defaultExpectation.paramPtrs == nil {
+		mmGetUploadUsage.defaultExpectation.paramPtrs = &MessageRepositoryMockGetUploadUsageParamPtrs{}
+	}
+	mmGetUploadUsage.defaultExpectation.paramPtrs.maxAge = &maxAge
+	mmGetUploadUsage.defaultExpectation.expectationOrigins.originMaxAge = minimock.CallerInfo(1)
+
+	return mmGetUploadUsage
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.GetUploadUsage
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) Inspect(f func(ctx context.Context, uploaderID int64, maxAge time.Duration)) *mMessageRepositoryMockGetUploadUsage {
+	if mmGetUploadUsage.mock.inspectFuncGetUploadUsage != nil {
+		mmGetUploadUsage.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.GetUploadUsage")
+	}
+
+	mmGetUploadUsage.mock.inspectFuncGetUploadUsage = f
+
+	return mmGetUploadUsage
+}
+
+// Return sets up results that will be returned by MessageRepository.GetUploadUsage
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) Return(up1 *model.UploadUsage, err error) *MessageRepositoryMock {
+	if mmGetUploadUsage.mock.funcGetUploadUsage != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Set")
+	}
+
+	if mmGetUploadUsage.defaultExpectation == nil {
+		mmGetUploadUsage.defaultExpectation = &MessageRepositoryMockGetUploadUsageExpectation{mock: mmGetUploadUsage.mock}
+	}
+	mmGetUploadUsage.defaultExpectation.results = &MessageRepositoryMockGetUploadUsageResults{up1, err}
+	mmGetUploadUsage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUploadUsage.mock
+}
+
+// Set uses given function f to mock the MessageRepository.GetUploadUsage method
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) Set(f func(ctx context.Context, uploaderID int64, maxAge time.Duration) (up1 *model.UploadUsage, err error)) *MessageRepositoryMock {
+	if mmGetUploadUsage.defaultExpectation != nil {
+		mmGetUploadUsage.mock.t.Fatalf("Default expectation is already set for the MessageRepository.GetUploadUsage method")
+	}
+
+	if len(mmGetUploadUsage.expectations) > 0 {
+		mmGetUploadUsage.mock.t.Fatalf("Some expectations are already set for the MessageRepository.GetUploadUsage method")
+	}
+
+	mmGetUploadUsage.mock.funcGetUploadUsage = f
+	mmGetUploadUsage.mock.funcGetUploadUsageOrigin = minimock.CallerInfo(1)
+	return mmGetUploadUsage.mock
+}
+
+// When sets expectation for the MessageRepository.GetUploadUsage which will trigger the result defined by the following
+// Then helper
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) When(ctx context.Context, uploaderID int64, maxAge time.Duration) *MessageRepositoryMockGetUploadUsageExpectation {
+	if mmGetUploadUsage.mock.funcGetUploadUsage != nil {
+		mmGetUploadUsage.mock.t.Fatalf("MessageRepositoryMock.GetUploadUsage mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockGetUploadUsageExpectation{
+		mock:               mmGetUploadUsage.mock,
+		params:             &MessageRepositoryMockGetUploadUsageParams{ctx, uploaderID, maxAge},
+		expectationOrigins: MessageRepositoryMockGetUploadUsageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUploadUsage.expectations = append(mmGetUploadUsage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.GetUploadUsage return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockGetUploadUsageExpectation) Then(up1 *model.UploadUsage, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockGetUploadUsageResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.GetUploadUsage should be invoked
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) Times(n uint64) *mMessageRepositoryMockGetUploadUsage {
+	if n == 0 {
+		mmGetUploadUsage.mock.t.Fatalf("Times of MessageRepositoryMock.GetUploadUsage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUploadUsage.expectedInvocations, n)
+	mmGetUploadUsage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUploadUsage
+}
+
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) invocationsDone() bool {
+	if len(mmGetUploadUsage.expectations) == 0 && mmGetUploadUsage.defaultExpectation == nil && mmGetUploadUsage.mock.funcGetUploadUsage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUploadUsage.mock.afterGetUploadUsageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUploadUsage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUploadUsage implements mm_repository.MessageRepository
+func (mmGetUploadUsage *MessageRepositoryMock) GetUploadUsage(ctx context.Context, uploaderID int64, maxAge time.Duration) (up1 *model.UploadUsage, err error) {
+	mm_atomic.AddUint64(&mmGetUploadUsage.beforeGetUploadUsageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUploadUsage.afterGetUploadUsageCounter, 1)
+
+	mmGetUploadUsage.t.Helper()
+
+	if mmGetUploadUsage.inspectFuncGetUploadUsage != nil {
+		mmGetUploadUsage.inspectFuncGetUploadUsage(ctx, uploaderID, maxAge)
+	}
+
+	mm_params := MessageRepositoryMockGetUploadUsageParams{ctx, uploaderID, maxAge}
+
+	// Record call args
+	mmGetUploadUsage.GetUploadUsageMock.mutex.Lock()
+	mmGetUploadUsage.GetUploadUsageMock.callArgs = append(mmGetUploadUsage.GetUploadUsageMock.callArgs, &mm_params)
+	mmGetUploadUsage.GetUploadUsageMock.mutex.Unlock()
+
+	for _, e := range mmGetUploadUsage.GetUploadUsageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUploadUsage.GetUploadUsageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUploadUsage.GetUploadUsageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUploadUsage.GetUploadUsageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUploadUsage.GetUploadUsageMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockGetUploadUsageParams{ctx, uploaderID, maxAge}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUploadUsage.t.Errorf("MessageRepositoryMock.GetUploadUsage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUploadUsage.GetUploadUsageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.uploaderID != nil && !minimock.Equal(*mm_want_ptrs.uploaderID, mm_got.uploaderID) {
+				mmGetUploadUsage.t.Errorf("MessageRepositoryMock.GetUploadUsage got unexpected parameter uploaderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUploadUsage.GetUploadUsageMock.defaultExpectation.expectationOrigins.originUploaderID, *mm_want_ptrs.uploaderID, mm_got.uploaderID, minimock.Diff(*mm_want_ptrs.uploaderID, mm_got.uploaderID))
+			}
+
+			if mm_want_ptrs.maxAge != nil && !minimock.Equal(*mm_want_ptrs.maxAge, mm_got.maxAge) {
+				mmGetUploadUsage.t.Errorf("MessageRepositoryMock.GetUploadUsage got unexpected parameter maxAge, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUploadUsage.GetUploadUsageMock.defaultExpectation.expectationOrigins.originMaxAge, *mm_want_ptrs.maxAge, mm_got.maxAge, minimock.Diff(*mm_want_ptrs.maxAge, mm_got.maxAge))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUploadUsage.t.Errorf("MessageRepositoryMock.GetUploadUsage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUploadUsage.GetUploadUsageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUploadUsage.GetUploadUsageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUploadUsage.t.Fatal("No results are set for the MessageRepositoryMock.GetUploadUsage")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUploadUsage.funcGetUploadUsage != nil {
+		return mmGetUploadUsage.funcGetUploadUsage(ctx, uploaderID, maxAge)
+	}
+	mmGetUploadUsage.t.Fatalf("Unexpected call to MessageRepositoryMock.GetUploadUsage. %v %v %v", ctx, uploaderID, maxAge)
+	return
+}
+
+// GetUploadUsageAfterCounter returns a count of finished MessageRepositoryMock.GetUploadUsage invocations
+func (mmGetUploadUsage *MessageRepositoryMock) GetUploadUsageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUploadUsage.afterGetUploadUsageCounter)
+}
+
+// GetUploadUsageBeforeCounter returns a count of MessageRepositoryMock.GetUploadUsage invocations
+func (mmGetUploadUsage *MessageRepositoryMock) GetUploadUsageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUploadUsage.beforeGetUploadUsageCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.GetUploadUsage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUploadUsage *mMessageRepositoryMockGetUploadUsage) Calls() []*MessageRepositoryMockGetUploadUsageParams {
+	mmGetUploadUsage.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockGetUploadUsageParams, len(mmGetUploadUsage.callArgs))
+	copy(argCopy, mmGetUploadUsage.callArgs)
+
+	mmGetUploadUsage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUploadUsageDone returns true if the count of the GetUploadUsage invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockGetUploadUsageDone() bool {
+	if m.GetUploadUsageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUploadUsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUploadUsageMock.invocationsDone()
+}
+
+// MinimockGetUploadUsageInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockGetUploadUsageInspect() {
+	for _, e := range m.GetUploadUsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetUploadUsage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUploadUsageCounter := mm_atomic.LoadUint64(&m.afterGetUploadUsageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUploadUsageMock.defaultExpectation != nil && afterGetUploadUsageCounter < 1 {
+		if m.GetUploadUsageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetUploadUsage at\n%s", m.GetUploadUsageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetUploadUsage at\n%s with params: %#v", m.GetUploadUsageMock.defaultExpectation.expectationOrigins.origin, *m.GetUploadUsageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUploadUsage != nil && afterGetUploadUsageCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.GetUploadUsage at\n%s", m.funcGetUploadUsageOrigin)
+	}
+
+	if !m.GetUploadUsageMock.invocationsDone() && afterGetUploadUsageCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.GetUploadUsage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUploadUsageMock.expectedInvocations), m.GetUploadUsageMock.expectedInvocationsOrigin, afterGetUploadUsageCounter)
+	}
+}
+
+type mMessageRepositoryMockList struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListExpectation
+	expectations       []*MessageRepositoryMockListExpectation
+
+	callArgs []*MessageRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListExpectation specifies expectation struct of the MessageRepository.List
+type MessageRepositoryMockListExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListParams
+	paramPtrs          *MessageRepositoryMockListParamPtrs
+	expectationOrigins MessageRepositoryMockListExpectationOrigins
+	results            *MessageRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListParams contains parameters of the MessageRepository.List
+type MessageRepositoryMockListParams struct {
+	ctx      context.Context
+	chatID   int64
+	beforeID int64
+	limit    uint64
+}
+
+// MessageRepositoryMockListParamPtrs contains pointers to parameters of the MessageRepository.List
+type MessageRepositoryMockListParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	beforeID *int64
+	limit    *uint64
+}
+
+// MessageRepositoryMockListResults contains results of the MessageRepository.List
+type MessageRepositoryMockListResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListOrigins contains origins of expectations of the MessageRepository.List
+type MessageRepositoryMockListExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originBeforeID string
+	originLimit    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mMessageRepositoryMockList) Optional() *mMessageRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for MessageRepository.List
+func (mmList *mMessageRepositoryMockList) Expect(ctx context.Context, chatID int64, beforeID int64, limit uint64) *mMessageRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &MessageRepositoryMockListParams{ctx, chatID, beforeID, limit}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.List
+func (mmList *mMessageRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &MessageRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.List
+func (mmList *mMessageRepositoryMockList) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &MessageRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.chatID = &chatID
+	mmList.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectBeforeIDParam3 sets up expected param beforeID for MessageRepository.List
+func (mmList *mMessageRepositoryMockList) ExpectBeforeIDParam3(beforeID int64) *mMessageRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &MessageRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.beforeID = &beforeID
+	mmList.defaultExpectation.expectationOrigins.originBeforeID = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectLimitParam4 sets up expected param limit for MessageRepository.List
+func (mmList *mMessageRepositoryMockList) ExpectLimitParam4(limit uint64) *mMessageRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &MessageRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.limit = &limit
+	mmList.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.List
+func (mmList *mMessageRepositoryMockList) Inspect(f func(ctx context.Context, chatID int64, beforeID int64, limit uint64)) *mMessageRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by MessageRepository.List
+func (mmList *mMessageRepositoryMockList) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &MessageRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &MessageRepositoryMockListResults{mpa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the MessageRepository.List method
+func (mmList *mMessageRepositoryMockList) Set(f func(ctx context.Context, chatID int64, beforeID int64, limit uint64) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the MessageRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the MessageRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the MessageRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mMessageRepositoryMockList) When(ctx context.Context, chatID int64, beforeID int64, limit uint64) *MessageRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("MessageRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &MessageRepositoryMockListParams{ctx, chatID, beforeID, limit},
+		expectationOrigins: MessageRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.List return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.List should be invoked
+func (mmList *mMessageRepositoryMockList) Times(n uint64) *mMessageRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of MessageRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mMessageRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.MessageRepository
+func (mmList *MessageRepositoryMock) List(ctx context.Context, chatID int64, beforeID int64, limit uint64) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, chatID, beforeID, limit)
+	}
+
+	mm_params := MessageRepositoryMockListParams{ctx, chatID, beforeID, limit}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListParams{ctx, chatID, beforeID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("MessageRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmList.t.Errorf("MessageRepositoryMock.List got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.beforeID != nil && !minimock.Equal(*mm_want_ptrs.beforeID, mm_got.beforeID) {
+				mmList.t.Errorf("MessageRepositoryMock.List got unexpected parameter beforeID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originBeforeID, *mm_want_ptrs.beforeID, mm_got.beforeID, minimock.Diff(*mm_want_ptrs.beforeID, mm_got.beforeID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmList.t.Errorf("MessageRepositoryMock.List got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("MessageRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the MessageRepositoryMock.List")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, chatID, beforeID, limit)
+	}
+	mmList.t.Fatalf("Unexpected call to MessageRepositoryMock.List. %v %v %v %v", ctx, chatID, beforeID, limit)
+	return
+}
+
+// ListAfterCounter returns a count of finished MessageRepositoryMock.List invocations
+func (mmList *MessageRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of MessageRepositoryMock.List invocations
+func (mmList *MessageRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mMessageRepositoryMockList) Calls() []*MessageRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mMessageRepositoryMockListAttachments struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListAttachmentsExpectation
+	expectations       []*MessageRepositoryMockListAttachmentsExpectation
+
+	callArgs []*MessageRepositoryMockListAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListAttachmentsExpectation specifies expectation struct of the MessageRepository.ListAttachments
+type MessageRepositoryMockListAttachmentsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListAttachmentsParams
+	paramPtrs          *MessageRepositoryMockListAttachmentsParamPtrs
+	expectationOrigins MessageRepositoryMockListAttachmentsExpectationOrigins
+	results            *MessageRepositoryMockListAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListAttachmentsParams contains parameters of the MessageRepository.ListAttachments
+type MessageRepositoryMockListAttachmentsParams struct {
+	ctx        context.Context
+	messageIDs []int64
+}
+
+// MessageRepositoryMockListAttachmentsParamPtrs contains pointers to parameters of the MessageRepository.ListAttachments
+type MessageRepositoryMockListAttachmentsParamPtrs struct {
+	ctx        *context.Context
+	messageIDs *[]int64
+}
+
+// MessageRepositoryMockListAttachmentsResults contains results of the MessageRepository.ListAttachments
+type MessageRepositoryMockListAttachmentsResults struct {
+	m1  map[int64][]*model.Attachment
+	err error
+}
+
+// MessageRepositoryMockListAttachmentsOrigins contains origins of expectations of the MessageRepository.ListAttachments
+type MessageRepositoryMockListAttachmentsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMessageIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAttachments *mMessageRepositoryMockListAttachments) Optional() *mMessageRepositoryMockListAttachments {
+	mmListAttachments.optional = true
+	return mmListAttachments
+}
+
+// Expect sets up expected params for MessageRepository.ListAttachments
+func (mmListAttachments *mMessageRepositoryMockListAttachments) Expect(ctx context.Context, messageIDs []int64) *mMessageRepositoryMockListAttachments {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("MessageRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	if mmListAttachments.defaultExpectation == nil {
+		mmListAttachments.defaultExpectation = &MessageRepositoryMockListAttachmentsExpectation{}
+	}
+
+	if mmListAttachments.defaultExpectation.paramPtrs != nil {
+		mmListAttachments.mock.t.Fatalf("MessageRepositoryMock.ListAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmListAttachments.defaultExpectation.params = &MessageRepositoryMockListAttachmentsParams{ctx, messageIDs}
+	mmListAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAttachments.expectations {
+		if minimock.Equal(e.params, mmListAttachments.defaultExpectation.params) {
+			mmListAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmListAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListAttachments
+func (mmListAttachments *mMessageRepositoryMockListAttachments) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListAttachments {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("MessageRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	if mmListAttachments.defaultExpectation == nil {
+		mmListAttachments.defaultExpectation = &MessageRepositoryMockListAttachmentsExpectation{}
+	}
+
+	if mmListAttachments.defaultExpectation.params != nil {
+		mmListAttachments.mock.t.Fatalf("MessageRepositoryMock.ListAttachments mock is already set by Expect")
+	}
+
+	if mmListAttachments.defaultExpectation.paramPtrs == nil {
+		mmListAttachments.defaultExpectation.paramPtrs = &MessageRepositoryMockListAttachmentsParamPtrs{}
+	}
+	mmListAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAttachments
+}
+
+// ExpectMessageIDsParam2 sets up expected param messageIDs for MessageRepository.ListAttachments
+func (mmListAttachments *mMessageRepositoryMockListAttachments) ExpectMessageIDsParam2(messageIDs []int64) *mMessageRepositoryMockListAttachments {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("MessageRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	if mmListAttachments.defaultExpectation == nil {
+		mmListAttachments.defaultExpectation = &MessageRepositoryMockListAttachmentsExpectation{}
+	}
+
+	if mmListAttachments.defaultExpectation.params != nil {
+		mmListAttachments.mock.t.Fatalf("MessageRepositoryMock.ListAttachments mock is already set by Expect")
+	}
+
+	if mmListAttachments.defaultExpectation.paramPtrs == nil {
+		mmListAttachments.defaultExpectation.paramPtrs = &MessageRepositoryMockListAttachmentsParamPtrs{}
+	}
+	mmListAttachments.defaultExpectation.paramPtrs.messageIDs = &messageIDs
+	mmListAttachments.defaultExpectation.expectationOrigins.originMessageIDs = minimock.CallerInfo(1)
+
+	return mmListAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListAttachments
+func (mmListAttachments *mMessageRepositoryMockListAttachments) Inspect(f func(ctx context.Context, messageIDs []int64)) *mMessageRepositoryMockListAttachments {
+	if mmListAttachments.mock.inspectFuncListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListAttachments")
+	}
+
+	mmListAttachments.mock.inspectFuncListAttachments = f
+
+	return mmListAttachments
+}
+
+// Return sets up results that will be returned by MessageRepository.ListAttachments
+func (mmListAttachments *mMessageRepositoryMockListAttachments) Return(m1 map[int64][]*model.Attachment, err error) *MessageRepositoryMock {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("MessageRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	if mmListAttachments.defaultExpectation == nil {
+		mmListAttachments.defaultExpectation = &MessageRepositoryMockListAttachmentsExpectation{mock: mmListAttachments.mock}
+	}
+	mmListAttachments.defaultExpectation.results = &MessageRepositoryMockListAttachmentsResults{m1, err}
+	mmListAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAttachments.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListAttachments method
+func (mmListAttachments *mMessageRepositoryMockListAttachments) Set(f func(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.Attachment, err error)) *MessageRepositoryMock {
+	if mmListAttachments.defaultExpectation != nil {
+		mmListAttachments.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListAttachments method")
+	}
+
+	if len(mmListAttachments.expectations) > 0 {
+		mmListAttachments.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListAttachments method")
+	}
+
+	mmListAttachments.mock.funcListAttachments = f
+	mmListAttachments.mock.funcListAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmListAttachments.mock
+}
+
+// When sets expectation for the MessageRepository.ListAttachments which will trigger the result defined by the following
+// Then helper
+func (mmListAttachments *mMessageRepositoryMockListAttachments) When(ctx context.Context, messageIDs []int64) *MessageRepositoryMockListAttachmentsExpectation {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("MessageRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListAttachmentsExpectation{
+		mock:               mmListAttachments.mock,
+		params:             &MessageRepositoryMockListAttachmentsParams{ctx, messageIDs},
+		expectationOrigins: MessageRepositoryMockListAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAttachments.expectations = append(mmListAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListAttachments return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListAttachmentsExpectation) Then(m1 map[int64][]*model.Attachment, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListAttachmentsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListAttachments should be invoked
+func (mmListAttachments *mMessageRepositoryMockListAttachments) Times(n uint64) *mMessageRepositoryMockListAttachments {
+	if n == 0 {
+		mmListAttachments.mock.t.Fatalf("Times of MessageRepositoryMock.ListAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAttachments.expectedInvocations, n)
+	mmListAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAttachments
+}
+
+func (mmListAttachments *mMessageRepositoryMockListAttachments) invocationsDone() bool {
+	if len(mmListAttachments.expectations) == 0 && mmListAttachments.defaultExpectation == nil && mmListAttachments.mock.funcListAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAttachments.mock.afterListAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAttachments implements mm_repository.MessageRepository
+func (mmListAttachments *MessageRepositoryMock) ListAttachments(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmListAttachments.beforeListAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAttachments.afterListAttachmentsCounter, 1)
+
+	mmListAttachments.t.Helper()
+
+	if mmListAttachments.inspectFuncListAttachments != nil {
+		mmListAttachments.inspectFuncListAttachments(ctx, messageIDs)
+	}
+
+	mm_params := MessageRepositoryMockListAttachmentsParams{ctx, messageIDs}
+
+	// Record call args
+	mmListAttachments.ListAttachmentsMock.mutex.Lock()
+	mmListAttachments.ListAttachmentsMock.callArgs = append(mmListAttachments.ListAttachmentsMock.callArgs, &mm_params)
+	mmListAttachments.ListAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmListAttachments.ListAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmListAttachments.ListAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAttachments.ListAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAttachments.ListAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAttachments.ListAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListAttachmentsParams{ctx, messageIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAttachments.t.Errorf("MessageRepositoryMock.ListAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAttachments.ListAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageIDs != nil && !minimock.Equal(*mm_want_ptrs.messageIDs, mm_got.messageIDs) {
+				mmListAttachments.t.Errorf("MessageRepositoryMock.ListAttachments got unexpected parameter messageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAttachments.ListAttachmentsMock.defaultExpectation.expectationOrigins.originMessageIDs, *mm_want_ptrs.messageIDs, mm_got.messageIDs, minimock.Diff(*mm_want_ptrs.messageIDs, mm_got.messageIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAttachments.t.Errorf("MessageRepositoryMock.ListAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAttachments.ListAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAttachments.ListAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAttachments.t.Fatal("No results are set for the MessageRepositoryMock.ListAttachments")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmListAttachments.funcListAttachments != nil {
+		return mmListAttachments.funcListAttachments(ctx, messageIDs)
+	}
+	mmListAttachments.t.Fatalf("Unexpected call to MessageRepositoryMock.ListAttachments. %v %v", ctx, messageIDs)
+	return
+}
+
+// ListAttachmentsAfterCounter returns a count of finished MessageRepositoryMock.ListAttachments invocations
+func (mmListAttachments *MessageRepositoryMock) ListAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAttachments.afterListAttachmentsCounter)
+}
+
+// ListAttachmentsBeforeCounter returns a count of MessageRepositoryMock.ListAttachments invocations
+func (mmListAttachments *MessageRepositoryMock) ListAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAttachments.beforeListAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAttachments *mMessageRepositoryMockListAttachments) Calls() []*MessageRepositoryMockListAttachmentsParams {
+	mmListAttachments.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListAttachmentsParams, len(mmListAttachments.callArgs))
+	copy(argCopy, mmListAttachments.callArgs)
+
+	mmListAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAttachmentsDone returns true if the count of the ListAttachments invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListAttachmentsDone() bool {
+	if m.ListAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAttachmentsMock.invocationsDone()
+}
+
+// MinimockListAttachmentsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListAttachmentsInspect() {
+	for _, e := range m.ListAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAttachmentsCounter := mm_atomic.LoadUint64(&m.afterListAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAttachmentsMock.defaultExpectation != nil && afterListAttachmentsCounter < 1 {
+		if m.ListAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListAttachments at\n%s", m.ListAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListAttachments at\n%s with params: %#v", m.ListAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.ListAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAttachments != nil && afterListAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListAttachments at\n%s", m.funcListAttachmentsOrigin)
+	}
+
+	if !m.ListAttachmentsMock.invocationsDone() && afterListAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAttachmentsMock.expectedInvocations), m.ListAttachmentsMock.expectedInvocationsOrigin, afterListAttachmentsCounter)
+	}
+}
+
+type mMessageRepositoryMockListReactions struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListReactionsExpectation
+	expectations       []*MessageRepositoryMockListReactionsExpectation
+
+	callArgs []*MessageRepositoryMockListReactionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListReactionsExpectation specifies expectation struct of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListReactionsParams
+	paramPtrs          *MessageRepositoryMockListReactionsParamPtrs
+	expectationOrigins MessageRepositoryMockListReactionsExpectationOrigins
+	results            *MessageRepositoryMockListReactionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListReactionsParams contains parameters of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsParams struct {
+	ctx        context.Context
+	messageIDs []int64
+	userID     int64
+}
+
+// MessageRepositoryMockListReactionsParamPtrs contains pointers to parameters of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsParamPtrs struct {
+	ctx        *context.Context
+	messageIDs *[]int64
+	userID     *int64
+}
+
+// MessageRepositoryMockListReactionsResults contains results of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsResults struct {
+	m1  map[int64][]*model.Reaction
+	err error
+}
+
+// MessageRepositoryMockListReactionsOrigins contains origins of expectations of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMessageIDs string
+	originUserID     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReactions *mMessageRepositoryMockListReactions) Optional() *mMessageRepositoryMockListReactions {
+	mmListReactions.optional = true
+	return mmListReactions
+}
+
+// Expect sets up expected params for MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) Expect(ctx context.Context, messageIDs []int64, userID int64) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{}
+	}
+
+	if mmListReactions.defaultExpectation.paramPtrs != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by ExpectParams functions")
+	}
+
+	mmListReactions.defaultExpectation.params = &MessageRepositoryMockListReactionsParams{ctx, messageIDs, userID}
+	mmListReactions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReactions.expectations {
+		if minimock.Equal(e.params, mmListReactions.defaultExpectation.params) {
+			mmListReactions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReactions.defaultExpectation.params)
+		}
+	}
+
+	return mmListReactions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{}
+	}
+
+	if mmListReactions.defaultExpectation.params != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Expect")
+	}
+
+	if mmListReactions.defaultExpectation.paramPtrs == nil {
+		mmListReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockListReactionsParamPtrs{}
+	}
+	mmListReactions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReactions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReactions
+}
+
+// ExpectMessageIDsParam2 sets up expected param messageIDs for MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) ExpectMessageIDsParam2(messageIDs []int64) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{}
+	}
+
+	if mmListReactions.defaultExpectation.params != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Expect")
+	}
+
+	if mmListReactions.defaultExpectation.paramPtrs == nil {
+		mmListReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockListReactionsParamPtrs{}
+	}
+	mmListReactions.defaultExpectation.paramPtrs.messageIDs = &messageIDs
+	mmListReactions.defaultExpectation.expectationOrigins.originMessageIDs = minimock.CallerInfo(1)
+
+	return mmListReactions
+}
+
+// ExpectUserIDParam3 sets up expected param userID for MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) ExpectUserIDParam3(userID int64) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{}
+	}
+
+	if mmListReactions.defaultExpectation.params != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Expect")
+	}
+
+	if mmListReactions.defaultExpectation.paramPtrs == nil {
+		mmListReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockListReactionsParamPtrs{}
+	}
+	mmListReactions.defaultExpectation.paramPtrs.userID = &userID
+	mmListReactions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListReactions
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) Inspect(f func(ctx context.Context, messageIDs []int64, userID int64)) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.inspectFuncListReactions != nil {
+		mmListReactions.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListReactions")
+	}
+
+	mmListReactions.mock.inspectFuncListReactions = f
+
+	return mmListReactions
+}
+
+// Return sets up results that will be returned by MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) Return(m1 map[int64][]*model.Reaction, err error) *MessageRepositoryMock {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{mock: mmListReactions.mock}
+	}
+	mmListReactions.defaultExpectation.results = &MessageRepositoryMockListReactionsResults{m1, err}
+	mmListReactions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReactions.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListReactions method
+func (mmListReactions *mMessageRepositoryMockListReactions) Set(f func(ctx context.Context, messageIDs []int64, userID int64) (m1 map[int64][]*model.Reaction, err error)) *MessageRepositoryMock {
+	if mmListReactions.defaultExpectation != nil {
+		mmListReactions.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListReactions method")
+	}
+
+	if len(mmListReactions.expectations) > 0 {
+		mmListReactions.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListReactions method")
+	}
+
+	mmListReactions.mock.funcListReactions = f
+	mmListReactions.mock.funcListReactionsOrigin = minimock.CallerInfo(1)
+	return mmListReactions.mock
+}
+
+// When sets expectation for the MessageRepository.ListReactions which will trigger the result defined by the following
+// Then helper
+func (mmListReactions *mMessageRepositoryMockListReactions) When(ctx context.Context, messageIDs []int64, userID int64) *MessageRepositoryMockListReactionsExpectation {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListReactionsExpectation{
+		mock:               mmListReactions.mock,
+		params:             &MessageRepositoryMockListReactionsParams{ctx, messageIDs, userID},
+		expectationOrigins: MessageRepositoryMockListReactionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReactions.expectations = append(mmListReactions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListReactions return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListReactionsExpectation) Then(m1 map[int64][]*model.Reaction, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListReactionsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListReactions should be invoked
+func (mmListReactions *mMessageRepositoryMockListReactions) Times(n uint64) *mMessageRepositoryMockListReactions {
+	if n == 0 {
+		mmListReactions.mock.t.Fatalf("Times of MessageRepositoryMock.ListReactions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReactions.expectedInvocations, n)
+	mmListReactions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReactions
+}
+
+func (mmListReactions *mMessageRepositoryMockListReactions) invocationsDone() bool {
+	if len(mmListReactions.expectations) == 0 && mmListReactions.defaultExpectation == nil && mmListReactions.mock.funcListReactions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReactions.mock.afterListReactionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReactions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReactions implements mm_repository.MessageRepository
+func (mmListReactions *MessageRepositoryMock) ListReactions(ctx context.Context, messageIDs []int64, userID int64) (m1 map[int64][]*model.Reaction, err error) {
+	mm_atomic.AddUint64(&mmListReactions.beforeListReactionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReactions.afterListReactionsCounter, 1)
+
+	mmListReactions.t.Helper()
+
+	if mmListReactions.inspectFuncListReactions != nil {
+		mmListReactions.inspectFuncListReactions(ctx, messageIDs, userID)
+	}
+
+	mm_params := MessageRepositoryMockListReactionsParams{ctx, messageIDs, userID}
+
+	// Record call args
+	mmListReactions.ListReactionsMock.mutex.Lock()
+	mmListReactions.ListReactionsMock.callArgs = append(mmListReactions.ListReactionsMock.callArgs, &mm_params)
+	mmListReactions.ListReactionsMock.mutex.Unlock()
+
+	for _, e := range mmListReactions.ListReactionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmListReactions.ListReactionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReactions.ListReactionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReactions.ListReactionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListReactions.ListReactionsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListReactionsParams{ctx, messageIDs, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReactions.t.Errorf("MessageRepositoryMock.ListReactions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReactions.ListReactionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageIDs != nil && !minimock.Equal(*mm_want_ptrs.messageIDs, mm_got.messageIDs) {
+				mmListReactions.t.Errorf("MessageRepositoryMock.ListReactions got unexpected parameter messageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReactions.ListReactionsMock.defaultExpectation.expectationOrigins.originMessageIDs, *mm_want_ptrs.messageIDs, mm_got.messageIDs, minimock.Diff(*mm_want_ptrs.messageIDs, mm_got.messageIDs))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListReactions.t.Errorf("MessageRepositoryMock.ListReactions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReactions.ListReactionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReactions.t.Errorf("MessageRepositoryMock.ListReactions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReactions.ListReactionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReactions.ListReactionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReactions.t.Fatal("No results are set for the MessageRepositoryMock.ListReactions")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmListReactions.funcListReactions != nil {
+		return mmListReactions.funcListReactions(ctx, messageIDs, userID)
+	}
+	mmListReactions.t.Fatalf("Unexpected call to MessageRepositoryMock.ListReactions. %v %v %v", ctx, messageIDs, userID)
+	return
+}
+
+// ListReactionsAfterCounter returns a count of finished MessageRepositoryMock.ListReactions invocations
+func (mmListReactions *MessageRepositoryMock) ListReactionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReactions.afterListReactionsCounter)
+}
+
+// ListReactionsBeforeCounter returns a count of MessageRepositoryMock.ListReactions invocations
+func (mmListReactions *MessageRepositoryMock) ListReactionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReactions.beforeListReactionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListReactions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReactions *mMessageRepositoryMockListReactions) Calls() []*MessageRepositoryMockListReactionsParams {
+	mmListReactions.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListReactionsParams, len(mmListReactions.callArgs))
+	copy(argCopy, mmListReactions.callArgs)
+
+	mmListReactions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListReactionsDone returns true if the count of the ListReactions invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListReactionsDone() bool {
+	if m.ListReactionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListReactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListReactionsMock.invocationsDone()
+}
+
+// MinimockListReactionsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListReactionsInspect() {
+	for _, e := range m.ListReactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReactions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListReactionsCounter := mm_atomic.LoadUint64(&m.afterListReactionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListReactionsMock.defaultExpectation != nil && afterListReactionsCounter < 1 {
+		if m.ListReactionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReactions at\n%s", m.ListReactionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReactions at\n%s with params: %#v", m.ListReactionsMock.defaultExpectation.expectationOrigins.origin, *m.ListReactionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReactions != nil && afterListReactionsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListReactions at\n%s", m.funcListReactionsOrigin)
+	}
+
+	if !m.ListReactionsMock.invocationsDone() && afterListReactionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListReactions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListReactionsMock.expectedInvocations), m.ListReactionsMock.expectedInvocationsOrigin, afterListReactionsCounter)
+	}
+}
+
+type mMessageRepositoryMockListReplies struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListRepliesExpectation
+	expectations       []*MessageRepositoryMockListRepliesExpectation
+
+	callArgs []*MessageRepositoryMockListRepliesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListRepliesExpectation specifies expectation struct of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListRepliesParams
+	paramPtrs          *MessageRepositoryMockListRepliesParamPtrs
+	expectationOrigins MessageRepositoryMockListRepliesExpectationOrigins
+	results            *MessageRepositoryMockListRepliesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListRepliesParams contains parameters of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesParams struct {
+	ctx     context.Context
+	rootID  int64
+	afterID int64
+	limit   uint64
+}
+
+// MessageRepositoryMockListRepliesParamPtrs contains pointers to parameters of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesParamPtrs struct {
+	ctx     *context.Context
+	rootID  *int64
+	afterID *int64
+	limit   *uint64
+}
+
+// MessageRepositoryMockListRepliesResults contains results of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListRepliesOrigins contains origins of expectations of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRootID  string
+	originAfterID string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReplies *mMessageRepositoryMockListReplies) Optional() *mMessageRepositoryMockListReplies {
+	mmListReplies.optional = true
+	return mmListReplies
+}
+
+// Expect sets up expected params for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Expect(ctx context.Context, rootID int64, afterID int64, limit uint64) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by ExpectParams functions")
+	}
+
+	mmListReplies.defaultExpectation.params = &MessageRepositoryMockListRepliesParams{ctx, rootID, afterID, limit}
+	mmListReplies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReplies.expectations {
+		if minimock.Equal(e.params, mmListReplies.defaultExpectation.params) {
+			mmListReplies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReplies.defaultExpectation.params)
+		}
+	}
+
+	return mmListReplies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReplies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// ExpectRootIDParam2 sets up expected param rootID for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectRootIDParam2(rootID int64) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.rootID = &rootID
+	mmListReplies.defaultExpectation.expectationOrigins.originRootID = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// ExpectAfterIDParam3 sets up expected param afterID for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectAfterIDParam3(afterID int64) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.afterID = &afterID
+	mmListReplies.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// ExpectLimitParam4 sets up expected param limit for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectLimitParam4(limit uint64) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.limit = &limit
+	mmListReplies.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Inspect(f func(ctx context.Context, rootID int64, afterID int64, limit uint64)) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.inspectFuncListReplies != nil {
+		mmListReplies.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListReplies")
+	}
+
+	mmListReplies.mock.inspectFuncListReplies = f
+
+	return mmListReplies
+}
+
+// Return sets up results that will be returned by MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{mock: mmListReplies.mock}
+	}
+	mmListReplies.defaultExpectation.results = &MessageRepositoryMockListRepliesResults{mpa1, err}
+	mmListReplies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReplies.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListReplies method
+func (mmListReplies *mMessageRepositoryMockListReplies) Set(f func(ctx context.Context, rootID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListReplies.defaultExpectation != nil {
+		mmListReplies.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListReplies method")
+	}
+
+	if len(mmListReplies.expectations) > 0 {
+		mmListReplies.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListReplies method")
+	}
+
+	mmListReplies.mock.funcListReplies = f
+	mmListReplies.mock.funcListRepliesOrigin = minimock.CallerInfo(1)
+	return mmListReplies.mock
+}
+
+// When sets expectation for the MessageRepository.ListReplies which will trigger the result defined by the following
+// Then helper
+func (mmListReplies *mMessageRepositoryMockListReplies) When(ctx context.Context, rootID int64, afterID int64, limit uint64) *MessageRepositoryMockListRepliesExpectation {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListRepliesExpectation{
+		mock:               mmListReplies.mock,
+		params:             &MessageRepositoryMockListRepliesParams{ctx, rootID, afterID, limit},
+		expectationOrigins: MessageRepositoryMockListRepliesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReplies.expectations = append(mmListReplies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListReplies return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListRepliesExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListRepliesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListReplies should be invoked
+func (mmListReplies *mMessageRepositoryMockListReplies) Times(n uint64) *mMessageRepositoryMockListReplies {
+	if n == 0 {
+		mmListReplies.mock.t.Fatalf("Times of MessageRepositoryMock.ListReplies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReplies.expectedInvocations, n)
+	mmListReplies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReplies
+}
+
+func (mmListReplies *mMessageRepositoryMockListReplies) invocationsDone() bool {
+	if len(mmListReplies.expectations) == 0 && mmListReplies.defaultExpectation == nil && mmListReplies.mock.funcListReplies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReplies.mock.afterListRepliesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReplies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReplies implements mm_repository.MessageRepository
+func (mmListReplies *MessageRepositoryMock) ListReplies(ctx context.Context, rootID int64, afterID int64, limit uint64) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListReplies.beforeListRepliesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReplies.afterListRepliesCounter, 1)
+
+	mmListReplies.t.Helper()
+
+	if mmListReplies.inspectFuncListReplies != nil {
+		mmListReplies.inspectFuncListReplies(ctx, rootID, afterID, limit)
+	}
+
+	mm_params := MessageRepositoryMockListRepliesParams{ctx, rootID, afterID, limit}
+
+	// Record call args
+	mmListReplies.ListRepliesMock.mutex.Lock()
+	mmListReplies.ListRepliesMock.callArgs = append(mmListReplies.ListRepliesMock.callArgs, &mm_params)
+	mmListReplies.ListRepliesMock.mutex.Unlock()
+
+	for _, e := range mmListReplies.ListRepliesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListReplies.ListRepliesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReplies.ListRepliesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReplies.ListRepliesMock.defaultExpectation.params
+		mm_want_ptrs := mmListReplies.ListRepliesMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListRepliesParams{ctx, rootID, afterID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rootID != nil && !minimock.Equal(*mm_want_ptrs.rootID, mm_got.rootID) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter rootID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originRootID, *mm_want_ptrs.rootID, mm_got.rootID, minimock.Diff(*mm_want_ptrs.rootID, mm_got.rootID))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReplies.ListRepliesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReplies.t.Fatal("No results are set for the MessageRepositoryMock.ListReplies")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListReplies.funcListReplies != nil {
+		return mmListReplies.funcListReplies(ctx, rootID, afterID, limit)
+	}
+	mmListReplies.t.Fatalf("Unexpected call to MessageRepositoryMock.ListReplies. %v %v %v %v", ctx, rootID, afterID, limit)
+	return
+}
+
+// ListRepliesAfterCounter returns a count of finished MessageRepositoryMock.ListReplies invocations
+func (mmListReplies *MessageRepositoryMock) ListRepliesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReplies.afterListRepliesCounter)
+}
+
+// ListRepliesBeforeCounter returns a count of MessageRepositoryMock.ListReplies invocations
+func (mmListReplies *MessageRepositoryMock) ListRepliesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReplies.beforeListRepliesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListReplies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReplies *mMessageRepositoryMockListReplies) Calls() []*MessageRepositoryMockListRepliesParams {
+	mmListReplies.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListRepliesParams, len(mmListReplies.callArgs))
+	copy(argCopy, mmListReplies.callArgs)
+
+	mmListReplies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRepliesDone returns true if the count of the ListReplies invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListRepliesDone() bool {
+	if m.ListRepliesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRepliesMock.invocationsDone()
+}
+
+// MinimockListRepliesInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListRepliesInspect() {
+	for _, e := range m.ListRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRepliesCounter := mm_atomic.LoadUint64(&m.afterListRepliesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRepliesMock.defaultExpectation != nil && afterListRepliesCounter < 1 {
+		if m.ListRepliesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s", m.ListRepliesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s with params: %#v", m.ListRepliesMock.defaultExpectation.expectationOrigins.origin, *m.ListRepliesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReplies != nil && afterListRepliesCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s", m.funcListRepliesOrigin)
+	}
+
+	if !m.ListRepliesMock.invocationsDone() && afterListRepliesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListReplies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRepliesMock.expectedInvocations), m.ListRepliesMock.expectedInvocationsOrigin, afterListRepliesCounter)
+	}
+}
+
+type mMessageRepositoryMockLockUploads struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockLockUploadsExpectation
+	expectations       []*MessageRepositoryMockLockUploadsExpectation
+
+	callArgs []*MessageRepositoryMockLockUploadsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockLockUploadsExpectation specifies expectation struct of the MessageRepository.LockUploads
+type MessageRepositoryMockLockUploadsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockLockUploadsParams
+	paramPtrs          *MessageRepositoryMockLockUploadsParamPtrs
+	expectationOrigins MessageRepositoryMockLockUploadsExpectationOrigins
+	results            *MessageRepositoryMockLockUploadsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockLockUploadsParams contains parameters of the MessageRepository.LockUploads
+type MessageRepositoryMockLockUploadsParams struct {
+	ctx        context.Context
+	uploaderID int64
+}
+
+// MessageRepositoryMockLockUploadsParamPtrs contains pointers to parameters of the MessageRepository.LockUploads
+type MessageRepositoryMockLockUploadsParamPtrs struct {
+	ctx        *context.Context
+	uploaderID *int64
+}
+
+// MessageRepositoryMockLockUploadsResults contains results of the MessageRepository.LockUploads
+type MessageRepositoryMockLockUploadsResults struct {
+	err error
+}
+
+// MessageRepositoryMockLockUploadsOrigins contains origins of expectations of the MessageRepository.LockUploads
+type MessageRepositoryMockLockUploadsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUploaderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockUploads *mMessageRepositoryMockLockUploads) Optional() *mMessageRepositoryMockLockUploads {
+	mmLockUploads.optional = true
+	return mmLockUploads
+}
+
+// Expect sets up expected params for MessageRepository.LockUploads
+func (mmLockUploads *mMessageRepositoryMockLockUploads) Expect(ctx context.Context, uploaderID int64) *mMessageRepositoryMockLockUploads {
+	if mmLockUploads.mock.funcLockUploads != nil {
+		mmLockUploads.mock.t.Fatalf("MessageRepositoryMock.LockUploads mock is already set by Set")
+	}
+
+	if mmLockUploads.defaultExpectation == nil {
+		mmLockUploads.defaultExpectation = &MessageRepositoryMockLockUploadsExpectation{}
+	}
+
+	if mmLockUploads.defaultExpectation.paramPtrs != nil {
+		mmLockUploads.mock.t.Fatalf("MessageRepositoryMock.LockUploads mock is already set by ExpectParams functions")
+	}
+
+	mmLockUploads.defaultExpectation.params = &MessageRepositoryMockLockUploadsParams{ctx, uploaderID}
+	mmLockUploads.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockUploads.expectations {
+		if minimock.Equal(e.params, mmLockUploads.defaultExpectation.params) {
+			mmLockUploads.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockUploads.defaultExpectation.params)
+		}
+	}
+
+	return mmLockUploads
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.LockUploads
+func (mmLockUploads *mMessageRepositoryMockLockUploads) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockLockUploads {
+	if mmLockUploads.mock.funcLockUploads != nil {
+		mmLockUploads.mock.t.Fatalf("MessageRepositoryMock.LockUploads mock is already set by Set")
+	}
+
+	if mmLockUploads.defaultExpectation == nil {
+		mmLockUploads.defaultExpectation = &MessageRepositoryMockLockUploadsExpectation{}
+	}
+
+	if mmLockUploads.defaultExpectation.params != nil {
+		mmLockUploads.mock.t.Fatalf("MessageRepositoryMock.LockUploads mock is already set by Expect")
+	}
+
+	if mmLockUploads.defaultExpectation.paramPtrs == nil {
+		mmLockUploads.defaultExpectation.paramPtrs = &MessageRepositoryMockLockUploadsParamPtrs{}
+	}
+	mmLockUploads.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockUploads.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockUploads
+}
+
+// ExpectUploaderIDParam2 sets up expected param uploaderID for MessageRepository.LockUploads
+func (mmLockUploads *mMessageRepositoryMockLockUploads) ExpectUploaderIDParam2(uploaderID int64) *mMessageRepositoryMockLockUploads {
+	if mmLockUploads.mock.funcLockUploads != nil {
+		mmLockUploads.mock.t.Fatalf("MessageRepositoryMock.LockUploads mock is already set by Set")
+	}
+
+	if mmLockUploads.defaultExpectation == nil {
+		mmLockUploads.defaultExpectation = &MessageRepositoryMockLockUploadsExpectation{}
+	}
+
+	if mmLockUploads.defaultExpectation.params != nil {
+		mmLockUploads.mock.t.Fatalf("MessageRepositoryMock.LockUploads mock is already set by Expect")
+	}
+
+	if mmLockUploads.defaultExpectation.paramPtrs == nil {
+		mmLockUploads.defaultExpectation.paramPtrs = &MessageRepositoryMockLockUploadsParamPtrs{}
+	}
+	mmLockUploads.defaultExpectation.paramPtrs.uploaderID = &uploaderID
+	mmLockUploads.defaultExpectation.expectationOrigins.originUploaderID = minimock.CallerInfo(1)
+
+	return mmLockUploads
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.LockUploads
+func (mmLockUploads *mMessageRepositoryMockLockUploads) Inspect(f func(ctx context.Context, uploaderID int64)) *mMessageRepositoryMockLockUploads {
+	if mmLockUploads.mock.inspectFuncLockUploads != nil {
+		mmLockUploads.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.LockUploads")
+	}
+
+	mmLockUploads.mock.inspectFuncLockUploads = f
+
+	return mmLockUploads
+}
+
+// Return sets up results that will be returned by MessageRepository.LockUploads
+func (mmLockUploads *mMessageRepositoryMockLockUploads) Return(err error) *MessageRepositoryMock {
+	if mmLockUploads.mock.funcLockUploads != nil {
+		mmLockUploads.mock.t.Fatalf("MessageRepositoryMock.LockUploads mock is already set by Set")
+	}
+
+	if mmLockUploads.defaultExpectation == nil {
+		mmLockUploads.defaultExpectation = &MessageRepositoryMockLockUploadsExpectation{mock: mmLockUploads.mock}
+	}
+	mmLockUploads.defaultExpectation.results = &MessageRepositoryMockLockUploadsResults{err}
+	mmLockUploads.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockUploads.mock
+}
+
+// Set uses given function f to mock the MessageRepository.LockUploads method
+func (mmLockUploads *mMessageRepositoryMockLockUploads) Set(f func(ctx context.Context, uploaderID int64) (err error)) *MessageRepositoryMock {
+	if mmLockUploads.defaultExpectation != nil {
+		mmLockUploads.mock.t.Fatalf("Default expectation is already set for the MessageRepository.LockUploads method")
+	}
+
+	if len(mmLockUploads.expectations) > 0 {
+		mmLockUploads.mock.t.Fatalf("Some expectations are already set for the MessageRepository.LockUploads method")
+	}
+
+	mmLockUploads.mock.funcLockUploads = f
+	mmLockUploads.mock.funcLockUploadsOrigin = minimock.CallerInfo(1)
+	return mmLockUploads.mock
+}
+
+// When sets expectation for the MessageRepository.LockUploads which will trigger the result defined by the following
+// Then helper
+func (mmLockUploads *mMessageRepositoryMockLockUploads) When(ctx context.Context, uploaderID int64) *MessageRepositoryMockLockUploadsExpectation {
+	if mmLockUploads.mock.funcLockUploads != nil {
+		mmLockUploads.mock.t.Fatalf("MessageRepositoryMock.LockUploads mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockLockUploadsExpectation{
+		mock:               mmLockUploads.mock,
+		params:             &MessageRepositoryMockLockUploadsParams{ctx, uploaderID},
+		expectationOrigins: MessageRepositoryMockLockUploadsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockUploads.expectations = append(mmLockUploads.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.LockUploads return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockLockUploadsExpectation) Then(err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockLockUploadsResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.LockUploads should be invoked
+func (mmLockUploads *mMessageRepositoryMockLockUploads) Times(n uint64) *mMessageRepositoryMockLockUploads {
+	if n == 0 {
+		mmLockUploads.mock.t.Fatalf("Times of MessageRepositoryMock.LockUploads mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockUploads.expectedInvocations, n)
+	mmLockUploads.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockUploads
+}
+
+func (mmLockUploads *mMessageRepositoryMockLockUploads) invocationsDone() bool {
+	if len(mmLockUploads.expectations) == 0 && mmLockUploads.defaultExpectation == nil && mmLockUploads.mock.funcLockUploads == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockUploads.mock.afterLockUploadsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockUploads.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockUploads implements mm_repository.MessageRepository
+func (mmLockUploads *MessageRepositoryMock) LockUploads(ctx context.Context, uploaderID int64) (err error) {
+	mm_atomic.AddUint64(&mmLockUploads.beforeLockUploadsCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockUploads.afterLockUploadsCounter, 1)
+
+	mmLockUploads.t.Helper()
+
+	if mmLockUploads.inspectFuncLockUploads != nil {
+		mmLockUploads.inspectFuncLockUploads(ctx, uploaderID)
+	}
+
+	mm_params := MessageRepositoryMockLockUploadsParams{ctx, uploaderID}
+
+	// Record call args
+	mmLockUploads.LockUploadsMock.mutex.Lock()
+	mmLockUploads.LockUploadsMock.callArgs = append(mmLockUploads.LockUploadsMock.callArgs, &mm_params)
+	mmLockUploads.LockUploadsMock.mutex.Unlock()
+
+	for _, e := range mmLockUploads.LockUploadsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLockUploads.LockUploadsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockUploads.LockUploadsMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockUploads.LockUploadsMock.defaultExpectation.params
+		mm_want_ptrs := mmLockUploads.LockUploadsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockLockUploadsParams{ctx, uploaderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockUploads.t.Errorf("MessageRepositoryMock.LockUploads got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockUploads.LockUploadsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.uploaderID != nil && !minimock.Equal(*mm_want_ptrs.uploaderID, mm_got.uploaderID) {
+				mmLockUploads.t.Errorf("MessageRepositoryMock.LockUploads got unexpected parameter uploaderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockUploads.LockUploadsMock.defaultExpectation.expectationOrigins.originUploaderID, *mm_want_ptrs.uploaderID, mm_got.uploaderID, minimock.Diff(*mm_want_ptrs.uploaderID, mm_got.uploaderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockUploads.t.Errorf("MessageRepositoryMock.LockUploads got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockUploads.LockUploadsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockUploads.LockUploadsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockUploads.t.Fatal("No results are set for the MessageRepositoryMock.LockUploads")
+		}
+		return (*mm_results).err
+	}
+	if mmLockUploads.funcLockUploads != nil {
+		return mmLockUploads.funcLockUploads(ctx, uploaderID)
+	}
+	mmLockUploads.t.Fatalf("Unexpected call to MessageRepositoryMock.LockUploads. %v %v", ctx, uploaderID)
+	return
+}
+
+// LockUploadsAfterCounter returns a count of finished MessageRepositoryMock.LockUploads invocations
+func (mmLockUploads *MessageRepositoryMock) LockUploadsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockUploads.afterLockUploadsCounter)
+}
+
+// LockUploadsBeforeCounter returns a count of MessageRepositoryMock.LockUploads invocations
+func (mmLockUploads *MessageRepositoryMock) LockUploadsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockUploads.beforeLockUploadsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.LockUploads.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockUploads *mMessageRepositoryMockLockUploads) Calls() []*MessageRepositoryMockLockUploadsParams {
+	mmLockUploads.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockLockUploadsParams, len(mmLockUploads.callArgs))
+	copy(argCopy, mmLockUploads.callArgs)
+
+	mmLockUploads.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockUploadsDone returns true if the count of the LockUploads invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockLockUploadsDone() bool {
+	if m.LockUploadsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockUploadsMock.invocationsDone()
+}
+
+// MinimockLockUploadsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockLockUploadsInspect() {
+	for _, e := range m.LockUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockUploads at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockUploadsCounter := mm_atomic.LoadUint64(&m.afterLockUploadsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockUploadsMock.defaultExpectation != nil && afterLockUploadsCounter < 1 {
+		if m.LockUploadsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockUploads at\n%s", m.LockUploadsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockUploads at\n%s with params: %#v", m.LockUploadsMock.defaultExpectation.expectationOrigins.origin, *m.LockUploadsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockUploads != nil && afterLockUploadsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.LockUploads at\n%s", m.funcLockUploadsOrigin)
+	}
+
+	if !m.LockUploadsMock.invocationsDone() && afterLockUploadsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.LockUploads at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockUploadsMock.expectedInvocations), m.LockUploadsMock.expectedInvocationsOrigin, afterLockUploadsCounter)
+	}
+}
+
+type mMessageRepositoryMockRemoveReaction struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockRemoveReactionExpectation
+	expectations       []*MessageRepositoryMockRemoveReactionExpectation
+
+	callArgs []*MessageRepositoryMockRemoveReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockRemoveReactionExpectation specifies expectation struct of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockRemoveReactionParams
+	paramPtrs          *MessageRepositoryMockRemoveReactionParamPtrs
+	expectationOrigins MessageRepositoryMockRemoveReactionExpectationOrigins
+	results            *MessageRepositoryMockRemoveReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockRemoveReactionParams contains parameters of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionParams struct {
+	ctx       context.Context
+	messageID int64
+	userID    int64
+	emoji     string
+}
+
+// MessageRepositoryMockRemoveReactionParamPtrs contains pointers to parameters of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	userID    *int64
+	emoji     *string
+}
+
+// MessageRepositoryMockRemoveReactionResults contains results of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionResults struct {
+	b1  bool
+	err error
+}
+
+// MessageRepositoryMockRemoveReactionOrigins contains origins of expectations of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originUserID    string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Optional() *mMessageRepositoryMockRemoveReaction {
+	mmRemoveReaction.optional = true
+	return mmRemoveReaction
+}
+
+// Expect sets up expected params for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Expect(ctx context.Context, messageID int64, userID int64, emoji string) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReaction.defaultExpectation.params = &MessageRepositoryMockRemoveReactionParams{ctx, messageID, userID, emoji}
+	mmRemoveReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveReaction.expectations {
+		if minimock.Equal(e.params, mmRemoveReaction.defaultExpectation.params) {
+			mmRemoveReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectUserIDParam3 sets up expected param userID for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) ExpectUserIDParam3(userID int64) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) ExpectEmojiParam4(emoji string) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Inspect(f func(ctx context.Context, messageID int64, userID int64, emoji string)) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.RemoveReaction")
+	}
+
+	mmRemoveReaction.mock.inspectFuncRemoveReaction = f
+
+	return mmRemoveReaction
+}
+
+// Return sets up results that will be returned by MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Return(b1 bool, err error) *MessageRepositoryMock {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{mock: mmRemoveReaction.mock}
+	}
+	mmRemoveReaction.defaultExpectation.results = &MessageRepositoryMockRemoveReactionResults{b1, err}
+	mmRemoveReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// Set uses given function f to mock the MessageRepository.RemoveReaction method
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Set(f func(ctx context.Context, messageID int64, userID int64, emoji string) (b1 bool, err error)) *MessageRepositoryMock {
+	if mmRemoveReaction.defaultExpectation != nil {
+		mmRemoveReaction.mock.t.Fatalf("Default expectation is already set for the MessageRepository.RemoveReaction method")
+	}
+
+	if len(mmRemoveReaction.expectations) > 0 {
+		mmRemoveReaction.mock.t.Fatalf("Some expectations are already set for the MessageRepository.RemoveReaction method")
+	}
+
+	mmRemoveReaction.mock.funcRemoveReaction = f
+	mmRemoveReaction.mock.funcRemoveReactionOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// When sets expectation for the MessageRepository.RemoveReaction which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) When(ctx context.Context, messageID int64, userID int64, emoji string) *MessageRepositoryMockRemoveReactionExpectation {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockRemoveReactionExpectation{
+		mock:               mmRemoveReaction.mock,
+		params:             &MessageRepositoryMockRemoveReactionParams{ctx, messageID, userID, emoji},
+		expectationOrigins: MessageRepositoryMockRemoveReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveReaction.expectations = append(mmRemoveReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.RemoveReaction return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockRemoveReactionExpectation) Then(b1 bool, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockRemoveReactionResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.RemoveReaction should be invoked
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Times(n uint64) *mMessageRepositoryMockRemoveReaction {
+	if n == 0 {
+		mmRemoveReaction.mock.t.Fatalf("Times of MessageRepositoryMock.RemoveReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReaction.expectedInvocations, n)
+	mmRemoveReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction
+}
+
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) invocationsDone() bool {
+	if len(mmRemoveReaction.expectations) == 0 && mmRemoveReaction.defaultExpectation == nil && mmRemoveReaction.mock.funcRemoveReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.mock.afterRemoveReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReaction implements mm_repository.MessageRepository
+func (mmRemoveReaction *MessageRepositoryMock) RemoveReaction(ctx context.Context, messageID int64, userID int64, emoji string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemoveReaction.beforeRemoveReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReaction.afterRemoveReactionCounter, 1)
+
+	mmRemoveReaction.t.Helper()
+
+	if mmRemoveReaction.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.inspectFuncRemoveReaction(ctx, messageID, userID, emoji)
+	}
+
+	mm_params := MessageRepositoryMockRemoveReactionParams{ctx, messageID, userID, emoji}
+
+	// Record call args
+	mmRemoveReaction.RemoveReactionMock.mutex.Lock()
+	mmRemoveReaction.RemoveReactionMock.callArgs = append(mmRemoveReaction.RemoveReactionMock.callArgs, &mm_params)
+	mmRemoveReaction.RemoveReactionMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReaction.RemoveReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemoveReaction.RemoveReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReaction.RemoveReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReaction.RemoveReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReaction.RemoveReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockRemoveReactionParams{ctx, messageID, userID, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReaction.RemoveReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReaction.t.Fatal("No results are set for the MessageRepositoryMock.RemoveReaction")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemoveReaction.funcRemoveReaction != nil {
+		return mmRemoveReaction.funcRemoveReaction(ctx, messageID, userID, emoji)
+	}
+	mmRemoveReaction.t.Fatalf("Unexpected call to MessageRepositoryMock.RemoveReaction. %v %v %v %v", ctx, messageID, userID, emoji)
+	return
+}
+
+// RemoveReactionAfterCounter returns a count of finished MessageRepositoryMock.RemoveReaction invocations
+func (mmRemoveReaction *MessageRepositoryMock) RemoveReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.afterRemoveReactionCounter)
+}
+
+// RemoveReactionBeforeCounter returns a count of MessageRepositoryMock.RemoveReaction invocations
+func (mmRemoveReaction *MessageRepositoryMock) RemoveReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.beforeRemoveReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.RemoveReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Calls() []*MessageRepositoryMockRemoveReactionParams {
+	mmRemoveReaction.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockRemoveReactionParams, len(mmRemoveReaction.callArgs))
+	copy(argCopy, mmRemoveReaction.callArgs)
+
+	mmRemoveReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReactionDone returns true if the count of the RemoveReaction invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockRemoveReactionDone() bool {
+	if m.RemoveReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReactionMock.invocationsDone()
+}
+
+// MinimockRemoveReactionInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockRemoveReactionInspect() {
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemoveReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveReactionCounter := mm_atomic.LoadUint64(&m.afterRemoveReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReactionMock.defaultExpectation != nil && afterRemoveReactionCounter < 1 {
+		if m.RemoveReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemoveReaction at\n%s", m.RemoveReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemoveReaction at\n%s with params: %#v", m.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *m.RemoveReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReaction != nil && afterRemoveReactionCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.RemoveReaction at\n%s", m.funcRemoveReactionOrigin)
+	}
+
+	if !m.RemoveReactionMock.invocationsDone() && afterRemoveReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.RemoveReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReactionMock.expectedInvocations), m.RemoveReactionMock.expectedInvocationsOrigin, afterRemoveReactionCounter)
+	}
+}
+
+type mMessageRepositoryMockSearch struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockSearchExpectation
+	expectations       []*MessageRepositoryMockSearchExpectation
+
+	callArgs []*MessageRepositoryMockSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockSearchExpectation specifies expectation struct of the MessageRepository.Search
+type MessageRepositoryMockSearchExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockSearchParams
+	paramPtrs          *MessageRepositoryMockSearchParamPtrs
+	expectationOrigins MessageRepositoryMockSearchExpectationOrigins
+	results            *MessageRepositoryMockSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockSearchParams contains parameters of the MessageRepository.Search
+type MessageRepositoryMockSearchParams struct {
+	ctx    context.Context
+	userID int64
+	search *model.MessageSearch
+	after  *model.SearchResult
+	limit  uint64
+}
+
+// MessageRepositoryMockSearchParamPtrs contains pointers to parameters of the MessageRepository.Search
+type MessageRepositoryMockSearchParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	search **model.MessageSearch
+	after  **model.SearchResult
+	limit  *uint64
+}
+
+// MessageRepositoryMockSearchResults contains results of the MessageRepository.Search
+type MessageRepositoryMockSearchResults struct {
+	spa1 []*model.SearchResult
+	err  error
+}
+
+// MessageRepositoryMockSearchOrigins contains origins of expectations of the MessageRepository.Search
+type MessageRepositoryMockSearchExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSearch string
+	originAfter  string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearch *mMessageRepositoryMockSearch) Optional() *mMessageRepositoryMockSearch {
+	mmSearch.optional = true
+	return mmSearch
+}
+
+// Expect sets up expected params for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) Expect(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by ExpectParams functions")
+	}
+
+	mmSearch.defaultExpectation.params = &MessageRepositoryMockSearchParams{ctx, userID, search, after, limit}
+	mmSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearch.expectations {
+		if minimock.Equal(e.params, mmSearch.defaultExpectation.params) {
+			mmSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectUserIDParam2 sets up expected param userID for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) ExpectUserIDParam2(userID int64) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.userID = &userID
+	mmSearch.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectSearchParam3 sets up expected param search for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) ExpectSearchParam3(search *model.MessageSearch) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.search = &search
+	mmSearch.defaultExpectation.expectationOrigins.originSearch = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectAfterParam4 sets up expected param after for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) ExpectAfterParam4(after *model.SearchResult) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.after = &after
+	mmSearch.defaultExpectation.expectationOrigins.originAfter = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectLimitParam5 sets up expected param limit for MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) ExpectLimitParam5(limit uint64) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.limit = &limit
+	mmSearch.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) Inspect(f func(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64)) *mMessageRepositoryMockSearch {
+	if mmSearch.mock.inspectFuncSearch != nil {
+		mmSearch.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.Search")
+	}
+
+	mmSearch.mock.inspectFuncSearch = f
+
+	return mmSearch
+}
+
+// Return sets up results that will be returned by MessageRepository.Search
+func (mmSearch *mMessageRepositoryMockSearch) Return(spa1 []*model.SearchResult, err error) *MessageRepositoryMock {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &MessageRepositoryMockSearchExpectation{mock: mmSearch.mock}
+	}
+	mmSearch.defaultExpectation.results = &MessageRepositoryMockSearchResults{spa1, err}
+	mmSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// Set uses given function f to mock the MessageRepository.Search method
+func (mmSearch *mMessageRepositoryMockSearch) Set(f func(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64) (spa1 []*model.SearchResult, err error)) *MessageRepositoryMock {
+	if mmSearch.defaultExpectation != nil {
+		mmSearch.mock.t.Fatalf("Default expectation is already set for the MessageRepository.Search method")
+	}
+
+	if len(mmSearch.expectations) > 0 {
+		mmSearch.mock.t.Fatalf("Some expectations are already set for the MessageRepository.Search method")
+	}
+
+	mmSearch.mock.funcSearch = f
+	mmSearch.mock.funcSearchOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// When sets expectation for the MessageRepository.Search which will trigger the result defined by the following
+// Then helper
+func (mmSearch *mMessageRepositoryMockSearch) When(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64) *MessageRepositoryMockSearchExpectation {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("MessageRepositoryMock.Search mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockSearchExpectation{
+		mock:               mmSearch.mock,
+		params:             &MessageRepositoryMockSearchParams{ctx, userID, search, after, limit},
+		expectationOrigins: MessageRepositoryMockSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearch.expectations = append(mmSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.Search return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockSearchExpectation) Then(spa1 []*model.SearchResult, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockSearchResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.Search should be invoked
+func (mmSearch *mMessageRepositoryMockSearch) Times(n uint64) *mMessageRepositoryMockSearch {
+	if n == 0 {
+		mmSearch.mock.t.Fatalf("Times of MessageRepositoryMock.Search mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearch.expectedInvocations, n)
+	mmSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearch
+}
+
+func (mmSearch *mMessageRepositoryMockSearch) invocationsDone() bool {
+	if len(mmSearch.expectations) == 0 && mmSearch.defaultExpectation == nil && mmSearch.mock.funcSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearch.mock.afterSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Search implements mm_repository.MessageRepository
+func (mmSearch *MessageRepositoryMock) Search(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64) (spa1 []*model.SearchResult, err error) {
+	mm_atomic.AddUint64(&mmSearch.beforeSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearch.afterSearchCounter, 1)
+
+	mmSearch.t.Helper()
+
+	if mmSearch.inspectFuncSearch != nil {
+		mmSearch.inspectFuncSearch(ctx, userID, search, after, limit)
+	}
+
+	mm_params := MessageRepositoryMockSearchParams{ctx, userID, search, after, limit}
+
+	// Record call args
+	mmSearch.SearchMock.mutex.Lock()
+	mmSearch.SearchMock.callArgs = append(mmSearch.SearchMock.callArgs, &mm_params)
+	mmSearch.SearchMock.mutex.Unlock()
+
+	for _, e := range mmSearch.SearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmSearch.SearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearch.SearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearch.SearchMock.defaultExpectation.params
+		mm_want_ptrs := mmSearch.SearchMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockSearchParams{ctx, userID, search, after, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.search != nil && !minimock.Equal(*mm_want_ptrs.search, mm_got.search) {
+				mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameter search, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originSearch, *mm_want_ptrs.search, mm_got.search, minimock.Diff(*mm_want_ptrs.search, mm_got.search))
+			}
+
+			if mm_want_ptrs.after != nil && !minimock.Equal(*mm_want_ptrs.after, mm_got.after) {
+				mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameter after, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originAfter, *mm_want_ptrs.after, mm_got.after, minimock.Diff(*mm_want_ptrs.after, mm_got.after))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearch.t.Errorf("MessageRepositoryMock.Search got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearch.SearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearch.SearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearch.t.Fatal("No results are set for the MessageRepositoryMock.Search")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmSearch.funcSearch != nil {
+		return mmSearch.funcSearch(ctx, userID, search, after, limit)
+	}
+	mmSearch.t.Fatalf("Unexpected call to MessageRepositoryMock.Search. %v %v %v %v %v", ctx, userID, search, after, limit)
+	return
+}
+
+// SearchAfterCounter returns a count of finished MessageRepositoryMock.Search invocations
+func (mmSearch *MessageRepositoryMock) SearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.afterSearchCounter)
+}
+
+// SearchBeforeCounter returns a count of MessageRepositoryMock.Search invocations
+func (mmSearch *MessageRepositoryMock) SearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.beforeSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.Search.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearch *mMessageRepositoryMockSearch) Calls() []*MessageRepositoryMockSearchParams {
+	mmSearch.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockSearchParams, len(mmSearch.callArgs))
+	copy(argCopy, mmSearch.callArgs)
+
+	mmSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchDone returns true if the count of the Search invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockSearchDone() bool {
+	if m.SearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchMock.invocationsDone()
+}
+
+// MinimockSearchInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockSearchInspect() {
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Search at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchCounter := mm_atomic.LoadUint64(&m.afterSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMock.defaultExpectation != nil && afterSearchCounter < 1 {
+		if m.SearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Search at\n%s", m.SearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.Search at\n%s with params: %#v", m.SearchMock.defaultExpectation.expectationOrigins.origin, *m.SearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearch != nil && afterSearchCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.Search at\n%s", m.funcSearchOrigin)
+	}
+
+	if !m.SearchMock.invocationsDone() && afterSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.Search at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMock.expectedInvocations), m.SearchMock.expectedInvocationsOrigin, afterSearchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MessageRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddReactionInspect()
+
+			m.MinimockAddReplyInspect()
+
+			m.MinimockAttachToMessageInspect()
+
+			m.MinimockCountReactionsInspect()
+
+			m.MinimockCreateInspect()
+
+			m.MinimockCreateAttachmentInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockDeleteChatAttachmentsInspect()
+
+			m.MinimockDeleteMessageAttachmentsInspect()
+
+			m.MinimockDeleteUnsentAttachmentsInspect()
+
+			m.MinimockEditInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockGetAttachmentInspect()
+
+			m.MinimockGetUploadUsageInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockListAttachmentsInspect()
+
+			m.MinimockListReactionsInspect()
+
+			m.MinimockListRepliesInspect()
+
+			m.MinimockLockUploadsInspect()
+
+			m.MinimockRemoveReactionInspect()
+
+			m.MinimockSearchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MessageRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MessageRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddReactionDone() &&
+		m.MinimockAddReplyDone() &&
+		m.MinimockAttachToMessageDone() &&
+		m.MinimockCountReactionsDone() &&
+		m.MinimockCreateDone() &&
+		m.MinimockCreateAttachmentDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockDeleteChatAttachmentsDone() &&
+		m.MinimockDeleteMessageAttachmentsDone() &&
+		m.MinimockDeleteUnsentAttachmentsDone() &&
+		m.MinimockEditDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockGetAttachmentDone() &&
+		m.MinimockGetUploadUsageDone() &&
+		m.MinimockListDone() &&
+		m.MinimockListAttachmentsDone() &&
+		m.MinimockListReactionsDone() &&
+		m.MinimockListRepliesDone() &&
+		m.MinimockLockUploadsDone() &&
+		m.MinimockRemoveReactionDone() &&
+		m.MinimockSearchDone()
+}
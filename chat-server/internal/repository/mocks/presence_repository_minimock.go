@@ -0,0 +1,806 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat-server/internal/repository.PresenceRepository -o presence_repository_minimock.go -n PresenceRepositoryMock -p mocks
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"database/sql"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PresenceRepositoryMock implements mm_repository.PresenceRepository
+type PresenceRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListLastSeen          func(ctx context.Context, userIDs []int64) (m1 map[int64]sql.NullTime, err error)
+	funcListLastSeenOrigin    string
+	inspectFuncListLastSeen   func(ctx context.Context, userIDs []int64)
+	afterListLastSeenCounter  uint64
+	beforeListLastSeenCounter uint64
+	ListLastSeenMock          mPresenceRepositoryMockListLastSeen
+
+	funcSaveLastSeen          func(ctx context.Context, user *model.User, at time.Time) (err error)
+	funcSaveLastSeenOrigin    string
+	inspectFuncSaveLastSeen   func(ctx context.Context, user *model.User, at time.Time)
+	afterSaveLastSeenCounter  uint64
+	beforeSaveLastSeenCounter uint64
+	SaveLastSeenMock          mPresenceRepositoryMockSaveLastSeen
+}
+
+// NewPresenceRepositoryMock returns a mock for mm_repository.PresenceRepository
+func NewPresenceRepositoryMock(t minimock.Tester) *PresenceRepositoryMock {
+	m := &PresenceRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListLastSeenMock = mPresenceRepositoryMockListLastSeen{mock: m}
+	m.ListLastSeenMock.callArgs = []*PresenceRepositoryMockListLastSeenParams{}
+
+	m.SaveLastSeenMock = mPresenceRepositoryMockSaveLastSeen{mock: m}
+	m.SaveLastSeenMock.callArgs = []*PresenceRepositoryMockSaveLastSeenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPresenceRepositoryMockListLastSeen struct {
+	optional           bool
+	mock               *PresenceRepositoryMock
+	defaultExpectation *PresenceRepositoryMockListLastSeenExpectation
+	expectations       []*PresenceRepositoryMockListLastSeenExpectation
+
+	callArgs []*PresenceRepositoryMockListLastSeenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PresenceRepositoryMockListLastSeenExpectation specifies expectation struct of the PresenceRepository.ListLastSeen
+type PresenceRepositoryMockListLastSeenExpectation struct {
+	mock               *PresenceRepositoryMock
+	params             *PresenceRepositoryMockListLastSeenParams
+	paramPtrs          *PresenceRepositoryMockListLastSeenParamPtrs
+	expectationOrigins PresenceRepositoryMockListLastSeenExpectationOrigins
+	results            *PresenceRepositoryMockListLastSeenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PresenceRepositoryMockListLastSeenParams contains parameters of the PresenceRepository.ListLastSeen
+type PresenceRepositoryMockListLastSeenParams struct {
+	ctx     context.Context
+	userIDs []int64
+}
+
+// PresenceRepositoryMockListLastSeenParamPtrs contains pointers to parameters of the PresenceRepository.ListLastSeen
+type PresenceRepositoryMockListLastSeenParamPtrs struct {
+	ctx     *context.Context
+	userIDs *[]int64
+}
+
+// PresenceRepositoryMockListLastSeenResults contains results of the PresenceRepository.ListLastSeen
+type PresenceRepositoryMockListLastSeenResults struct {
+	m1  map[int64]sql.NullTime
+	err error
+}
+
+// PresenceRepositoryMockListLastSeenOrigins contains origins of expectations of the PresenceRepository.ListLastSeen
+type PresenceRepositoryMockListLastSeenExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) Optional() *mPresenceRepositoryMockListLastSeen {
+	mmListLastSeen.optional = true
+	return mmListLastSeen
+}
+
+// Expect sets up expected params for PresenceRepository.ListLastSeen
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) Expect(ctx context.Context, userIDs []int64) *mPresenceRepositoryMockListLastSeen {
+	if mmListLastSeen.mock.funcListLastSeen != nil {
+		mmListLastSeen.mock.t.Fatalf("PresenceRepositoryMock.ListLastSeen mock is already set by Set")
+	}
+
+	if mmListLastSeen.defaultExpectation == nil {
+		mmListLastSeen.defaultExpectation = &PresenceRepositoryMockListLastSeenExpectation{}
+	}
+
+	if mmListLastSeen.defaultExpectation.paramPtrs != nil {
+		mmListLastSeen.mock.t.Fatalf("PresenceRepositoryMock.ListLastSeen mock is already set by ExpectParams functions")
+	}
+
+	mmListLastSeen.defaultExpectation.params = &PresenceRepositoryMockListLastSeenParams{ctx, userIDs}
+	mmListLastSeen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListLastSeen.expectations {
+		if minimock.Equal(e.params, mmListLastSeen.defaultExpectation.params) {
+			mmListLastSeen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListLastSeen.defaultExpectation.params)
+		}
+	}
+
+	return mmListLastSeen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceRepository.ListLastSeen
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) ExpectCtxParam1(ctx context.Context) *mPresenceRepositoryMockListLastSeen {
+	if mmListLastSeen.mock.funcListLastSeen != nil {
+		mmListLastSeen.mock.t.Fatalf("PresenceRepositoryMock.ListLastSeen mock is already set by Set")
+	}
+
+	if mmListLastSeen.defaultExpectation == nil {
+		mmListLastSeen.defaultExpectation = &PresenceRepositoryMockListLastSeenExpectation{}
+	}
+
+	if mmListLastSeen.defaultExpectation.params != nil {
+		mmListLastSeen.mock.t.Fatalf("PresenceRepositoryMock.ListLastSeen mock is already set by Expect")
+	}
+
+	if mmListLastSeen.defaultExpectation.paramPtrs == nil {
+		mmListLastSeen.defaultExpectation.paramPtrs = &PresenceRepositoryMockListLastSeenParamPtrs{}
+	}
+	mmListLastSeen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListLastSeen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListLastSeen
+}
+
+// ExpectUserIDsParam2 sets up expected param userIDs for PresenceRepository.ListLastSeen
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) ExpectUserIDsParam2(userIDs []int64) *mPresenceRepositoryMockListLastSeen {
+	if mmListLastSeen.mock.funcListLastSeen != nil {
+		mmListLastSeen.mock.t.Fatalf("PresenceRepositoryMock.ListLastSeen mock is already set by Set")
+	}
+
+	if mmListLastSeen.defaultExpectation == nil {
+		mmListLastSeen.defaultExpectation = &PresenceRepositoryMockListLastSeenExpectation{}
+	}
+
+	if mmListLastSeen.defaultExpectation.params != nil {
+		mmListLastSeen.mock.t.Fatalf("PresenceRepositoryMock.ListLastSeen mock is already set by Expect")
+	}
+
+	if mmListLastSeen.defaultExpectation.paramPtrs == nil {
+		mmListLastSeen.defaultExpectation.paramPtrs = &PresenceRepositoryMockListLastSeenParamPtrs{}
+	}
+	mmListLastSeen.defaultExpectation.paramPtrs.userIDs = &userIDs
+	mmListLastSeen.defaultExpectation.expectationOrigins.originUserIDs = minimock.CallerInfo(1)
+
+	return mmListLastSeen
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceRepository.ListLastSeen
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) Inspect(f func(ctx context.Context, userIDs []int64)) *mPresenceRepositoryMockListLastSeen {
+	if mmListLastSeen.mock.inspectFuncListLastSeen != nil {
+		mmListLastSeen.mock.t.Fatalf("Inspect function is already set for PresenceRepositoryMock.ListLastSeen")
+	}
+
+	mmListLastSeen.mock.inspectFuncListLastSeen = f
+
+	return mmListLastSeen
+}
+
+// Return sets up results that will be returned by PresenceRepository.ListLastSeen
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) Return(m1 map[int64]sql.NullTime, err error) *PresenceRepositoryMock {
+	if mmListLastSeen.mock.funcListLastSeen != nil {
+		mmListLastSeen.mock.t.Fatalf("PresenceRepositoryMock.ListLastSeen mock is already set by Set")
+	}
+
+	if mmListLastSeen.defaultExpectation == nil {
+		mmListLastSeen.defaultExpectation = &PresenceRepositoryMockListLastSeenExpectation{mock: mmListLastSeen.mock}
+	}
+	mmListLastSeen.defaultExpectation.results = &PresenceRepositoryMockListLastSeenResults{m1, err}
+	mmListLastSeen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListLastSeen.mock
+}
+
+// Set uses given function f to mock the PresenceRepository.ListLastSeen method
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) Set(f func(ctx context.Context, userIDs []int64) (m1 map[int64]sql.NullTime, err error)) *PresenceRepositoryMock {
+	if mmListLastSeen.defaultExpectation != nil {
+		mmListLastSeen.mock.t.Fatalf("Default expectation is already set for the PresenceRepository.ListLastSeen method")
+	}
+
+	if len(mmListLastSeen.expectations) > 0 {
+		mmListLastSeen.mock.t.Fatalf("Some expectations are already set for the PresenceRepository.ListLastSeen method")
+	}
+
+	mmListLastSeen.mock.funcListLastSeen = f
+	mmListLastSeen.mock.funcListLastSeenOrigin = minimock.CallerInfo(1)
+	return mmListLastSeen.mock
+}
+
+// When sets expectation for the PresenceRepository.ListLastSeen which will trigger the result defined by the following
+// Then helper
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) When(ctx context.Context, userIDs []int64) *PresenceRepositoryMockListLastSeenExpectation {
+	if mmListLastSeen.mock.funcListLastSeen != nil {
+		mmListLastSeen.mock.t.Fatalf("PresenceRepositoryMock.ListLastSeen mock is already set by Set")
+	}
+
+	expectation := &PresenceRepositoryMockListLastSeenExpectation{
+		mock:               mmListLastSeen.mock,
+		params:             &PresenceRepositoryMockListLastSeenParams{ctx, userIDs},
+		expectationOrigins: PresenceRepositoryMockListLastSeenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListLastSeen.expectations = append(mmListLastSeen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceRepository.ListLastSeen return parameters for the expectation previously defined by the When method
+func (e *PresenceRepositoryMockListLastSeenExpectation) Then(m1 map[int64]sql.NullTime, err error) *PresenceRepositoryMock {
+	e.results = &PresenceRepositoryMockListLastSeenResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times PresenceRepository.ListLastSeen should be invoked
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) Times(n uint64) *mPresenceRepositoryMockListLastSeen {
+	if n == 0 {
+		mmListLastSeen.mock.t.Fatalf("Times of PresenceRepositoryMock.ListLastSeen mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListLastSeen.expectedInvocations, n)
+	mmListLastSeen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListLastSeen
+}
+
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) invocationsDone() bool {
+	if len(mmListLastSeen.expectations) == 0 && mmListLastSeen.defaultExpectation == nil && mmListLastSeen.mock.funcListLastSeen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListLastSeen.mock.afterListLastSeenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListLastSeen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListLastSeen implements mm_repository.PresenceRepository
+func (mmListLastSeen *PresenceRepositoryMock) ListLastSeen(ctx context.Context, userIDs []int64) (m1 map[int64]sql.NullTime, err error) {
+	mm_atomic.AddUint64(&mmListLastSeen.beforeListLastSeenCounter, 1)
+	defer mm_atomic.AddUint64(&mmListLastSeen.afterListLastSeenCounter, 1)
+
+	mmListLastSeen.t.Helper()
+
+	if mmListLastSeen.inspectFuncListLastSeen != nil {
+		mmListLastSeen.inspectFuncListLastSeen(ctx, userIDs)
+	}
+
+	mm_params := PresenceRepositoryMockListLastSeenParams{ctx, userIDs}
+
+	// Record call args
+	mmListLastSeen.ListLastSeenMock.mutex.Lock()
+	mmListLastSeen.ListLastSeenMock.callArgs = append(mmListLastSeen.ListLastSeenMock.callArgs, &mm_params)
+	mmListLastSeen.ListLastSeenMock.mutex.Unlock()
+
+	for _, e := range mmListLastSeen.ListLastSeenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmListLastSeen.ListLastSeenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListLastSeen.ListLastSeenMock.defaultExpectation.Counter, 1)
+		mm_want := mmListLastSeen.ListLastSeenMock.defaultExpectation.params
+		mm_want_ptrs := mmListLastSeen.ListLastSeenMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceRepositoryMockListLastSeenParams{ctx, userIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListLastSeen.t.Errorf("PresenceRepositoryMock.ListLastSeen got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLastSeen.ListLastSeenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userIDs != nil && !minimock.Equal(*mm_want_ptrs.userIDs, mm_got.userIDs) {
+				mmListLastSeen.t.Errorf("PresenceRepositoryMock.ListLastSeen got unexpected parameter userIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLastSeen.ListLastSeenMock.defaultExpectation.expectationOrigins.originUserIDs, *mm_want_ptrs.userIDs, mm_got.userIDs, minimock.Diff(*mm_want_ptrs.userIDs, mm_got.userIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListLastSeen.t.Errorf("PresenceRepositoryMock.ListLastSeen got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListLastSeen.ListLastSeenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListLastSeen.ListLastSeenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListLastSeen.t.Fatal("No results are set for the PresenceRepositoryMock.ListLastSeen")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmListLastSeen.funcListLastSeen != nil {
+		return mmListLastSeen.funcListLastSeen(ctx, userIDs)
+	}
+	mmListLastSeen.t.Fatalf("Unexpected call to PresenceRepositoryMock.ListLastSeen. %v %v", ctx, userIDs)
+	return
+}
+
+// ListLastSeenAfterCounter returns a count of finished PresenceRepositoryMock.ListLastSeen invocations
+func (mmListLastSeen *PresenceRepositoryMock) ListLastSeenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLastSeen.afterListLastSeenCounter)
+}
+
+// ListLastSeenBeforeCounter returns a count of PresenceRepositoryMock.ListLastSeen invocations
+func (mmListLastSeen *PresenceRepositoryMock) ListLastSeenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLastSeen.beforeListLastSeenCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceRepositoryMock.ListLastSeen.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListLastSeen *mPresenceRepositoryMockListLastSeen) Calls() []*PresenceRepositoryMockListLastSeenParams {
+	mmListLastSeen.mutex.RLock()
+
+	argCopy := make([]*PresenceRepositoryMockListLastSeenParams, len(mmListLastSeen.callArgs))
+	copy(argCopy, mmListLastSeen.callArgs)
+
+	mmListLastSeen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListLastSeenDone returns true if the count of the ListLastSeen invocations corresponds
+// the number of defined expectations
+func (m *PresenceRepositoryMock) MinimockListLastSeenDone() bool {
+	if m.ListLastSeenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListLastSeenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListLastSeenMock.invocationsDone()
+}
+
+// MinimockListLastSeenInspect logs each unmet expectation
+func (m *PresenceRepositoryMock) MinimockListLastSeenInspect() {
+	for _, e := range m.ListLastSeenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.ListLastSeen at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListLastSeenCounter := mm_atomic.LoadUint64(&m.afterListLastSeenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListLastSeenMock.defaultExpectation != nil && afterListLastSeenCounter < 1 {
+		if m.ListLastSeenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.ListLastSeen at\n%s", m.ListLastSeenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.ListLastSeen at\n%s with params: %#v", m.ListLastSeenMock.defaultExpectation.expectationOrigins.origin, *m.ListLastSeenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListLastSeen != nil && afterListLastSeenCounter < 1 {
+		m.t.Errorf("Expected call to PresenceRepositoryMock.ListLastSeen at\n%s", m.funcListLastSeenOrigin)
+	}
+
+	if !m.ListLastSeenMock.invocationsDone() && afterListLastSeenCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceRepositoryMock.ListLastSeen at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListLastSeenMock.expectedInvocations), m.ListLastSeenMock.expectedInvocationsOrigin, afterListLastSeenCounter)
+	}
+}
+
+type mPresenceRepositoryMockSaveLastSeen struct {
+	optional           bool
+	mock               *PresenceRepositoryMock
+	defaultExpectation *PresenceRepositoryMockSaveLastSeenExpectation
+	expectations       []*PresenceRepositoryMockSaveLastSeenExpectation
+
+	callArgs []*PresenceRepositoryMockSaveLastSeenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PresenceRepositoryMockSaveLastSeenExpectation specifies expectation struct of the PresenceRepository.SaveLastSeen
+type PresenceRepositoryMockSaveLastSeenExpectation struct {
+	mock               *PresenceRepositoryMock
+	params             *PresenceRepositoryMockSaveLastSeenParams
+	paramPtrs          *PresenceRepositoryMockSaveLastSeenParamPtrs
+	expectationOrigins PresenceRepositoryMockSaveLastSeenExpectationOrigins
+	results            *PresenceRepositoryMockSaveLastSeenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PresenceRepositoryMockSaveLastSeenParams contains parameters of the PresenceRepository.SaveLastSeen
+type PresenceRepositoryMockSaveLastSeenParams struct {
+	ctx  context.Context
+	user *model.User
+	at   time.Time
+}
+
+// PresenceRepositoryMockSaveLastSeenParamPtrs contains pointers to parameters of the PresenceRepository.SaveLastSeen
+type PresenceRepositoryMockSaveLastSeenParamPtrs struct {
+	ctx  *context.Context
+	user **model.User
+	at   *time.Time
+}
+
+// PresenceRepositoryMockSaveLastSeenResults contains results of the PresenceRepository.SaveLastSeen
+type PresenceRepositoryMockSaveLastSeenResults struct {
+	err error
+}
+
+// PresenceRepositoryMockSaveLastSeenOrigins contains origins of expectations of the PresenceRepository.SaveLastSeen
+type PresenceRepositoryMockSaveLastSeenExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+	originAt   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) Optional() *mPresenceRepositoryMockSaveLastSeen {
+	mmSaveLastSeen.optional = true
+	return mmSaveLastSeen
+}
+
+// Expect sets up expected params for PresenceRepository.SaveLastSeen
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) Expect(ctx context.Context, user *model.User, at time.Time) *mPresenceRepositoryMockSaveLastSeen {
+	if mmSaveLastSeen.mock.funcSaveLastSeen != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Set")
+	}
+
+	if mmSaveLastSeen.defaultExpectation == nil {
+		mmSaveLastSeen.defaultExpectation = &PresenceRepositoryMockSaveLastSeenExpectation{}
+	}
+
+	if mmSaveLastSeen.defaultExpectation.paramPtrs != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by ExpectParams functions")
+	}
+
+	mmSaveLastSeen.defaultExpectation.params = &PresenceRepositoryMockSaveLastSeenParams{ctx, user, at}
+	mmSaveLastSeen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveLastSeen.expectations {
+		if minimock.Equal(e.params, mmSaveLastSeen.defaultExpectation.params) {
+			mmSaveLastSeen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveLastSeen.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveLastSeen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceRepository.SaveLastSeen
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) ExpectCtxParam1(ctx context.Context) *mPresenceRepositoryMockSaveLastSeen {
+	if mmSaveLastSeen.mock.funcSaveLastSeen != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Set")
+	}
+
+	if mmSaveLastSeen.defaultExpectation == nil {
+		mmSaveLastSeen.defaultExpectation = &PresenceRepositoryMockSaveLastSeenExpectation{}
+	}
+
+	if mmSaveLastSeen.defaultExpectation.params != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Expect")
+	}
+
+	if mmSaveLastSeen.defaultExpectation.paramPtrs == nil {
+		mmSaveLastSeen.defaultExpectation.paramPtrs = &PresenceRepositoryMockSaveLastSeenParamPtrs{}
+	}
+	mmSaveLastSeen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveLastSeen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveLastSeen
+}
+
+// ExpectUserParam2 sets up expected param user for PresenceRepository.SaveLastSeen
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) ExpectUserParam2(user *model.User) *mPresenceRepositoryMockSaveLastSeen {
+	if mmSaveLastSeen.mock.funcSaveLastSeen != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Set")
+	}
+
+	if mmSaveLastSeen.defaultExpectation == nil {
+		mmSaveLastSeen.defaultExpectation = &PresenceRepositoryMockSaveLastSeenExpectation{}
+	}
+
+	if mmSaveLastSeen.defaultExpectation.params != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Expect")
+	}
+
+	if mmSaveLastSeen.defaultExpectation.paramPtrs == nil {
+		mmSaveLastSeen.defaultExpectation.paramPtrs = &PresenceRepositoryMockSaveLastSeenParamPtrs{}
+	}
+	mmSaveLastSeen.defaultExpectation.paramPtrs.user = &user
+	mmSaveLastSeen.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmSaveLastSeen
+}
+
+// ExpectAtParam3 sets up expected param at for PresenceRepository.SaveLastSeen
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) ExpectAtParam3(at time.Time) *mPresenceRepositoryMockSaveLastSeen {
+	if mmSaveLastSeen.mock.funcSaveLastSeen != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Set")
+	}
+
+	if mmSaveLastSeen.defaultExpectation == nil {
+		mmSaveLastSeen.defaultExpectation = &PresenceRepositoryMockSaveLastSeenExpectation{}
+	}
+
+	if mmSaveLastSeen.defaultExpectation.params != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Expect")
+	}
+
+	if mmSaveLastSeen.defaultExpectation.paramPtrs == nil {
+		mmSaveLastSeen.defaultExpectation.paramPtrs = &PresenceRepositoryMockSaveLastSeenParamPtrs{}
+	}
+	mmSaveLastSeen.defaultExpectation.paramPtrs.at = &at
+	mmSaveLastSeen.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmSaveLastSeen
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceRepository.SaveLastSeen
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) Inspect(f func(ctx context.Context, user *model.User, at time.Time)) *mPresenceRepositoryMockSaveLastSeen {
+	if mmSaveLastSeen.mock.inspectFuncSaveLastSeen != nil {
+		mmSaveLastSeen.mock.t.Fatalf("Inspect function is already set for PresenceRepositoryMock.SaveLastSeen")
+	}
+
+	mmSaveLastSeen.mock.inspectFuncSaveLastSeen = f
+
+	return mmSaveLastSeen
+}
+
+// Return sets up results that will be returned by PresenceRepository.SaveLastSeen
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) Return(err error) *PresenceRepositoryMock {
+	if mmSaveLastSeen.mock.funcSaveLastSeen != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Set")
+	}
+
+	if mmSaveLastSeen.defaultExpectation == nil {
+		mmSaveLastSeen.defaultExpectation = &PresenceRepositoryMockSaveLastSeenExpectation{mock: mmSaveLastSeen.mock}
+	}
+	mmSaveLastSeen.defaultExpectation.results = &PresenceRepositoryMockSaveLastSeenResults{err}
+	mmSaveLastSeen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveLastSeen.mock
+}
+
+// Set uses given function f to mock the PresenceRepository.SaveLastSeen method
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) Set(f func(ctx context.Context, user *model.User, at time.Time) (err error)) *PresenceRepositoryMock {
+	if mmSaveLastSeen.defaultExpectation != nil {
+		mmSaveLastSeen.mock.t.Fatalf("Default expectation is already set for the PresenceRepository.SaveLastSeen method")
+	}
+
+	if len(mmSaveLastSeen.expectations) > 0 {
+		mmSaveLastSeen.mock.t.Fatalf("Some expectations are already set for the PresenceRepository.SaveLastSeen method")
+	}
+
+	mmSaveLastSeen.mock.funcSaveLastSeen = f
+	mmSaveLastSeen.mock.funcSaveLastSeenOrigin = minimock.CallerInfo(1)
+	return mmSaveLastSeen.mock
+}
+
+// When sets expectation for the PresenceRepository.SaveLastSeen which will trigger the result defined by the following
+// Then helper
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) When(ctx context.Context, user *model.User, at time.Time) *PresenceRepositoryMockSaveLastSeenExpectation {
+	if mmSaveLastSeen.mock.funcSaveLastSeen != nil {
+		mmSaveLastSeen.mock.t.Fatalf("PresenceRepositoryMock.SaveLastSeen mock is already set by Set")
+	}
+
+	expectation := &PresenceRepositoryMockSaveLastSeenExpectation{
+		mock:               mmSaveLastSeen.mock,
+		params:             &PresenceRepositoryMockSaveLastSeenParams{ctx, user, at},
+		expectationOrigins: PresenceRepositoryMockSaveLastSeenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveLastSeen.expectations = append(mmSaveLastSeen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceRepository.SaveLastSeen return parameters for the expectation previously defined by the When method
+func (e *PresenceRepositoryMockSaveLastSeenExpectation) Then(err error) *PresenceRepositoryMock {
+	e.results = &PresenceRepositoryMockSaveLastSeenResults{err}
+	return e.mock
+}
+
+// Times sets number of times PresenceRepository.SaveLastSeen should be invoked
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) Times(n uint64) *mPresenceRepositoryMockSaveLastSeen {
+	if n == 0 {
+		mmSaveLastSeen.mock.t.Fatalf("Times of PresenceRepositoryMock.SaveLastSeen mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveLastSeen.expectedInvocations, n)
+	mmSaveLastSeen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveLastSeen
+}
+
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) invocationsDone() bool {
+	if len(mmSaveLastSeen.expectations) == 0 && mmSaveLastSeen.defaultExpectation == nil && mmSaveLastSeen.mock.funcSaveLastSeen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveLastSeen.mock.afterSaveLastSeenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveLastSeen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveLastSeen implements mm_repository.PresenceRepository
+func (mmSaveLastSeen *PresenceRepositoryMock) SaveLastSeen(ctx context.Context, user *model.User, at time.Time) (err error) {
+	mm_atomic.AddUint64(&mmSaveLastSeen.beforeSaveLastSeenCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveLastSeen.afterSaveLastSeenCounter, 1)
+
+	mmSaveLastSeen.t.Helper()
+
+	if mmSaveLastSeen.inspectFuncSaveLastSeen != nil {
+		mmSaveLastSeen.inspectFuncSaveLastSeen(ctx, user, at)
+	}
+
+	mm_params := PresenceRepositoryMockSaveLastSeenParams{ctx, user, at}
+
+	// Record call args
+	mmSaveLastSeen.SaveLastSeenMock.mutex.Lock()
+	mmSaveLastSeen.SaveLastSeenMock.callArgs = append(mmSaveLastSeen.SaveLastSeenMock.callArgs, &mm_params)
+	mmSaveLastSeen.SaveLastSeenMock.mutex.Unlock()
+
+	for _, e := range mmSaveLastSeen.SaveLastSeenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveLastSeen.SaveLastSeenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveLastSeen.SaveLastSeenMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveLastSeen.SaveLastSeenMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveLastSeen.SaveLastSeenMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceRepositoryMockSaveLastSeenParams{ctx, user, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveLastSeen.t.Errorf("PresenceRepositoryMock.SaveLastSeen got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveLastSeen.SaveLastSeenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmSaveLastSeen.t.Errorf("PresenceRepositoryMock.SaveLastSeen got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveLastSeen.SaveLastSeenMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmSaveLastSeen.t.Errorf("PresenceRepositoryMock.SaveLastSeen got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveLastSeen.SaveLastSeenMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveLastSeen.t.Errorf("PresenceRepositoryMock.SaveLastSeen got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveLastSeen.SaveLastSeenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveLastSeen.SaveLastSeenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveLastSeen.t.Fatal("No results are set for the PresenceRepositoryMock.SaveLastSeen")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveLastSeen.funcSaveLastSeen != nil {
+		return mmSaveLastSeen.funcSaveLastSeen(ctx, user, at)
+	}
+	mmSaveLastSeen.t.Fatalf("Unexpected call to PresenceRepositoryMock.SaveLastSeen. %v %v %v", ctx, user, at)
+	return
+}
+
+// SaveLastSeenAfterCounter returns a count of finished PresenceRepositoryMock.SaveLastSeen invocations
+func (mmSaveLastSeen *PresenceRepositoryMock) SaveLastSeenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveLastSeen.afterSaveLastSeenCounter)
+}
+
+// SaveLastSeenBeforeCounter returns a count of PresenceRepositoryMock.SaveLastSeen invocations
+func (mmSaveLastSeen *PresenceRepositoryMock) SaveLastSeenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveLastSeen.beforeSaveLastSeenCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceRepositoryMock.SaveLastSeen.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveLastSeen *mPresenceRepositoryMockSaveLastSeen) Calls() []*PresenceRepositoryMockSaveLastSeenParams {
+	mmSaveLastSeen.mutex.RLock()
+
+	argCopy := make([]*PresenceRepositoryMockSaveLastSeenParams, len(mmSaveLastSeen.callArgs))
+	copy(argCopy, mmSaveLastSeen.callArgs)
+
+	mmSaveLastSeen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveLastSeenDone returns true if the count of the SaveLastSeen invocations corresponds
+// the number of defined expectations
+func (m *PresenceRepositoryMock) MinimockSaveLastSeenDone() bool {
+	if m.SaveLastSeenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveLastSeenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveLastSeenMock.invocationsDone()
+}
+
+// MinimockSaveLastSeenInspect logs each unmet expectation
+func (m *PresenceRepositoryMock) MinimockSaveLastSeenInspect() {
+	for _, e := range m.SaveLastSeenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.SaveLastSeen at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveLastSeenCounter := mm_atomic.LoadUint64(&m.afterSaveLastSeenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveLastSeenMock.defaultExpectation != nil && afterSaveLastSeenCounter < 1 {
+		if m.SaveLastSeenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.SaveLastSeen at\n%s", m.SaveLastSeenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.SaveLastSeen at\n%s with params: %#v", m.SaveLastSeenMock.defaultExpectation.expectationOrigins.origin, *m.SaveLastSeenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveLastSeen != nil && afterSaveLastSeenCounter < 1 {
+		m.t.Errorf("Expected call to PresenceRepositoryMock.SaveLastSeen at\n%s", m.funcSaveLastSeenOrigin)
+	}
+
+	if !m.SaveLastSeenMock.invocationsDone() && afterSaveLastSeenCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceRepositoryMock.SaveLastSeen at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveLastSeenMock.expectedInvocations), m.SaveLastSeenMock.expectedInvocationsOrigin, afterSaveLastSeenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PresenceRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListLastSeenInspect()
+
+			m.MinimockSaveLastSeenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PresenceRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PresenceRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListLastSeenDone() &&
+		m.MinimockSaveLastSeenDone()
+}
@@ -0,0 +1,15 @@
+package converter
+
+import (
+	modelRepo "chat-server/internal/repository/presence/model"
+	"database/sql"
+)
+
+func ToLastSeenFromRepo(presence []*modelRepo.Presence) map[int64]sql.NullTime {
+	res := make(map[int64]sql.NullTime, len(presence))
+	for _, p := range presence {
+		res[p.UserID] = sql.NullTime{Time: p.LastSeenAt, Valid: true}
+	}
+
+	return res
+}
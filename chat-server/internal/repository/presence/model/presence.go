@@ -0,0 +1,8 @@
+package model
+
+import "time"
+
+type Presence struct {
+	UserID     int64     `db:"user_id"`
+	LastSeenAt time.Time `db:"last_seen_at"`
+}
@@ -0,0 +1,78 @@
+package presence
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	repoConverter "chat-server/internal/repository/presence/converter"
+	modelRepo "chat-server/internal/repository/presence/model"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/makxtr/go-common/pkg/db"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	tableName = "user_presence"
+
+	userIDColumn     = "user_id"
+	lastSeenAtColumn = "last_seen_at"
+)
+
+type repo struct {
+	db db.Client
+}
+
+func NewRepository(db db.Client) repository.PresenceRepository {
+	return &repo{db: db}
+}
+
+func (r *repo) SaveLastSeen(ctx context.Context, user *model.User, at time.Time) error {
+	builder := sq.Insert(tableName).
+		PlaceholderFormat(sq.Dollar).
+		Columns(userIDColumn, lastSeenAtColumn).
+		Values(user.ID, at).
+		Suffix("ON CONFLICT (" + userIDColumn + ") DO UPDATE SET " +
+			lastSeenAtColumn + " = GREATEST(" + tableName + "." + lastSeenAtColumn + ", EXCLUDED." + lastSeenAtColumn + ")")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return err
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, db.Query{Name: "presence_repository.SaveLastSeen", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to save last seen: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListLastSeen returns the last time each of the users was seen, users that
+// were never seen are left out.
+func (r *repo) ListLastSeen(ctx context.Context, userIDs []int64) (map[int64]sql.NullTime, error) {
+	builder := sq.Select(userIDColumn, lastSeenAtColumn).
+		PlaceholderFormat(sq.Dollar).
+		From(tableName).
+		Where(sq.Expr(userIDColumn+" = ANY(?)", userIDs))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		log.Printf("failed to build query: %v", err)
+		return nil, err
+	}
+
+	var presence []*modelRepo.Presence
+	err = r.db.DB().ScanAllContext(ctx, &presence, db.Query{Name: "presence_repository.ListLastSeen", QueryRaw: query}, args...)
+	if err != nil {
+		log.Printf("failed to list last seen: %v", err)
+		return nil, err
+	}
+
+	return repoConverter.ToLastSeenFromRepo(presence), nil
+}
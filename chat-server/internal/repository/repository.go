@@ -3,13 +3,56 @@ package repository
 import (
 	"chat-server/internal/model"
 	"context"
+	"database/sql"
+	"time"
 
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
 )
 
 type ChatRepository interface {
 	Create(ctx context.Context, chat *model.Chat) (int64, error)
+	Get(ctx context.Context, id int64) (*model.Chat, error)
+	GetDirect(ctx context.Context, userID, otherUserID int64) (int64, error)
+	Update(ctx context.Context, id int64, data *model.UpdateChatData) error
 	Delete(ctx context.Context, id int64) error
+	AddMembers(ctx context.Context, chatID int64, members []*model.ChatMember) error
+	LockMembers(ctx context.Context, chatID int64) ([]*model.ChatMember, error)
+	RemoveMember(ctx context.Context, chatID, userID int64) error
+	UpdateMemberRole(ctx context.Context, chatID, userID int64, role model.ChatRole) error
+	MarkRead(ctx context.Context, chatID, userID, messageID int64) (bool, error)
+	ListByUser(ctx context.Context, userID int64, before *model.Chat, limit uint64) ([]*model.ChatSummary, error)
+	UpdateLastMessage(ctx context.Context, chatID, messageID int64) error
+	ListLegacyUsernames(ctx context.Context) ([]string, error)
+	ResolveLegacyMember(ctx context.Context, user *model.User) (int64, error)
+}
+
+type MessageRepository interface {
+	Create(ctx context.Context, message *model.Message) (*model.Message, error)
+	Get(ctx context.Context, id int64) (*model.Message, error)
+	List(ctx context.Context, chatID, beforeID int64, limit uint64) ([]*model.Message, error)
+	ListReplies(ctx context.Context, rootID, afterID int64, limit uint64) ([]*model.Message, error)
+	AddReply(ctx context.Context, rootID int64) (*model.Message, error)
+	Edit(ctx context.Context, id int64, text, editedBy string) (*model.Message, error)
+	Delete(ctx context.Context, id int64, deletedBy string) (*model.Message, error)
+	AddReaction(ctx context.Context, messageID, userID int64, emoji string) (bool, error)
+	RemoveReaction(ctx context.Context, messageID, userID int64, emoji string) (bool, error)
+	CountReactions(ctx context.Context, messageID int64, emoji string) (int64, error)
+	ListReactions(ctx context.Context, messageIDs []int64, userID int64) (map[int64][]*model.Reaction, error)
+	CreateAttachment(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error)
+	GetAttachment(ctx context.Context, id int64) (*model.Attachment, error)
+	AttachToMessage(ctx context.Context, message *model.Message, uploaderID int64, ids []int64, maxAge time.Duration) ([]*model.Attachment, error)
+	ListAttachments(ctx context.Context, messageIDs []int64) (map[int64][]*model.Attachment, error)
+	LockUploads(ctx context.Context, uploaderID int64) error
+	GetUploadUsage(ctx context.Context, uploaderID int64, maxAge time.Duration) (*model.UploadUsage, error)
+	DeleteUnsentAttachments(ctx context.Context, maxAge time.Duration) ([]string, error)
+	DeleteChatAttachments(ctx context.Context, chatID int64) ([]string, error)
+	DeleteMessageAttachments(ctx context.Context, messageID int64) ([]string, error)
+	Search(ctx context.Context, userID int64, search *model.MessageSearch, after *model.SearchResult, limit uint64) ([]*model.SearchResult, error)
+}
+
+type PresenceRepository interface {
+	SaveLastSeen(ctx context.Context, user *model.User, at time.Time) error
+	ListLastSeen(ctx context.Context, userIDs []int64) (map[int64]sql.NullTime, error)
 }
 
 type LogRepository interface {
@@ -0,0 +1,365 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"chat-server/internal/storage"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxAttachmentSize     = 20 << 20
+	maxFileNameLength     = 255
+	maxMessageAttachments = 10
+
+	// Attachments that aren't sent within unsentAttachmentTTL expire, until
+	// then they count towards the uploader's quota.
+	unsentAttachmentTTL     = 24 * time.Hour
+	maxUnsentAttachments    = 50
+	maxUnsentAttachmentSize = maxMessageAttachments * maxAttachmentSize
+
+	// sniffLength is how much of the content http.DetectContentType looks at.
+	sniffLength = 512
+)
+
+var (
+	errAttachmentTooLarge = status.Error(codes.InvalidArgument, "attachment is larger than 20 MiB")
+	errUploadQuota        = status.Error(codes.ResourceExhausted, "too many unsent attachments, send them or wait for them to expire")
+)
+
+// UploadAttachment stores the content read with recv, until it returns
+// io.EOF, in the blob store and records the attachment in the chat. The
+// upload must fit into the user's quota of unsent attachments.
+func (s *serv) UploadAttachment(ctx context.Context, info *model.Attachment, recv func() ([]byte, error)) (*model.Attachment, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateFileName(info.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.MimeType != "" {
+		mediaType, params, err := mime.ParseMediaType(info.MimeType)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid mime type")
+		}
+		info.MimeType = mime.FormatMediaType(mediaType, params)
+	}
+
+	chat, err := s.chatRepository.Get(ctx, info.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = chatMember(chat, user)
+	if err != nil {
+		return nil, err
+	}
+
+	s.pruneUnsentAttachments(ctx)
+
+	// A full quota is turned down before the content is read, the size of
+	// the upload is checked once it is stored.
+	usage, err := s.messageRepository.GetUploadUsage(ctx, user.ID, unsentAttachmentTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkUploadQuota(usage, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := newBlobKey()
+	if err != nil {
+		return nil, err
+	}
+
+	content := &uploadReader{recv: recv, hash: sha256.New()}
+	err = s.blobStore.Put(ctx, key, content)
+	if content.err != nil && !errors.Is(content.err, io.EOF) {
+		return nil, content.err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if content.size == 0 {
+		s.deleteBlob(ctx, key)
+		return nil, status.Error(codes.InvalidArgument, "attachment is empty")
+	}
+
+	attachment := &model.Attachment{
+		ChatID:     info.ChatID,
+		UploaderID: user.ID,
+		UploadedBy: user.Username,
+		FileName:   info.FileName,
+		MimeType:   info.MimeType,
+		Size:       content.size,
+		SHA256:     hex.EncodeToString(content.hash.Sum(nil)),
+		BlobKey:    key,
+	}
+	if attachment.MimeType == "" {
+		attachment.MimeType = http.DetectContentType(content.head)
+	}
+
+	var (
+		created  *model.Attachment
+		quotaErr error
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.messageRepository.LockUploads(ctx, user.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		usage, errTx := s.messageRepository.GetUploadUsage(ctx, user.ID, unsentAttachmentTTL)
+		if errTx != nil {
+			return errTx
+		}
+
+		quotaErr = checkUploadQuota(usage, attachment.Size)
+		if quotaErr != nil {
+			return nil
+		}
+
+		created, errTx = s.messageRepository.CreateAttachment(ctx, attachment)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "attachment_uploaded",
+			EntityID: created.ID,
+		})
+	})
+	if err == nil {
+		err = quotaErr
+	}
+	if err != nil {
+		s.deleteBlob(ctx, key)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// DownloadAttachment opens the content of the attachment, the caller closes it.
+func (s *serv) DownloadAttachment(ctx context.Context, id int64) (*model.Attachment, io.ReadCloser, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.messageRepository.GetAttachment(ctx, id)
+	if err != nil {
+		return nil, nil, attachmentError(err)
+	}
+
+	chat, err := s.chatRepository.Get(ctx, attachment.ChatID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = chatMember(chat, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The attachments of a deleted message go with it.
+	if attachment.MessageID != 0 {
+		message, err := s.messageRepository.Get(ctx, attachment.MessageID)
+		if err != nil {
+			return nil, nil, messageError(err)
+		}
+		if message.DeletedAt.Valid {
+			return nil, nil, attachmentError(repository.ErrNotFound)
+		}
+	}
+
+	content, err := s.blobStore.Open(ctx, attachment.BlobKey)
+	if errors.Is(err, storage.ErrBlobNotFound) {
+		log.Printf("blob %s of attachment %d is missing", attachment.BlobKey, attachment.ID)
+		return nil, nil, attachmentError(repository.ErrNotFound)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+// sendAttachments links the attachments to the new message, they must have
+// been uploaded to the chat by the sender and not sent or expired yet.
+func (s *serv) sendAttachments(ctx context.Context, message *model.Message, user *model.User, ids []int64) error {
+	attached, err := s.messageRepository.AttachToMessage(ctx, message, user.ID, ids, unsentAttachmentTTL)
+	if err != nil {
+		return err
+	}
+
+	if len(attached) != len(ids) {
+		return status.Error(codes.InvalidArgument, "attachment not found, already sent or expired")
+	}
+
+	message.Attachments = attached
+
+	return nil
+}
+
+// attachFiles fills in the attachments of the messages, deleted messages have
+// none.
+func (s *serv) attachFiles(ctx context.Context, messages ...*model.Message) error {
+	ids := make([]int64, 0, len(messages))
+	for _, message := range messages {
+		if !message.DeletedAt.Valid {
+			ids = append(ids, message.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	attachments, err := s.messageRepository.ListAttachments(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		message.Attachments = attachments[message.ID]
+	}
+
+	return nil
+}
+
+// pruneUnsentAttachments deletes the expired attachments and their blobs. A
+// failed prune is left to the next upload.
+func (s *serv) pruneUnsentAttachments(ctx context.Context) {
+	blobKeys, err := s.messageRepository.DeleteUnsentAttachments(ctx, unsentAttachmentTTL)
+	if err != nil {
+		log.Printf("failed to prune unsent attachments: %v", err)
+		return
+	}
+
+	if len(blobKeys) > 0 {
+		log.Printf("pruned %d unsent attachments", len(blobKeys))
+	}
+
+	s.deleteBlobs(ctx, blobKeys)
+}
+
+func (s *serv) deleteBlob(ctx context.Context, key string) {
+	err := s.blobStore.Delete(context.WithoutCancel(ctx), key)
+	if err != nil {
+		log.Printf("failed to delete blob %s: %v", key, err)
+	}
+}
+
+// deleteBlobs removes the blobs of deleted attachments. A blob that fails to
+// go is only logged, its attachment is gone either way.
+func (s *serv) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		s.deleteBlob(ctx, key)
+	}
+}
+
+// checkUploadQuota tells whether an upload of size fits next to the unsent
+// attachments the user already holds.
+func checkUploadQuota(usage *model.UploadUsage, size int64) error {
+	if usage.Count >= maxUnsentAttachments || usage.Size+size > maxUnsentAttachmentSize {
+		return errUploadQuota
+	}
+
+	return nil
+}
+
+func attachmentError(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "attachment not found")
+	}
+
+	return err
+}
+
+func validateFileName(name string) error {
+	switch {
+	case name == "":
+		return status.Error(codes.InvalidArgument, "file name is empty")
+	case len(name) > maxFileNameLength:
+		return status.Error(codes.InvalidArgument, "file name is too long")
+	case !utf8.ValidString(name) || name == "." || name == ".." ||
+		strings.ContainsFunc(name, func(r rune) bool { return r == '/' || r == '\\' || unicode.IsControl(r) }):
+		return status.Error(codes.InvalidArgument, "invalid file name")
+	}
+
+	return nil
+}
+
+func newBlobKey() (string, error) {
+	raw := make([]byte, 16)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(raw), nil
+}
+
+// uploadReader turns the chunks of an upload into a reader, hashing and
+// counting the content on the way. err keeps what ended the upload, io.EOF
+// once recv ran out of chunks.
+type uploadReader struct {
+	recv  func() ([]byte, error)
+	chunk []byte
+	hash  hash.Hash
+	size  int64
+	head  []byte
+	err   error
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		r.chunk, r.err = r.recv()
+	}
+
+	if r.size+int64(len(r.chunk)) > maxAttachmentSize {
+		r.chunk = nil
+		r.err = errAttachmentTooLarge
+		return 0, r.err
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	r.size += int64(n)
+	r.hash.Write(p[:n])
+
+	if len(r.head) < sniffLength {
+		r.head = append(r.head, p[:min(n, sniffLength-len(r.head))]...)
+	}
+
+	return n, nil
+}
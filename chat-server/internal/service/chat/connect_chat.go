@@ -0,0 +1,45 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConnectChat subscribes the caller to the chat's live events. With a
+// threadRootID only the events of that thread are delivered.
+func (s *serv) ConnectChat(ctx context.Context, chatID, threadRootID int64) (<-chan *model.ChatEvent, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.Member(user.ID) == nil {
+		return nil, status.Error(codes.PermissionDenied, "user is not a member of the chat")
+	}
+
+	if threadRootID != 0 {
+		_, err = s.threadRoot(ctx, threadRootID, chatID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events := s.hub.subscribe(chatID, user.ID, threadRootID)
+	s.connectStream(user)
+
+	go func() {
+		<-ctx.Done()
+		s.hub.unsubscribe(chatID, events)
+		s.disconnectStream(ctx, user)
+	}()
+
+	return events, nil
+}
@@ -2,14 +2,85 @@ package chat
 
 import (
 	"chat-server/internal/model"
+	"chat-server/internal/repository"
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// Create makes the caller the owner of the new chat, the requested users join
+// as members. Direct chats have no owner and are created once per pair of
+// users, asking for an existing one returns its id.
 func (s *serv) Create(ctx context.Context, chat *model.Chat) (int64, error) {
+	creator, err := currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if chat.Type == model.ChatTypeUnspecified {
+		chat.Type = model.ChatTypeGroup
+	}
+
+	err = validateProfile(chat.Type, &model.UpdateChatData{
+		Title:       &chat.Title,
+		Description: &chat.Description,
+		AvatarURL:   &chat.AvatarURL,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	usernames := make([]string, 0, len(chat.Members))
+	for _, member := range chat.Members {
+		if member.Username != creator.Username {
+			usernames = append(usernames, member.Username)
+		}
+	}
+
+	users, err := s.resolveMembers(ctx, usernames)
+	if err != nil {
+		return 0, err
+	}
+
+	creatorRole := model.ChatRoleOwner
+	if chat.Type == model.ChatTypeDirect {
+		if len(users) != 1 {
+			return 0, status.Error(codes.InvalidArgument, "a direct chat needs exactly one other user")
+		}
+
+		id, errDirect := s.chatRepository.GetDirect(ctx, creator.ID, users[0].ID)
+		if errDirect == nil {
+			return id, nil
+		}
+		if !errors.Is(errDirect, repository.ErrNotFound) {
+			return 0, errDirect
+		}
+
+		creatorRole = model.ChatRoleMember
+	}
+
+	chat.Members = make([]*model.ChatMember, 0, len(users)+1)
+	chat.Members = append(chat.Members, &model.ChatMember{
+		UserID:   creator.ID,
+		Username: creator.Username,
+		Role:     creatorRole,
+	})
+	for _, user := range users {
+		chat.Members = append(chat.Members, &model.ChatMember{
+			UserID:   user.ID,
+			Username: user.Username,
+			Role:     model.ChatRoleMember,
+		})
+	}
+
 	var id int64
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
 		id, errTx = s.chatRepository.Create(ctx, chat)
 		if errTx != nil {
@@ -27,9 +98,60 @@ func (s *serv) Create(ctx context.Context, chat *model.Chat) (int64, error) {
 		return nil
 	})
 
+	// A concurrent request created the same direct chat first, it is the
+	// one both callers get.
+	if errors.Is(err, repository.ErrAlreadyExists) && chat.Type == model.ChatTypeDirect {
+		return s.chatRepository.GetDirect(ctx, creator.ID, users[0].ID)
+	}
 	if err != nil {
 		return 0, err
 	}
 
 	return id, nil
 }
+
+// resolveMembers looks the usernames up in auth and returns the matching
+// users in request order, duplicates removed. Unknown usernames and names
+// shared by several users are rejected.
+func (s *serv) resolveMembers(ctx context.Context, usernames []string) ([]*model.User, error) {
+	names := make([]string, 0, len(usernames))
+	for _, name := range usernames {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.userClient.GetByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]*model.User, len(users))
+	for _, user := range users {
+		if _, ok := found[user.Username]; ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("username %q is ambiguous", user.Username))
+		}
+		found[user.Username] = user
+	}
+
+	var unknown []string
+	members := make([]*model.User, 0, len(names))
+	for _, name := range names {
+		user, ok := found[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		members = append(members, user)
+	}
+
+	if len(unknown) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "unknown usernames: "+strings.Join(unknown, ", "))
+	}
+
+	return members, nil
+}
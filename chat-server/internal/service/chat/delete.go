@@ -8,9 +8,19 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Delete removes the chat with everything in it, the blobs of its attachments
+// are removed once the chat is gone.
 func (s *serv) Delete(ctx context.Context, id int64) error {
+	var blobKeys []string
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
+		// The attachments would go with the chat anyway, deleting them first
+		// tells which blobs to remove.
+		blobKeys, errTx = s.messageRepository.DeleteChatAttachments(ctx, id)
+		if errTx != nil {
+			return errors.Wrap(errTx, "failed to delete chat attachments from repository")
+		}
+
 		errTx = s.chatRepository.Delete(ctx, id)
 		if errTx != nil {
 			return errors.Wrap(errTx, "failed to delete chat from repository")
@@ -31,5 +41,7 @@ func (s *serv) Delete(ctx context.Context, id int64) error {
 		return errors.Wrap(err, "failed to delete chat with transaction")
 	}
 
+	s.deleteBlobs(ctx, blobKeys)
+
 	return nil
 }
@@ -0,0 +1,53 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+)
+
+// DeleteMessage leaves a tombstone in place of the message so the history
+// keeps its shape, the text is moved to the edit history. The attachments of
+// the message are deleted along with their blobs.
+func (s *serv) DeleteMessage(ctx context.Context, messageID int64) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.changeableMessage(ctx, user, messageID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		deleted  *model.Message
+		blobKeys []string
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		deleted, errTx = s.messageRepository.Delete(ctx, messageID, user.Username)
+		if errTx != nil {
+			return messageError(errTx)
+		}
+
+		blobKeys, errTx = s.messageRepository.DeleteMessageAttachments(ctx, messageID)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "message_deleted",
+			EntityID: messageID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, blobKeys)
+	s.hub.publish(deleted.ChatID, &model.ChatEvent{Type: model.ChatEventMessageDeleted, Message: deleted})
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"context"
+	"errors"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EditMessage replaces the message text, the previous text is kept in the
+// edit history. Members on the live stream receive the edited message.
+func (s *serv) EditMessage(ctx context.Context, messageID int64, text string) (*model.Message, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(text) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "message text is empty")
+	}
+
+	message, err := s.changeableMessage(ctx, user, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.Text == text {
+		return message, nil
+	}
+
+	var edited *model.Message
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		edited, errTx = s.messageRepository.Edit(ctx, messageID, text, user.Username)
+		if errTx != nil {
+			return messageError(errTx)
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "message_edited",
+			EntityID: messageID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.hub.publish(edited.ChatID, &model.ChatEvent{Type: model.ChatEventMessageEdited, Message: edited})
+
+	return edited, nil
+}
+
+// changeableMessage loads a message the user may edit or delete: a live,
+// non-system message written by the user, or by anyone if the user is a chat
+// admin.
+func (s *serv) changeableMessage(ctx context.Context, user *model.User, messageID int64) (*model.Message, error) {
+	message, err := s.messageRepository.Get(ctx, messageID)
+	if err != nil {
+		return nil, messageError(err)
+	}
+
+	chat, err := s.chatRepository.Get(ctx, message.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := chatMember(chat, user)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case message.System:
+		return nil, status.Error(codes.FailedPrecondition, "system messages can't be changed")
+	case message.DeletedAt.Valid:
+		return nil, status.Error(codes.FailedPrecondition, "message is deleted")
+	case message.From != user.Username && member.Role < model.ChatRoleAdmin:
+		return nil, status.Error(codes.PermissionDenied, "only the author and chat admins can change the message")
+	}
+
+	return message, nil
+}
+
+func messageError(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, "message not found")
+	}
+
+	return err
+}
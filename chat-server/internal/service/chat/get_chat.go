@@ -0,0 +1,42 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/repository"
+	"context"
+	"errors"
+)
+
+// GetChat returns the chat with its members and the last message, which is
+// nil for a chat without messages.
+func (s *serv) GetChat(ctx context.Context, chatID int64) (*model.Chat, *model.Message, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = chatMember(chat, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if chat.LastMessageID == 0 {
+		return chat, nil, nil
+	}
+
+	lastMessage, err := s.messageRepository.Get(ctx, chat.LastMessageID)
+	if err != nil {
+		// The message may have been removed since the chat was read.
+		if errors.Is(err, repository.ErrNotFound) {
+			return chat, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	return chat, lastMessage, nil
+}
@@ -0,0 +1,113 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxPresenceUsers = 100
+
+func (s *serv) GetPresence(ctx context.Context, userIDs []int64) ([]*model.Presence, error) {
+	_, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs, err = presenceUserIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSeen, err := s.presenceRepository.ListLastSeen(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*model.Presence, 0, len(userIDs))
+	for _, userID := range userIDs {
+		res = append(res, &model.Presence{
+			UserID:     userID,
+			Online:     s.presence.online(userID),
+			LastSeenAt: lastSeen[userID],
+		})
+	}
+
+	return res, nil
+}
+
+// WatchPresence streams the presence of the users until ctx ends, the stream
+// counts towards the caller's own presence.
+func (s *serv) WatchPresence(ctx context.Context, userIDs []int64) (<-chan *model.Presence, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs, err = presenceUserIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSeen, err := s.presenceRepository.ListLastSeen(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher := s.presence.watch(userIDs, lastSeen)
+	s.connectStream(user)
+
+	go func() {
+		<-ctx.Done()
+		s.presence.unwatch(watcher)
+		s.disconnectStream(ctx, user)
+	}()
+
+	return watcher.ch, nil
+}
+
+// connectStream counts an open stream of the user towards their presence.
+func (s *serv) connectStream(user *model.User) {
+	s.presence.connect(user.ID)
+}
+
+// disconnectStream stores when the user was last seen once their last stream
+// closes. ctx is the stream's own context, already done at this point.
+func (s *serv) disconnectStream(ctx context.Context, user *model.User) {
+	now := time.Now()
+	if !s.presence.disconnect(user.ID, now) {
+		return
+	}
+
+	err := s.presenceRepository.SaveLastSeen(context.WithoutCancel(ctx), user, now)
+	if err != nil {
+		log.Printf("failed to save last seen of user %d: %v", user.ID, err)
+	}
+}
+
+func presenceUserIDs(userIDs []int64) ([]int64, error) {
+	if len(userIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no user ids given")
+	}
+
+	seen := make(map[int64]struct{}, len(userIDs))
+	res := make([]int64, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+
+		seen[userID] = struct{}{}
+		res = append(res, userID)
+	}
+
+	if len(res) > maxPresenceUsers {
+		return nil, status.Error(codes.InvalidArgument, "too many user ids")
+	}
+
+	return res, nil
+}
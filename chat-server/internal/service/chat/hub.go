@@ -0,0 +1,125 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"log"
+	"sync"
+)
+
+const subscriberBufferSize = 64
+
+// hub fans out events published to a chat to every subscriber of that chat.
+// Subscribers that do not drain their buffer in time are evicted so that one
+// slow stream can't hold back the others. Each subscriber channel is mapped to
+// the user it belongs to and the thread it listens to.
+type hub struct {
+	mu          sync.RWMutex
+	subscribers map[int64]map[chan *model.ChatEvent]subscriber
+}
+
+// subscriber listens either to the top-level messages of a chat, threadRootID
+// zero, or to a single thread.
+type subscriber struct {
+	userID       int64
+	threadRootID int64
+}
+
+// wants reports whether the event belongs to the subscriber's scope. Events
+// about a thread root reach both the chat and the thread listeners, events
+// without a message reach everyone.
+func (s subscriber) wants(event *model.ChatEvent) bool {
+	message := event.Message
+	if message == nil {
+		return true
+	}
+
+	if s.threadRootID == 0 {
+		return message.ThreadRootID == 0
+	}
+
+	return message.ThreadRootID == s.threadRootID || message.ID == s.threadRootID
+}
+
+func newHub() *hub {
+	return &hub{
+		subscribers: make(map[int64]map[chan *model.ChatEvent]subscriber),
+	}
+}
+
+func (h *hub) subscribe(chatID, userID, threadRootID int64) chan *model.ChatEvent {
+	ch := make(chan *model.ChatEvent, subscriberBufferSize)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subscribers[chatID] == nil {
+		h.subscribers[chatID] = make(map[chan *model.ChatEvent]subscriber)
+	}
+	h.subscribers[chatID][ch] = subscriber{userID: userID, threadRootID: threadRootID}
+
+	return ch
+}
+
+// unsubscribe removes the subscriber and closes its channel. It is safe to call
+// for a subscriber that has already been evicted.
+func (h *hub) unsubscribe(chatID int64, ch chan *model.ChatEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.subscribers[chatID]
+	if !ok {
+		return
+	}
+
+	if _, ok = subs[ch]; !ok {
+		return
+	}
+
+	delete(subs, ch)
+	if len(subs) == 0 {
+		delete(h.subscribers, chatID)
+	}
+
+	close(ch)
+}
+
+func (h *hub) publish(chatID int64, event *model.ChatEvent) {
+	var slow []chan *model.ChatEvent
+
+	h.mu.RLock()
+	for ch, sub := range h.subscribers[chatID] {
+		if !sub.wants(event) {
+			continue
+		}
+
+		select {
+		case ch <- event:
+		default:
+			slow = append(slow, ch)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, ch := range slow {
+		log.Printf("evicting slow subscriber of chat %d", chatID)
+		h.unsubscribe(chatID, ch)
+	}
+}
+
+// disconnect closes every stream the user has open on the chat, used once the
+// user is no longer a member.
+func (h *hub) disconnect(chatID, userID int64) {
+	var streams []chan *model.ChatEvent
+
+	h.mu.RLock()
+	for ch, sub := range h.subscribers[chatID] {
+		if sub.userID == userID {
+			streams = append(streams, ch)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, ch := range streams {
+		h.unsubscribe(chatID, ch)
+	}
+}
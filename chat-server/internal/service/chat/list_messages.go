@@ -0,0 +1,83 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/pagination"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+type messageCursor struct {
+	BeforeID int64 `json:"before_id"`
+}
+
+func (s *serv) ListMessages(ctx context.Context, chatID int64, cursor string, limit uint64) ([]*model.Message, string, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var position messageCursor
+	if len(cursor) > 0 {
+		err = pagination.DecodeCursor(cursor, &position)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if chat.Member(user.ID) == nil {
+		return nil, "", status.Error(codes.PermissionDenied, "user is not a member of the chat")
+	}
+
+	limit = pageSize(limit)
+
+	// Fetch one extra row to find out whether there is another page.
+	messages, err := s.messageRepository.List(ctx, chatID, position.BeforeID, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if uint64(len(messages)) > limit {
+		messages = messages[:limit]
+		next, err = pagination.EncodeCursor(messageCursor{BeforeID: messages[len(messages)-1].ID})
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	err = s.attachReactions(ctx, user.ID, messages...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = s.attachFiles(ctx, messages...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return messages, next, nil
+}
+
+func pageSize(limit uint64) uint64 {
+	switch {
+	case limit == 0:
+		return defaultPageSize
+	case limit > maxPageSize:
+		return maxPageSize
+	default:
+		return limit
+	}
+}
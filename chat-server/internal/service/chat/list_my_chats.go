@@ -0,0 +1,55 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/pagination"
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type chatCursor struct {
+	LastActivityAt time.Time `json:"last_activity_at"`
+	ID             int64     `json:"id"`
+}
+
+func (s *serv) ListMyChats(ctx context.Context, cursor string, limit uint64) ([]*model.ChatSummary, string, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var before *model.Chat
+	if len(cursor) > 0 {
+		var position chatCursor
+		err = pagination.DecodeCursor(cursor, &position)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		before = &model.Chat{ID: position.ID, LastActivityAt: position.LastActivityAt}
+	}
+
+	limit = pageSize(limit)
+
+	// Fetch one extra row to find out whether there is another page.
+	chats, err := s.chatRepository.ListByUser(ctx, user.ID, before, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if uint64(len(chats)) <= limit {
+		return chats, "", nil
+	}
+
+	chats = chats[:limit]
+	last := chats[len(chats)-1].Chat
+	next, err := pagination.EncodeCursor(chatCursor{LastActivityAt: last.LastActivityAt, ID: last.ID})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return chats, next, nil
+}
@@ -0,0 +1,95 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/pagination"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type threadCursor struct {
+	AfterID int64 `json:"after_id"`
+}
+
+// ListThread returns the thread root and a page of its replies, oldest first.
+func (s *serv) ListThread(ctx context.Context, rootID int64, cursor string, limit uint64) (*model.Message, []*model.Message, string, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	var position threadCursor
+	if len(cursor) > 0 {
+		err = pagination.DecodeCursor(cursor, &position)
+		if err != nil {
+			return nil, nil, "", status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	root, err := s.threadRoot(ctx, rootID, 0)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	chat, err := s.chatRepository.Get(ctx, root.ChatID)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	_, err = chatMember(chat, user)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	limit = pageSize(limit)
+
+	// Fetch one extra row to find out whether there is another page.
+	replies, err := s.messageRepository.ListReplies(ctx, rootID, position.AfterID, limit+1)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	next := ""
+	if uint64(len(replies)) > limit {
+		replies = replies[:limit]
+		next, err = pagination.EncodeCursor(threadCursor{AfterID: replies[len(replies)-1].ID})
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	thread := append([]*model.Message{root}, replies...)
+
+	err = s.attachReactions(ctx, user.ID, thread...)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	err = s.attachFiles(ctx, thread...)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return root, replies, next, nil
+}
+
+// threadRoot loads a top-level message to be used as a thread root. A non-zero
+// chatID also requires the root to belong to that chat.
+func (s *serv) threadRoot(ctx context.Context, rootID, chatID int64) (*model.Message, error) {
+	root, err := s.messageRepository.Get(ctx, rootID)
+	if err != nil {
+		return nil, messageError(err)
+	}
+
+	if chatID != 0 && root.ChatID != chatID {
+		return nil, status.Error(codes.InvalidArgument, "thread root is in another chat")
+	}
+
+	if root.ThreadRootID != 0 {
+		return nil, status.Error(codes.InvalidArgument, "message is not a thread root")
+	}
+
+	return root, nil
+}
@@ -0,0 +1,374 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"fmt"
+	"strings"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddMembers adds the users to the chat with the role. The caller's role is
+// checked on the members as read before their names are looked up, and again
+// on the locked members before anyone is added.
+func (s *serv) AddMembers(ctx context.Context, chatID int64, usernames []string, role model.ChatRole) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(usernames) == 0 {
+		return status.Error(codes.InvalidArgument, "no usernames given")
+	}
+
+	switch role {
+	case model.ChatRoleUnspecified:
+		role = model.ChatRoleMember
+	case model.ChatRoleOwner:
+		return status.Error(codes.InvalidArgument, "a chat has exactly one owner")
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	err = checkAddMembers(chat, user, role)
+	if err != nil {
+		return err
+	}
+
+	users, err := s.resolveMembers(ctx, usernames)
+	if err != nil {
+		return err
+	}
+
+	var (
+		notices []*model.Message
+		denied  error
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.lockMembers(ctx, chat)
+		if errTx != nil {
+			return errTx
+		}
+
+		denied = checkAddMembers(chat, user, role)
+		if denied != nil {
+			return nil
+		}
+
+		var (
+			members []*model.ChatMember
+			added   []string
+		)
+		for _, u := range users {
+			if chat.Member(u.ID) != nil {
+				continue
+			}
+
+			members = append(members, &model.ChatMember{
+				ChatID:   chatID,
+				UserID:   u.ID,
+				Username: u.Username,
+				Role:     role,
+			})
+			added = append(added, u.Username)
+		}
+
+		if len(members) == 0 {
+			return nil
+		}
+
+		errTx = s.chatRepository.AddMembers(ctx, chatID, members)
+		if errTx != nil {
+			return errTx
+		}
+
+		notices, errTx = s.createSystemMessages(ctx, chatID, fmt.Sprintf("%s added %s", user.Username, strings.Join(added, ", ")))
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "chat_members_added",
+			EntityID: chatID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if denied != nil {
+		return denied
+	}
+
+	s.publish(chatID, notices)
+
+	return nil
+}
+
+// checkAddMembers tells whether the user may add members with the role.
+func checkAddMembers(chat *model.Chat, user *model.User, role model.ChatRole) error {
+	actor, err := chatMember(chat, user)
+	if err != nil {
+		return err
+	}
+
+	if chat.Type == model.ChatTypeDirect {
+		return errDirectMembers
+	}
+
+	if actor.Role < model.ChatRoleAdmin {
+		return status.Error(codes.PermissionDenied, "only the owner and admins can add members")
+	}
+	if role == model.ChatRoleAdmin && actor.Role != model.ChatRoleOwner {
+		return status.Error(codes.PermissionDenied, "only the owner can add admins")
+	}
+
+	return nil
+}
+
+// RemoveMember removes the member with the username, the roles are checked on
+// the locked members.
+func (s *serv) RemoveMember(ctx context.Context, chatID int64, username string) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		target  *model.ChatMember
+		notices []*model.Message
+		denied  error
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.lockMembers(ctx, chat)
+		if errTx != nil {
+			return errTx
+		}
+
+		target, denied = checkRemoveMember(chat, user, username)
+		if denied != nil {
+			return nil
+		}
+
+		errTx = s.chatRepository.RemoveMember(ctx, chatID, target.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		notices, errTx = s.createSystemMessages(ctx, chatID, fmt.Sprintf("%s removed %s", user.Username, target.Username))
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "chat_member_removed",
+			EntityID: chatID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if denied != nil {
+		return denied
+	}
+
+	s.publish(chatID, notices)
+	s.hub.disconnect(chatID, target.UserID)
+	s.signals.drop(chatID, target.UserID)
+
+	return nil
+}
+
+// checkRemoveMember returns the member with the username if the user may
+// remove them.
+func checkRemoveMember(chat *model.Chat, user *model.User, username string) (*model.ChatMember, error) {
+	actor, err := chatMember(chat, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.Type == model.ChatTypeDirect {
+		return nil, errDirectMembers
+	}
+
+	var target *model.ChatMember
+	for _, member := range chat.Members {
+		if member.Username == username {
+			target = member
+			break
+		}
+	}
+
+	switch {
+	case target == nil:
+		return nil, status.Error(codes.NotFound, "member not found")
+	case target.UserID == actor.UserID:
+		return nil, status.Error(codes.InvalidArgument, "use LeaveChat to leave the chat")
+	case actor.Role < model.ChatRoleAdmin || actor.Role <= target.Role:
+		return nil, status.Error(codes.PermissionDenied, "not allowed to remove this member")
+	}
+
+	return target, nil
+}
+
+// LeaveChat removes the caller from the chat. When the owner leaves, the
+// ownership passes to the longest-standing admin, or member if there are no
+// admins. The successor is picked from the locked members, so a concurrent
+// removal can't leave the chat without an owner.
+func (s *serv) LeaveChat(ctx context.Context, chatID int64) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		actor   *model.ChatMember
+		notices []*model.Message
+		denied  error
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.lockMembers(ctx, chat)
+		if errTx != nil {
+			return errTx
+		}
+
+		actor, denied = chatMember(chat, user)
+		if denied != nil {
+			return nil
+		}
+
+		if chat.Type == model.ChatTypeDirect {
+			denied = errDirectMembers
+			return nil
+		}
+
+		texts := []string{fmt.Sprintf("%s left the chat", actor.Username)}
+
+		var successor *model.ChatMember
+		if actor.Role == model.ChatRoleOwner {
+			successor = nextOwner(chat, actor.UserID)
+			if successor != nil {
+				texts = append(texts, fmt.Sprintf("%s is now the owner", successor.Username))
+			}
+		}
+
+		errTx = s.chatRepository.RemoveMember(ctx, chatID, actor.UserID)
+		if errTx != nil {
+			return errTx
+		}
+
+		if successor != nil {
+			errTx = s.chatRepository.UpdateMemberRole(ctx, chatID, successor.UserID, model.ChatRoleOwner)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		notices, errTx = s.createSystemMessages(ctx, chatID, texts...)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "chat_member_left",
+			EntityID: chatID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if denied != nil {
+		return denied
+	}
+
+	s.publish(chatID, notices)
+	s.hub.disconnect(chatID, actor.UserID)
+	s.signals.drop(chatID, actor.UserID)
+
+	return nil
+}
+
+var errDirectMembers = status.Error(codes.FailedPrecondition, "members of a direct chat can't be changed")
+
+// lockMembers replaces the members of the chat with its locked members, the
+// checks made on them hold until the transaction ends.
+func (s *serv) lockMembers(ctx context.Context, chat *model.Chat) error {
+	members, err := s.chatRepository.LockMembers(ctx, chat.ID)
+	if err != nil {
+		return err
+	}
+
+	chat.Members = members
+	return nil
+}
+
+func chatMember(chat *model.Chat, user *model.User) (*model.ChatMember, error) {
+	member := chat.Member(user.ID)
+	if member == nil {
+		return nil, status.Error(codes.PermissionDenied, "user is not a member of the chat")
+	}
+
+	return member, nil
+}
+
+// nextOwner picks the member with the highest role, members are ordered by
+// join time so ties go to whoever joined first.
+func nextOwner(chat *model.Chat, leaving int64) *model.ChatMember {
+	var next *model.ChatMember
+	for _, member := range chat.Members {
+		if member.UserID == leaving {
+			continue
+		}
+		if next == nil || member.Role > next.Role {
+			next = member
+		}
+	}
+
+	return next
+}
+
+func (s *serv) createSystemMessages(ctx context.Context, chatID int64, texts ...string) ([]*model.Message, error) {
+	messages := make([]*model.Message, 0, len(texts))
+	for _, text := range texts {
+		message, err := s.messageRepository.Create(ctx, &model.Message{
+			ChatID: chatID,
+			Text:   text,
+			System: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	if len(messages) > 0 {
+		err := s.chatRepository.UpdateLastMessage(ctx, chatID, messages[len(messages)-1].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return messages, nil
+}
+
+func (s *serv) publish(chatID int64, messages []*model.Message) {
+	for _, message := range messages {
+		s.hub.publish(chatID, &model.ChatEvent{Type: model.ChatEventMessage, Message: message})
+	}
+}
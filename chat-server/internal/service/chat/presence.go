@@ -0,0 +1,137 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"database/sql"
+	"log"
+	"sync"
+	"time"
+)
+
+// presence counts the open streams of every user, a user with at least one
+// stream is online. Watchers hear about the users they watch going online and
+// offline, slow watchers are evicted like slow hub subscribers.
+type presence struct {
+	mu       sync.Mutex
+	streams  map[int64]int
+	watchers map[int64]map[*presenceWatcher]struct{}
+}
+
+type presenceWatcher struct {
+	userIDs []int64
+	ch      chan *model.Presence
+}
+
+func newPresence() *presence {
+	return &presence{
+		streams:  make(map[int64]int),
+		watchers: make(map[int64]map[*presenceWatcher]struct{}),
+	}
+}
+
+// connect counts a new stream of the user.
+func (p *presence) connect(userID int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.streams[userID]++
+	if p.streams[userID] == 1 {
+		p.notify(&model.Presence{UserID: userID, Online: true})
+	}
+}
+
+// disconnect reports whether the closed stream was the user's last one.
+func (p *presence) disconnect(userID int64, at time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.streams[userID]--
+	if p.streams[userID] > 0 {
+		return false
+	}
+
+	delete(p.streams, userID)
+	p.notify(&model.Presence{UserID: userID, LastSeenAt: sql.NullTime{Time: at, Valid: true}})
+
+	return true
+}
+
+func (p *presence) online(userID int64) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.streams[userID] > 0
+}
+
+// watch registers a watcher of the users and queues their current state, the
+// last seen times of offline users are taken from lastSeen.
+func (p *presence) watch(userIDs []int64, lastSeen map[int64]sql.NullTime) *presenceWatcher {
+	w := &presenceWatcher{
+		userIDs: userIDs,
+		ch:      make(chan *model.Presence, len(userIDs)+subscriberBufferSize),
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, userID := range userIDs {
+		if p.watchers[userID] == nil {
+			p.watchers[userID] = make(map[*presenceWatcher]struct{})
+		}
+		p.watchers[userID][w] = struct{}{}
+
+		w.ch <- &model.Presence{
+			UserID:     userID,
+			Online:     p.streams[userID] > 0,
+			LastSeenAt: lastSeen[userID],
+		}
+	}
+
+	return w
+}
+
+// unwatch removes the watcher and closes its channel. It is safe to call for a
+// watcher that has already been evicted.
+func (p *presence) unwatch(w *presenceWatcher) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.remove(w)
+}
+
+// notify must be called with the lock held.
+func (p *presence) notify(change *model.Presence) {
+	for w := range p.watchers[change.UserID] {
+		select {
+		case w.ch <- change:
+		default:
+			log.Printf("evicting slow presence watcher")
+			p.remove(w)
+		}
+	}
+}
+
+// remove must be called with the lock held.
+func (p *presence) remove(w *presenceWatcher) {
+	removed := false
+	for _, userID := range w.userIDs {
+		watchers, ok := p.watchers[userID]
+		if !ok {
+			continue
+		}
+
+		if _, ok = watchers[w]; !ok {
+			continue
+		}
+
+		removed = true
+		delete(watchers, w)
+		if len(watchers) == 0 {
+			delete(p.watchers, userID)
+		}
+	}
+
+	if removed {
+		close(w.ch)
+	}
+}
@@ -0,0 +1,73 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PublishSignals applies the signals read with recv until it returns io.EOF or
+// fails. The signals set through the stream end with it, the stream counts
+// towards the caller's presence.
+func (s *serv) PublishSignals(ctx context.Context, recv func() (*model.Signal, error)) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.connectStream(user)
+	defer s.disconnectStream(ctx, user)
+
+	owner := s.signals.newOwner()
+	defer s.signals.release(owner)
+
+	for {
+		signal, err := recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = s.applySignal(ctx, owner, user, signal)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *serv) applySignal(ctx context.Context, owner int64, user *model.User, signal *model.Signal) error {
+	switch signal.Type {
+	case model.SignalTypeTyping, model.SignalTypeOnline, model.SignalTypeAway:
+	default:
+		return status.Error(codes.InvalidArgument, "unknown signal type")
+	}
+
+	if signal.Stop {
+		s.signals.stop(signal.ChatID, user.ID, signal.Type)
+		return nil
+	}
+
+	if s.signals.refresh(owner, signal.ChatID, user.ID, signal.Type) {
+		return nil
+	}
+
+	chat, err := s.chatRepository.Get(ctx, signal.ChatID)
+	if err != nil {
+		return err
+	}
+
+	_, err = chatMember(chat, user)
+	if err != nil {
+		return err
+	}
+
+	s.signals.set(owner, signal.ChatID, user, signal.Type)
+
+	return nil
+}
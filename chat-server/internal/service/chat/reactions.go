@@ -0,0 +1,142 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxEmojiLength leaves room for emoji built from several code points, like
+// flags and skin tone variants.
+const maxEmojiLength = 16
+
+func (s *serv) AddReaction(ctx context.Context, messageID int64, emoji string) error {
+	return s.changeReaction(ctx, messageID, emoji, true)
+}
+
+func (s *serv) RemoveReaction(ctx context.Context, messageID int64, emoji string) error {
+	return s.changeReaction(ctx, messageID, emoji, false)
+}
+
+// changeReaction adds or removes the caller's reaction. Repeating a change is
+// a no-op and publishes nothing.
+func (s *serv) changeReaction(ctx context.Context, messageID int64, emoji string, add bool) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = validateEmoji(emoji)
+	if err != nil {
+		return err
+	}
+
+	message, err := s.messageRepository.Get(ctx, messageID)
+	if err != nil {
+		return messageError(err)
+	}
+
+	chat, err := s.chatRepository.Get(ctx, message.ChatID)
+	if err != nil {
+		return err
+	}
+
+	_, err = chatMember(chat, user)
+	if err != nil {
+		return err
+	}
+
+	if add && message.DeletedAt.Valid {
+		return status.Error(codes.FailedPrecondition, "message is deleted")
+	}
+
+	eventType, action := model.ChatEventReactionAdded, "reaction_added"
+	if !add {
+		eventType, action = model.ChatEventReactionRemoved, "reaction_removed"
+	}
+
+	var (
+		changed bool
+		count   int64
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		if add {
+			changed, errTx = s.messageRepository.AddReaction(ctx, messageID, user.ID, emoji)
+		} else {
+			changed, errTx = s.messageRepository.RemoveReaction(ctx, messageID, user.ID, emoji)
+		}
+		if errTx != nil || !changed {
+			return errTx
+		}
+
+		count, errTx = s.messageRepository.CountReactions(ctx, messageID, emoji)
+		if errTx != nil {
+			return errTx
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   action,
+			EntityID: messageID,
+		})
+	})
+	if err != nil || !changed {
+		return err
+	}
+
+	s.hub.publish(message.ChatID, &model.ChatEvent{
+		Type:    eventType,
+		Message: message,
+		Reaction: &model.ReactionChange{
+			MessageID: messageID,
+			Emoji:     emoji,
+			UserID:    user.ID,
+			Username:  user.Username,
+			Count:     count,
+		},
+	})
+
+	return nil
+}
+
+// attachReactions loads the reaction counts of the messages as seen by userID.
+func (s *serv) attachReactions(ctx context.Context, userID int64, messages ...*model.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+
+	reactions, err := s.messageRepository.ListReactions(ctx, ids, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		message.Reactions = reactions[message.ID]
+	}
+
+	return nil
+}
+
+func validateEmoji(emoji string) error {
+	if len(emoji) == 0 {
+		return status.Error(codes.InvalidArgument, "emoji is empty")
+	}
+
+	if !utf8.ValidString(emoji) || utf8.RuneCountInString(emoji) > maxEmojiLength ||
+		strings.ContainsFunc(emoji, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) {
+		return status.Error(codes.InvalidArgument, "invalid emoji")
+	}
+
+	return nil
+}
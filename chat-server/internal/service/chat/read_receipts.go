@@ -0,0 +1,90 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarkRead advances the caller's read pointer in the chat and tells the
+// other members when it moved.
+func (s *serv) MarkRead(ctx context.Context, chatID, messageID int64) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	message, err := s.messageRepository.Get(ctx, messageID)
+	if err != nil {
+		return messageError(err)
+	}
+
+	if message.ChatID != chatID {
+		return status.Error(codes.InvalidArgument, "message is in another chat")
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	member, err := chatMember(chat, user)
+	if err != nil {
+		return err
+	}
+
+	if member.LastReadMessageID >= messageID {
+		return nil
+	}
+
+	advanced, err := s.chatRepository.MarkRead(ctx, chatID, user.ID, messageID)
+	if err != nil || !advanced {
+		return err
+	}
+
+	s.hub.publish(chatID, &model.ChatEvent{
+		Type: model.ChatEventRead,
+		Read: &model.ReadChange{
+			UserID:            user.ID,
+			Username:          user.Username,
+			LastReadMessageID: messageID,
+		},
+	})
+
+	return nil
+}
+
+// GetReadReceipts lists the members whose read pointer is at or past the
+// message, the author is left out.
+func (s *serv) GetReadReceipts(ctx context.Context, messageID int64) ([]*model.ChatMember, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := s.messageRepository.Get(ctx, messageID)
+	if err != nil {
+		return nil, messageError(err)
+	}
+
+	chat, err := s.chatRepository.Get(ctx, message.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = chatMember(chat, user)
+	if err != nil {
+		return nil, err
+	}
+
+	readers := make([]*model.ChatMember, 0, len(chat.Members))
+	for _, member := range chat.Members {
+		if member.Username != message.From && member.LastReadMessageID >= messageID {
+			readers = append(readers, member)
+		}
+	}
+
+	return readers, nil
+}
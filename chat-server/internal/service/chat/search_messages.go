@@ -0,0 +1,97 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"chat-server/internal/pagination"
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxQueryLength = 256
+
+type searchCursor struct {
+	Rank    float32 `json:"rank"`
+	AfterID int64   `json:"after_id"`
+}
+
+// SearchMessages finds messages in the caller's chats, best matches first.
+func (s *serv) SearchMessages(ctx context.Context, search *model.MessageSearch, cursor string, limit uint64) ([]*model.SearchResult, string, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	search.Query = strings.TrimSpace(search.Query)
+	switch {
+	case search.Query == "":
+		return nil, "", status.Error(codes.InvalidArgument, "query is empty")
+	case utf8.RuneCountInString(search.Query) > maxQueryLength:
+		return nil, "", status.Error(codes.InvalidArgument, "query is too long")
+	case !search.Since.IsZero() && !search.Until.IsZero() && !search.Until.After(search.Since):
+		return nil, "", status.Error(codes.InvalidArgument, "until must be after since")
+	}
+
+	var after *model.SearchResult
+	if len(cursor) > 0 {
+		var position searchCursor
+		err = pagination.DecodeCursor(cursor, &position)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		after = &model.SearchResult{Message: &model.Message{ID: position.AfterID}, Rank: position.Rank}
+	}
+
+	// The search itself is limited to the caller's chats, the check only
+	// tells a missing chat from one without a match.
+	if search.ChatID != 0 {
+		chat, err := s.chatRepository.Get(ctx, search.ChatID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		_, err = chatMember(chat, user)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	limit = pageSize(limit)
+
+	// Fetch one extra row to find out whether there is another page.
+	results, err := s.messageRepository.Search(ctx, user.ID, search, after, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if uint64(len(results)) > limit {
+		results = results[:limit]
+		last := results[len(results)-1]
+		next, err = pagination.EncodeCursor(searchCursor{Rank: last.Rank, AfterID: last.Message.ID})
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	messages := make([]*model.Message, 0, len(results))
+	for _, result := range results {
+		messages = append(messages, result.Message)
+	}
+
+	err = s.attachReactions(ctx, user.ID, messages...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = s.attachFiles(ctx, messages...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return results, next, nil
+}
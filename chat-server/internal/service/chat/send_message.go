@@ -3,25 +3,80 @@ package chat
 import (
 	"chat-server/internal/model"
 	"context"
-	"log"
 
 	logModel "github.com/makxtr/go-common/pkg/logger/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (s *serv) SendMessage(ctx context.Context, message *model.Message) error {
-	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		var errTx error
-		// In the future, we might want to save the message to the DB or send it to a message broker.
-		// For now, we just log it as per the original implementation.
-		log.Printf("Message received - From: %s, Text: %s, Timestamp: %v",
-			message.From,
-			message.Text,
-			message.Timestamp)
-		// Since the current API definition does not include a ChatID in SendMessageRequest,
-		// we cannot log a meaningful EntityID. We will use 0 for now.
+func (s *serv) SendMessage(ctx context.Context, message *model.Message) (*model.Message, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(message.Text) == 0 && len(message.AttachmentIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "message text is empty")
+	}
+
+	attachmentIDs := uniqueIDs(message.AttachmentIDs)
+	if len(attachmentIDs) > maxMessageAttachments {
+		return nil, status.Error(codes.InvalidArgument, "too many attachments")
+	}
+
+	// The sender is always the authenticated caller, whatever the client put into the message.
+	message.From = user.Username
+
+	var created, root *model.Message
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		chat, errTx := s.chatRepository.Get(ctx, message.ChatID)
+		if errTx != nil {
+			return errTx
+		}
+
+		sender := chat.Member(user.ID)
+		if sender == nil {
+			return status.Error(codes.PermissionDenied, "sender is not a member of the chat")
+		}
+		if chat.Type == model.ChatTypeChannel && sender.Role < model.ChatRoleAdmin {
+			return status.Error(codes.PermissionDenied, "only the owner and admins can post in a channel")
+		}
+
+		if message.ReplyToMessageID != 0 {
+			errTx = s.joinThread(ctx, message)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		created, errTx = s.messageRepository.Create(ctx, message)
+		if errTx != nil {
+			return errTx
+		}
+
+		if len(attachmentIDs) > 0 {
+			errTx = s.sendAttachments(ctx, created, user, attachmentIDs)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		if created.ThreadRootID != 0 {
+			root, errTx = s.messageRepository.AddReply(ctx, created.ThreadRootID)
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		errTx = s.chatRepository.UpdateLastMessage(ctx, created.ChatID, created.ID)
+		if errTx != nil {
+			return errTx
+		}
+
 		errTx = s.logRepository.Log(ctx, &logModel.Log{
 			Action:   "message_sent",
-			EntityID: 0,
+			EntityID: created.ID,
 		})
 		if errTx != nil {
 			return errTx
@@ -30,7 +85,52 @@ func (s *serv) SendMessage(ctx context.Context, message *model.Message) error {
 		return nil
 	})
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	s.hub.publish(created.ChatID, &model.ChatEvent{Type: model.ChatEventMessage, Message: created})
+	if root != nil {
+		s.hub.publish(root.ChatID, &model.ChatEvent{Type: model.ChatEventThreadUpdated, Message: root})
 	}
+
+	return created, nil
+}
+
+// joinThread puts a reply into the thread of the message it replies to, a
+// reply to a reply stays in the same thread.
+func (s *serv) joinThread(ctx context.Context, message *model.Message) error {
+	parent, err := s.messageRepository.Get(ctx, message.ReplyToMessageID)
+	if err != nil {
+		return messageError(err)
+	}
+
+	if parent.ChatID != message.ChatID {
+		return status.Error(codes.InvalidArgument, "replied message is in another chat")
+	}
+
+	if parent.DeletedAt.Valid {
+		return status.Error(codes.FailedPrecondition, "can't reply to a deleted message")
+	}
+
+	message.ThreadRootID = parent.ThreadRootID
+	if message.ThreadRootID == 0 {
+		message.ThreadRootID = parent.ID
+	}
+
 	return nil
 }
+
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+
+	return res
+}
@@ -1,26 +1,64 @@
 package chat
 
 import (
+	"chat-server/internal/client/rpc"
+	"chat-server/internal/identity"
+	"chat-server/internal/model"
 	"chat-server/internal/repository"
 	"chat-server/internal/service"
+	"chat-server/internal/storage"
+	"context"
 
 	"github.com/makxtr/go-common/pkg/db"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type serv struct {
-	chatRepository repository.ChatRepository
-	logRepository  repository.LogRepository
-	txManager      db.TxManager
+	chatRepository     repository.ChatRepository
+	messageRepository  repository.MessageRepository
+	presenceRepository repository.PresenceRepository
+	logRepository      repository.LogRepository
+	txManager          db.TxManager
+	userClient         rpc.UserClient
+	blobStore          storage.BlobStore
+
+	hub      *hub
+	signals  *signals
+	presence *presence
 }
 
 func NewService(
 	chatRepository repository.ChatRepository,
+	messageRepository repository.MessageRepository,
+	presenceRepository repository.PresenceRepository,
 	logRepository repository.LogRepository,
 	txManager db.TxManager,
+	userClient rpc.UserClient,
+	blobStore storage.BlobStore,
 ) service.ChatService {
+	h := newHub()
+
 	return &serv{
-		chatRepository: chatRepository,
-		logRepository:  logRepository,
-		txManager:      txManager,
+		chatRepository:     chatRepository,
+		messageRepository:  messageRepository,
+		presenceRepository: presenceRepository,
+		logRepository:      logRepository,
+		txManager:          txManager,
+		userClient:         userClient,
+		blobStore:          blobStore,
+		hub:                h,
+		signals:            newSignals(h),
+		presence:           newPresence(),
 	}
 }
+
+func currentUser(ctx context.Context) (*model.User, error) {
+	user, ok := identity.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	return user, nil
+}
@@ -0,0 +1,192 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"sync"
+	"time"
+)
+
+const (
+	typingTTL   = 5 * time.Second
+	presenceTTL = time.Minute
+)
+
+// signalSlot groups the signal types that replace each other, a member is
+// either online or away but can type in either state.
+type signalSlot int
+
+const (
+	signalSlotTyping signalSlot = iota
+	signalSlotPresence
+)
+
+func slotOf(signalType model.SignalType) (signalSlot, time.Duration) {
+	if signalType == model.SignalTypeTyping {
+		return signalSlotTyping, typingTTL
+	}
+
+	return signalSlotPresence, presenceTTL
+}
+
+type signalKey struct {
+	chatID int64
+	userID int64
+	slot   signalSlot
+}
+
+type activeSignal struct {
+	change    *model.SignalChange
+	owner     int64
+	expiresAt time.Time
+	timer     *time.Timer
+}
+
+// signals keeps the ephemeral signals of chat members in memory and announces
+// them through the hub. A signal ends when it expires, when it is stopped or
+// when the stream that set it, its owner, goes away.
+type signals struct {
+	hub *hub
+
+	mu        sync.Mutex
+	active    map[signalKey]*activeSignal
+	lastOwner int64
+}
+
+func newSignals(h *hub) *signals {
+	return &signals{
+		hub:    h,
+		active: make(map[signalKey]*activeSignal),
+	}
+}
+
+func (s *signals) newOwner() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastOwner++
+
+	return s.lastOwner
+}
+
+// refresh extends the signal if the user already has it active in the chat,
+// so that repeated signals neither hit the database nor reach the streams.
+func (s *signals) refresh(owner, chatID, userID int64, signalType model.SignalType) bool {
+	slot, ttl := slotOf(signalType)
+	key := signalKey{chatID: chatID, userID: userID, slot: slot}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	signal, ok := s.active[key]
+	if !ok || signal.change.Type != signalType {
+		return false
+	}
+
+	signal.owner = owner
+	signal.expiresAt = time.Now().Add(ttl)
+	signal.timer.Reset(ttl)
+
+	return true
+}
+
+// set starts the signal, replacing whatever the user had in the same slot.
+func (s *signals) set(owner, chatID int64, user *model.User, signalType model.SignalType) {
+	slot, ttl := slotOf(signalType)
+	key := signalKey{chatID: chatID, userID: user.ID, slot: slot}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if previous, ok := s.active[key]; ok {
+		previous.timer.Stop()
+	}
+
+	signal := &activeSignal{
+		change: &model.SignalChange{
+			UserID:   user.ID,
+			Username: user.Username,
+			Type:     signalType,
+			Active:   true,
+		},
+		owner:     owner,
+		expiresAt: time.Now().Add(ttl),
+	}
+	signal.timer = time.AfterFunc(ttl, func() {
+		s.expire(key, signal)
+	})
+	s.active[key] = signal
+
+	s.announce(chatID, signal.change)
+}
+
+// stop ends the signal if it is the one the user has active in the chat.
+func (s *signals) stop(chatID, userID int64, signalType model.SignalType) {
+	slot, _ := slotOf(signalType)
+	key := signalKey{chatID: chatID, userID: userID, slot: slot}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	signal, ok := s.active[key]
+	if !ok || signal.change.Type != signalType {
+		return
+	}
+
+	s.end(key, signal)
+}
+
+// release ends every signal the owner set last.
+func (s *signals) release(owner int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, signal := range s.active {
+		if signal.owner == owner {
+			s.end(key, signal)
+		}
+	}
+}
+
+// drop ends the user's signals in the chat, used once the user is no longer a
+// member.
+func (s *signals) drop(chatID, userID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, signal := range s.active {
+		if key.chatID == chatID && key.userID == userID {
+			s.end(key, signal)
+		}
+	}
+}
+
+func (s *signals) expire(key signalKey, signal *activeSignal) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The timer may fire while a refresh waits for the lock, the refresh has
+	// then rearmed it already.
+	if s.active[key] != signal || time.Now().Before(signal.expiresAt) {
+		return
+	}
+
+	s.end(key, signal)
+}
+
+// end must be called with the lock held.
+func (s *signals) end(key signalKey, signal *activeSignal) {
+	signal.timer.Stop()
+	delete(s.active, key)
+
+	s.announce(key.chatID, &model.SignalChange{
+		UserID:   signal.change.UserID,
+		Username: signal.change.Username,
+		Type:     signal.change.Type,
+	})
+}
+
+// announce publishes under the lock so that the streams see the changes of a
+// signal in the order they happened.
+func (s *signals) announce(chatID int64, change *model.SignalChange) {
+	s.hub.publish(chatID, &model.ChatEvent{Type: model.ChatEventSignal, Signal: change})
+}
@@ -0,0 +1,134 @@
+package chat
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+
+	logModel "github.com/makxtr/go-common/pkg/logger/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxTitleLength       = 100
+	maxDescriptionLength = 1000
+)
+
+// UpdateChat changes the profile of a group or channel, only the owner and
+// admins may do so. A new title is announced with a system message.
+func (s *serv) UpdateChat(ctx context.Context, chatID int64, data *model.UpdateChatData) error {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if data.Title == nil && data.Description == nil && data.AvatarURL == nil {
+		return status.Error(codes.InvalidArgument, "nothing to update")
+	}
+
+	chat, err := s.chatRepository.Get(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		notices []*model.Message
+		denied  error
+	)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := s.lockMembers(ctx, chat)
+		if errTx != nil {
+			return errTx
+		}
+
+		denied = checkUpdateChat(chat, user, data)
+		if denied != nil {
+			return nil
+		}
+
+		errTx = s.chatRepository.Update(ctx, chatID, data)
+		if errTx != nil {
+			return errTx
+		}
+
+		if data.Title != nil && *data.Title != chat.Title {
+			notices, errTx = s.createSystemMessages(ctx, chatID, fmt.Sprintf("%s renamed the chat to %q", user.Username, *data.Title))
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		return s.logRepository.Log(ctx, &logModel.Log{
+			Action:   "chat_updated",
+			EntityID: chatID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if denied != nil {
+		return denied
+	}
+
+	s.publish(chatID, notices)
+
+	return nil
+}
+
+// checkUpdateChat tells whether the user may update the chat with data, the
+// caller's role is checked on the locked members.
+func checkUpdateChat(chat *model.Chat, user *model.User, data *model.UpdateChatData) error {
+	actor, err := chatMember(chat, user)
+	if err != nil {
+		return err
+	}
+
+	if chat.Type == model.ChatTypeDirect {
+		return status.Error(codes.FailedPrecondition, "direct chats have no title, description or avatar")
+	}
+
+	if actor.Role < model.ChatRoleAdmin {
+		return status.Error(codes.PermissionDenied, "only the owner and admins can update the chat")
+	}
+
+	return validateProfile(chat.Type, data)
+}
+
+// validateProfile checks the set fields of data. Direct chats are shown by
+// the other user's name, so they can't have a profile of their own.
+func validateProfile(chatType model.ChatType, data *model.UpdateChatData) error {
+	switch chatType {
+	case model.ChatTypeDirect:
+		if nonEmpty(data.Title) || nonEmpty(data.Description) || nonEmpty(data.AvatarURL) {
+			return status.Error(codes.InvalidArgument, "direct chats have no title, description or avatar")
+		}
+		return nil
+	case model.ChatTypeGroup, model.ChatTypeChannel:
+	default:
+		return status.Error(codes.InvalidArgument, "unknown chat type")
+	}
+
+	if data.Title != nil && utf8.RuneCountInString(*data.Title) > maxTitleLength {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("title is longer than %d characters", maxTitleLength))
+	}
+
+	if data.Description != nil && utf8.RuneCountInString(*data.Description) > maxDescriptionLength {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("description is longer than %d characters", maxDescriptionLength))
+	}
+
+	if nonEmpty(data.AvatarURL) {
+		u, err := url.Parse(*data.AvatarURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return status.Error(codes.InvalidArgument, "avatar url must be an absolute http(s) url")
+		}
+	}
+
+	return nil
+}
+
+func nonEmpty(s *string) bool {
+	return s != nil && *s != ""
+}
@@ -0,0 +1,63 @@
+package legacymembers
+
+import (
+	"chat-server/internal/model"
+	"context"
+	"log"
+)
+
+// legacyLookupSize caps the usernames sent to auth in one call.
+const legacyLookupSize = 100
+
+// Resolve looks the members of chats created before user ids were stored up
+// in auth and moves them into their chats. It returns how many memberships
+// were resolved and the usernames that weren't: names auth doesn't know or
+// that several users share stay where they are.
+func (s *serv) Resolve(ctx context.Context) (int64, []string, error) {
+	usernames, err := s.chatRepository.ListLegacyUsernames(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		resolved   int64
+		unresolved []string
+	)
+	for start := 0; start < len(usernames); start += legacyLookupSize {
+		names := usernames[start:min(start+legacyLookupSize, len(usernames))]
+
+		users, err := s.userClient.GetByNames(ctx, names)
+		if err != nil {
+			return resolved, nil, err
+		}
+
+		found := make(map[string][]*model.User, len(users))
+		for _, user := range users {
+			found[user.Username] = append(found[user.Username], user)
+		}
+
+		err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+			for _, name := range names {
+				if len(found[name]) != 1 {
+					unresolved = append(unresolved, name)
+					continue
+				}
+
+				count, errTx := s.chatRepository.ResolveLegacyMember(ctx, found[name][0])
+				if errTx != nil {
+					return errTx
+				}
+				resolved += count
+			}
+
+			return nil
+		})
+		if err != nil {
+			return resolved, nil, err
+		}
+	}
+
+	log.Printf("resolved %d legacy chat memberships, %d usernames left unresolved", resolved, len(unresolved))
+
+	return resolved, unresolved, nil
+}
@@ -0,0 +1,29 @@
+package legacymembers
+
+import (
+	"chat-server/internal/client/rpc"
+	"chat-server/internal/repository"
+	"chat-server/internal/service"
+
+	"github.com/makxtr/go-common/pkg/db"
+)
+
+type serv struct {
+	chatRepository repository.ChatRepository
+	txManager      db.TxManager
+	userClient     rpc.UserClient
+}
+
+// NewService resolves the members of legacy chats, it is run once from the
+// command line and is not served.
+func NewService(
+	chatRepository repository.ChatRepository,
+	txManager db.TxManager,
+	userClient rpc.UserClient,
+) service.LegacyMembersService {
+	return &serv{
+		chatRepository: chatRepository,
+		txManager:      txManager,
+		userClient:     userClient,
+	}
+}
@@ -0,0 +1,115 @@
+package legacymembers_test
+
+import (
+	rpcMocks "chat-server/internal/client/rpc/mocks"
+	"chat-server/internal/model"
+	"chat-server/internal/repository/mocks"
+	"chat-server/internal/service"
+	legacyMembersService "chat-server/internal/service/legacymembers"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/makxtr/go-common/pkg/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Resolve(t *testing.T) {
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		alice  = &model.User{ID: 1, Username: "alice"}
+		bob    = &model.User{ID: 2, Username: "bob"}
+		otherB = &model.User{ID: 3, Username: "bob"}
+
+		authErr = errors.New("auth is down")
+	)
+
+	t.Run("known usernames are moved into their chats", func(t *testing.T) {
+		var resolvedUsers []*model.User
+
+		s := newService(mc,
+			func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListLegacyUsernamesMock.Expect(ctx).Return([]string{"alice", "bob", "carol"}, nil)
+				mock.ResolveLegacyMemberMock.Set(func(_ context.Context, user *model.User) (int64, error) {
+					resolvedUsers = append(resolvedUsers, user)
+					return 2, nil
+				})
+				return mock
+			},
+			func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Expect(ctx, []string{"alice", "bob", "carol"}).Return([]*model.User{alice, bob, otherB}, nil)
+				return mock
+			},
+		)
+
+		resolved, unresolved, err := s.Resolve(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), resolved)
+		require.Equal(t, []*model.User{alice}, resolvedUsers)
+		// bob is shared by two users and carol is unknown to auth.
+		require.Equal(t, []string{"bob", "carol"}, unresolved)
+	})
+
+	t.Run("nothing to resolve", func(t *testing.T) {
+		s := newService(mc,
+			func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListLegacyUsernamesMock.Return(nil, nil)
+				return mock
+			},
+			nil,
+		)
+
+		resolved, unresolved, err := s.Resolve(ctx)
+		require.NoError(t, err)
+		require.Zero(t, resolved)
+		require.Empty(t, unresolved)
+	})
+
+	t.Run("auth error", func(t *testing.T) {
+		s := newService(mc,
+			func(mc *minimock.Controller) *mocks.ChatRepositoryMock {
+				mock := mocks.NewChatRepositoryMock(mc)
+				mock.ListLegacyUsernamesMock.Return([]string{"alice"}, nil)
+				return mock
+			},
+			func(mc *minimock.Controller) *rpcMocks.UserClientMock {
+				mock := rpcMocks.NewUserClientMock(mc)
+				mock.GetByNamesMock.Return(nil, authErr)
+				return mock
+			},
+		)
+
+		_, _, err := s.Resolve(ctx)
+		require.ErrorIs(t, err, authErr)
+	})
+}
+
+// txManagerMock is a simple mock for TxManager that executes the function without transaction
+type txManagerMock struct{}
+
+func (tm *txManagerMock) ReadCommitted(ctx context.Context, fn db.Handler) error {
+	return fn(ctx)
+}
+
+func newService(
+	mc *minimock.Controller,
+	chatRepositoryMock func(mc *minimock.Controller) *mocks.ChatRepositoryMock,
+	userClientMock func(mc *minimock.Controller) *rpcMocks.UserClientMock,
+) service.LegacyMembersService {
+	chatRepo := mocks.NewChatRepositoryMock(mc)
+	if chatRepositoryMock != nil {
+		chatRepo = chatRepositoryMock(mc)
+	}
+	userClient := rpcMocks.NewUserClientMock(mc)
+	if userClientMock != nil {
+		userClient = userClientMock(mc)
+	}
+
+	return legacyMembersService.NewService(chatRepo, &txManagerMock{}, userClient)
+}
@@ -3,10 +3,38 @@ package service
 import (
 	"chat-server/internal/model"
 	"context"
+	"io"
 )
 
 type ChatService interface {
 	Create(ctx context.Context, chat *model.Chat) (int64, error)
 	Delete(ctx context.Context, id int64) error
-	SendMessage(ctx context.Context, message *model.Message) error
+	SendMessage(ctx context.Context, message *model.Message) (*model.Message, error)
+	ConnectChat(ctx context.Context, chatID, threadRootID int64) (<-chan *model.ChatEvent, error)
+	ListMessages(ctx context.Context, chatID int64, cursor string, limit uint64) ([]*model.Message, string, error)
+	AddMembers(ctx context.Context, chatID int64, usernames []string, role model.ChatRole) error
+	RemoveMember(ctx context.Context, chatID int64, username string) error
+	LeaveChat(ctx context.Context, chatID int64) error
+	GetChat(ctx context.Context, chatID int64) (*model.Chat, *model.Message, error)
+	ListMyChats(ctx context.Context, cursor string, limit uint64) ([]*model.ChatSummary, string, error)
+	UpdateChat(ctx context.Context, chatID int64, data *model.UpdateChatData) error
+	EditMessage(ctx context.Context, messageID int64, text string) (*model.Message, error)
+	DeleteMessage(ctx context.Context, messageID int64) error
+	AddReaction(ctx context.Context, messageID int64, emoji string) error
+	RemoveReaction(ctx context.Context, messageID int64, emoji string) error
+	MarkRead(ctx context.Context, chatID, messageID int64) error
+	GetReadReceipts(ctx context.Context, messageID int64) ([]*model.ChatMember, error)
+	PublishSignals(ctx context.Context, recv func() (*model.Signal, error)) error
+	GetPresence(ctx context.Context, userIDs []int64) ([]*model.Presence, error)
+	WatchPresence(ctx context.Context, userIDs []int64) (<-chan *model.Presence, error)
+	SearchMessages(ctx context.Context, search *model.MessageSearch, cursor string, limit uint64) ([]*model.SearchResult, string, error)
+	UploadAttachment(ctx context.Context, info *model.Attachment, recv func() ([]byte, error)) (*model.Attachment, error)
+	DownloadAttachment(ctx context.Context, id int64) (*model.Attachment, io.ReadCloser, error)
+	ListThread(ctx context.Context, rootID int64, cursor string, limit uint64) (*model.Message, []*model.Message, string, error)
+}
+
+// LegacyMembersService moves the members of chats created before user ids
+// were stored into their chats, it runs at startup and isn't served.
+type LegacyMembersService interface {
+	Resolve(ctx context.Context) (int64, []string, error)
 }
@@ -0,0 +1,3 @@
+package storage
+
+//go:generate minimock -i BlobStore -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,1159 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat-server/internal/storage.BlobStore -o blob_store_minimock.go -n BlobStoreMock -p mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// BlobStoreMock implements mm_storage.BlobStore
+type BlobStoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDelete          func(ctx context.Context, key string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, key string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mBlobStoreMockDelete
+
+	funcOpen          func(ctx context.Context, key string) (r1 io.ReadCloser, err error)
+	funcOpenOrigin    string
+	inspectFuncOpen   func(ctx context.Context, key string)
+	afterOpenCounter  uint64
+	beforeOpenCounter uint64
+	OpenMock          mBlobStoreMockOpen
+
+	funcPut          func(ctx context.Context, key string, content io.Reader) (err error)
+	funcPutOrigin    string
+	inspectFuncPut   func(ctx context.Context, key string, content io.Reader)
+	afterPutCounter  uint64
+	beforePutCounter uint64
+	PutMock          mBlobStoreMockPut
+}
+
+// NewBlobStoreMock returns a mock for mm_storage.BlobStore
+func NewBlobStoreMock(t minimock.Tester) *BlobStoreMock {
+	m := &BlobStoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteMock = mBlobStoreMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*BlobStoreMockDeleteParams{}
+
+	m.OpenMock = mBlobStoreMockOpen{mock: m}
+	m.OpenMock.callArgs = []*BlobStoreMockOpenParams{}
+
+	m.PutMock = mBlobStoreMockPut{mock: m}
+	m.PutMock.callArgs = []*BlobStoreMockPutParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBlobStoreMockDelete struct {
+	optional           bool
+	mock               *BlobStoreMock
+	defaultExpectation *BlobStoreMockDeleteExpectation
+	expectations       []*BlobStoreMockDeleteExpectation
+
+	callArgs []*BlobStoreMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BlobStoreMockDeleteExpectation specifies expectation struct of the BlobStore.Delete
+type BlobStoreMockDeleteExpectation struct {
+	mock               *BlobStoreMock
+	params             *BlobStoreMockDeleteParams
+	paramPtrs          *BlobStoreMockDeleteParamPtrs
+	expectationOrigins BlobStoreMockDeleteExpectationOrigins
+	results            *BlobStoreMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BlobStoreMockDeleteParams contains parameters of the BlobStore.Delete
+type BlobStoreMockDeleteParams struct {
+	ctx context.Context
+	key string
+}
+
+// BlobStoreMockDeleteParamPtrs contains pointers to parameters of the BlobStore.Delete
+type BlobStoreMockDeleteParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// BlobStoreMockDeleteResults contains results of the BlobStore.Delete
+type BlobStoreMockDeleteResults struct {
+	err error
+}
+
+// BlobStoreMockDeleteOrigins contains origins of expectations of the BlobStore.Delete
+type BlobStoreMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mBlobStoreMockDelete) Optional() *mBlobStoreMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for BlobStore.Delete
+func (mmDelete *mBlobStoreMockDelete) Expect(ctx context.Context, key string) *mBlobStoreMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStoreMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &BlobStoreMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("BlobStoreMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &BlobStoreMockDeleteParams{ctx, key}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlobStore.Delete
+func (mmDelete *mBlobStoreMockDelete) ExpectCtxParam1(ctx context.Context) *mBlobStoreMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStoreMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &BlobStoreMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("BlobStoreMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &BlobStoreMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectKeyParam2 sets up expected param key for BlobStore.Delete
+func (mmDelete *mBlobStoreMockDelete) ExpectKeyParam2(key string) *mBlobStoreMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStoreMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &BlobStoreMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("BlobStoreMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &BlobStoreMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.key = &key
+	mmDelete.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlobStore.Delete
+func (mmDelete *mBlobStoreMockDelete) Inspect(f func(ctx context.Context, key string)) *mBlobStoreMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for BlobStoreMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by BlobStore.Delete
+func (mmDelete *mBlobStoreMockDelete) Return(err error) *BlobStoreMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStoreMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &BlobStoreMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &BlobStoreMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the BlobStore.Delete method
+func (mmDelete *mBlobStoreMockDelete) Set(f func(ctx context.Context, key string) (err error)) *BlobStoreMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the BlobStore.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the BlobStore.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the BlobStore.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mBlobStoreMockDelete) When(ctx context.Context, key string) *BlobStoreMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("BlobStoreMock.Delete mock is already set by Set")
+	}
+
+	expectation := &BlobStoreMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &BlobStoreMockDeleteParams{ctx, key},
+		expectationOrigins: BlobStoreMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlobStore.Delete return parameters for the expectation previously defined by the When method
+func (e *BlobStoreMockDeleteExpectation) Then(err error) *BlobStoreMock {
+	e.results = &BlobStoreMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times BlobStore.Delete should be invoked
+func (mmDelete *mBlobStoreMockDelete) Times(n uint64) *mBlobStoreMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of BlobStoreMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mBlobStoreMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_storage.BlobStore
+func (mmDelete *BlobStoreMock) Delete(ctx context.Context, key string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, key)
+	}
+
+	mm_params := BlobStoreMockDeleteParams{ctx, key}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := BlobStoreMockDeleteParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("BlobStoreMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmDelete.t.Errorf("BlobStoreMock.Delete got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("BlobStoreMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the BlobStoreMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, key)
+	}
+	mmDelete.t.Fatalf("Unexpected call to BlobStoreMock.Delete. %v %v", ctx, key)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished BlobStoreMock.Delete invocations
+func (mmDelete *BlobStoreMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of BlobStoreMock.Delete invocations
+func (mmDelete *BlobStoreMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlobStoreMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mBlobStoreMockDelete) Calls() []*BlobStoreMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*BlobStoreMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *BlobStoreMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *BlobStoreMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlobStoreMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BlobStoreMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BlobStoreMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to BlobStoreMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlobStoreMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mBlobStoreMockOpen struct {
+	optional           bool
+	mock               *BlobStoreMock
+	defaultExpectation *BlobStoreMockOpenExpectation
+	expectations       []*BlobStoreMockOpenExpectation
+
+	callArgs []*BlobStoreMockOpenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BlobStoreMockOpenExpectation specifies expectation struct of the BlobStore.Open
+type BlobStoreMockOpenExpectation struct {
+	mock               *BlobStoreMock
+	params             *BlobStoreMockOpenParams
+	paramPtrs          *BlobStoreMockOpenParamPtrs
+	expectationOrigins BlobStoreMockOpenExpectationOrigins
+	results            *BlobStoreMockOpenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BlobStoreMockOpenParams contains parameters of the BlobStore.Open
+type BlobStoreMockOpenParams struct {
+	ctx context.Context
+	key string
+}
+
+// BlobStoreMockOpenParamPtrs contains pointers to parameters of the BlobStore.Open
+type BlobStoreMockOpenParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// BlobStoreMockOpenResults contains results of the BlobStore.Open
+type BlobStoreMockOpenResults struct {
+	r1  io.ReadCloser
+	err error
+}
+
+// BlobStoreMockOpenOrigins contains origins of expectations of the BlobStore.Open
+type BlobStoreMockOpenExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpen *mBlobStoreMockOpen) Optional() *mBlobStoreMockOpen {
+	mmOpen.optional = true
+	return mmOpen
+}
+
+// Expect sets up expected params for BlobStore.Open
+func (mmOpen *mBlobStoreMockOpen) Expect(ctx context.Context, key string) *mBlobStoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("BlobStoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &BlobStoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs != nil {
+		mmOpen.mock.t.Fatalf("BlobStoreMock.Open mock is already set by ExpectParams functions")
+	}
+
+	mmOpen.defaultExpectation.params = &BlobStoreMockOpenParams{ctx, key}
+	mmOpen.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpen.expectations {
+		if minimock.Equal(e.params, mmOpen.defaultExpectation.params) {
+			mmOpen.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpen.defaultExpectation.params)
+		}
+	}
+
+	return mmOpen
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlobStore.Open
+func (mmOpen *mBlobStoreMockOpen) ExpectCtxParam1(ctx context.Context) *mBlobStoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("BlobStoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &BlobStoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.params != nil {
+		mmOpen.mock.t.Fatalf("BlobStoreMock.Open mock is already set by Expect")
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs == nil {
+		mmOpen.defaultExpectation.paramPtrs = &BlobStoreMockOpenParamPtrs{}
+	}
+	mmOpen.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpen.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpen
+}
+
+// ExpectKeyParam2 sets up expected param key for BlobStore.Open
+func (mmOpen *mBlobStoreMockOpen) ExpectKeyParam2(key string) *mBlobStoreMockOpen {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("BlobStoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &BlobStoreMockOpenExpectation{}
+	}
+
+	if mmOpen.defaultExpectation.params != nil {
+		mmOpen.mock.t.Fatalf("BlobStoreMock.Open mock is already set by Expect")
+	}
+
+	if mmOpen.defaultExpectation.paramPtrs == nil {
+		mmOpen.defaultExpectation.paramPtrs = &BlobStoreMockOpenParamPtrs{}
+	}
+	mmOpen.defaultExpectation.paramPtrs.key = &key
+	mmOpen.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmOpen
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlobStore.Open
+func (mmOpen *mBlobStoreMockOpen) Inspect(f func(ctx context.Context, key string)) *mBlobStoreMockOpen {
+	if mmOpen.mock.inspectFuncOpen != nil {
+		mmOpen.mock.t.Fatalf("Inspect function is already set for BlobStoreMock.Open")
+	}
+
+	mmOpen.mock.inspectFuncOpen = f
+
+	return mmOpen
+}
+
+// Return sets up results that will be returned by BlobStore.Open
+func (mmOpen *mBlobStoreMockOpen) Return(r1 io.ReadCloser, err error) *BlobStoreMock {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("BlobStoreMock.Open mock is already set by Set")
+	}
+
+	if mmOpen.defaultExpectation == nil {
+		mmOpen.defaultExpectation = &BlobStoreMockOpenExpectation{mock: mmOpen.mock}
+	}
+	mmOpen.defaultExpectation.results = &BlobStoreMockOpenResults{r1, err}
+	mmOpen.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpen.mock
+}
+
+// Set uses given function f to mock the BlobStore.Open method
+func (mmOpen *mBlobStoreMockOpen) Set(f func(ctx context.Context, key string) (r1 io.ReadCloser, err error)) *BlobStoreMock {
+	if mmOpen.defaultExpectation != nil {
+		mmOpen.mock.t.Fatalf("Default expectation is already set for the BlobStore.Open method")
+	}
+
+	if len(mmOpen.expectations) > 0 {
+		mmOpen.mock.t.Fatalf("Some expectations are already set for the BlobStore.Open method")
+	}
+
+	mmOpen.mock.funcOpen = f
+	mmOpen.mock.funcOpenOrigin = minimock.CallerInfo(1)
+	return mmOpen.mock
+}
+
+// When sets expectation for the BlobStore.Open which will trigger the result defined by the following
+// Then helper
+func (mmOpen *mBlobStoreMockOpen) When(ctx context.Context, key string) *BlobStoreMockOpenExpectation {
+	if mmOpen.mock.funcOpen != nil {
+		mmOpen.mock.t.Fatalf("BlobStoreMock.Open mock is already set by Set")
+	}
+
+	expectation := &BlobStoreMockOpenExpectation{
+		mock:               mmOpen.mock,
+		params:             &BlobStoreMockOpenParams{ctx, key},
+		expectationOrigins: BlobStoreMockOpenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpen.expectations = append(mmOpen.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlobStore.Open return parameters for the expectation previously defined by the When method
+func (e *BlobStoreMockOpenExpectation) Then(r1 io.ReadCloser, err error) *BlobStoreMock {
+	e.results = &BlobStoreMockOpenResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times BlobStore.Open should be invoked
+func (mmOpen *mBlobStoreMockOpen) Times(n uint64) *mBlobStoreMockOpen {
+	if n == 0 {
+		mmOpen.mock.t.Fatalf("Times of BlobStoreMock.Open mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpen.expectedInvocations, n)
+	mmOpen.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpen
+}
+
+func (mmOpen *mBlobStoreMockOpen) invocationsDone() bool {
+	if len(mmOpen.expectations) == 0 && mmOpen.defaultExpectation == nil && mmOpen.mock.funcOpen == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpen.mock.afterOpenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpen.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Open implements mm_storage.BlobStore
+func (mmOpen *BlobStoreMock) Open(ctx context.Context, key string) (r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmOpen.beforeOpenCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpen.afterOpenCounter, 1)
+
+	mmOpen.t.Helper()
+
+	if mmOpen.inspectFuncOpen != nil {
+		mmOpen.inspectFuncOpen(ctx, key)
+	}
+
+	mm_params := BlobStoreMockOpenParams{ctx, key}
+
+	// Record call args
+	mmOpen.OpenMock.mutex.Lock()
+	mmOpen.OpenMock.callArgs = append(mmOpen.OpenMock.callArgs, &mm_params)
+	mmOpen.OpenMock.mutex.Unlock()
+
+	for _, e := range mmOpen.OpenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmOpen.OpenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpen.OpenMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpen.OpenMock.defaultExpectation.params
+		mm_want_ptrs := mmOpen.OpenMock.defaultExpectation.paramPtrs
+
+		mm_got := BlobStoreMockOpenParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpen.t.Errorf("BlobStoreMock.Open got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpen.OpenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmOpen.t.Errorf("BlobStoreMock.Open got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpen.OpenMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpen.t.Errorf("BlobStoreMock.Open got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpen.OpenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpen.OpenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpen.t.Fatal("No results are set for the BlobStoreMock.Open")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmOpen.funcOpen != nil {
+		return mmOpen.funcOpen(ctx, key)
+	}
+	mmOpen.t.Fatalf("Unexpected call to BlobStoreMock.Open. %v %v", ctx, key)
+	return
+}
+
+// OpenAfterCounter returns a count of finished BlobStoreMock.Open invocations
+func (mmOpen *BlobStoreMock) OpenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpen.afterOpenCounter)
+}
+
+// OpenBeforeCounter returns a count of BlobStoreMock.Open invocations
+func (mmOpen *BlobStoreMock) OpenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpen.beforeOpenCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlobStoreMock.Open.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpen *mBlobStoreMockOpen) Calls() []*BlobStoreMockOpenParams {
+	mmOpen.mutex.RLock()
+
+	argCopy := make([]*BlobStoreMockOpenParams, len(mmOpen.callArgs))
+	copy(argCopy, mmOpen.callArgs)
+
+	mmOpen.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenDone returns true if the count of the Open invocations corresponds
+// the number of defined expectations
+func (m *BlobStoreMock) MinimockOpenDone() bool {
+	if m.OpenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenMock.invocationsDone()
+}
+
+// MinimockOpenInspect logs each unmet expectation
+func (m *BlobStoreMock) MinimockOpenInspect() {
+	for _, e := range m.OpenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlobStoreMock.Open at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenCounter := mm_atomic.LoadUint64(&m.afterOpenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenMock.defaultExpectation != nil && afterOpenCounter < 1 {
+		if m.OpenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BlobStoreMock.Open at\n%s", m.OpenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BlobStoreMock.Open at\n%s with params: %#v", m.OpenMock.defaultExpectation.expectationOrigins.origin, *m.OpenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpen != nil && afterOpenCounter < 1 {
+		m.t.Errorf("Expected call to BlobStoreMock.Open at\n%s", m.funcOpenOrigin)
+	}
+
+	if !m.OpenMock.invocationsDone() && afterOpenCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlobStoreMock.Open at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenMock.expectedInvocations), m.OpenMock.expectedInvocationsOrigin, afterOpenCounter)
+	}
+}
+
+type mBlobStoreMockPut struct {
+	optional           bool
+	mock               *BlobStoreMock
+	defaultExpectation *BlobStoreMockPutExpectation
+	expectations       []*BlobStoreMockPutExpectation
+
+	callArgs []*BlobStoreMockPutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// BlobStoreMockPutExpectation specifies expectation struct of the BlobStore.Put
+type BlobStoreMockPutExpectation struct {
+	mock               *BlobStoreMock
+	params             *BlobStoreMockPutParams
+	paramPtrs          *BlobStoreMockPutParamPtrs
+	expectationOrigins BlobStoreMockPutExpectationOrigins
+	results            *BlobStoreMockPutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// BlobStoreMockPutParams contains parameters of the BlobStore.Put
+type BlobStoreMockPutParams struct {
+	ctx     context.Context
+	key     string
+	content io.Reader
+}
+
+// BlobStoreMockPutParamPtrs contains pointers to parameters of the BlobStore.Put
+type BlobStoreMockPutParamPtrs struct {
+	ctx     *context.Context
+	key     *string
+	content *io.Reader
+}
+
+// BlobStoreMockPutResults contains results of the BlobStore.Put
+type BlobStoreMockPutResults struct {
+	err error
+}
+
+// BlobStoreMockPutOrigins contains origins of expectations of the BlobStore.Put
+type BlobStoreMockPutExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originKey     string
+	originContent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPut *mBlobStoreMockPut) Optional() *mBlobStoreMockPut {
+	mmPut.optional = true
+	return mmPut
+}
+
+// Expect sets up expected params for BlobStore.Put
+func (mmPut *mBlobStoreMockPut) Expect(ctx context.Context, key string, content io.Reader) *mBlobStoreMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStoreMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.paramPtrs != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by ExpectParams functions")
+	}
+
+	mmPut.defaultExpectation.params = &BlobStoreMockPutParams{ctx, key, content}
+	mmPut.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPut.expectations {
+		if minimock.Equal(e.params, mmPut.defaultExpectation.params) {
+			mmPut.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPut.defaultExpectation.params)
+		}
+	}
+
+	return mmPut
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlobStore.Put
+func (mmPut *mBlobStoreMockPut) ExpectCtxParam1(ctx context.Context) *mBlobStoreMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStoreMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &BlobStoreMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPut.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// ExpectKeyParam2 sets up expected param key for BlobStore.Put
+func (mmPut *mBlobStoreMockPut) ExpectKeyParam2(key string) *mBlobStoreMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStoreMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &BlobStoreMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.key = &key
+	mmPut.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// ExpectContentParam3 sets up expected param content for BlobStore.Put
+func (mmPut *mBlobStoreMockPut) ExpectContentParam3(content io.Reader) *mBlobStoreMockPut {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStoreMockPutExpectation{}
+	}
+
+	if mmPut.defaultExpectation.params != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Expect")
+	}
+
+	if mmPut.defaultExpectation.paramPtrs == nil {
+		mmPut.defaultExpectation.paramPtrs = &BlobStoreMockPutParamPtrs{}
+	}
+	mmPut.defaultExpectation.paramPtrs.content = &content
+	mmPut.defaultExpectation.expectationOrigins.originContent = minimock.CallerInfo(1)
+
+	return mmPut
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlobStore.Put
+func (mmPut *mBlobStoreMockPut) Inspect(f func(ctx context.Context, key string, content io.Reader)) *mBlobStoreMockPut {
+	if mmPut.mock.inspectFuncPut != nil {
+		mmPut.mock.t.Fatalf("Inspect function is already set for BlobStoreMock.Put")
+	}
+
+	mmPut.mock.inspectFuncPut = f
+
+	return mmPut
+}
+
+// Return sets up results that will be returned by BlobStore.Put
+func (mmPut *mBlobStoreMockPut) Return(err error) *BlobStoreMock {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Set")
+	}
+
+	if mmPut.defaultExpectation == nil {
+		mmPut.defaultExpectation = &BlobStoreMockPutExpectation{mock: mmPut.mock}
+	}
+	mmPut.defaultExpectation.results = &BlobStoreMockPutResults{err}
+	mmPut.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPut.mock
+}
+
+// Set uses given function f to mock the BlobStore.Put method
+func (mmPut *mBlobStoreMockPut) Set(f func(ctx context.Context, key string, content io.Reader) (err error)) *BlobStoreMock {
+	if mmPut.defaultExpectation != nil {
+		mmPut.mock.t.Fatalf("Default expectation is already set for the BlobStore.Put method")
+	}
+
+	if len(mmPut.expectations) > 0 {
+		mmPut.mock.t.Fatalf("Some expectations are already set for the BlobStore.Put method")
+	}
+
+	mmPut.mock.funcPut = f
+	mmPut.mock.funcPutOrigin = minimock.CallerInfo(1)
+	return mmPut.mock
+}
+
+// When sets expectation for the BlobStore.Put which will trigger the result defined by the following
+// Then helper
+func (mmPut *mBlobStoreMockPut) When(ctx context.Context, key string, content io.Reader) *BlobStoreMockPutExpectation {
+	if mmPut.mock.funcPut != nil {
+		mmPut.mock.t.Fatalf("BlobStoreMock.Put mock is already set by Set")
+	}
+
+	expectation := &BlobStoreMockPutExpectation{
+		mock:               mmPut.mock,
+		params:             &BlobStoreMockPutParams{ctx, key, content},
+		expectationOrigins: BlobStoreMockPutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPut.expectations = append(mmPut.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlobStore.Put return parameters for the expectation previously defined by the When method
+func (e *BlobStoreMockPutExpectation) Then(err error) *BlobStoreMock {
+	e.results = &BlobStoreMockPutResults{err}
+	return e.mock
+}
+
+// Times sets number of times BlobStore.Put should be invoked
+func (mmPut *mBlobStoreMockPut) Times(n uint64) *mBlobStoreMockPut {
+	if n == 0 {
+		mmPut.mock.t.Fatalf("Times of BlobStoreMock.Put mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPut.expectedInvocations, n)
+	mmPut.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPut
+}
+
+func (mmPut *mBlobStoreMockPut) invocationsDone() bool {
+	if len(mmPut.expectations) == 0 && mmPut.defaultExpectation == nil && mmPut.mock.funcPut == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPut.mock.afterPutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPut.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Put implements mm_storage.BlobStore
+func (mmPut *BlobStoreMock) Put(ctx context.Context, key string, content io.Reader) (err error) {
+	mm_atomic.AddUint64(&mmPut.beforePutCounter, 1)
+	defer mm_atomic.AddUint64(&mmPut.afterPutCounter, 1)
+
+	mmPut.t.Helper()
+
+	if mmPut.inspectFuncPut != nil {
+		mmPut.inspectFuncPut(ctx, key, content)
+	}
+
+	mm_params := BlobStoreMockPutParams{ctx, key, content}
+
+	// Record call args
+	mmPut.PutMock.mutex.Lock()
+	mmPut.PutMock.callArgs = append(mmPut.PutMock.callArgs, &mm_params)
+	mmPut.PutMock.mutex.Unlock()
+
+	for _, e := range mmPut.PutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPut.PutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPut.PutMock.defaultExpectation.Counter, 1)
+		mm_want := mmPut.PutMock.defaultExpectation.params
+		mm_want_ptrs := mmPut.PutMock.defaultExpectation.paramPtrs
+
+		mm_got := BlobStoreMockPutParams{ctx, key, content}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPut.t.Errorf("BlobStoreMock.Put got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmPut.t.Errorf("BlobStoreMock.Put got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.content != nil && !minimock.Equal(*mm_want_ptrs.content, mm_got.content) {
+				mmPut.t.Errorf("BlobStoreMock.Put got unexpected parameter content, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPut.PutMock.defaultExpectation.expectationOrigins.originContent, *mm_want_ptrs.content, mm_got.content, minimock.Diff(*mm_want_ptrs.content, mm_got.content))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPut.t.Errorf("BlobStoreMock.Put got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPut.PutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPut.PutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPut.t.Fatal("No results are set for the BlobStoreMock.Put")
+		}
+		return (*mm_results).err
+	}
+	if mmPut.funcPut != nil {
+		return mmPut.funcPut(ctx, key, content)
+	}
+	mmPut.t.Fatalf("Unexpected call to BlobStoreMock.Put. %v %v %v", ctx, key, content)
+	return
+}
+
+// PutAfterCounter returns a count of finished BlobStoreMock.Put invocations
+func (mmPut *BlobStoreMock) PutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPut.afterPutCounter)
+}
+
+// PutBeforeCounter returns a count of BlobStoreMock.Put invocations
+func (mmPut *BlobStoreMock) PutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPut.beforePutCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlobStoreMock.Put.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPut *mBlobStoreMockPut) Calls() []*BlobStoreMockPutParams {
+	mmPut.mutex.RLock()
+
+	argCopy := make([]*BlobStoreMockPutParams, len(mmPut.callArgs))
+	copy(argCopy, mmPut.callArgs)
+
+	mmPut.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPutDone returns true if the count of the Put invocations corresponds
+// the number of defined expectations
+func (m *BlobStoreMock) MinimockPutDone() bool {
+	if m.PutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PutMock.invocationsDone()
+}
+
+// MinimockPutInspect logs each unmet expectation
+func (m *BlobStoreMock) MinimockPutInspect() {
+	for _, e := range m.PutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlobStoreMock.Put at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPutCounter := mm_atomic.LoadUint64(&m.afterPutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PutMock.defaultExpectation != nil && afterPutCounter < 1 {
+		if m.PutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to BlobStoreMock.Put at\n%s", m.PutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to BlobStoreMock.Put at\n%s with params: %#v", m.PutMock.defaultExpectation.expectationOrigins.origin, *m.PutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPut != nil && afterPutCounter < 1 {
+		m.t.Errorf("Expected call to BlobStoreMock.Put at\n%s", m.funcPutOrigin)
+	}
+
+	if !m.PutMock.invocationsDone() && afterPutCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlobStoreMock.Put at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PutMock.expectedInvocations), m.PutMock.expectedInvocationsOrigin, afterPutCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BlobStoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteInspect()
+
+			m.MinimockOpenInspect()
+
+			m.MinimockPutInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BlobStoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BlobStoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteDone() &&
+		m.MinimockOpenDone() &&
+		m.MinimockPutDone()
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+create table messages (
+    id bigserial primary key,
+    chat_id int not null references chats (id) on delete cascade,
+    from_username text not null,
+    text text not null,
+    created_at timestamp not null default now()
+);
+
+create index messages_chat_id_id_idx on messages (chat_id, id);
+
+-- +goose Down
+drop table messages;
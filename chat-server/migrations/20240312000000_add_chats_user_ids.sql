@@ -0,0 +1,7 @@
+-- +goose Up
+-- Auth user ids of the members, in the same order as usernames.
+-- Chats created before this migration keep an empty array.
+alter table chats add column user_ids bigint[] not null default '{}';
+
+-- +goose Down
+alter table chats drop column user_ids;
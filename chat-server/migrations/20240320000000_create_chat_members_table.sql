@@ -0,0 +1,70 @@
+-- +goose Up
+create table chat_members (
+    chat_id int not null references chats (id) on delete cascade,
+    user_id bigint not null,
+    username text not null,
+    role int not null,
+    joined_at timestamp not null default now(),
+    last_read_message_id bigint,
+    primary key (chat_id, user_id)
+);
+
+create index chat_members_user_id_idx on chat_members (user_id);
+
+-- Chats created before user_ids was added have no ids to migrate, and the
+-- ids can't be resolved here because users live in the auth database. Their
+-- members wait in legacy_chat_members until the server is run once with
+-- -resolve-legacy-members, which looks the usernames up in auth.
+create table legacy_chat_members (
+    chat_id int not null references chats (id) on delete cascade,
+    username text not null,
+    joined_at timestamp not null,
+    primary key (chat_id, username)
+);
+
+insert into legacy_chat_members (chat_id, username, joined_at)
+select c.id, m.username, c.created_at
+from chats c
+cross join lateral unnest(c.usernames) as m (username)
+where cardinality(c.user_ids) <> cardinality(c.usernames)
+on conflict do nothing;
+
+-- role 1 is MEMBER
+insert into chat_members (chat_id, user_id, username, role, joined_at)
+select c.id, m.user_id, m.username, 1, c.created_at
+from chats c
+cross join lateral unnest(c.user_ids, c.usernames) as m (user_id, username)
+where cardinality(c.user_ids) = cardinality(c.usernames)
+on conflict do nothing;
+
+alter table chats drop column usernames;
+alter table chats drop column user_ids;
+
+-- +goose Down
+alter table chats add column usernames text[] not null default '{}';
+alter table chats add column user_ids bigint[] not null default '{}';
+
+update chats c set
+    usernames = m.usernames,
+    user_ids = m.user_ids
+from (
+    select chat_id, array_agg(username order by joined_at, user_id) as usernames,
+           array_agg(user_id order by joined_at, user_id) as user_ids
+    from chat_members
+    group by chat_id
+) m
+where m.chat_id = c.id;
+
+-- Members still waiting for their ids go back without one, which marks the
+-- chat as legacy again.
+update chats c set
+    usernames = c.usernames || m.usernames
+from (
+    select chat_id, array_agg(username order by joined_at, username) as usernames
+    from legacy_chat_members
+    group by chat_id
+) m
+where m.chat_id = c.id;
+
+drop table legacy_chat_members;
+drop table chat_members;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- System messages ("alice added bob") are written by the server, from_username is empty for them.
+alter table messages add column system boolean not null default false;
+
+-- +goose Down
+alter table messages drop column system;
@@ -0,0 +1,25 @@
+-- +goose Up
+alter table chats add column title text not null default '';
+alter table chats add column last_message_id bigint references messages (id) on delete set null;
+alter table chats add column last_activity_at timestamp not null default now();
+
+update chats c set
+    last_message_id = m.id,
+    last_activity_at = m.created_at
+from (
+    select distinct on (chat_id) chat_id, id, created_at
+    from messages
+    order by chat_id, id desc
+) m
+where m.chat_id = c.id;
+
+update chats set last_activity_at = created_at where last_message_id is null;
+
+-- ListMyChats pages through a user's chats by last activity.
+create index chats_last_activity_at_id_idx on chats (last_activity_at, id);
+
+-- +goose Down
+drop index chats_last_activity_at_id_idx;
+alter table chats drop column last_activity_at;
+alter table chats drop column last_message_id;
+alter table chats drop column title;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Chats created before types existed are all groups.
+alter table chats add column type smallint not null default 2;
+alter table chats add column description text not null default '';
+alter table chats add column avatar_url text not null default '';
+-- direct_key is "<lower user id>:<higher user id>" for direct chats and null
+-- otherwise, so there is at most one direct chat per pair of users.
+alter table chats add column direct_key text;
+
+create unique index chats_direct_key_idx on chats (direct_key) where direct_key is not null;
+
+-- +goose Down
+drop index chats_direct_key_idx;
+alter table chats drop column direct_key;
+alter table chats drop column avatar_url;
+alter table chats drop column description;
+alter table chats drop column type;
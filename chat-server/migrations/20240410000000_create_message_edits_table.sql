@@ -0,0 +1,20 @@
+-- +goose Up
+alter table messages add column edited_at timestamp;
+alter table messages add column deleted_at timestamp;
+
+-- message_edits keeps every text a message had before it was edited or
+-- deleted, edited_by is the user who made the change.
+create table message_edits (
+    id bigserial primary key,
+    message_id bigint not null references messages (id) on delete cascade,
+    text text not null,
+    edited_by text not null,
+    edited_at timestamp not null default now()
+);
+
+create index message_edits_message_id_idx on message_edits (message_id);
+
+-- +goose Down
+drop table message_edits;
+alter table messages drop column deleted_at;
+alter table messages drop column edited_at;
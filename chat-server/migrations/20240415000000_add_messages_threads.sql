@@ -0,0 +1,15 @@
+-- +goose Up
+alter table messages add column reply_to_message_id bigint references messages (id) on delete set null;
+alter table messages add column thread_root_id bigint references messages (id) on delete cascade;
+alter table messages add column reply_count integer not null default 0;
+alter table messages add column last_reply_at timestamp;
+
+-- ListThread pages through the replies of a root.
+create index messages_thread_root_id_id_idx on messages (thread_root_id, id) where thread_root_id is not null;
+
+-- +goose Down
+drop index messages_thread_root_id_id_idx;
+alter table messages drop column last_reply_at;
+alter table messages drop column reply_count;
+alter table messages drop column thread_root_id;
+alter table messages drop column reply_to_message_id;
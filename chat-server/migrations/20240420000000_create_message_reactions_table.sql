@@ -0,0 +1,11 @@
+-- +goose Up
+create table message_reactions (
+    message_id bigint not null references messages (id) on delete cascade,
+    user_id bigint not null,
+    emoji text not null,
+    created_at timestamp not null default now(),
+    primary key (message_id, user_id, emoji)
+);
+
+-- +goose Down
+drop table message_reactions;
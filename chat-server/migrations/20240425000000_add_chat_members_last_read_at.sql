@@ -0,0 +1,9 @@
+-- +goose Up
+alter table chat_members add column last_read_at timestamp;
+
+-- Lets the unread counters of ListMyChats be answered from the index alone.
+create index messages_chat_id_id_unread_idx on messages (chat_id, id) include (from_username) where deleted_at is null;
+
+-- +goose Down
+drop index messages_chat_id_id_unread_idx;
+alter table chat_members drop column last_read_at;
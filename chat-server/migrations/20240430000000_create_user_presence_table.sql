@@ -0,0 +1,11 @@
+-- +goose Up
+create table user_presence (
+    user_id bigint primary key,
+    username text not null,
+    last_seen_at timestamp not null
+);
+
+create index user_presence_username_idx on user_presence (username);
+
+-- +goose Down
+drop table user_presence;
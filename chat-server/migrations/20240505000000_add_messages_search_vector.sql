@@ -0,0 +1,11 @@
+-- +goose Up
+-- The simple configuration doesn't stem words, messages are written in more
+-- than one language.
+alter table messages add column search_vector tsvector
+    generated always as (to_tsvector('simple', text)) stored;
+
+create index messages_search_vector_idx on messages using gin (search_vector);
+
+-- +goose Down
+drop index messages_search_vector_idx;
+alter table messages drop column search_vector;
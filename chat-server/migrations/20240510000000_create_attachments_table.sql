@@ -0,0 +1,19 @@
+-- +goose Up
+create table attachments (
+    id serial primary key,
+    chat_id int not null references chats (id) on delete cascade,
+    message_id bigint references messages (id) on delete cascade,
+    uploader_id bigint not null,
+    uploaded_by text not null,
+    file_name text not null,
+    mime_type text not null,
+    size bigint not null,
+    sha256 text not null,
+    blob_key text not null unique,
+    created_at timestamp not null default now()
+);
+
+create index attachments_message_id_idx on attachments (message_id);
+
+-- +goose Down
+drop table attachments;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Chats migrated from the usernames array got their members without roles,
+-- so no one can manage them. The longest-standing member of each group and
+-- channel without an owner becomes it, ties go to the lowest user id.
+-- role 3 is OWNER, type 1 is DIRECT
+update chat_members m set
+    role = 3
+from (
+    select distinct on (cm.chat_id) cm.chat_id, cm.user_id
+    from chat_members cm
+    join chats c on c.id = cm.chat_id
+    where c.type <> 1
+      and not exists (
+          select 1 from chat_members o where o.chat_id = cm.chat_id and o.role = 3
+      )
+    order by cm.chat_id, cm.joined_at, cm.user_id
+) f
+where m.chat_id = f.chat_id and m.user_id = f.user_id;
+
+-- +goose Down
+-- The promoted owners can't be told apart from the ones chosen since, they
+-- keep their role.
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Presence is looked up by user id, usernames aren't unique.
+drop index user_presence_username_idx;
+alter table user_presence drop column username;
+
+-- +goose Down
+alter table user_presence add column username text not null default '';
+create index user_presence_username_idx on user_presence (username);
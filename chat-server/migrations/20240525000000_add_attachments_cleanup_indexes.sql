@@ -0,0 +1,11 @@
+-- +goose Up
+-- Unsent attachments are counted per uploader for the quota and pruned once
+-- they expire, deleting a chat deletes its attachments first.
+create index attachments_unsent_uploader_id_idx on attachments (uploader_id, created_at) where message_id is null;
+create index attachments_unsent_created_at_idx on attachments (created_at) where message_id is null;
+create index attachments_chat_id_idx on attachments (chat_id);
+
+-- +goose Down
+drop index attachments_chat_id_idx;
+drop index attachments_unsent_created_at_idx;
+drop index attachments_unsent_uploader_id_idx;
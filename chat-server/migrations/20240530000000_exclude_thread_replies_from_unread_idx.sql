@@ -0,0 +1,8 @@
+-- +goose Up
+-- Thread replies don't count as unread, the index leaves them out as well.
+drop index messages_chat_id_id_unread_idx;
+create index messages_chat_id_id_unread_idx on messages (chat_id, id) include (from_username) where deleted_at is null and thread_root_id is null;
+
+-- +goose Down
+drop index messages_chat_id_id_unread_idx;
+create index messages_chat_id_id_unread_idx on messages (chat_id, id) include (from_username) where deleted_at is null;
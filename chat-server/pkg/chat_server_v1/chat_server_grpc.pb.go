@@ -25,7 +25,39 @@ const _ = grpc.SupportPackageIsVersion7
 type ChatServerV1Client interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	ConnectChat(ctx context.Context, in *ConnectChatRequest, opts ...grpc.CallOption) (ChatServerV1_ConnectChatClient, error)
+	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error)
+	AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	LeaveChat(ctx context.Context, in *LeaveChatRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetChat(ctx context.Context, in *GetChatRequest, opts ...grpc.CallOption) (*GetChatResponse, error)
+	ListMyChats(ctx context.Context, in *ListMyChatsRequest, opts ...grpc.CallOption) (*ListMyChatsResponse, error)
+	UpdateChat(ctx context.Context, in *UpdateChatRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error)
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListThread(ctx context.Context, in *ListThreadRequest, opts ...grpc.CallOption) (*ListThreadResponse, error)
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetReadReceipts(ctx context.Context, in *GetReadReceiptsRequest, opts ...grpc.CallOption) (*GetReadReceiptsResponse, error)
+	// PublishSignals carries the caller's ephemeral signals into the chats they
+	// name. Nothing is stored, signals that aren't refreshed expire and the
+	// signals of a stream are cleared when it ends.
+	PublishSignals(ctx context.Context, opts ...grpc.CallOption) (ChatServerV1_PublishSignalsClient, error)
+	// GetPresence tells which of the users hold a stream open to the server.
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error)
+	// WatchPresence keeps the caller online while it is open and streams the
+	// presence of the users, first their current state, then every change.
+	WatchPresence(ctx context.Context, in *WatchPresenceRequest, opts ...grpc.CallOption) (ChatServerV1_WatchPresenceClient, error)
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// UploadAttachment stores a file in a chat, the first request carries the
+	// info and the following ones the content. The attachment is then sent with
+	// SendMessage within a day, unsent attachments expire and count towards
+	// the uploader's quota until then.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (ChatServerV1_UploadAttachmentClient, error)
+	// DownloadAttachment streams the info of the attachment, then its content.
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (ChatServerV1_DownloadAttachmentClient, error)
 }
 
 type chatServerV1Client struct {
@@ -54,8 +86,8 @@ func (c *chatServerV1Client) Delete(ctx context.Context, in *DeleteRequest, opts
 	return out, nil
 }
 
-func (c *chatServerV1Client) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
+func (c *chatServerV1Client) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error) {
+	out := new(SendMessageResponse)
 	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/SendMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -63,13 +95,353 @@ func (c *chatServerV1Client) SendMessage(ctx context.Context, in *SendMessageReq
 	return out, nil
 }
 
+func (c *chatServerV1Client) ConnectChat(ctx context.Context, in *ConnectChatRequest, opts ...grpc.CallOption) (ChatServerV1_ConnectChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatServerV1_ServiceDesc.Streams[0], "/chat_server_v1.ChatServerV1/ConnectChat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatServerV1ConnectChatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChatServerV1_ConnectChatClient interface {
+	Recv() (*ChatEvent, error)
+	grpc.ClientStream
+}
+
+type chatServerV1ConnectChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServerV1ConnectChatClient) Recv() (*ChatEvent, error) {
+	m := new(ChatEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatServerV1Client) ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error) {
+	out := new(ListMessagesResponse)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/ListMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/AddMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/RemoveMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) LeaveChat(ctx context.Context, in *LeaveChatRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/LeaveChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) GetChat(ctx context.Context, in *GetChatRequest, opts ...grpc.CallOption) (*GetChatResponse, error) {
+	out := new(GetChatResponse)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/GetChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) ListMyChats(ctx context.Context, in *ListMyChatsRequest, opts ...grpc.CallOption) (*ListMyChatsResponse, error) {
+	out := new(ListMyChatsResponse)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/ListMyChats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) UpdateChat(ctx context.Context, in *UpdateChatRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/UpdateChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error) {
+	out := new(EditMessageResponse)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/EditMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/DeleteMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) ListThread(ctx context.Context, in *ListThreadRequest, opts ...grpc.CallOption) (*ListThreadResponse, error) {
+	out := new(ListThreadResponse)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/ListThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/AddReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/RemoveReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/MarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) GetReadReceipts(ctx context.Context, in *GetReadReceiptsRequest, opts ...grpc.CallOption) (*GetReadReceiptsResponse, error) {
+	out := new(GetReadReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/GetReadReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) PublishSignals(ctx context.Context, opts ...grpc.CallOption) (ChatServerV1_PublishSignalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatServerV1_ServiceDesc.Streams[1], "/chat_server_v1.ChatServerV1/PublishSignals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatServerV1PublishSignalsClient{stream}
+	return x, nil
+}
+
+type ChatServerV1_PublishSignalsClient interface {
+	Send(*Signal) error
+	CloseAndRecv() (*empty.Empty, error)
+	grpc.ClientStream
+}
+
+type chatServerV1PublishSignalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServerV1PublishSignalsClient) Send(m *Signal) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *chatServerV1PublishSignalsClient) CloseAndRecv() (*empty.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(empty.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatServerV1Client) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error) {
+	out := new(GetPresenceResponse)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/GetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) WatchPresence(ctx context.Context, in *WatchPresenceRequest, opts ...grpc.CallOption) (ChatServerV1_WatchPresenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatServerV1_ServiceDesc.Streams[2], "/chat_server_v1.ChatServerV1/WatchPresence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatServerV1WatchPresenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChatServerV1_WatchPresenceClient interface {
+	Recv() (*Presence, error)
+	grpc.ClientStream
+}
+
+type chatServerV1WatchPresenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServerV1WatchPresenceClient) Recv() (*Presence, error) {
+	m := new(Presence)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatServerV1Client) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, "/chat_server_v1.ChatServerV1/SearchMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServerV1Client) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (ChatServerV1_UploadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatServerV1_ServiceDesc.Streams[3], "/chat_server_v1.ChatServerV1/UploadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatServerV1UploadAttachmentClient{stream}
+	return x, nil
+}
+
+type ChatServerV1_UploadAttachmentClient interface {
+	Send(*UploadAttachmentRequest) error
+	CloseAndRecv() (*UploadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type chatServerV1UploadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServerV1UploadAttachmentClient) Send(m *UploadAttachmentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *chatServerV1UploadAttachmentClient) CloseAndRecv() (*UploadAttachmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chatServerV1Client) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (ChatServerV1_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatServerV1_ServiceDesc.Streams[4], "/chat_server_v1.ChatServerV1/DownloadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatServerV1DownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChatServerV1_DownloadAttachmentClient interface {
+	Recv() (*DownloadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type chatServerV1DownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServerV1DownloadAttachmentClient) Recv() (*DownloadAttachmentResponse, error) {
+	m := new(DownloadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ChatServerV1Server is the server API for ChatServerV1 service.
 // All implementations must embed UnimplementedChatServerV1Server
 // for forward compatibility
 type ChatServerV1Server interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
-	SendMessage(context.Context, *SendMessageRequest) (*empty.Empty, error)
+	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
+	ConnectChat(*ConnectChatRequest, ChatServerV1_ConnectChatServer) error
+	ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error)
+	AddMembers(context.Context, *AddMembersRequest) (*empty.Empty, error)
+	RemoveMember(context.Context, *RemoveMemberRequest) (*empty.Empty, error)
+	LeaveChat(context.Context, *LeaveChatRequest) (*empty.Empty, error)
+	GetChat(context.Context, *GetChatRequest) (*GetChatResponse, error)
+	ListMyChats(context.Context, *ListMyChatsRequest) (*ListMyChatsResponse, error)
+	UpdateChat(context.Context, *UpdateChatRequest) (*empty.Empty, error)
+	EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error)
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*empty.Empty, error)
+	ListThread(context.Context, *ListThreadRequest) (*ListThreadResponse, error)
+	AddReaction(context.Context, *AddReactionRequest) (*empty.Empty, error)
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*empty.Empty, error)
+	MarkRead(context.Context, *MarkReadRequest) (*empty.Empty, error)
+	GetReadReceipts(context.Context, *GetReadReceiptsRequest) (*GetReadReceiptsResponse, error)
+	// PublishSignals carries the caller's ephemeral signals into the chats they
+	// name. Nothing is stored, signals that aren't refreshed expire and the
+	// signals of a stream are cleared when it ends.
+	PublishSignals(ChatServerV1_PublishSignalsServer) error
+	// GetPresence tells which of the users hold a stream open to the server.
+	GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error)
+	// WatchPresence keeps the caller online while it is open and streams the
+	// presence of the users, first their current state, then every change.
+	WatchPresence(*WatchPresenceRequest, ChatServerV1_WatchPresenceServer) error
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// UploadAttachment stores a file in a chat, the first request carries the
+	// info and the following ones the content. The attachment is then sent with
+	// SendMessage within a day, unsent attachments expire and count towards
+	// the uploader's quota until then.
+	UploadAttachment(ChatServerV1_UploadAttachmentServer) error
+	// DownloadAttachment streams the info of the attachment, then its content.
+	DownloadAttachment(*DownloadAttachmentRequest, ChatServerV1_DownloadAttachmentServer) error
 	mustEmbedUnimplementedChatServerV1Server()
 }
 
@@ -83,9 +455,72 @@ func (UnimplementedChatServerV1Server) Create(context.Context, *CreateRequest) (
 func (UnimplementedChatServerV1Server) Delete(context.Context, *DeleteRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedChatServerV1Server) SendMessage(context.Context, *SendMessageRequest) (*empty.Empty, error) {
+func (UnimplementedChatServerV1Server) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedChatServerV1Server) ConnectChat(*ConnectChatRequest, ChatServerV1_ConnectChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConnectChat not implemented")
+}
+func (UnimplementedChatServerV1Server) ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
+}
+func (UnimplementedChatServerV1Server) AddMembers(context.Context, *AddMembersRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMembers not implemented")
+}
+func (UnimplementedChatServerV1Server) RemoveMember(context.Context, *RemoveMemberRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedChatServerV1Server) LeaveChat(context.Context, *LeaveChatRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveChat not implemented")
+}
+func (UnimplementedChatServerV1Server) GetChat(context.Context, *GetChatRequest) (*GetChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChat not implemented")
+}
+func (UnimplementedChatServerV1Server) ListMyChats(context.Context, *ListMyChatsRequest) (*ListMyChatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyChats not implemented")
+}
+func (UnimplementedChatServerV1Server) UpdateChat(context.Context, *UpdateChatRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChat not implemented")
+}
+func (UnimplementedChatServerV1Server) EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedChatServerV1Server) DeleteMessage(context.Context, *DeleteMessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedChatServerV1Server) ListThread(context.Context, *ListThreadRequest) (*ListThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListThread not implemented")
+}
+func (UnimplementedChatServerV1Server) AddReaction(context.Context, *AddReactionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedChatServerV1Server) RemoveReaction(context.Context, *RemoveReactionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedChatServerV1Server) MarkRead(context.Context, *MarkReadRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedChatServerV1Server) GetReadReceipts(context.Context, *GetReadReceiptsRequest) (*GetReadReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadReceipts not implemented")
+}
+func (UnimplementedChatServerV1Server) PublishSignals(ChatServerV1_PublishSignalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PublishSignals not implemented")
+}
+func (UnimplementedChatServerV1Server) GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedChatServerV1Server) WatchPresence(*WatchPresenceRequest, ChatServerV1_WatchPresenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPresence not implemented")
+}
+func (UnimplementedChatServerV1Server) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedChatServerV1Server) UploadAttachment(ChatServerV1_UploadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedChatServerV1Server) DownloadAttachment(*DownloadAttachmentRequest, ChatServerV1_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
 func (UnimplementedChatServerV1Server) mustEmbedUnimplementedChatServerV1Server() {}
 
 // UnsafeChatServerV1Server may be embedded to opt out of forward compatibility for this service.
@@ -153,26 +588,519 @@ func _ChatServerV1_SendMessage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// ChatServerV1_ServiceDesc is the grpc.ServiceDesc for ChatServerV1 service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ChatServerV1_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "chat_server_v1.ChatServerV1",
-	HandlerType: (*ChatServerV1Server)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _ChatServerV1_Create_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _ChatServerV1_Delete_Handler,
-		},
-		{
-			MethodName: "SendMessage",
-			Handler:    _ChatServerV1_SendMessage_Handler,
+func _ChatServerV1_ConnectChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConnectChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServerV1Server).ConnectChat(m, &chatServerV1ConnectChatServer{stream})
+}
+
+type ChatServerV1_ConnectChatServer interface {
+	Send(*ChatEvent) error
+	grpc.ServerStream
+}
+
+type chatServerV1ConnectChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatServerV1ConnectChatServer) Send(m *ChatEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChatServerV1_ListMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).ListMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/ListMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).ListMessages(ctx, req.(*ListMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_AddMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).AddMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/AddMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).AddMembers(ctx, req.(*AddMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/RemoveMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_LeaveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).LeaveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/LeaveChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).LeaveChat(ctx, req.(*LeaveChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_GetChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).GetChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/GetChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).GetChat(ctx, req.(*GetChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_ListMyChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).ListMyChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/ListMyChats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).ListMyChats(ctx, req.(*ListMyChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_UpdateChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).UpdateChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/UpdateChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).UpdateChat(ctx, req.(*UpdateChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/EditMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/DeleteMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_ListThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).ListThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/ListThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).ListThread(ctx, req.(*ListThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/AddReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/RemoveReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/MarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_GetReadReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).GetReadReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/GetReadReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).GetReadReceipts(ctx, req.(*GetReadReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_PublishSignals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ChatServerV1Server).PublishSignals(&chatServerV1PublishSignalsServer{stream})
+}
+
+type ChatServerV1_PublishSignalsServer interface {
+	SendAndClose(*empty.Empty) error
+	Recv() (*Signal, error)
+	grpc.ServerStream
+}
+
+type chatServerV1PublishSignalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatServerV1PublishSignalsServer) SendAndClose(m *empty.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *chatServerV1PublishSignalsServer) Recv() (*Signal, error) {
+	m := new(Signal)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ChatServerV1_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/GetPresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).GetPresence(ctx, req.(*GetPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_WatchPresence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPresenceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServerV1Server).WatchPresence(m, &chatServerV1WatchPresenceServer{stream})
+}
+
+type ChatServerV1_WatchPresenceServer interface {
+	Send(*Presence) error
+	grpc.ServerStream
+}
+
+type chatServerV1WatchPresenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatServerV1WatchPresenceServer) Send(m *Presence) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChatServerV1_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServerV1Server).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat_server_v1.ChatServerV1/SearchMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServerV1Server).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatServerV1_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ChatServerV1Server).UploadAttachment(&chatServerV1UploadAttachmentServer{stream})
+}
+
+type ChatServerV1_UploadAttachmentServer interface {
+	SendAndClose(*UploadAttachmentResponse) error
+	Recv() (*UploadAttachmentRequest, error)
+	grpc.ServerStream
+}
+
+type chatServerV1UploadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatServerV1UploadAttachmentServer) SendAndClose(m *UploadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *chatServerV1UploadAttachmentServer) Recv() (*UploadAttachmentRequest, error) {
+	m := new(UploadAttachmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ChatServerV1_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServerV1Server).DownloadAttachment(m, &chatServerV1DownloadAttachmentServer{stream})
+}
+
+type ChatServerV1_DownloadAttachmentServer interface {
+	Send(*DownloadAttachmentResponse) error
+	grpc.ServerStream
+}
+
+type chatServerV1DownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatServerV1DownloadAttachmentServer) Send(m *DownloadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ChatServerV1_ServiceDesc is the grpc.ServiceDesc for ChatServerV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChatServerV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chat_server_v1.ChatServerV1",
+	HandlerType: (*ChatServerV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ChatServerV1_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ChatServerV1_Delete_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _ChatServerV1_SendMessage_Handler,
+		},
+		{
+			MethodName: "ListMessages",
+			Handler:    _ChatServerV1_ListMessages_Handler,
+		},
+		{
+			MethodName: "AddMembers",
+			Handler:    _ChatServerV1_AddMembers_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _ChatServerV1_RemoveMember_Handler,
+		},
+		{
+			MethodName: "LeaveChat",
+			Handler:    _ChatServerV1_LeaveChat_Handler,
+		},
+		{
+			MethodName: "GetChat",
+			Handler:    _ChatServerV1_GetChat_Handler,
+		},
+		{
+			MethodName: "ListMyChats",
+			Handler:    _ChatServerV1_ListMyChats_Handler,
+		},
+		{
+			MethodName: "UpdateChat",
+			Handler:    _ChatServerV1_UpdateChat_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _ChatServerV1_EditMessage_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _ChatServerV1_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "ListThread",
+			Handler:    _ChatServerV1_ListThread_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _ChatServerV1_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _ChatServerV1_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _ChatServerV1_MarkRead_Handler,
+		},
+		{
+			MethodName: "GetReadReceipts",
+			Handler:    _ChatServerV1_GetReadReceipts_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _ChatServerV1_GetPresence_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _ChatServerV1_SearchMessages_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ConnectChat",
+			Handler:       _ChatServerV1_ConnectChat_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PublishSignals",
+			Handler:       _ChatServerV1_PublishSignals_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchPresence",
+			Handler:       _ChatServerV1_WatchPresence_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _ChatServerV1_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _ChatServerV1_DownloadAttachment_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat_server.proto",
 }
@@ -28,6 +28,20 @@ gcloud iam service-accounts add-iam-policy-binding \
   --role="roles/iam.serviceAccountUser" \
   --project=$PROJECT_ID
 
+# 3. Bucket for chat attachments, Cloud Run mounts it at BLOB_DIR
+echo "🪣 Setting up the attachments bucket..."
+BLOB_BUCKET="${PROJECT_ID}-chat-blobs"
+if ! gcloud storage buckets describe gs://$BLOB_BUCKET --project=$PROJECT_ID > /dev/null 2>&1; then
+  gcloud storage buckets create gs://$BLOB_BUCKET \
+    --project=$PROJECT_ID \
+    --location=$REGION \
+    --uniform-bucket-level-access
+fi
+
+gcloud storage buckets add-iam-policy-binding gs://$BLOB_BUCKET \
+  --member="serviceAccount:${PROJECT_NUMBER}-compute@developer.gserviceaccount.com" \
+  --role="roles/storage.objectAdmin"
+
 echo ""
 echo "✅ Permissions configured!"
 echo ""
@@ -19,6 +19,9 @@ if [ ! -f "neon-credentials.txt" ]; then
   echo ""
   echo "AUTH_DATABASE_URL=postgresql://..."
   echo "CHAT_DATABASE_URL=postgresql://..."
+  echo "SMTP_HOST=smtp.example.com"
+  echo "SMTP_USERNAME=..."
+  echo "SMTP_PASSWORD=..."
   exit 1
 fi
 
@@ -32,6 +35,16 @@ if [ -z "$AUTH_DATABASE_URL" ] || [ -z "$CHAT_DATABASE_URL" ]; then
   exit 1
 fi
 
+# The auth service deploys with the smtp mailer and mounts all three SMTP
+# secrets, the deploy fails if any of them is missing
+if [ -z "$SMTP_HOST" ] || [ -z "$SMTP_USERNAME" ] || [ -z "$SMTP_PASSWORD" ]; then
+  echo "Error: SMTP_HOST, SMTP_USERNAME or SMTP_PASSWORD not set in neon-credentials.txt"
+  echo "SMTP_HOST: ${SMTP_HOST:-not set}"
+  echo "SMTP_USERNAME: ${SMTP_USERNAME:-not set}"
+  echo "SMTP_PASSWORD: $([ -n "$SMTP_PASSWORD" ] && echo set || echo not set)"
+  exit 1
+fi
+
 echo "================================================"
 echo "Enabling Secret Manager API..."
 echo "================================================"
@@ -69,6 +82,39 @@ else
     --project=$PROJECT_ID
 fi
 
+# Create token signing keys for auth (kept as-is if they already exist,
+# rotating them would log out every user)
+for SECRET in auth-access-token-key auth-refresh-token-key; do
+  if gcloud secrets describe $SECRET --project=$PROJECT_ID &>/dev/null; then
+    echo "$SECRET secret already exists, skipping..."
+  else
+    echo "Creating $SECRET secret..."
+    openssl rand -base64 48 | tr -d '\n' | gcloud secrets create $SECRET \
+      --data-file=- \
+      --replication-policy="automatic" \
+      --project=$PROJECT_ID
+  fi
+done
+
+# SMTP settings for the auth mailer, taken from SMTP_HOST, SMTP_USERNAME and
+# SMTP_PASSWORD in neon-credentials.txt
+for VAR in SMTP_HOST SMTP_USERNAME SMTP_PASSWORD; do
+  SECRET="auth-$(echo $VAR | tr '[:upper:]_' '[:lower:]-')"
+  VALUE="${!VAR}"
+  if gcloud secrets describe $SECRET --project=$PROJECT_ID &>/dev/null; then
+    echo "Updating $SECRET secret..."
+    echo -n "$VALUE" | gcloud secrets versions add $SECRET \
+      --data-file=- \
+      --project=$PROJECT_ID
+  else
+    echo "Creating $SECRET secret..."
+    echo -n "$VALUE" | gcloud secrets create $SECRET \
+      --data-file=- \
+      --replication-policy="automatic" \
+      --project=$PROJECT_ID
+  fi
+done
+
 echo "================================================"
 echo "Setting up permissions..."
 echo "================================================"
@@ -101,6 +147,13 @@ if [ ! -z "$COMPUTE_SA" ]; then
     --member="serviceAccount:$COMPUTE_SA" \
     --role="roles/secretmanager.secretAccessor" \
     --project=$PROJECT_ID
+
+  for SECRET in auth-access-token-key auth-refresh-token-key auth-smtp-host auth-smtp-username auth-smtp-password; do
+    gcloud secrets add-iam-policy-binding $SECRET \
+      --member="serviceAccount:$COMPUTE_SA" \
+      --role="roles/secretmanager.secretAccessor" \
+      --project=$PROJECT_ID
+  done
 fi
 
 echo "================================================"
@@ -110,6 +163,9 @@ echo ""
 echo "Secrets created:"
 echo "  - auth-database-url"
 echo "  - chat-database-url"
+echo "  - auth-access-token-key"
+echo "  - auth-refresh-token-key"
+echo "  - auth-smtp-host, auth-smtp-username, auth-smtp-password"
 echo ""
 echo "Next steps:"
 echo "1. Update cloudbuild.yaml files (already done if you run update script)"